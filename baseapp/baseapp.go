@@ -6,9 +6,11 @@ import (
 	"io/ioutil"
 	"os"
 	"reflect"
+	"runtime"
 	"runtime/debug"
 	"sort"
 	"strings"
+	"sync"
 
 	"errors"
 
@@ -21,6 +23,7 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/cosmos/cosmos-sdk/store"
+	"github.com/cosmos/cosmos-sdk/store/snapshots"
 	storetypes "github.com/cosmos/cosmos-sdk/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
@@ -38,9 +41,19 @@ const (
 	runTxModeSimulate runTxMode = iota
 	// Deliver a transaction
 	runTxModeDeliver runTxMode = iota
+	// Check a transaction replacing a pending one against the committed state
+	runTxModeReplace runTxMode = iota
 
 	// MainStoreKey is the string representation of the main store
 	MainStoreKey = "main"
+
+	// DefaultSigCacheSize is the default number of valid signatures cached by
+	// the app
+	DefaultSigCacheSize = 10000
+
+	// DefaultBlockStatsRetention is the default number of recent blocks whose
+	// statistics are retained by the app
+	DefaultBlockStatsRetention = 100
 )
 
 // StoreLoader defines a customizable function to control how we load the CommitMultiStore
@@ -49,6 +62,12 @@ const (
 // (or removed a substore) between two versions of the software.
 type StoreLoader func(ms sdk.CommitMultiStore) error
 
+// versionAcquirer is implemented by the multistores able to keep a committed
+// version from being pruned while it is queried.
+type versionAcquirer interface {
+	AcquireVersion(version int64) (release func(), err error)
+}
+
 // BaseApp reflects the ABCI application implementation.
 type BaseApp struct {
 	// initialized on creation
@@ -64,13 +83,17 @@ type BaseApp struct {
 	// set upon LoadVersion or LoadLatestVersion.
 	baseKey *sdk.KVStoreKey // Main KVStore in cms
 
-	anteHandler    sdk.AnteHandler  // ante handler for fee and auth
-	initChainer    sdk.InitChainer  // initialize state with validators and state blob
-	beginBlocker   sdk.BeginBlocker // logic to run before any txs
-	endBlocker     sdk.EndBlocker   // logic to run after all txs, and to determine valset changes
-	addrPeerFilter sdk.PeerFilter   // filter peers by address and port
-	idPeerFilter   sdk.PeerFilter   // filter peers by node ID
-	fauxMerkleMode bool             // if true, IAVL MountStores uses MountStoresDB for simulation speed.
+	anteHandler    sdk.AnteHandler    // ante handler for fee and auth
+	postHandler    sdk.PostHandler    // post handler, run after the msgs of a tx succeed
+	circuitBreaker sdk.CircuitBreaker // rejects disabled msgs before they are handled
+	txPriority     sdk.TxPriority     // priority of txs in the mempool, reported by CheckTx
+	sigPreVerifier sdk.SigPreVerifier // signatures of the txs of a block, verified before delivering them
+	initChainer    sdk.InitChainer    // initialize state with validators and state blob
+	beginBlocker   sdk.BeginBlocker   // logic to run before any txs
+	endBlocker     sdk.EndBlocker     // logic to run after all txs, and to determine valset changes
+	addrPeerFilter sdk.PeerFilter     // filter peers by address and port
+	idPeerFilter   sdk.PeerFilter     // filter peers by node ID
+	fauxMerkleMode bool               // if true, IAVL MountStores uses MountStoresDB for simulation speed.
 
 	// --------------------
 	// Volatile state
@@ -81,6 +104,30 @@ type BaseApp struct {
 	deliverState *state          // for DeliverTx
 	voteInfos    []abci.VoteInfo // absent validators from begin block
 
+	// queryState is set on initialization and on Commit. Queries only read it
+	// and versioned snapshots of the multistore, never checkState or
+	// deliverState, so they do not contend with block execution.
+	queryState queryState
+
+	// bounds the number of queries served concurrently
+	queryWorkers chan struct{}
+
+	// sender slots of the txs accepted by CheckTx, reset on Commit
+	txSlots *txSlots
+
+	// valid signatures, shared by CheckTx and DeliverTx
+	sigCache *sdk.SigCache
+
+	// statistics of the block being delivered, reset on BeginBlock
+	blockStats sdk.BlockStats
+
+	// statistics of the most recent blocks, kept in memory, nil if they are not
+	// retained
+	recentBlockStats *blockStatsStore
+
+	// store of the BaseApp parameters, e.g. the gas costs of KVStores
+	paramStore ParamStore
+
 	// consensus params
 	// TODO: Move this in the future to baseapp param store on main store.
 	consensusParams *abci.ConsensusParams
@@ -89,6 +136,10 @@ type BaseApp struct {
 	// transaction. This is mainly used for DoS and spam prevention.
 	minGasPrices sdk.DecCoins
 
+	// The node-local fee policies overriding the minimum gas prices for some
+	// message routes or types.
+	feePolicies sdk.FeePolicies
+
 	// flag for sealing options and parameters to a BaseApp
 	sealed bool
 
@@ -97,6 +148,14 @@ type BaseApp struct {
 
 	// application's version string
 	appVersion string
+
+	// manages state sync snapshots, nil if snapshots are disabled
+	snapshotManager    *snapshots.Manager
+	snapshotInterval   uint64 // block interval between state sync snapshots
+	snapshotKeepRecent uint32 // recent state sync snapshots to keep
+
+	// notified of the ABCI markers of every block, see SetStreamingService
+	abciListeners []ABCIListener
 }
 
 var _ abci.Application = (*BaseApp)(nil)
@@ -120,7 +179,14 @@ func NewBaseApp(
 		queryRouter:    NewQueryRouter(),
 		txDecoder:      txDecoder,
 		fauxMerkleMode: false,
+		queryWorkers:   make(chan struct{}, runtime.NumCPU()),
+		txSlots:        newTxSlots(),
+		sigCache:       sdk.NewSigCache(DefaultSigCacheSize),
+
+		recentBlockStats: newBlockStatsStore(DefaultBlockStatsRetention),
 	}
+	app.queryRouter.AddRoute(sdk.BlockStatsQuerierRoute, app.queryBlockStats)
+
 	for _, option := range options {
 		option(app)
 	}
@@ -320,6 +386,7 @@ func (app *BaseApp) initFromMainStore(baseKey *sdk.KVStoreKey) error {
 
 	// needed for the export command which inits from store but never calls initchain
 	app.setCheckState(abci.Header{})
+	app.queryState.set(abci.Header{Height: app.LastBlockHeight()})
 	app.Seal()
 
 	return nil
@@ -329,10 +396,55 @@ func (app *BaseApp) setMinGasPrices(gasPrices sdk.DecCoins) {
 	app.minGasPrices = gasPrices
 }
 
+func (app *BaseApp) setSigCacheSize(size int) {
+	if size <= 0 {
+		app.sigCache = nil
+		return
+	}
+
+	app.sigCache = sdk.NewSigCache(size)
+}
+
+func (app *BaseApp) setFeePolicies(policies sdk.FeePolicies) {
+	app.feePolicies = policies
+}
+
 func (app *BaseApp) setHaltHeight(height uint64) {
 	app.haltHeight = height
 }
 
+func (app *BaseApp) setSnapshotStore(snapshotStore *snapshots.Store) {
+	if snapshotStore == nil {
+		app.snapshotManager = nil
+		return
+	}
+
+	snapshotter, ok := app.cms.(snapshots.Snapshotter)
+	if !ok {
+		panic("commit multistore does not support snapshots")
+	}
+	app.snapshotManager = snapshots.NewManager(snapshotStore, snapshotter)
+}
+
+func (app *BaseApp) setSnapshotInterval(snapshotInterval uint64) {
+	app.snapshotInterval = snapshotInterval
+}
+
+func (app *BaseApp) setSnapshotKeepRecent(snapshotKeepRecent uint32) {
+	app.snapshotKeepRecent = snapshotKeepRecent
+}
+
+func (app *BaseApp) setBlockStatsRetention(retention uint) {
+	app.recentBlockStats = newBlockStatsStore(retention)
+}
+
+func (app *BaseApp) setQueryWorkers(workers uint) {
+	if workers == 0 {
+		workers = uint(runtime.NumCPU())
+	}
+	app.queryWorkers = make(chan struct{}, workers)
+}
+
 // Router returns the router of the BaseApp.
 func (app *BaseApp) Router() sdk.Router {
 	if app.sealed {
@@ -358,9 +470,12 @@ func (app *BaseApp) IsSealed() bool { return app.sealed }
 func (app *BaseApp) setCheckState(header abci.Header) {
 	ms := app.cms.CacheMultiStore()
 	app.checkState = &state{
-		ms:  ms,
-		ctx: sdk.NewContext(ms, header, true, app.logger).WithMinGasPrices(app.minGasPrices),
+		ms: ms,
+		ctx: sdk.NewContext(ms, header, true, app.logger).
+			WithMinGasPrices(app.minGasPrices).
+			WithFeePolicies(app.feePolicies),
 	}
+	app.checkState.ctx = app.withGasConfig(app.checkState.ctx)
 }
 
 // setCheckState sets checkState with the cached multistore and
@@ -445,6 +560,7 @@ func (app *BaseApp) InitChain(req abci.RequestInitChain) (res abci.ResponseInitC
 	// initialize the deliver state and check state with a correct header
 	app.setDeliverState(initHeader)
 	app.setCheckState(initHeader)
+	app.storeDefaultGasConfig(app.deliverState.ctx)
 
 	if app.initChainer == nil {
 		return
@@ -506,7 +622,15 @@ func splitPath(requestPath string) (path []string) {
 
 // Query implements the ABCI interface. It delegates to CommitMultiStore if it
 // implements Queryable.
+//
+// Store and custom queries are served from the multistore version at the
+// requested height, so they do not touch the state used for processing blocks
+// and may be called concurrently with each other and with block execution. At most the configured number of
+// query workers are served at once.
 func (app *BaseApp) Query(req abci.RequestQuery) (res abci.ResponseQuery) {
+	app.queryWorkers <- struct{}{}
+	defer func() { <-app.queryWorkers }()
+
 	path := splitPath(req.Path)
 	if len(path) == 0 {
 		msg := "no query path provided"
@@ -583,7 +707,7 @@ func handleQueryStore(app *BaseApp, path []string, req abci.RequestQuery) abci.R
 
 	// when a client did not provide a query height, manually inject the latest
 	if req.Height == 0 {
-		req.Height = app.LastBlockHeight()
+		req.Height = app.queryState.height()
 	}
 
 	if req.Height <= 1 && req.Prove {
@@ -636,28 +760,47 @@ func handleQueryCustom(app *BaseApp, path []string, req abci.RequestQuery) (res
 	}
 
 	// when a client did not provide a query height, manually inject the latest
+	header := app.queryState.header()
 	if req.Height == 0 {
-		req.Height = app.LastBlockHeight()
+		req.Height = header.Height
 	}
 
 	if req.Height <= 1 && req.Prove {
 		return sdk.ErrInternal("cannot query with proof when height <= 1; please provide a valid height").QueryResult()
 	}
 
+	// keep the queried version from being pruned while the querier reads it
+	if acquirer, ok := app.cms.(versionAcquirer); ok {
+		release, err := acquirer.AcquireVersion(req.Height)
+		if err != nil {
+			return sdk.ErrInternal(
+				fmt.Sprintf(
+					"failed to load state at height %d; %s (latest height: %d)",
+					req.Height, err, header.Height,
+				),
+			).QueryResult()
+		}
+		defer release()
+	}
+
 	cacheMS, err := app.cms.CacheMultiStoreWithVersion(req.Height)
 	if err != nil {
 		return sdk.ErrInternal(
 			fmt.Sprintf(
 				"failed to load state at height %d; %s (latest height: %d)",
-				req.Height, err, app.LastBlockHeight(),
+				req.Height, err, header.Height,
 			),
 		).QueryResult()
 	}
 
+	// Only the latest header is kept, so queriers at a historical height see
+	// the latest header with the height of the queried state.
+	header.Height = req.Height
+
 	// cache wrap the commit-multistore for safety
 	ctx := sdk.NewContext(
-		cacheMS, app.checkState.ctx.BlockHeader(), true, app.logger,
-	).WithMinGasPrices(app.minGasPrices)
+		cacheMS, header, true, app.logger,
+	).WithMinGasPrices(app.minGasPrices).WithFeePolicies(app.feePolicies)
 
 	// Passes the rest of the path as an argument to the querier.
 	//
@@ -720,13 +863,16 @@ func (app *BaseApp) BeginBlock(req abci.RequestBeginBlock) (res abci.ResponseBeg
 
 	// add block gas meter
 	var gasMeter sdk.GasMeter
-	if maxGas := app.getMaximumBlockGas(); maxGas > 0 {
+	maxGas := app.getMaximumBlockGas()
+	if maxGas > 0 {
 		gasMeter = sdk.NewGasMeter(maxGas)
 	} else {
 		gasMeter = sdk.NewInfiniteGasMeter()
 	}
 
-	app.deliverState.ctx = app.deliverState.ctx.WithBlockGasMeter(gasMeter)
+	app.blockStats = sdk.NewBlockStats(req.Header.Height, maxGas)
+
+	app.deliverState.ctx = app.withGasConfig(app.deliverState.ctx.WithBlockGasMeter(gasMeter))
 
 	if app.beginBlocker != nil {
 		res = app.beginBlocker(app.deliverState.ctx, req)
@@ -734,6 +880,8 @@ func (app *BaseApp) BeginBlock(req abci.RequestBeginBlock) (res abci.ResponseBeg
 
 	// set the signed validators for addition to context in deliverTx
 	app.voteInfos = req.LastCommitInfo.GetVotes()
+
+	app.listenBeginBlock(req, res)
 	return
 }
 
@@ -749,7 +897,7 @@ func (app *BaseApp) CheckTx(req abci.RequestCheckTx) (res abci.ResponseCheckTx)
 	if err != nil {
 		result = err.Result()
 	} else {
-		result = app.runTx(runTxModeCheck, req.Tx, tx)
+		result = app.checkTx(req, tx)
 	}
 
 	return abci.ResponseCheckTx{
@@ -762,6 +910,31 @@ func (app *BaseApp) CheckTx(req abci.RequestCheckTx) (res abci.ResponseCheckTx)
 	}
 }
 
+// PreVerifyTxs verifies the signatures of the transactions of a block
+// concurrently, with one worker per CPU, and caches the valid ones, so that the
+// AnteHandler only consults the signature cache when they are delivered. It
+// must be called after BeginBlock and before the transactions are delivered.
+// The result of the transactions does not depend on it: undecodable
+// transactions and invalid signatures are left to DeliverTx to report.
+func (app *BaseApp) PreVerifyTxs(txs [][]byte) {
+	if app.sigPreVerifier == nil || app.sigCache == nil || app.deliverState == nil {
+		return
+	}
+
+	decoded := make([]sdk.Tx, 0, len(txs))
+	for _, txBytes := range txs {
+		if tx, err := app.txDecoder(txBytes); err == nil {
+			decoded = append(decoded, tx)
+		}
+	}
+
+	// the state is only read, with a cache-wrapped context that is discarded
+	ctx, _ := app.deliverState.ctx.CacheContext()
+	ctx = ctx.WithGasMeter(sdk.NewInfiniteGasMeter())
+
+	app.sigCache.PreVerify(app.sigPreVerifier(ctx, decoded))
+}
+
 // DeliverTx implements the ABCI interface.
 func (app *BaseApp) DeliverTx(req abci.RequestDeliverTx) (res abci.ResponseDeliverTx) {
 	var result sdk.Result
@@ -773,7 +946,9 @@ func (app *BaseApp) DeliverTx(req abci.RequestDeliverTx) (res abci.ResponseDeliv
 		result = app.runTx(runTxModeDeliver, req.Tx, tx)
 	}
 
-	return abci.ResponseDeliverTx{
+	app.blockStats.AddTx(len(req.Tx), result.IsOK())
+
+	res = abci.ResponseDeliverTx{
 		Code:      uint32(result.Code),
 		Codespace: string(result.Codespace),
 		Data:      result.Data,
@@ -782,6 +957,9 @@ func (app *BaseApp) DeliverTx(req abci.RequestDeliverTx) (res abci.ResponseDeliv
 		GasUsed:   int64(result.GasUsed),   // TODO: Should type accept unsigned ints?
 		Events:    result.Events.ToABCIEvents(),
 	}
+
+	app.listenDeliverTx(req, res)
+	return res
 }
 
 // validateBasicTxMsgs executes basic validator calls for messages.
@@ -806,10 +984,14 @@ func (app *BaseApp) getContextForTx(mode runTxMode, txBytes []byte) (ctx sdk.Con
 	ctx = app.getState(mode).ctx.
 		WithTxBytes(txBytes).
 		WithVoteInfos(app.voteInfos).
-		WithConsensusParams(app.consensusParams)
+		WithConsensusParams(app.consensusParams).
+		WithSigCache(app.sigCache)
 
-	if mode == runTxModeSimulate {
+	switch mode {
+	case runTxModeSimulate:
 		ctx, _ = ctx.CacheContext()
+	case runTxModeReplace:
+		ctx = ctx.WithMultiStore(app.cms.CacheMultiStore())
 	}
 
 	return
@@ -839,9 +1021,12 @@ func (app *BaseApp) runMsgs(ctx sdk.Context, msgs []sdk.Msg, mode runTxMode) (re
 
 		var msgResult sdk.Result
 
-		// skip actual execution for CheckTx mode
-		if mode != runTxModeCheck {
+		// skip actual execution for CheckTx mode, where the circuit breaker,
+		// otherwise checked by the router, is checked here
+		if mode != runTxModeCheck && mode != runTxModeReplace {
 			msgResult = handler(ctx, msg)
+		} else if app.circuitBreaker != nil && !app.circuitBreaker.IsAllowed(ctx, msg) {
+			return sdk.ErrMsgDisabled(fmt.Sprintf("%s/%s", msgRoute, msg.Type())).Result()
 		}
 
 		// Each message result's Data must be length prefixed in order to separate
@@ -884,7 +1069,7 @@ func (app *BaseApp) runMsgs(ctx sdk.Context, msgs []sdk.Msg, mode runTxMode) (re
 // Returns the applications's deliverState if app is in runTxModeDeliver,
 // otherwise it returns the application's checkstate.
 func (app *BaseApp) getState(mode runTxMode) *state {
-	if mode == runTxModeCheck || mode == runTxModeSimulate {
+	if mode == runTxModeCheck || mode == runTxModeSimulate || mode == runTxModeReplace {
 		return app.checkState
 	}
 
@@ -925,6 +1110,11 @@ func (app *BaseApp) runTx(mode runTxMode, txBytes []byte, tx sdk.Tx) (result sdk
 	ctx := app.getContextForTx(mode, txBytes)
 	ms := ctx.MultiStore()
 
+	// profile the gas of simulations, which is reported in their result
+	if mode == runTxModeSimulate {
+		ctx = ctx.WithGasProfiler(sdk.NewGasProfiler())
+	}
+
 	// only run the tx if there is block gas remaining
 	if mode == runTxModeDeliver && ctx.BlockGasMeter().IsOutOfGas() {
 		return sdk.ErrOutOfGas("no block gas left to run tx").Result()
@@ -952,6 +1142,11 @@ func (app *BaseApp) runTx(mode runTxMode, txBytes []byte, tx sdk.Tx) (result sdk
 
 		result.GasWanted = gasWanted
 		result.GasUsed = ctx.GasMeter().GasConsumed()
+
+		if profiler := ctx.GasProfiler(); profiler != nil {
+			profile := profiler.Profile()
+			result.GasProfile = &profile
+		}
 	}()
 
 	// If BlockGasMeter() panics it will be caught by the above recover and will
@@ -977,6 +1172,8 @@ func (app *BaseApp) runTx(mode runTxMode, txBytes []byte, tx sdk.Tx) (result sdk
 		return err.Result()
 	}
 
+	var anteLog string
+
 	if app.anteHandler != nil {
 		var anteCtx sdk.Context
 		var msCache sdk.CacheMultiStore
@@ -1003,12 +1200,18 @@ func (app *BaseApp) runTx(mode runTxMode, txBytes []byte, tx sdk.Tx) (result sdk
 		}
 
 		gasWanted = result.GasWanted
+		anteLog = result.Log
 
 		if abort {
 			return result
 		}
 
 		msCache.Write()
+
+		// the fees are collected by the AnteHandler, even if the messages fail
+		if feeTx, ok := tx.(sdk.FeeTx); ok && mode == runTxModeDeliver {
+			app.blockStats.AddFees(feeTx.GetFee())
+		}
 	}
 
 	// Create a new context based off of the existing context with a cache wrapped
@@ -1017,6 +1220,30 @@ func (app *BaseApp) runTx(mode runTxMode, txBytes []byte, tx sdk.Tx) (result sdk
 	result = app.runMsgs(runMsgCtx, msgs, mode)
 	result.GasWanted = gasWanted
 
+	// The PostHandler runs in the same context, so its state changes are only
+	// written along with those of the messages.
+	if app.postHandler != nil && result.IsOK() {
+		postResult, abort := app.postHandler(runMsgCtx, tx, mode == runTxModeSimulate)
+		if abort {
+			// the state changes of the tx are discarded, so it must not be
+			// reported as successful
+			if postResult.IsOK() {
+				postResult = sdk.ErrInternal(fmt.Sprintf("post handler aborted with an OK result: %s", postResult.Log)).Result()
+			}
+
+			postResult.GasWanted = gasWanted
+			return postResult
+		}
+
+		result.Events = result.Events.AppendEvents(postResult.Events)
+	}
+
+	// The messages are not run in CheckTx, so the log of the AnteHandler (e.g.
+	// the fee policy applied) is reported instead.
+	if (mode == runTxModeCheck || mode == runTxModeReplace) && anteLog != "" && result.IsOK() {
+		result.Log = anteLog
+	}
+
 	// Safety check: don't write the cache state unless we're in DeliverTx.
 	if mode != runTxModeDeliver {
 		return result
@@ -1040,13 +1267,23 @@ func (app *BaseApp) EndBlock(req abci.RequestEndBlock) (res abci.ResponseEndBloc
 		res = app.endBlocker(app.deliverState.ctx, req)
 	}
 
+	if gasMeter := app.deliverState.ctx.BlockGasMeter(); gasMeter != nil {
+		app.blockStats.GasUsed = gasMeter.GasConsumed()
+	}
+
+	res.Events = append(res.Events, sdk.Events{app.blockStats.Event()}.ToABCIEvents()...)
+
+	app.listenEndBlock(req, res)
 	return
 }
 
 // Commit implements the ABCI interface. It will commit all state that exists in
 // the deliver state's multi-store and includes the resulting commit ID in the
 // returned abci.ResponseCommit. Commit will set the check state based on the
-// latest header and reset the deliver state. Also, if a non-zero halt height is
+// latest header and reset the deliver state. If snapshots are enabled and the
+// height is a multiple of the snapshot interval, a snapshot is taken in the
+// background. Registered ABCIListeners are notified once the state is
+// committed. Also, if a non-zero halt height is
 // defined in config, Commit will execute a deferred function call to check
 // against that height and gracefully halt if it matches the latest committed
 // height.
@@ -1057,16 +1294,23 @@ func (app *BaseApp) Commit() (res abci.ResponseCommit) {
 	app.deliverState.ms.Write()
 	commitID := app.cms.Commit()
 	app.logger.Debug("Commit synced", "commit", fmt.Sprintf("%X", commitID))
+	app.recentBlockStats.add(app.blockStats)
 
 	// Reset the Check state to the latest committed.
 	//
 	// NOTE: This is safe because Tendermint holds a lock on the mempool for
 	// Commit. Use the header from this latest block.
 	app.setCheckState(header)
+	app.queryState.set(header)
+	app.txSlots.reset()
 
 	// empty/reset the deliver state
 	app.deliverState = nil
 
+	if app.snapshotManager != nil && app.snapshotInterval > 0 && uint64(header.Height)%app.snapshotInterval == 0 {
+		go app.snapshot(uint64(header.Height))
+	}
+
 	defer func() {
 		if app.haltHeight > 0 && uint64(header.Height) == app.haltHeight {
 			app.logger.Info("halting node per configuration", "height", app.haltHeight)
@@ -1074,9 +1318,12 @@ func (app *BaseApp) Commit() (res abci.ResponseCommit) {
 		}
 	}()
 
-	return abci.ResponseCommit{
+	res = abci.ResponseCommit{
 		Data: commitID.Hash,
 	}
+
+	app.listenCommit(res)
+	return res
 }
 
 // ----------------------------------------------------------------------------
@@ -1094,3 +1341,28 @@ func (st *state) CacheMultiStore() sdk.CacheMultiStore {
 func (st *state) Context() sdk.Context {
 	return st.ctx
 }
+
+// queryState holds the header of the last committed block, from which queries
+// learn the latest height.
+type queryState struct {
+	mtx sync.RWMutex
+	hdr abci.Header
+}
+
+func (qs *queryState) set(header abci.Header) {
+	qs.mtx.Lock()
+	defer qs.mtx.Unlock()
+
+	qs.hdr = header
+}
+
+func (qs *queryState) header() abci.Header {
+	qs.mtx.RLock()
+	defer qs.mtx.RUnlock()
+
+	return qs.hdr
+}
+
+func (qs *queryState) height() int64 {
+	return qs.header().Height
+}
@@ -6,12 +6,15 @@ import (
 	"fmt"
 	"io/ioutil"
 	"os"
+	"sync"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
 	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/crypto/secp256k1"
+	cmn "github.com/tendermint/tendermint/libs/common"
 	"github.com/tendermint/tendermint/libs/log"
 	dbm "github.com/tendermint/tm-db"
 
@@ -422,6 +425,14 @@ func TestSetMinGasPrices(t *testing.T) {
 	require.Equal(t, minGasPrices, app.minGasPrices)
 }
 
+func TestSetFeePolicies(t *testing.T) {
+	policies := sdk.FeePolicies{sdk.NewFeePolicy("bank", "send", nil, 0)}
+	app := newBaseApp(t.Name(), SetFeePolicies(policies))
+	require.Equal(t, policies, app.feePolicies)
+
+	require.Panics(t, func() { SetFeePolicies(append(policies, policies...)) })
+}
+
 func TestInitChainer(t *testing.T) {
 	name := t.Name()
 	// keep the db and logger ourselves so
@@ -870,6 +881,7 @@ func TestSimulateTx(t *testing.T) {
 		result := app.Simulate(txBytes, tx)
 		require.True(t, result.IsOK(), result.Log)
 		require.Equal(t, gasConsumed, result.GasUsed)
+		require.Equal(t, []sdk.GasProfileEntry{{Name: "test", Gas: gasConsumed}}, result.GasProfile.Descriptors)
 
 		// simulate again, same result
 		result = app.Simulate(txBytes, tx)
@@ -889,6 +901,7 @@ func TestSimulateTx(t *testing.T) {
 		require.Nil(t, err, "Result unmarshalling failed")
 		require.True(t, res.IsOK(), res.Log)
 		require.Equal(t, gasConsumed, res.GasUsed, res.Log)
+		require.Equal(t, result.GasProfile, res.GasProfile)
 		app.EndBlock(abci.RequestEndBlock{})
 		app.Commit()
 	}
@@ -1363,6 +1376,683 @@ func TestQuery(t *testing.T) {
 	require.Equal(t, value, res.Value)
 }
 
+// Test that the log of the AnteHandler, e.g. the fee policy applied, is
+// reported in CheckTx, where messages are not run.
+func TestCheckTxAnteLog(t *testing.T) {
+	policies := sdk.FeePolicies{sdk.NewFeePolicy(routeMsgCounter, "", nil, 0)}
+	anteOpt := func(bapp *BaseApp) {
+		bapp.SetAnteHandler(func(ctx sdk.Context, tx sdk.Tx, simulate bool) (newCtx sdk.Context, res sdk.Result, abort bool) {
+			if fp, ok := ctx.FeePolicies().Match(tx.GetMsgs()[0]); ok && ctx.IsCheckTx() {
+				res.Log = "fee policy: " + fp.Name()
+			}
+			return
+		})
+	}
+	routerOpt := func(bapp *BaseApp) {
+		bapp.Router().AddRoute(routeMsgCounter, func(ctx sdk.Context, msg sdk.Msg) sdk.Result { return sdk.Result{} })
+	}
+
+	app := setupBaseApp(t, anteOpt, routerOpt, SetFeePolicies(policies))
+	app.InitChain(abci.RequestInitChain{})
+
+	resTx := app.Check(newTxCounter(0, 0))
+	require.True(t, resTx.IsOK(), fmt.Sprintf("%v", resTx))
+	require.Equal(t, "fee policy: "+routeMsgCounter, resTx.Log)
+
+	header := abci.Header{Height: app.LastBlockHeight() + 1}
+	app.BeginBlock(abci.RequestBeginBlock{Header: header})
+	resTx = app.Deliver(newTxCounter(0, 0))
+	require.True(t, resTx.IsOK(), fmt.Sprintf("%v", resTx))
+	require.NotContains(t, resTx.Log, "fee policy")
+}
+
+func TestCheckTxPriority(t *testing.T) {
+	counterKey := []byte("counter-key")
+	anteOpt := func(bapp *BaseApp) {
+		bapp.SetAnteHandler(func(ctx sdk.Context, tx sdk.Tx, simulate bool) (newCtx sdk.Context, res sdk.Result, abort bool) {
+			store := ctx.KVStore(capKey1)
+			counter := getIntFromStore(store, counterKey)
+			if tx.(txTest).Counter != counter {
+				return newCtx, sdk.ErrInvalidSequence("wrong counter").Result(), true
+			}
+			setIntOnStore(store, counterKey, counter+1)
+			return
+		})
+	}
+	routerOpt := func(bapp *BaseApp) {
+		bapp.Router().AddRoute(routeMsgCounter, func(ctx sdk.Context, msg sdk.Msg) sdk.Result { return sdk.Result{} })
+	}
+	// the priority of a tx is the counter of its msg and its slot is the
+	// counter it consumes
+	priorityOpt := func(bapp *BaseApp) {
+		bapp.SetTxPriority(func(ctx sdk.Context, tx sdk.Tx) (int64, string) {
+			counter := getIntFromStore(ctx.KVStore(capKey1), counterKey)
+			return tx.GetMsgs()[0].(*msgCounter).Counter, fmt.Sprintf("sender/%d", counter)
+		})
+	}
+
+	app := setupBaseApp(t, anteOpt, routerOpt, priorityOpt)
+	app.InitChain(abci.RequestInitChain{})
+
+	codec := codec.New()
+	registerTestCodec(codec)
+
+	checkTx := func(tx *txTest, checkType abci.CheckTxType) abci.ResponseCheckTx {
+		txBytes, err := codec.MarshalBinaryLengthPrefixed(tx)
+		require.NoError(t, err)
+		return app.CheckTx(abci.RequestCheckTx{Tx: txBytes, Type: checkType})
+	}
+	requirePriority := func(res abci.ResponseCheckTx, priority string) {
+		require.True(t, res.IsOK(), fmt.Sprintf("%v", res))
+		event := res.Events[len(res.Events)-1]
+		require.Equal(t, sdk.EventTypeTx, event.Type)
+		require.Equal(t, sdk.AttributeKeyPriority, string(event.Attributes[0].Key))
+		require.Equal(t, priority, string(event.Attributes[0].Value))
+	}
+
+	txLow := newTxCounter(0, 1)
+	requirePriority(checkTx(txLow, abci.CheckTxType_New), "1")
+
+	// a tx with the same priority cannot replace the pending tx
+	res := checkTx(newTxCounter(0, 1, 0), abci.CheckTxType_New)
+	require.Equal(t, sdk.CodeInvalidSequence, sdk.CodeType(res.Code))
+
+	// a tx with a higher priority replaces the pending tx without changing the
+	// check state
+	txHigh := newTxCounter(0, 5)
+	requirePriority(checkTx(txHigh, abci.CheckTxType_New), "5")
+	require.Equal(t, int64(1), getIntFromStore(app.checkState.ctx.KVStore(capKey1), counterKey))
+
+	// the next tx of the sender is checked against the check state
+	requirePriority(checkTx(newTxCounter(1, 2), abci.CheckTxType_New), "2")
+
+	header := abci.Header{Height: app.LastBlockHeight() + 1}
+	app.BeginBlock(abci.RequestBeginBlock{Header: header})
+	app.EndBlock(abci.RequestEndBlock{})
+	app.Commit()
+
+	// the replaced tx is evicted by the recheck
+	res = checkTx(txLow, abci.CheckTxType_Recheck)
+	require.Equal(t, sdk.CodeInvalidSequence, sdk.CodeType(res.Code))
+	requirePriority(checkTx(txHigh, abci.CheckTxType_Recheck), "5")
+	requirePriority(checkTx(newTxCounter(1, 2), abci.CheckTxType_Recheck), "2")
+}
+
+func TestSigCache(t *testing.T) {
+	priv := secp256k1.GenPrivKey()
+	signBytes := []byte("sign bytes")
+	sig, err := priv.Sign(signBytes)
+	require.NoError(t, err)
+
+	// the signature verified by CheckTx is found in the cache by DeliverTx
+	verified := 0
+	anteOpt := func(bapp *BaseApp) {
+		bapp.SetAnteHandler(func(ctx sdk.Context, tx sdk.Tx, simulate bool) (newCtx sdk.Context, res sdk.Result, abort bool) {
+			require.Equal(t, verified, ctx.SigCache().Len())
+			require.True(t, ctx.SigCache().VerifyBytes(priv.PubKey(), signBytes, sig))
+			verified = 1
+			return
+		})
+	}
+	routerOpt := func(bapp *BaseApp) {
+		bapp.Router().AddRoute(routeMsgCounter, func(ctx sdk.Context, msg sdk.Msg) sdk.Result { return sdk.Result{} })
+	}
+
+	app := setupBaseApp(t, anteOpt, routerOpt)
+	app.InitChain(abci.RequestInitChain{})
+
+	resTx := app.Check(newTxCounter(0, 0))
+	require.True(t, resTx.IsOK(), fmt.Sprintf("%v", resTx))
+
+	header := abci.Header{Height: app.LastBlockHeight() + 1}
+	app.BeginBlock(abci.RequestBeginBlock{Header: header})
+	resTx = app.Deliver(newTxCounter(0, 0))
+	require.True(t, resTx.IsOK(), fmt.Sprintf("%v", resTx))
+
+	// the cache can be disabled
+	anteOpt = func(bapp *BaseApp) {
+		bapp.SetAnteHandler(func(ctx sdk.Context, tx sdk.Tx, simulate bool) (newCtx sdk.Context, res sdk.Result, abort bool) {
+			require.Nil(t, ctx.SigCache())
+			return
+		})
+	}
+
+	app = setupBaseApp(t, anteOpt, routerOpt, SetSigCacheSize(0))
+	app.InitChain(abci.RequestInitChain{})
+
+	resTx = app.Check(newTxCounter(0, 0))
+	require.True(t, resTx.IsOK(), fmt.Sprintf("%v", resTx))
+}
+
+func TestPreVerifyTxs(t *testing.T) {
+	priv := secp256k1.GenPrivKey()
+	sigs := make(map[int64][]byte)
+	for _, counter := range []int64{0, 1} {
+		sig, err := priv.Sign([]byte{byte(counter)})
+		require.NoError(t, err)
+		sigs[counter] = sig
+	}
+	sigVerification := func(tx sdk.Tx) sdk.SigVerification {
+		counter := tx.(txTest).Counter
+		return sdk.NewSigVerification(priv.PubKey(), []byte{byte(counter)}, sigs[counter])
+	}
+
+	// the signatures of the block are all in the cache when the first tx is
+	// delivered
+	anteOpt := func(bapp *BaseApp) {
+		bapp.SetAnteHandler(func(ctx sdk.Context, tx sdk.Tx, simulate bool) (newCtx sdk.Context, res sdk.Result, abort bool) {
+			require.Equal(t, 2, ctx.SigCache().Len())
+			sv := sigVerification(tx)
+			require.True(t, ctx.SigCache().VerifyBytes(sv.PubKey, sv.SignBytes, sv.Signature))
+			return
+		})
+		bapp.SetSigPreVerifier(func(ctx sdk.Context, txs []sdk.Tx) []sdk.SigVerification {
+			require.Equal(t, int64(1), ctx.BlockHeight())
+			svs := make([]sdk.SigVerification, len(txs))
+			for i, tx := range txs {
+				svs[i] = sigVerification(tx)
+			}
+			return svs
+		})
+	}
+	routerOpt := func(bapp *BaseApp) {
+		bapp.Router().AddRoute(routeMsgCounter, func(ctx sdk.Context, msg sdk.Msg) sdk.Result { return sdk.Result{} })
+	}
+
+	app := setupBaseApp(t, anteOpt, routerOpt)
+	app.InitChain(abci.RequestInitChain{})
+
+	codec := codec.New()
+	registerTestCodec(codec)
+	txs := [][]byte{
+		codec.MustMarshalBinaryLengthPrefixed(newTxCounter(0, 0)),
+		[]byte("undecodable"),
+		codec.MustMarshalBinaryLengthPrefixed(newTxCounter(1, 0)),
+	}
+
+	header := abci.Header{Height: app.LastBlockHeight() + 1}
+	app.BeginBlock(abci.RequestBeginBlock{Header: header})
+	app.PreVerifyTxs(txs)
+
+	for _, tx := range txs {
+		res := app.DeliverTx(abci.RequestDeliverTx{Tx: tx})
+		require.Equal(t, string(tx) != "undecodable", res.IsOK(), res.Log)
+	}
+}
+
+// paramStore is a ParamStore backed by a map.
+type paramStore struct {
+	db map[string][]byte
+}
+
+func (ps *paramStore) Set(_ sdk.Context, key []byte, value interface{}) {
+	ps.db[string(key)] = codec.Cdc.MustMarshalJSON(value)
+}
+
+func (ps *paramStore) Has(_ sdk.Context, key []byte) bool {
+	_, ok := ps.db[string(key)]
+	return ok
+}
+
+func (ps *paramStore) Get(_ sdk.Context, key []byte, ptr interface{}) {
+	codec.Cdc.MustUnmarshalJSON(ps.db[string(key)], ptr)
+}
+
+func TestGasConfigParams(t *testing.T) {
+	ps := &paramStore{db: make(map[string][]byte)}
+
+	var gasConfig sdk.GasConfig
+	anteOpt := func(bapp *BaseApp) {
+		bapp.SetAnteHandler(func(ctx sdk.Context, tx sdk.Tx, simulate bool) (newCtx sdk.Context, res sdk.Result, abort bool) {
+			gasConfig = ctx.KVGasConfig()
+			return
+		})
+		bapp.SetParamStore(ps)
+	}
+	routerOpt := func(bapp *BaseApp) {
+		bapp.Router().AddRoute(routeMsgCounter, func(ctx sdk.Context, msg sdk.Msg) sdk.Result { return sdk.Result{} })
+	}
+
+	app := setupBaseApp(t, anteOpt, routerOpt)
+	app.InitChain(abci.RequestInitChain{})
+
+	// the default gas configs are stored on InitChain
+	require.True(t, ps.Has(sdk.Context{}, ParamStoreKeyKVGasConfig))
+	require.True(t, ps.Has(sdk.Context{}, ParamStoreKeyTransientGasConfig))
+
+	header := abci.Header{Height: app.LastBlockHeight() + 1}
+	app.BeginBlock(abci.RequestBeginBlock{Header: header})
+	require.True(t, app.Deliver(newTxCounter(0, 0)).IsOK())
+	require.Equal(t, sdk.KVGasConfig(), gasConfig)
+
+	// a change of the gas configs applies from the next block
+	newGasConfig := sdk.KVGasConfig()
+	newGasConfig.ReadCostFlat = 500
+	ps.Set(sdk.Context{}, ParamStoreKeyKVGasConfig, newGasConfig)
+
+	require.True(t, app.Deliver(newTxCounter(1, 0)).IsOK())
+	require.Equal(t, sdk.KVGasConfig(), gasConfig)
+	app.EndBlock(abci.RequestEndBlock{})
+	app.Commit()
+
+	require.True(t, app.Check(newTxCounter(2, 0)).IsOK())
+	require.Equal(t, newGasConfig, gasConfig)
+
+	header = abci.Header{Height: app.LastBlockHeight() + 1}
+	app.BeginBlock(abci.RequestBeginBlock{Header: header})
+	require.True(t, app.Deliver(newTxCounter(2, 0)).IsOK())
+	require.Equal(t, newGasConfig, gasConfig)
+	require.Equal(t, newGasConfig, app.deliverState.ctx.KVGasConfig())
+	require.Equal(t, sdk.TransientGasConfig(), app.deliverState.ctx.TransientGasConfig())
+}
+
+func TestPostHandler(t *testing.T) {
+	postKey := []byte("post-key")
+	// each tx gets its own gas meter
+	anteOpt := func(bapp *BaseApp) {
+		bapp.SetAnteHandler(func(ctx sdk.Context, tx sdk.Tx, simulate bool) (sdk.Context, sdk.Result, bool) {
+			return ctx.WithGasMeter(sdk.NewGasMeter(1000000)), sdk.Result{}, false
+		})
+	}
+	routerOpt := func(bapp *BaseApp) {
+		bapp.Router().AddRoute(routeMsgCounter, func(ctx sdk.Context, msg sdk.Msg) sdk.Result { return sdk.Result{} })
+	}
+	// the post handler records the tx and aborts txs with a zero counter
+	postOpt := func(bapp *BaseApp) {
+		bapp.SetPostHandler(func(ctx sdk.Context, tx sdk.Tx, simulate bool) (sdk.Result, bool) {
+			ctx.GasMeter().ConsumeGas(10, "post handler")
+			ctx.KVStore(capKey1).Set(postKey, []byte("set"))
+
+			if tx.(*txTest).Counter == 0 {
+				return sdk.ErrUnauthorized("zero counter").Result(), true
+			}
+			if tx.(*txTest).Counter == 2 {
+				return sdk.Result{Log: "aborted"}, true
+			}
+
+			event := sdk.NewEvent("post", sdk.NewAttribute("counter", fmt.Sprintf("%d", tx.(*txTest).Counter)))
+			return sdk.Result{Events: sdk.Events{event}}, false
+		})
+	}
+
+	app := setupBaseApp(t, anteOpt, routerOpt, postOpt)
+	app.InitChain(abci.RequestInitChain{})
+
+	header := abci.Header{Height: app.LastBlockHeight() + 1}
+	app.BeginBlock(abci.RequestBeginBlock{Header: header})
+
+	// the gas of the aborted post handler is still counted
+	gasConfig := sdk.KVGasConfig()
+	postGas := 10 + gasConfig.WriteCostFlat + gasConfig.WriteCostPerByte*3
+
+	res := app.Deliver(newTxCounter(0, 0))
+	require.Equal(t, sdk.CodeUnauthorized, res.Code, fmt.Sprintf("%v", res))
+	require.Equal(t, postGas, res.GasUsed)
+	require.Nil(t, app.deliverState.ctx.KVStore(capKey1).Get(postKey))
+
+	res = app.Deliver(newTxCounter(1, 0))
+	require.True(t, res.IsOK(), fmt.Sprintf("%v", res))
+	require.Equal(t, postGas, res.GasUsed)
+	require.Equal(t, "post", res.Events[len(res.Events)-1].Type)
+	require.Equal(t, []byte("set"), app.deliverState.ctx.KVStore(capKey1).Get(postKey))
+
+	// aborting with an OK result fails the tx, as its state is not written
+	app.deliverState.ctx.KVStore(capKey1).Delete(postKey)
+	res = app.Deliver(newTxCounter(2, 0))
+	require.Equal(t, sdk.CodeInternal, res.Code, fmt.Sprintf("%v", res))
+	require.Contains(t, res.Log, "aborted")
+	require.Equal(t, postGas, res.GasUsed)
+	require.Nil(t, app.deliverState.ctx.KVStore(capKey1).Get(postKey))
+}
+
+func TestRouterMiddlewareAbort(t *testing.T) {
+	// each tx gets its own gas meter
+	anteOpt := func(bapp *BaseApp) {
+		bapp.SetAnteHandler(func(ctx sdk.Context, tx sdk.Tx, simulate bool) (sdk.Context, sdk.Result, bool) {
+			return ctx.WithGasMeter(sdk.NewGasMeter(1000000)), sdk.Result{}, false
+		})
+	}
+	// the middleware charges a fee per msg, which aborts txs with a negative
+	// msg counter
+	routerOpt := func(bapp *BaseApp) {
+		bapp.Router().AddRoute(routeMsgCounter, func(ctx sdk.Context, msg sdk.Msg) sdk.Result { return sdk.Result{} })
+		bapp.Router().AddMiddleware(func(next sdk.Handler) sdk.Handler {
+			return func(ctx sdk.Context, msg sdk.Msg) sdk.Result {
+				ctx.GasMeter().ConsumeGas(7, "msg fee")
+				if msg.(msgCounter).Counter == 1 {
+					return sdk.ErrInsufficientFee("msg fee").Result()
+				}
+				return next(ctx, msg)
+			}
+		})
+	}
+
+	app := setupBaseApp(t, anteOpt, routerOpt)
+	app.InitChain(abci.RequestInitChain{})
+
+	header := abci.Header{Height: app.LastBlockHeight() + 1}
+	app.BeginBlock(abci.RequestBeginBlock{Header: header})
+
+	res := app.Deliver(newTxCounter(0, 0, 0))
+	require.True(t, res.IsOK(), fmt.Sprintf("%v", res))
+	require.Equal(t, uint64(14), res.GasUsed)
+
+	res = app.Deliver(newTxCounter(0, 0, 1))
+	require.Equal(t, sdk.CodeInsufficientFee, res.Code, fmt.Sprintf("%v", res))
+	require.Equal(t, uint64(14), res.GasUsed)
+}
+
+type msgCounterBreaker struct {
+	disabled int64
+}
+
+func (cb msgCounterBreaker) IsAllowed(_ sdk.Context, msg sdk.Msg) bool {
+	return msg.(msgCounter).Counter != cb.disabled
+}
+
+func TestCircuitBreaker(t *testing.T) {
+	routerOpt := func(bapp *BaseApp) {
+		bapp.Router().AddRoute(routeMsgCounter, func(ctx sdk.Context, msg sdk.Msg) sdk.Result { return sdk.Result{} })
+	}
+	breakerOpt := func(bapp *BaseApp) { bapp.SetCircuitBreaker(msgCounterBreaker{disabled: 1}) }
+
+	app := setupBaseApp(t, routerOpt, breakerOpt)
+	app.InitChain(abci.RequestInitChain{})
+
+	header := abci.Header{Height: app.LastBlockHeight() + 1}
+	app.BeginBlock(abci.RequestBeginBlock{Header: header})
+
+	res := app.Deliver(newTxCounter(0, 0, 2))
+	require.True(t, res.IsOK(), fmt.Sprintf("%v", res))
+
+	// a single disabled msg fails the whole tx
+	res = app.Deliver(newTxCounter(1, 0, 1))
+	require.Equal(t, sdk.CodeMsgDisabled, res.Code, fmt.Sprintf("%v", res))
+
+	res = app.Check(newTxCounter(2, 1))
+	require.Equal(t, sdk.CodeMsgDisabled, res.Code, fmt.Sprintf("%v", res))
+
+	// msgs dispatched by modules through the router are checked as well
+	ctx := app.deliverState.ctx
+	res = app.router.Route(routeMsgCounter)(ctx, msgCounter{Counter: 1})
+	require.Equal(t, sdk.CodeMsgDisabled, res.Code, fmt.Sprintf("%v", res))
+
+	res = app.router.Route(routeMsgCounter)(ctx, msgCounter{Counter: 2})
+	require.True(t, res.IsOK(), fmt.Sprintf("%v", res))
+}
+
+// txFeeTest is a txTest paying fees.
+type txFeeTest struct {
+	txTest
+	Fee sdk.Coins
+}
+
+func (tx txFeeTest) GetFee() sdk.Coins { return tx.Fee }
+
+func TestBlockStats(t *testing.T) {
+	anteOpt := func(bapp *BaseApp) {
+		bapp.SetAnteHandler(func(ctx sdk.Context, tx sdk.Tx, simulate bool) (sdk.Context, sdk.Result, bool) {
+			if tx, ok := tx.(txTest); ok && tx.FailOnAnte {
+				return ctx, sdk.ErrInternal("ante handler failure").Result(), true
+			}
+
+			return ctx.WithGasMeter(sdk.NewGasMeter(1000000)), sdk.Result{}, false
+		})
+	}
+	routerOpt := func(bapp *BaseApp) {
+		bapp.Router().AddRoute(routeMsgCounter, func(ctx sdk.Context, msg sdk.Msg) sdk.Result {
+			ctx.GasMeter().ConsumeGas(5, "test")
+			return sdk.Result{}
+		})
+	}
+
+	app := setupBaseApp(t, anteOpt, routerOpt, SetBlockStatsRetention(2))
+	app.InitChain(abci.RequestInitChain{})
+
+	cdc := codec.New()
+	registerTestCodec(cdc)
+
+	okTx, err := cdc.MarshalBinaryLengthPrefixed(newTxCounter(0, 0))
+	require.NoError(t, err)
+
+	failedTx := newTxCounter(1, 1)
+	failedTx.setFailOnAnte(true)
+	failedTxBytes, err := cdc.MarshalBinaryLengthPrefixed(failedTx)
+	require.NoError(t, err)
+
+	fees := sdk.NewCoins(sdk.NewInt64Coin("stake", 10))
+	feeTx := txFeeTest{*newTxCounter(2, 2), fees}
+
+	for height := int64(1); height <= 3; height++ {
+		app.BeginBlock(abci.RequestBeginBlock{Header: abci.Header{Height: height}})
+
+		require.True(t, app.DeliverTx(abci.RequestDeliverTx{Tx: okTx}).IsOK())
+		require.False(t, app.DeliverTx(abci.RequestDeliverTx{Tx: failedTxBytes}).IsOK())
+		require.False(t, app.DeliverTx(abci.RequestDeliverTx{Tx: []byte{0x1}}).IsOK())
+
+		// the fees are collected without going through DeliverTx
+		res := app.Deliver(feeTx)
+		require.True(t, res.IsOK(), fmt.Sprintf("%v", res))
+
+		resEnd := app.EndBlock(abci.RequestEndBlock{Height: height})
+		event := resEnd.Events[len(resEnd.Events)-1]
+		require.Equal(t, sdk.EventTypeBlockStats, event.Type)
+		require.Contains(t, event.Attributes, cmn.KVPair{Key: []byte(sdk.AttributeKeyNumTxs), Value: []byte("3")})
+		require.Contains(t, event.Attributes, cmn.KVPair{Key: []byte(sdk.AttributeKeyFees), Value: []byte("10stake")})
+
+		app.Commit()
+	}
+
+	expected := sdk.BlockStats{
+		Height:       3,
+		GasUsed:      10,
+		NumTxs:       3,
+		NumFailedTxs: 2,
+		TxBytes:      uint64(len(okTx) + len(failedTxBytes) + 1),
+		Fees:         fees,
+	}
+
+	// only the last 2 blocks are retained
+	res := app.Query(abci.RequestQuery{Path: "/custom/blockstats"})
+	require.True(t, res.IsOK(), res.Log)
+	require.Equal(t, int64(3), res.Height)
+
+	var list sdk.BlockStatsList
+	require.NoError(t, codec.Cdc.UnmarshalJSON(res.Value, &list))
+	require.Len(t, list, 2)
+	require.Equal(t, int64(2), list[0].Height)
+	require.Equal(t, expected, list[1])
+
+	res = app.Query(abci.RequestQuery{Path: "/custom/blockstats/3"})
+	require.True(t, res.IsOK(), res.Log)
+
+	var stats sdk.BlockStats
+	require.NoError(t, codec.Cdc.UnmarshalJSON(res.Value, &stats))
+	require.Equal(t, expected, stats)
+
+	res = app.Query(abci.RequestQuery{Path: "/custom/blockstats/1"})
+	require.False(t, res.IsOK())
+
+	// the statistics can be disabled
+	app = setupBaseApp(t, anteOpt, routerOpt, SetBlockStatsRetention(0))
+	app.InitChain(abci.RequestInitChain{})
+	app.BeginBlock(abci.RequestBeginBlock{Header: abci.Header{Height: 1}})
+	app.EndBlock(abci.RequestEndBlock{Height: 1})
+	app.Commit()
+
+	res = app.Query(abci.RequestQuery{Path: "/custom/blockstats"})
+	require.True(t, res.IsOK(), res.Log)
+	require.NoError(t, codec.Cdc.UnmarshalJSON(res.Value, &list))
+	require.Empty(t, list)
+}
+
+// Test that store and custom queries at historical heights are served from
+// committed state while blocks are being processed.
+func TestQueryHistoricalHeights(t *testing.T) {
+	key := []byte("counter")
+	routerOpt := func(bapp *BaseApp) {
+		bapp.Router().AddRoute(routeMsgCounter, func(ctx sdk.Context, msg sdk.Msg) sdk.Result {
+			ctx.KVStore(capKey1).Set(key, encodeHeight(ctx.BlockHeight()))
+			return sdk.Result{}
+		})
+	}
+	querierOpt := func(bapp *BaseApp) {
+		bapp.QueryRouter().AddRoute("counter", func(ctx sdk.Context, path []string, req abci.RequestQuery) ([]byte, sdk.Error) {
+			value := ctx.KVStore(capKey1).Get(key)
+			if !bytes.Equal(value, encodeHeight(ctx.BlockHeight())) {
+				return nil, sdk.ErrInternal(fmt.Sprintf("unexpected counter %X at height %d", value, ctx.BlockHeight()))
+			}
+			return value, nil
+		})
+	}
+
+	pruningOpt := SetPruning(store.PruneNothing)
+	app := setupBaseApp(t, routerOpt, querierOpt, pruningOpt, SetQueryWorkers(2))
+	app.InitChain(abci.RequestInitChain{})
+
+	commitBlock := func() {
+		header := abci.Header{Height: app.LastBlockHeight() + 1}
+		app.BeginBlock(abci.RequestBeginBlock{Header: header})
+		resTx := app.Deliver(newTxCounter(header.Height, 0))
+		require.True(t, resTx.IsOK(), fmt.Sprintf("%v", resTx))
+		app.EndBlock(abci.RequestEndBlock{Height: header.Height})
+		app.Commit()
+	}
+
+	numBlocks := int64(5)
+	for i := int64(0); i < numBlocks; i++ {
+		commitBlock()
+	}
+
+	// both query types default to the latest height
+	res := app.Query(abci.RequestQuery{Path: "/store/key1/key", Data: key})
+	require.Equal(t, numBlocks, res.Height)
+	require.Equal(t, encodeHeight(numBlocks), res.Value)
+
+	res = app.Query(abci.RequestQuery{Path: "/custom/counter"})
+	require.True(t, res.IsOK(), res.Log)
+	require.Equal(t, numBlocks, res.Height)
+	require.Equal(t, encodeHeight(numBlocks), res.Value)
+
+	// heights that are not committed yet cannot be queried
+	res = app.Query(abci.RequestQuery{Path: "/custom/counter", Height: numBlocks + 1})
+	require.False(t, res.IsOK())
+
+	// query every historical height while more blocks are committed
+	var wg sync.WaitGroup
+	for i := 0; i < 4; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for height := int64(1); height <= numBlocks; height++ {
+				res := app.Query(abci.RequestQuery{Path: "/store/key1/key", Data: key, Height: height})
+				assert.Equal(t, encodeHeight(height), res.Value)
+
+				res = app.Query(abci.RequestQuery{Path: "/custom/counter", Height: height})
+				assert.True(t, res.IsOK(), res.Log)
+				assert.Equal(t, height, res.Height)
+			}
+		}()
+	}
+
+	for i := int64(0); i < numBlocks; i++ {
+		commitBlock()
+	}
+	wg.Wait()
+}
+
+func encodeHeight(height int64) []byte {
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, uint64(height))
+	return bz
+}
+
+type mockStreamingService struct {
+	listener *store.MemoryListener
+	events   []string
+	writes   [][]store.StoreKVPair
+}
+
+func (m *mockStreamingService) Listeners() map[sdk.StoreKey][]sdk.WriteListener {
+	return map[sdk.StoreKey][]sdk.WriteListener{capKey1: {m.listener}}
+}
+
+func (m *mockStreamingService) ListenBeginBlock(abci.RequestBeginBlock, abci.ResponseBeginBlock) error {
+	m.events = append(m.events, "begin_block")
+	return nil
+}
+
+func (m *mockStreamingService) ListenDeliverTx(abci.RequestDeliverTx, abci.ResponseDeliverTx) error {
+	m.events = append(m.events, "deliver_tx")
+	return nil
+}
+
+func (m *mockStreamingService) ListenEndBlock(abci.RequestEndBlock, abci.ResponseEndBlock) error {
+	m.events = append(m.events, "end_block")
+	return nil
+}
+
+func (m *mockStreamingService) ListenCommit(abci.ResponseCommit) error {
+	m.events = append(m.events, "commit")
+	m.writes = append(m.writes, m.listener.PopStateCache())
+	return nil
+}
+
+// Test that a streaming service observes the committed writes of every block
+// along with the block's ABCI markers.
+func TestStreamingService(t *testing.T) {
+	key := []byte("counter")
+	routerOpt := func(bapp *BaseApp) {
+		bapp.Router().AddRoute(routeMsgCounter, func(ctx sdk.Context, msg sdk.Msg) sdk.Result {
+			ctx.KVStore(capKey1).Set(key, encodeHeight(ctx.BlockHeight()))
+			ctx.KVStore(capKey2).Set(key, encodeHeight(ctx.BlockHeight()))
+			if msg.(*msgCounter).FailOnHandler {
+				ctx.KVStore(capKey1).Set(key, []byte("failed"))
+				return sdk.ErrInternal("handler failed").Result()
+			}
+			return sdk.Result{}
+		})
+	}
+
+	// Create same codec used in txDecoder
+	codec := codec.New()
+	registerTestCodec(codec)
+
+	streamingService := &mockStreamingService{listener: store.NewMemoryListener()}
+	app := setupBaseApp(t, routerOpt)
+	app.SetStreamingService(streamingService)
+	app.InitChain(abci.RequestInitChain{})
+
+	for height := int64(1); height <= 2; height++ {
+		header := abci.Header{Height: height}
+		app.BeginBlock(abci.RequestBeginBlock{Header: header})
+
+		// the failed tx writes nothing
+		txBytes := codec.MustMarshalBinaryLengthPrefixed(newTxCounter(height, 0))
+		res := app.DeliverTx(abci.RequestDeliverTx{Tx: txBytes})
+		require.True(t, res.IsOK(), fmt.Sprintf("%v", res))
+
+		tx := newTxCounter(height, 0)
+		tx.setFailOnHandler(true)
+		txBytes = codec.MustMarshalBinaryLengthPrefixed(tx)
+		res = app.DeliverTx(abci.RequestDeliverTx{Tx: txBytes})
+		require.False(t, res.IsOK())
+
+		app.EndBlock(abci.RequestEndBlock{Height: height})
+		require.Len(t, streamingService.writes, int(height-1))
+		app.Commit()
+
+		require.Equal(t, []store.StoreKVPair{
+			{StoreKey: capKey1.Name(), Key: key, Value: encodeHeight(height)},
+		}, streamingService.writes[height-1])
+	}
+
+	require.Equal(t, []string{
+		"begin_block", "deliver_tx", "deliver_tx", "end_block", "commit",
+		"begin_block", "deliver_tx", "deliver_tx", "end_block", "commit",
+	}, streamingService.events)
+}
+
 // Test p2p filter queries
 func TestP2PQuery(t *testing.T) {
 	addrPeerFilterOpt := func(bapp *BaseApp) {
@@ -0,0 +1,106 @@
+package baseapp
+
+import (
+	"fmt"
+	"strconv"
+	"sync"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// blockStatsStore is a ring of the statistics of the most recent blocks. The
+// statistics are node-local, they are not part of the committed state, so they
+// are only kept in memory. It is written by Commit and read by concurrent
+// queries.
+type blockStatsStore struct {
+	mtx   sync.RWMutex
+	stats []sdk.BlockStats // ring of the retained statistics
+	next  int              // index of the oldest statistics once the ring is full
+}
+
+// newBlockStatsStore returns a store retaining the statistics of the given
+// number of blocks, or nil if retention is 0.
+func newBlockStatsStore(retention uint) *blockStatsStore {
+	if retention == 0 {
+		return nil
+	}
+
+	return &blockStatsStore{stats: make([]sdk.BlockStats, 0, retention)}
+}
+
+// add records the statistics of a block, replacing the ones of the oldest
+// block once the retention is reached. The empty statistics of a commit without
+// a block, e.g. after InitChain, are ignored.
+func (bss *blockStatsStore) add(bs sdk.BlockStats) {
+	if bss == nil || bs.Height <= 0 {
+		return
+	}
+
+	bss.mtx.Lock()
+	defer bss.mtx.Unlock()
+
+	if len(bss.stats) < cap(bss.stats) {
+		bss.stats = append(bss.stats, bs)
+		return
+	}
+
+	bss.stats[bss.next] = bs
+	bss.next = (bss.next + 1) % len(bss.stats)
+}
+
+// list returns the retained statistics by ascending height.
+func (bss *blockStatsStore) list() sdk.BlockStatsList {
+	list := sdk.BlockStatsList{}
+	if bss == nil {
+		return list
+	}
+
+	bss.mtx.RLock()
+	defer bss.mtx.RUnlock()
+
+	list = append(list, bss.stats[bss.next:]...)
+	return append(list, bss.stats[:bss.next]...)
+}
+
+// get returns the statistics of the block at the given height, or false if
+// they are not retained.
+func (bss *blockStatsStore) get(height int64) (sdk.BlockStats, bool) {
+	if bss == nil {
+		return sdk.BlockStats{}, false
+	}
+
+	bss.mtx.RLock()
+	defer bss.mtx.RUnlock()
+
+	for _, bs := range bss.stats {
+		if bs.Height == height {
+			return bs, true
+		}
+	}
+
+	return sdk.BlockStats{}, false
+}
+
+// queryBlockStats is the querier of the sdk.BlockStatsQuerierRoute route. It
+// returns the JSON encoded statistics of the retained blocks, or of the block
+// at the height given as the first path element.
+func (app *BaseApp) queryBlockStats(_ sdk.Context, path []string, _ abci.RequestQuery) ([]byte, sdk.Error) {
+	if len(path) == 0 {
+		return codec.Cdc.MustMarshalJSON(app.recentBlockStats.list()), nil
+	}
+
+	height, err := strconv.ParseInt(path[0], 10, 64)
+	if err != nil {
+		return nil, sdk.ErrUnknownRequest(fmt.Sprintf("invalid height: %s", path[0]))
+	}
+
+	bs, ok := app.recentBlockStats.get(height)
+	if !ok {
+		return nil, sdk.ErrUnknownRequest(fmt.Sprintf("no statistics of block %d", height))
+	}
+
+	return codec.Cdc.MustMarshalJSON(bs), nil
+}
@@ -0,0 +1,124 @@
+package baseapp
+
+import (
+	"strconv"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/crypto/tmhash"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// txSlots tracks the sender slots of the transactions accepted by CheckTx, as
+// returned by the TxPriority of the app.
+//
+// A transaction that fails CheckTx may replace the pending transaction of its
+// slot in the committed state if it has a higher priority, i.e. it is checked
+// against the committed state instead. The Tendermint mempool then holds both
+// transactions until the recheck that follows the next Commit, which evicts
+// the replaced transaction. As only the committed state is known, only the
+// next transaction of a sender can be replaced.
+type txSlots struct {
+	// slot -> pending tx, rebuilt by the recheck that follows a Commit
+	pending map[string]slotTx
+
+	// slot -> hash of the tx replacing the pending tx of the slot
+	replaced map[string]string
+
+	// the replacements made before the last Commit, enforced in the recheck
+	evicting map[string]string
+}
+
+type slotTx struct {
+	hash     string
+	priority int64
+}
+
+func newTxSlots() *txSlots {
+	return &txSlots{
+		pending:  make(map[string]slotTx),
+		replaced: make(map[string]string),
+		evicting: make(map[string]string),
+	}
+}
+
+// reset is called on Commit, after which the mempool is rechecked.
+func (ts *txSlots) reset() {
+	ts.evicting = ts.replaced
+	ts.replaced = make(map[string]string)
+	ts.pending = make(map[string]slotTx)
+}
+
+// add records the tx as the pending tx of the slot.
+func (ts *txSlots) add(slot, hash string, priority int64) {
+	if slot != "" {
+		ts.pending[slot] = slotTx{hash: hash, priority: priority}
+	}
+}
+
+// canReplace returns true if a tx with the given priority can replace the
+// pending tx of the slot.
+func (ts *txSlots) canReplace(slot string, priority int64) bool {
+	pending, ok := ts.pending[slot]
+	return slot != "" && ok && priority > pending.priority
+}
+
+// replace records the tx as the replacement of the pending tx of the slot.
+func (ts *txSlots) replace(slot, hash string, priority int64) {
+	ts.replaced[slot] = hash
+	ts.add(slot, hash, priority)
+}
+
+// isEvicted returns true if the tx of the slot was replaced by another one
+// before the last Commit.
+func (ts *txSlots) isEvicted(slot, hash string) bool {
+	replacement, ok := ts.evicting[slot]
+	return slot != "" && ok && replacement != hash
+}
+
+// checkTx runs the tx in CheckTx. If the app has a TxPriority, the priority
+// of the tx is reported in a tx event, and the tx may replace a pending tx of
+// the same sender slot with a lower priority (see txSlots).
+func (app *BaseApp) checkTx(req abci.RequestCheckTx, tx sdk.Tx) sdk.Result {
+	if app.txPriority == nil {
+		return app.runTx(runTxModeCheck, req.Tx, tx)
+	}
+
+	hash := string(tmhash.Sum(req.Tx))
+	priority, slot := app.txPriority(app.getContextForTx(runTxModeCheck, req.Tx), tx)
+
+	if req.Type == abci.CheckTxType_Recheck && app.txSlots.isEvicted(slot, hash) {
+		return sdk.ErrInvalidSequence("tx was replaced by a tx with a higher priority").Result()
+	}
+
+	result := app.runTx(runTxModeCheck, req.Tx, tx)
+	switch {
+	case result.IsOK():
+		app.txSlots.add(slot, hash, priority)
+
+	case req.Type == abci.CheckTxType_New:
+		// the tx may be signed for the slot of a pending tx, which it can only
+		// replace with a higher priority
+		ctx := app.getContextForTx(runTxModeReplace, req.Tx)
+		if _, slot = app.txPriority(ctx, tx); !app.txSlots.canReplace(slot, priority) {
+			return result
+		}
+
+		replaceResult := app.runTx(runTxModeReplace, req.Tx, tx)
+		if !replaceResult.IsOK() {
+			return result
+		}
+
+		result = replaceResult
+		app.txSlots.replace(slot, hash, priority)
+
+	default:
+		return result
+	}
+
+	result.Events = result.Events.AppendEvent(
+		sdk.NewEvent(sdk.EventTypeTx, sdk.NewAttribute(sdk.AttributeKeyPriority, strconv.FormatInt(priority, 10))),
+	)
+
+	return result
+}
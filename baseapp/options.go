@@ -8,6 +8,7 @@ import (
 	dbm "github.com/tendermint/tm-db"
 
 	"github.com/cosmos/cosmos-sdk/store"
+	"github.com/cosmos/cosmos-sdk/store/snapshots"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
@@ -29,11 +30,57 @@ func SetMinGasPrices(gasPricesStr string) func(*BaseApp) {
 	return func(bap *BaseApp) { bap.setMinGasPrices(gasPrices) }
 }
 
+// SetFeePolicies returns an option that sets the fee policies on the app,
+// which override the minimum gas prices for some message routes or types.
+func SetFeePolicies(policies sdk.FeePolicies) func(*BaseApp) {
+	if err := policies.Validate(); err != nil {
+		panic(fmt.Sprintf("invalid fee policies: %v", err))
+	}
+
+	return func(bap *BaseApp) { bap.setFeePolicies(policies) }
+}
+
+// SetSigCacheSize returns an option that sets the number of valid signatures
+// cached by the app. A size of zero disables the cache.
+func SetSigCacheSize(size int) func(*BaseApp) {
+	return func(bap *BaseApp) { bap.setSigCacheSize(size) }
+}
+
 // SetHaltHeight returns a BaseApp option function that sets the halt height.
 func SetHaltHeight(height uint64) func(*BaseApp) {
 	return func(bap *BaseApp) { bap.setHaltHeight(height) }
 }
 
+// SetSnapshotStore returns a BaseApp option function that enables state sync
+// snapshots, stored in the given snapshot store.
+func SetSnapshotStore(snapshotStore *snapshots.Store) func(*BaseApp) {
+	return func(bap *BaseApp) { bap.setSnapshotStore(snapshotStore) }
+}
+
+// SetSnapshotInterval returns a BaseApp option function that sets the block
+// interval between state sync snapshots, 0 disables periodic snapshots.
+func SetSnapshotInterval(interval uint64) func(*BaseApp) {
+	return func(bap *BaseApp) { bap.setSnapshotInterval(interval) }
+}
+
+// SetSnapshotKeepRecent returns a BaseApp option function that sets the number
+// of recent state sync snapshots to keep, 0 keeps all snapshots.
+func SetSnapshotKeepRecent(keepRecent uint32) func(*BaseApp) {
+	return func(bap *BaseApp) { bap.setSnapshotKeepRecent(keepRecent) }
+}
+
+// SetBlockStatsRetention returns a BaseApp option function that sets the
+// number of recent blocks whose statistics are retained, 0 disables it.
+func SetBlockStatsRetention(retention uint) func(*BaseApp) {
+	return func(bap *BaseApp) { bap.setBlockStatsRetention(retention) }
+}
+
+// SetQueryWorkers returns a BaseApp option function that sets the maximum
+// number of queries served concurrently, 0 uses the number of CPUs.
+func SetQueryWorkers(workers uint) func(*BaseApp) {
+	return func(bap *BaseApp) { bap.setQueryWorkers(workers) }
+}
+
 func (app *BaseApp) SetName(name string) {
 	if app.sealed {
 		panic("SetName() on sealed BaseApp")
@@ -91,6 +138,51 @@ func (app *BaseApp) SetAnteHandler(ah sdk.AnteHandler) {
 	app.anteHandler = ah
 }
 
+// SetPostHandler sets the PostHandler, which runs after the messages of every
+// transaction are handled successfully.
+func (app *BaseApp) SetPostHandler(ph sdk.PostHandler) {
+	if app.sealed {
+		panic("SetPostHandler() on sealed BaseApp")
+	}
+	app.postHandler = ph
+}
+
+// SetCircuitBreaker sets the CircuitBreaker, which is asked whether each
+// message may be executed. It is checked by a middleware of the router, so
+// messages dispatched by modules through the router, e.g. by authz or gov, are
+// checked as well as the messages of transactions.
+func (app *BaseApp) SetCircuitBreaker(cb sdk.CircuitBreaker) {
+	if app.sealed {
+		panic("SetCircuitBreaker() on sealed BaseApp")
+	}
+	if app.circuitBreaker != nil {
+		panic("SetCircuitBreaker() called twice")
+	}
+	app.circuitBreaker = cb
+	app.router.AddMiddleware(circuitBreakerMiddleware(cb))
+}
+
+// SetTxPriority sets the priority of transactions in the mempool, which
+// CheckTx reports and uses to let a transaction replace a pending one of the
+// same sender slot.
+func (app *BaseApp) SetTxPriority(txPriority sdk.TxPriority) {
+	if app.sealed {
+		panic("SetTxPriority() on sealed BaseApp")
+	}
+	app.txPriority = txPriority
+}
+
+// SetSigPreVerifier sets how the signatures of the transactions of a block are
+// found, which allows PreVerifyTxs to verify them concurrently before the block
+// is delivered. The AnteHandler must verify them through the signature cache of
+// the context to take advantage of it.
+func (app *BaseApp) SetSigPreVerifier(pv sdk.SigPreVerifier) {
+	if app.sealed {
+		panic("SetSigPreVerifier() on sealed BaseApp")
+	}
+	app.sigPreVerifier = pv
+}
+
 func (app *BaseApp) SetAddrPeerFilter(pf sdk.PeerFilter) {
 	if app.sealed {
 		panic("SetAddrPeerFilter() on sealed BaseApp")
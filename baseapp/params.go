@@ -0,0 +1,68 @@
+package baseapp
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Paramspace defines the parameter subspace of the BaseApp parameters.
+const Paramspace = "baseapp"
+
+// Parameter store keys of the BaseApp parameters.
+var (
+	ParamStoreKeyKVGasConfig        = []byte("KVGasConfig")
+	ParamStoreKeyTransientGasConfig = []byte("TransientGasConfig")
+)
+
+// ParamStore defines the interface the parameter store used by the BaseApp
+// must fulfill.
+type ParamStore interface {
+	Get(ctx sdk.Context, key []byte, ptr interface{})
+	Has(ctx sdk.Context, key []byte) bool
+	Set(ctx sdk.Context, key []byte, param interface{})
+}
+
+// SetParamStore sets the parameter store of the BaseApp parameters, such as
+// the gas costs of the operations on KVStores.
+func (app *BaseApp) SetParamStore(ps ParamStore) {
+	if app.sealed {
+		panic("SetParamStore() on sealed BaseApp")
+	}
+	app.paramStore = ps
+}
+
+// storeDefaultGasConfig stores the default gas costs of the operations on
+// KVStores in the parameter store, from which governance can change them.
+func (app *BaseApp) storeDefaultGasConfig(ctx sdk.Context) {
+	if app.paramStore == nil {
+		return
+	}
+
+	app.paramStore.Set(ctx, ParamStoreKeyKVGasConfig, sdk.KVGasConfig())
+	app.paramStore.Set(ctx, ParamStoreKeyTransientGasConfig, sdk.TransientGasConfig())
+}
+
+// withGasConfig returns the context with the gas costs of the operations on
+// KVStores set in the parameter store, if any. The costs are read at the
+// beginning of every block, so changes to them apply from the next block.
+func (app *BaseApp) withGasConfig(ctx sdk.Context) sdk.Context {
+	if app.paramStore == nil {
+		return ctx
+	}
+
+	// reading the parameters is not charged to the context
+	paramsCtx := ctx.WithGasMeter(sdk.NewInfiniteGasMeter())
+
+	if app.paramStore.Has(paramsCtx, ParamStoreKeyKVGasConfig) {
+		var gasConfig sdk.GasConfig
+		app.paramStore.Get(paramsCtx, ParamStoreKeyKVGasConfig, &gasConfig)
+		ctx = ctx.WithKVGasConfig(gasConfig)
+	}
+
+	if app.paramStore.Has(paramsCtx, ParamStoreKeyTransientGasConfig) {
+		var gasConfig sdk.GasConfig
+		app.paramStore.Get(paramsCtx, ParamStoreKeyTransientGasConfig, &gasConfig)
+		ctx = ctx.WithTransientGasConfig(gasConfig)
+	}
+
+	return ctx
+}
@@ -7,7 +7,9 @@ import (
 )
 
 type router struct {
-	routes map[string]sdk.Handler
+	routes      map[string]sdk.Handler // handlers as added
+	wrapped     map[string]sdk.Handler // handlers wrapped by the middlewares
+	middlewares []sdk.HandlerMiddleware
 }
 
 var _ sdk.Router = NewRouter()
@@ -17,7 +19,8 @@ var _ sdk.Router = NewRouter()
 // TODO: Either make the function private or make return type (router) public.
 func NewRouter() *router { // nolint: golint
 	return &router{
-		routes: make(map[string]sdk.Handler),
+		routes:  make(map[string]sdk.Handler),
+		wrapped: make(map[string]sdk.Handler),
 	}
 }
 
@@ -32,12 +35,47 @@ func (rtr *router) AddRoute(path string, h sdk.Handler) sdk.Router {
 	}
 
 	rtr.routes[path] = h
+	rtr.wrapped[path] = rtr.wrap(h)
 	return rtr
 }
 
-// Route returns a handler for a given route path.
+// AddMiddleware adds a middleware wrapping the handlers of all the routes. The
+// middleware added first is the outermost one, i.e. it runs first.
+func (rtr *router) AddMiddleware(mw sdk.HandlerMiddleware) sdk.Router {
+	rtr.middlewares = append(rtr.middlewares, mw)
+	for path, h := range rtr.routes {
+		rtr.wrapped[path] = rtr.wrap(h)
+	}
+
+	return rtr
+}
+
+// Route returns a handler for a given route path, wrapped by the middleware of
+// the router.
 //
 // TODO: Handle expressive matches.
 func (rtr *router) Route(path string) sdk.Handler {
-	return rtr.routes[path]
+	return rtr.wrapped[path]
+}
+
+// wrap returns the given handler wrapped by the middlewares of the router.
+func (rtr *router) wrap(h sdk.Handler) sdk.Handler {
+	for i := len(rtr.middlewares) - 1; i >= 0; i-- {
+		h = rtr.middlewares[i](h)
+	}
+
+	return h
+}
+
+// circuitBreakerMiddleware returns a middleware failing the messages which the
+// circuit breaker doesn't allow.
+func circuitBreakerMiddleware(cb sdk.CircuitBreaker) sdk.HandlerMiddleware {
+	return func(next sdk.Handler) sdk.Handler {
+		return func(ctx sdk.Context, msg sdk.Msg) sdk.Result {
+			if !cb.IsAllowed(ctx, msg) {
+				return sdk.ErrMsgDisabled(fmt.Sprintf("%s/%s", msg.Route(), msg.Type())).Result()
+			}
+			return next(ctx, msg)
+		}
+	}
 }
@@ -29,3 +29,43 @@ func TestRouter(t *testing.T) {
 		rtr.AddRoute("testRoute", testHandler)
 	})
 }
+
+func TestRouterMiddleware(t *testing.T) {
+	rtr := NewRouter()
+	require.Nil(t, rtr.Route("testRoute"))
+
+	var calls []string
+	wraps := 0
+	middleware := func(name string) sdk.HandlerMiddleware {
+		return func(next sdk.Handler) sdk.Handler {
+			wraps++
+			return func(ctx sdk.Context, msg sdk.Msg) sdk.Result {
+				calls = append(calls, name)
+				return next(ctx, msg)
+			}
+		}
+	}
+
+	rtr.AddRoute("testRoute", func(_ sdk.Context, _ sdk.Msg) sdk.Result {
+		calls = append(calls, "handler")
+		return sdk.Result{}
+	})
+	rtr.AddMiddleware(middleware("first")).AddMiddleware(middleware("second"))
+
+	// the middleware added first runs first
+	rtr.Route("testRoute")(sdk.Context{}, nil)
+	require.Equal(t, []string{"first", "second", "handler"}, calls)
+
+	// routes added after the middlewares are wrapped too
+	rtr.AddRoute("otherRoute", testHandler)
+	calls = nil
+	rtr.Route("otherRoute")(sdk.Context{}, nil)
+	require.Equal(t, []string{"first", "second"}, calls)
+
+	// the handlers are wrapped when routes and middlewares are added, not on
+	// every message
+	wraps = 0
+	rtr.Route("testRoute")(sdk.Context{}, nil)
+	rtr.Route("otherRoute")(sdk.Context{}, nil)
+	require.Zero(t, wraps)
+}
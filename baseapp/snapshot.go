@@ -0,0 +1,78 @@
+package baseapp
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/store/snapshots"
+)
+
+// errSnapshotsDisabled is returned by snapshot operations when no snapshot
+// store has been configured.
+var errSnapshotsDisabled = errors.New("state sync snapshots are disabled")
+
+// snapshot takes a snapshot of the given height and prunes old snapshots. It
+// is run in the background after Commit, so errors are only logged.
+func (app *BaseApp) snapshot(height uint64) {
+	app.logger.Info("creating state snapshot", "height", height)
+	snapshot, err := app.snapshotManager.Create(height)
+	if err != nil {
+		app.logger.Error("failed to create state snapshot", "height", height, "err", err)
+		return
+	}
+	app.logger.Info("completed state snapshot", "height", height, "format", snapshot.Format)
+
+	if app.snapshotKeepRecent > 0 {
+		app.logger.Debug("pruning state snapshots")
+		pruned, err := app.snapshotManager.Prune(app.snapshotKeepRecent)
+		if err != nil {
+			app.logger.Error("failed to prune state snapshots", "err", err)
+			return
+		}
+		app.logger.Debug("pruned state snapshots", "pruned", pruned)
+	}
+}
+
+// ListSnapshots returns the locally stored state sync snapshots, newest first.
+func (app *BaseApp) ListSnapshots() ([]*snapshots.Snapshot, error) {
+	if app.snapshotManager == nil {
+		return nil, errSnapshotsDisabled
+	}
+	return app.snapshotManager.List()
+}
+
+// CreateSnapshot takes a state sync snapshot of the given committed height, or
+// of the latest committed height if height is 0.
+func (app *BaseApp) CreateSnapshot(height uint64) (*snapshots.Snapshot, error) {
+	if app.snapshotManager == nil {
+		return nil, errSnapshotsDisabled
+	}
+	if height == 0 {
+		height = uint64(app.LastBlockHeight())
+	}
+	return app.snapshotManager.Create(height)
+}
+
+// RestoreSnapshot restores the app state from a locally stored state sync
+// snapshot. The app must not have committed any blocks yet, and the restored
+// commit hash must match the given app hash, which should be obtained from a
+// trusted source such as the header of the block following the snapshot
+// height. If the app hash does not match, the data directory must be discarded.
+func (app *BaseApp) RestoreSnapshot(height uint64, format uint32, appHash []byte) error {
+	if app.snapshotManager == nil {
+		return errSnapshotsDisabled
+	}
+
+	err := app.snapshotManager.RestoreLocal(height, format)
+	if err != nil {
+		return err
+	}
+
+	commitID := app.cms.LastCommitID()
+	if !bytes.Equal(commitID.Hash, appHash) {
+		return fmt.Errorf("restored app hash %X does not match expected app hash %X", commitID.Hash, appHash)
+	}
+
+	return nil
+}
@@ -0,0 +1,55 @@
+package baseapp
+
+import (
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/cosmos/cosmos-sdk/store/snapshots"
+)
+
+func TestSnapshots(t *testing.T) {
+	dir, err := ioutil.TempDir("", "snapshots")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	snapshotStore, err := snapshots.NewStore(dir)
+	require.NoError(t, err)
+
+	// snapshots are disabled without a snapshot store
+	app := setupBaseApp(t)
+	_, err = app.CreateSnapshot(0)
+	require.Error(t, err)
+
+	app = setupBaseApp(t, SetSnapshotStore(snapshotStore))
+	app.InitChain(abci.RequestInitChain{})
+	for height := int64(1); height <= 3; height++ {
+		app.BeginBlock(abci.RequestBeginBlock{Header: abci.Header{Height: height}})
+		app.deliverState.ctx.KVStore(capKey2).Set([]byte("key"), []byte{byte(height)})
+		app.EndBlock(abci.RequestEndBlock{Height: height})
+		app.Commit()
+	}
+
+	snapshot, err := app.CreateSnapshot(0)
+	require.NoError(t, err)
+	require.EqualValues(t, 3, snapshot.Height)
+	require.EqualValues(t, snapshots.CurrentFormat, snapshot.Format)
+
+	list, err := app.ListSnapshots()
+	require.NoError(t, err)
+	require.Equal(t, []*snapshots.Snapshot{snapshot}, list)
+
+	appHash := app.LastCommitID().Hash
+
+	// restoring with the wrong app hash must fail
+	restored := setupBaseApp(t, SetSnapshotStore(snapshotStore))
+	require.Error(t, restored.RestoreSnapshot(3, snapshots.CurrentFormat, []byte("wrong")))
+
+	restored = setupBaseApp(t, SetSnapshotStore(snapshotStore))
+	require.NoError(t, restored.RestoreSnapshot(3, snapshots.CurrentFormat, appHash))
+	require.Equal(t, app.LastCommitID(), restored.LastCommitID())
+	require.Equal(t, []byte{3}, restored.cms.GetKVStore(capKey2).Get([]byte("key")))
+}
@@ -0,0 +1,75 @@
+package baseapp
+
+import (
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// ABCIListener is notified of the requests and responses of the ABCI methods
+// that process a block. The markers allow a listener to group the state
+// changes it observes per block.
+type ABCIListener interface {
+	ListenBeginBlock(req abci.RequestBeginBlock, res abci.ResponseBeginBlock) error
+	ListenDeliverTx(req abci.RequestDeliverTx, res abci.ResponseDeliverTx) error
+	ListenEndBlock(req abci.RequestEndBlock, res abci.ResponseEndBlock) error
+
+	// ListenCommit is called once the block's state is committed, i.e. after
+	// the WriteListeners have observed all the writes of the block.
+	ListenCommit(res abci.ResponseCommit) error
+}
+
+// StreamingService streams the state changes of every committed block along
+// with the block's ABCI requests and responses.
+type StreamingService interface {
+	ABCIListener
+
+	// Listeners returns the WriteListeners to register for each store key.
+	Listeners() map[sdk.StoreKey][]sdk.WriteListener
+}
+
+// SetStreamingService registers a StreamingService on the BaseApp. Its
+// WriteListeners are added to the CommitMultiStore and it is notified of the
+// ABCI markers of every block.
+func (app *BaseApp) SetStreamingService(s StreamingService) {
+	for key, listeners := range s.Listeners() {
+		app.cms.AddListeners(key, listeners)
+	}
+
+	app.abciListeners = append(app.abciListeners, s)
+}
+
+// The listening hooks below only log errors, as a failing listener must not
+// halt the processing of blocks.
+
+func (app *BaseApp) listenBeginBlock(req abci.RequestBeginBlock, res abci.ResponseBeginBlock) {
+	for _, l := range app.abciListeners {
+		if err := l.ListenBeginBlock(req, res); err != nil {
+			app.logger.Error("BeginBlock listening hook failed", "height", req.Header.Height, "err", err)
+		}
+	}
+}
+
+func (app *BaseApp) listenDeliverTx(req abci.RequestDeliverTx, res abci.ResponseDeliverTx) {
+	for _, l := range app.abciListeners {
+		if err := l.ListenDeliverTx(req, res); err != nil {
+			app.logger.Error("DeliverTx listening hook failed", "err", err)
+		}
+	}
+}
+
+func (app *BaseApp) listenEndBlock(req abci.RequestEndBlock, res abci.ResponseEndBlock) {
+	for _, l := range app.abciListeners {
+		if err := l.ListenEndBlock(req, res); err != nil {
+			app.logger.Error("EndBlock listening hook failed", "height", req.Height, "err", err)
+		}
+	}
+}
+
+func (app *BaseApp) listenCommit(res abci.ResponseCommit) {
+	for _, l := range app.abciListeners {
+		if err := l.ListenCommit(res); err != nil {
+			app.logger.Error("Commit listening hook failed", "err", err)
+		}
+	}
+}
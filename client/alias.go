@@ -86,6 +86,7 @@ var (
 	ReadPassphraseFromStdin            = keys.ReadPassphraseFromStdin
 	NewKeyBaseFromHomeFlag             = keys.NewKeyBaseFromHomeFlag
 	NewKeyBaseFromDir                  = keys.NewKeyBaseFromDir
+	NewKeyringFromDir                  = keys.NewKeyringFromDir
 	NewInMemoryKeyBase                 = keys.NewInMemoryKeyBase
 	NewRestServer                      = lcd.NewRestServer
 	ServeCommand                       = lcd.ServeCommand
@@ -101,6 +102,9 @@ var (
 	GetValidators                      = rpc.GetValidators
 	ValidatorSetRequestHandlerFn       = rpc.ValidatorSetRequestHandlerFn
 	LatestValidatorSetRequestHandlerFn = rpc.LatestValidatorSetRequestHandlerFn
+	BlockStatsCommand                  = rpc.BlockStatsCommand
+	BlockStatsRequestHandlerFn         = rpc.BlockStatsRequestHandlerFn
+	BlockStatsByHeightRequestHandlerFn = rpc.BlockStatsByHeightRequestHandlerFn
 	GetPassword                        = input.GetPassword
 	GetCheckPassword                   = input.GetCheckPassword
 	GetConfirmation                    = input.GetConfirmation
@@ -151,8 +151,10 @@ func runAddCmd(cmd *cobra.Command, args []string) error {
 			return nil
 		}
 
-		// ask for a password when generating a local key
-		if viper.GetString(FlagPublicKey) == "" && !viper.GetBool(flags.FlagUseLedger) {
+		// ask for a password when generating a local key, unless the keyring
+		// backend protects it
+		if viper.GetString(FlagPublicKey) == "" && !viper.GetBool(flags.FlagUseLedger) &&
+			keyringBackend() == keys.BackendLegacy {
 			encryptPassword, err = input.GetCheckPassword(
 				"Enter a passphrase to encrypt your key to disk:",
 				"Repeat the passphrase:", inBuf)
@@ -65,8 +65,9 @@ func runDeleteCmd(cmd *cobra.Command, args []string) error {
 		return nil
 	}
 
-	// skip passphrase check if run with --force
-	skipPass := viper.GetBool(flagForce)
+	// skip passphrase check if run with --force or if the key is protected
+	// by the keyring backend
+	skipPass := viper.GetBool(flagForce) || keyringBackend() != keys.BackendLegacy
 	var oldpass string
 	if !skipPass {
 		if oldpass, err = input.GetPassword(
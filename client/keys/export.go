@@ -6,6 +6,7 @@ import (
 	"github.com/spf13/cobra"
 
 	"github.com/cosmos/cosmos-sdk/client/input"
+	"github.com/cosmos/cosmos-sdk/crypto/keys"
 )
 
 func exportKeyCommand() *cobra.Command {
@@ -26,9 +27,13 @@ func runExportCmd(cmd *cobra.Command, args []string) error {
 	}
 
 	buf := bufio.NewReader(cmd.InOrStdin())
-	decryptPassword, err := input.GetPassword("Enter passphrase to decrypt your key:", buf)
-	if err != nil {
-		return err
+	// keys protected by the keyring backend don't need to be decrypted
+	var decryptPassword string
+	if keyringBackend() == keys.BackendLegacy {
+		decryptPassword, err = input.GetPassword("Enter passphrase to decrypt your key:", buf)
+		if err != nil {
+			return err
+		}
 	}
 	encryptPassword, err := input.GetPassword("Enter passphrase to encrypt the exported key:", buf)
 	if err != nil {
@@ -0,0 +1,107 @@
+package keys
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/input"
+	"github.com/cosmos/cosmos-sdk/crypto/keys"
+)
+
+func migrateCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "migrate",
+		Short: "Migrate keys from the legacy keybase to the selected keyring",
+		Long: `Migrate all keys from the legacy LevelDB keybase to the keyring selected with
+--keyring-backend. The passphrase of every locally stored private key is prompted
+for to decrypt it. Keys that already exist in the keyring are skipped, and the
+legacy keybase is left untouched.
+`,
+		Args: cobra.NoArgs,
+		RunE: runMigrateCmd,
+	}
+	cmd.Flags().Bool(flagDryRun, false, "Run the migration without persisting any changes to the keyring")
+	return cmd
+}
+
+func runMigrateCmd(cmd *cobra.Command, args []string) error {
+	backend := keyringBackend()
+	if backend == keys.BackendLegacy {
+		return errors.New("select the keyring to migrate to with --keyring-backend")
+	}
+
+	rootDir := viper.GetString(flags.FlagHome)
+	buf := bufio.NewReader(cmd.InOrStdin())
+
+	legacyKb, err := getLazyKeyBaseFromDir(rootDir)
+	if err != nil {
+		return err
+	}
+
+	var kb keys.Keybase
+	if viper.GetBool(flagDryRun) {
+		kb = keys.NewInMemoryKeyring()
+	} else {
+		kb, err = keys.NewKeyring(keyringServiceName, backend, rootDir, buf)
+		if err != nil {
+			return err
+		}
+	}
+
+	infos, err := legacyKb.List()
+	if err != nil {
+		return err
+	}
+
+	if len(infos) == 0 {
+		cmd.PrintErrln("No keys to migrate")
+		return nil
+	}
+
+	for _, info := range infos {
+		name := info.GetName()
+
+		if _, err := kb.Get(name); err == nil {
+			cmd.PrintErrf("Key %q already exists in the keyring, skipping\n", name)
+			continue
+		}
+
+		if info.GetType() != keys.TypeLocal {
+			armor, err := legacyKb.Export(name)
+			if err != nil {
+				return err
+			}
+
+			if err := kb.Import(name, armor); err != nil {
+				return err
+			}
+
+			cmd.PrintErrf("Key %q migrated\n", name)
+			continue
+		}
+
+		passphrase, err := input.GetPassword(fmt.Sprintf("Enter passphrase to decrypt key %q:", name), buf)
+		if err != nil {
+			return err
+		}
+
+		// the key is only armored with its own passphrase in transit
+		armor, err := legacyKb.ExportPrivKey(name, passphrase, passphrase)
+		if err != nil {
+			return fmt.Errorf("failed to decrypt key %q: %v", name, err)
+		}
+
+		if err := kb.ImportPrivKey(name, armor, passphrase); err != nil {
+			return err
+		}
+
+		cmd.PrintErrf("Key %q migrated\n", name)
+	}
+
+	return nil
+}
@@ -0,0 +1,68 @@
+package keys
+
+import (
+	"testing"
+
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/crypto/keys"
+	"github.com/cosmos/cosmos-sdk/tests"
+)
+
+func Test_runMigrateCmd(t *testing.T) {
+	cmd := migrateCommand()
+
+	kbHome, cleanUp := tests.NewTestCaseDir(t)
+	defer cleanUp()
+	viper.Set(flags.FlagHome, kbHome)
+	defer viper.Set(flags.FlagKeyringBackend, "")
+
+	// populate the legacy keybase
+	viper.Set(flags.FlagKeyringBackend, keys.BackendLegacy)
+	legacyKb, err := NewKeyBaseFromHomeFlag()
+	require.NoError(t, err)
+	local, err := legacyKb.CreateAccount("local", tests.TestMnemonic, "", "12345678", 0, 0)
+	require.NoError(t, err)
+	offline, err := legacyKb.CreateAccount("offline", tests.TestMnemonic, "", "", 0, 1)
+	require.NoError(t, err)
+
+	// the legacy keybase can't be migrated into itself
+	require.Error(t, runMigrateCmd(cmd, []string{}))
+
+	viper.Set(flags.FlagKeyringBackend, keys.BackendTest)
+
+	// a wrong passphrase aborts the migration
+	mockIn, _, _ := tests.ApplyMockIO(cmd)
+	mockIn.Reset("wrongpassword\n")
+	require.Error(t, runMigrateCmd(cmd, []string{}))
+
+	// a dry run doesn't persist anything
+	viper.Set(flagDryRun, true)
+	mockIn.Reset("12345678\n")
+	require.NoError(t, runMigrateCmd(cmd, []string{}))
+	viper.Set(flagDryRun, false)
+
+	kb, err := NewKeyBaseFromHomeFlag()
+	require.NoError(t, err)
+	_, err = kb.Get("local")
+	require.Error(t, err)
+
+	mockIn.Reset("12345678\n")
+	require.NoError(t, runMigrateCmd(cmd, []string{}))
+
+	info, err := kb.Get("local")
+	require.NoError(t, err)
+	require.Equal(t, local.GetPubKey(), info.GetPubKey())
+	require.Equal(t, keys.TypeLocal, info.GetType())
+	_, _, err = kb.Sign("local", "", []byte("message"))
+	require.NoError(t, err)
+
+	info, err = kb.GetByAddress(offline.GetAddress())
+	require.NoError(t, err)
+	require.Equal(t, keys.TypeOffline, info.GetType())
+
+	// migrating again skips the existing keys
+	require.NoError(t, runMigrateCmd(cmd, []string{}))
+}
@@ -3,6 +3,7 @@ package keys
 import (
 	"bufio"
 	"fmt"
+	"io"
 	"os"
 	"path/filepath"
 
@@ -22,6 +23,9 @@ const (
 
 	// defaultKeyDBName is the client's subdirectory where keys are stored.
 	defaultKeyDBName = "keys"
+
+	// keyringServiceName scopes the keys in shared keyring backends.
+	keyringServiceName = "cosmos"
 )
 
 type bechKeyOutFn func(keyInfo keys.Info) (keys.KeyOutput, error)
@@ -49,9 +53,10 @@ func GetPassphrase(name string) (string, error) {
 		return passphrase, err
 	}
 
-	// we only need a passphrase for locally stored keys
+	// we only need a passphrase for locally stored keys, which are protected
+	// by the keyring itself unless the legacy backend is used
 	// TODO: (ref: #864) address security concerns
-	if keyInfo.GetType() == keys.TypeLocal {
+	if keyInfo.GetType() == keys.TypeLocal && keyringBackend() == keys.BackendLegacy {
 		passphrase, err = ReadPassphraseFromStdin(name)
 		if err != nil {
 			return passphrase, err
@@ -81,9 +86,22 @@ func NewKeyBaseFromHomeFlag() (keys.Keybase, error) {
 	return NewKeyBaseFromDir(rootDir)
 }
 
-// NewKeyBaseFromDir initializes a keybase at a particular dir.
+// NewKeyBaseFromDir initializes a keybase at a particular dir, using the
+// backend selected with the --keyring-backend flag.
 func NewKeyBaseFromDir(rootDir string) (keys.Keybase, error) {
-	return getLazyKeyBaseFromDir(rootDir)
+	return NewKeyringFromDir(rootDir, os.Stdin)
+}
+
+// NewKeyringFromDir initializes a keybase at a particular dir, using the
+// backend selected with the --keyring-backend flag. Keyring passphrases are
+// read from userInput.
+func NewKeyringFromDir(rootDir string, userInput io.Reader) (keys.Keybase, error) {
+	backend := keyringBackend()
+	if backend == keys.BackendLegacy {
+		return getLazyKeyBaseFromDir(rootDir)
+	}
+
+	return keys.NewKeyring(keyringServiceName, backend, rootDir, userInput)
 }
 
 // NewInMemoryKeyBase returns a storage-less keybase.
@@ -93,6 +111,16 @@ func getLazyKeyBaseFromDir(rootDir string) (keys.Keybase, error) {
 	return keys.New(defaultKeyDBName, filepath.Join(rootDir, "keys")), nil
 }
 
+// keyringBackend returns the keybase backend selected with the
+// --keyring-backend flag.
+func keyringBackend() string {
+	backend := viper.GetString(flags.FlagKeyringBackend)
+	if backend == "" {
+		return flags.DefaultKeyringBackend
+	}
+	return backend
+}
+
 func printKeyInfo(keyInfo keys.Info, bechKeyOut bechKeyOutFn) {
 	ko, err := bechKeyOut(keyInfo)
 	if err != nil {
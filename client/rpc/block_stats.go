@@ -0,0 +1,103 @@
+package rpc
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/gorilla/mux"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/rest"
+)
+
+// BlockStatsCommand returns the statistics of the recent blocks retained by
+// the node, or of the block at the given height.
+func BlockStatsCommand(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "block-stats [height]",
+		Short: "Get the gas, txs and fees of the recent blocks retained by the node",
+		Args:  cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			if len(args) == 0 {
+				var stats sdk.BlockStatsList
+
+				res, _, err := cliCtx.Query(fmt.Sprintf("custom/%s", sdk.BlockStatsQuerierRoute))
+				if err != nil {
+					return err
+				}
+
+				cdc.MustUnmarshalJSON(res, &stats)
+				return cliCtx.PrintOutput(stats)
+			}
+
+			height, err := strconv.ParseInt(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			var stats sdk.BlockStats
+
+			res, _, err := cliCtx.Query(fmt.Sprintf("custom/%s/%d", sdk.BlockStatsQuerierRoute, height))
+			if err != nil {
+				return err
+			}
+
+			cdc.MustUnmarshalJSON(res, &stats)
+			return cliCtx.PrintOutput(stats)
+		},
+	}
+
+	cmd.Flags().StringP(flags.FlagNode, "n", "tcp://localhost:26657", "Node to connect to")
+	viper.BindPFlag(flags.FlagNode, cmd.Flags().Lookup(flags.FlagNode))
+	cmd.Flags().Bool(flags.FlagIndentResponse, false, "indent JSON response")
+	viper.BindPFlag(flags.FlagIndentResponse, cmd.Flags().Lookup(flags.FlagIndentResponse))
+
+	return cmd
+}
+
+// REST
+
+// REST handler to get the statistics of the recent blocks
+func BlockStatsRequestHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		res, height, err := cliCtx.Query(fmt.Sprintf("custom/%s", sdk.BlockStatsQuerierRoute))
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		cliCtx = cliCtx.WithHeight(height)
+		rest.PostProcessResponse(w, cliCtx, res)
+	}
+}
+
+// REST handler to get the statistics of a block
+func BlockStatsByHeightRequestHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		vars := mux.Vars(r)
+
+		height, err := strconv.ParseInt(vars["height"], 10, 64)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest,
+				"couldn't parse block height. Assumed format is '/block_stats/{height}'.")
+			return
+		}
+
+		res, queryHeight, err := cliCtx.Query(fmt.Sprintf("custom/%s/%d", sdk.BlockStatsQuerierRoute, height))
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusNotFound, err.Error())
+			return
+		}
+
+		cliCtx = cliCtx.WithHeight(queryHeight)
+		rest.PostProcessResponse(w, cliCtx, res)
+	}
+}
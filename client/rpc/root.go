@@ -14,4 +14,6 @@ func RegisterRPCRoutes(cliCtx context.CLIContext, r *mux.Router) {
 	r.HandleFunc("/blocks/{height}", BlockRequestHandlerFn(cliCtx)).Methods("GET")
 	r.HandleFunc("/validatorsets/latest", LatestValidatorSetRequestHandlerFn(cliCtx)).Methods("GET")
 	r.HandleFunc("/validatorsets/{height}", ValidatorSetRequestHandlerFn(cliCtx)).Methods("GET")
+	r.HandleFunc("/block_stats", BlockStatsRequestHandlerFn(cliCtx)).Methods("GET")
+	r.HandleFunc("/block_stats/{height}", BlockStatsByHeightRequestHandlerFn(cliCtx)).Methods("GET")
 }
@@ -0,0 +1,80 @@
+package codec
+
+// AminoCodec defines a Marshaler that encodes binary and JSON with amino. It
+// keeps the encoding of state that was written before the protobuf types were
+// introduced.
+type AminoCodec struct {
+	amino *Codec
+}
+
+var _ Marshaler = (*AminoCodec)(nil)
+
+// NewAminoCodec returns a new AminoCodec using the given amino codec.
+func NewAminoCodec(amino *Codec) *AminoCodec {
+	return &AminoCodec{amino: amino}
+}
+
+// Amino returns the underlying amino codec.
+func (ac *AminoCodec) Amino() *Codec {
+	return ac.amino
+}
+
+// MarshalBinaryBare implements Marshaler.
+func (ac *AminoCodec) MarshalBinaryBare(o ProtoMarshaler) ([]byte, error) {
+	return ac.amino.MarshalBinaryBare(o)
+}
+
+// MustMarshalBinaryBare implements Marshaler.
+func (ac *AminoCodec) MustMarshalBinaryBare(o ProtoMarshaler) []byte {
+	return ac.amino.MustMarshalBinaryBare(o)
+}
+
+// MarshalBinaryLengthPrefixed implements Marshaler.
+func (ac *AminoCodec) MarshalBinaryLengthPrefixed(o ProtoMarshaler) ([]byte, error) {
+	return ac.amino.MarshalBinaryLengthPrefixed(o)
+}
+
+// MustMarshalBinaryLengthPrefixed implements Marshaler.
+func (ac *AminoCodec) MustMarshalBinaryLengthPrefixed(o ProtoMarshaler) []byte {
+	return ac.amino.MustMarshalBinaryLengthPrefixed(o)
+}
+
+// UnmarshalBinaryBare implements Marshaler.
+func (ac *AminoCodec) UnmarshalBinaryBare(bz []byte, ptr ProtoMarshaler) error {
+	return ac.amino.UnmarshalBinaryBare(bz, ptr)
+}
+
+// MustUnmarshalBinaryBare implements Marshaler.
+func (ac *AminoCodec) MustUnmarshalBinaryBare(bz []byte, ptr ProtoMarshaler) {
+	ac.amino.MustUnmarshalBinaryBare(bz, ptr)
+}
+
+// UnmarshalBinaryLengthPrefixed implements Marshaler.
+func (ac *AminoCodec) UnmarshalBinaryLengthPrefixed(bz []byte, ptr ProtoMarshaler) error {
+	return ac.amino.UnmarshalBinaryLengthPrefixed(bz, ptr)
+}
+
+// MustUnmarshalBinaryLengthPrefixed implements Marshaler.
+func (ac *AminoCodec) MustUnmarshalBinaryLengthPrefixed(bz []byte, ptr ProtoMarshaler) {
+	ac.amino.MustUnmarshalBinaryLengthPrefixed(bz, ptr)
+}
+
+// MarshalJSON implements Marshaler.
+func (ac *AminoCodec) MarshalJSON(o interface{}) ([]byte, error) {
+	return ac.amino.MarshalJSON(o)
+}
+
+// MustMarshalJSON implements Marshaler.
+func (ac *AminoCodec) MustMarshalJSON(o interface{}) []byte {
+	return ac.amino.MustMarshalJSON(o)
+}
+
+// UnmarshalJSON implements Marshaler.
+func (ac *AminoCodec) UnmarshalJSON(bz []byte, ptr interface{}) error {
+	return ac.amino.UnmarshalJSON(bz, ptr)
+}
+
+// MustUnmarshalJSON implements Marshaler.
+func (ac *AminoCodec) MustUnmarshalJSON(bz []byte, ptr interface{}) {
+	ac.amino.MustUnmarshalJSON(bz, ptr)
+}
@@ -0,0 +1,119 @@
+package codec
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+)
+
+// HybridCodec defines a Marshaler that encodes binary with protobuf and JSON
+// with amino. Amino JSON is kept for sign bytes and legacy REST clients.
+type HybridCodec struct {
+	amino *Codec
+}
+
+var _ Marshaler = (*HybridCodec)(nil)
+
+// NewHybridCodec returns a new HybridCodec using the given amino codec for
+// JSON.
+func NewHybridCodec(amino *Codec) *HybridCodec {
+	return &HybridCodec{amino: amino}
+}
+
+// Amino returns the underlying amino codec.
+func (hc *HybridCodec) Amino() *Codec {
+	return hc.amino
+}
+
+// MarshalBinaryBare implements Marshaler.
+func (hc *HybridCodec) MarshalBinaryBare(o ProtoMarshaler) ([]byte, error) {
+	return o.Marshal()
+}
+
+// MustMarshalBinaryBare implements Marshaler.
+func (hc *HybridCodec) MustMarshalBinaryBare(o ProtoMarshaler) []byte {
+	bz, err := hc.MarshalBinaryBare(o)
+	if err != nil {
+		panic(err)
+	}
+
+	return bz
+}
+
+// MarshalBinaryLengthPrefixed implements Marshaler. The message is prefixed
+// with its length as an unsigned varint.
+func (hc *HybridCodec) MarshalBinaryLengthPrefixed(o ProtoMarshaler) ([]byte, error) {
+	bz, err := hc.MarshalBinaryBare(o)
+	if err != nil {
+		return nil, err
+	}
+
+	var sizeBuf [binary.MaxVarintLen64]byte
+	n := binary.PutUvarint(sizeBuf[:], uint64(len(bz)))
+	return append(sizeBuf[:n], bz...), nil
+}
+
+// MustMarshalBinaryLengthPrefixed implements Marshaler.
+func (hc *HybridCodec) MustMarshalBinaryLengthPrefixed(o ProtoMarshaler) []byte {
+	bz, err := hc.MarshalBinaryLengthPrefixed(o)
+	if err != nil {
+		panic(err)
+	}
+
+	return bz
+}
+
+// UnmarshalBinaryBare implements Marshaler.
+func (hc *HybridCodec) UnmarshalBinaryBare(bz []byte, ptr ProtoMarshaler) error {
+	ptr.Reset()
+	return ptr.Unmarshal(bz)
+}
+
+// MustUnmarshalBinaryBare implements Marshaler.
+func (hc *HybridCodec) MustUnmarshalBinaryBare(bz []byte, ptr ProtoMarshaler) {
+	if err := hc.UnmarshalBinaryBare(bz, ptr); err != nil {
+		panic(err)
+	}
+}
+
+// UnmarshalBinaryLengthPrefixed implements Marshaler.
+func (hc *HybridCodec) UnmarshalBinaryLengthPrefixed(bz []byte, ptr ProtoMarshaler) error {
+	size, n := binary.Uvarint(bz)
+	if n <= 0 {
+		return errors.New("invalid length prefix")
+	}
+
+	bz = bz[n:]
+	if uint64(len(bz)) != size {
+		return fmt.Errorf("length prefix %d does not match the length %d of the message", size, len(bz))
+	}
+
+	return hc.UnmarshalBinaryBare(bz, ptr)
+}
+
+// MustUnmarshalBinaryLengthPrefixed implements Marshaler.
+func (hc *HybridCodec) MustUnmarshalBinaryLengthPrefixed(bz []byte, ptr ProtoMarshaler) {
+	if err := hc.UnmarshalBinaryLengthPrefixed(bz, ptr); err != nil {
+		panic(err)
+	}
+}
+
+// MarshalJSON implements Marshaler.
+func (hc *HybridCodec) MarshalJSON(o interface{}) ([]byte, error) {
+	return hc.amino.MarshalJSON(o)
+}
+
+// MustMarshalJSON implements Marshaler.
+func (hc *HybridCodec) MustMarshalJSON(o interface{}) []byte {
+	return hc.amino.MustMarshalJSON(o)
+}
+
+// UnmarshalJSON implements Marshaler.
+func (hc *HybridCodec) UnmarshalJSON(bz []byte, ptr interface{}) error {
+	return hc.amino.UnmarshalJSON(bz, ptr)
+}
+
+// MustUnmarshalJSON implements Marshaler.
+func (hc *HybridCodec) MustUnmarshalJSON(bz []byte, ptr interface{}) {
+	hc.amino.MustUnmarshalJSON(bz, ptr)
+}
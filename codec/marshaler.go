@@ -0,0 +1,48 @@
+package codec
+
+import (
+	"github.com/gogo/protobuf/proto"
+)
+
+type (
+	// Marshaler defines the interface module keepers use to encode and decode
+	// their state. Binary encoding is restricted to protobuf messages so that
+	// every stored type has a protobuf definition, whereas JSON encoding accepts
+	// any value and is used for queries and legacy REST.
+	Marshaler interface {
+		MarshalBinaryBare(o ProtoMarshaler) ([]byte, error)
+		MustMarshalBinaryBare(o ProtoMarshaler) []byte
+
+		MarshalBinaryLengthPrefixed(o ProtoMarshaler) ([]byte, error)
+		MustMarshalBinaryLengthPrefixed(o ProtoMarshaler) []byte
+
+		UnmarshalBinaryBare(bz []byte, ptr ProtoMarshaler) error
+		MustUnmarshalBinaryBare(bz []byte, ptr ProtoMarshaler)
+
+		UnmarshalBinaryLengthPrefixed(bz []byte, ptr ProtoMarshaler) error
+		MustUnmarshalBinaryLengthPrefixed(bz []byte, ptr ProtoMarshaler)
+
+		JSONMarshaler
+	}
+
+	// JSONMarshaler defines the JSON encoding of a codec.
+	JSONMarshaler interface {
+		MarshalJSON(o interface{}) ([]byte, error)
+		MustMarshalJSON(o interface{}) []byte
+
+		UnmarshalJSON(bz []byte, ptr interface{}) error
+		MustUnmarshalJSON(bz []byte, ptr interface{})
+	}
+
+	// ProtoMarshaler defines an interface a type must implement to be
+	// serialized as a protobuf message. It is implemented by the code generated
+	// with the gogoproto marshaler, unmarshaler and sizer options.
+	ProtoMarshaler interface {
+		proto.Message
+
+		Marshal() ([]byte, error)
+		MarshalTo(data []byte) (n int, err error)
+		Size() int
+		Unmarshal(data []byte) error
+	}
+)
@@ -0,0 +1,78 @@
+package codec
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/gogo/protobuf/proto"
+)
+
+// The functions below help to write the protobuf encoding of types that cannot
+// be generated, such as the ones holding an interface value. Fields are only
+// appended when they are not empty, following proto3.
+
+// AppendProtoVarint appends a varint field to a protobuf message.
+func AppendProtoVarint(bz []byte, field int, v uint64) []byte {
+	if v == 0 {
+		return bz
+	}
+
+	bz = append(bz, proto.EncodeVarint(uint64(field)<<3|proto.WireVarint)...)
+	return append(bz, proto.EncodeVarint(v)...)
+}
+
+// AppendProtoBytes appends a length delimited field to a protobuf message.
+func AppendProtoBytes(bz []byte, field int, data []byte) []byte {
+	if len(data) == 0 {
+		return bz
+	}
+
+	bz = append(bz, proto.EncodeVarint(uint64(field)<<3|proto.WireBytes)...)
+	bz = append(bz, proto.EncodeVarint(uint64(len(data)))...)
+	return append(bz, data...)
+}
+
+// AppendProtoMessage appends an embedded message field to a protobuf message.
+// Unlike AppendProtoBytes, the field is appended even if the message is empty
+// so that repeated messages keep their count.
+func AppendProtoMessage(bz []byte, field int, msg ProtoMarshaler) ([]byte, error) {
+	data, err := msg.Marshal()
+	if err != nil {
+		return nil, err
+	}
+
+	bz = append(bz, proto.EncodeVarint(uint64(field)<<3|proto.WireBytes)...)
+	bz = append(bz, proto.EncodeVarint(uint64(len(data)))...)
+	return append(bz, data...), nil
+}
+
+// DecodeProtoField decodes the next field of a protobuf message. It returns the
+// field number, the value of a varint field or the data of a length delimited
+// field, and the number of bytes read. Other wire types are not supported.
+func DecodeProtoField(bz []byte) (field int, v uint64, data []byte, n int, err error) {
+	key, n := proto.DecodeVarint(bz)
+	if n == 0 {
+		return 0, 0, nil, 0, errors.New("proto: invalid field key")
+	}
+
+	field = int(key >> 3)
+	switch wireType := key & 0x7; wireType {
+	case proto.WireVarint:
+		v, m := proto.DecodeVarint(bz[n:])
+		if m == 0 {
+			return 0, 0, nil, 0, fmt.Errorf("proto: invalid varint for field %d", field)
+		}
+		return field, v, nil, n + m, nil
+
+	case proto.WireBytes:
+		l, m := proto.DecodeVarint(bz[n:])
+		if m == 0 || uint64(len(bz)-n-m) < l {
+			return 0, 0, nil, 0, fmt.Errorf("proto: invalid length for field %d", field)
+		}
+		start := n + m
+		return field, 0, bz[start : start+int(l)], start + int(l), nil
+
+	default:
+		return 0, 0, nil, 0, fmt.Errorf("proto: unsupported wire type %d for field %d", wireType, field)
+	}
+}
@@ -103,13 +103,7 @@ func (kb dbKeybase) CreateMnemonic(name string, language Language, passwd string
 		return
 	}
 
-	// default number of words (24):
-	// this generates a mnemonic directly from the number of words by reading system entropy.
-	entropy, err := bip39.NewEntropy(defaultEntropySize)
-	if err != nil {
-		return
-	}
-	mnemonic, err = bip39.NewMnemonic(entropy)
+	mnemonic, err = newMnemonic()
 	if err != nil {
 		return
 	}
@@ -169,9 +163,7 @@ func (kb dbKeybase) CreateMulti(name string, pub tmcrypto.PubKey) (Info, error)
 }
 
 func (kb *dbKeybase) persistDerivedKey(seed []byte, passwd, name, fullHdPath string) (info Info, err error) {
-	// create master key and derive first key:
-	masterPriv, ch := hd.ComputeMastersFromSeed(seed)
-	derivedPriv, err := hd.DerivePrivateKeyForPath(masterPriv, ch, fullHdPath)
+	derivedPriv, err := derivePrivKey(seed, fullHdPath)
 	if err != nil {
 		return
 	}
@@ -179,10 +171,9 @@ func (kb *dbKeybase) persistDerivedKey(seed []byte, passwd, name, fullHdPath str
 	// if we have a password, use it to encrypt the private key and store it
 	// else store the public key only
 	if passwd != "" {
-		info = kb.writeLocalKey(name, secp256k1.PrivKeySecp256k1(derivedPriv), passwd)
+		info = kb.writeLocalKey(name, derivedPriv, passwd)
 	} else {
-		pubk := secp256k1.PrivKeySecp256k1(derivedPriv).PubKey()
-		info = kb.writeOfflineKey(name, pubk)
+		info = kb.writeOfflineKey(name, derivedPriv.PubKey())
 	}
 	return
 }
@@ -254,28 +245,7 @@ func (kb dbKeybase) Sign(name, passphrase string, msg []byte) (sig []byte, pub t
 		}
 
 	case offlineInfo, multiInfo:
-		_, err := fmt.Fprintf(os.Stderr, "Message to sign:\n\n%s\n", msg)
-		if err != nil {
-			return nil, nil, err
-		}
-
-		buf := bufio.NewReader(os.Stdin)
-		_, err = fmt.Fprintf(os.Stderr, "\nEnter Amino-encoded signature:\n")
-		if err != nil {
-			return nil, nil, err
-		}
-
-		// Will block until user inputs the signature
-		signed, err := buf.ReadString('\n')
-		if err != nil {
-			return nil, nil, err
-		}
-
-		if err := cdc.UnmarshalBinaryLengthPrefixed([]byte(signed), sig); err != nil {
-			return nil, nil, errors.Wrap(err, "failed to decode signature")
-		}
-
-		return sig, info.GetPubKey(), nil
+		return signWithStdin(info, msg)
 	}
 
 	sig, err = priv.Sign(msg)
@@ -455,6 +425,53 @@ func (kb dbKeybase) CloseDB() {
 	kb.db.Close()
 }
 
+// newMnemonic generates a new 24 words mnemonic from system entropy.
+func newMnemonic() (string, error) {
+	entropy, err := bip39.NewEntropy(defaultEntropySize)
+	if err != nil {
+		return "", err
+	}
+	return bip39.NewMnemonic(entropy)
+}
+
+// derivePrivKey derives the secp256k1 private key at the given HD path from
+// a BIP39 seed.
+func derivePrivKey(seed []byte, fullHdPath string) (tmcrypto.PrivKey, error) {
+	masterPriv, ch := hd.ComputeMastersFromSeed(seed)
+	derivedPriv, err := hd.DerivePrivateKeyForPath(masterPriv, ch, fullHdPath)
+	if err != nil {
+		return nil, err
+	}
+	return secp256k1.PrivKeySecp256k1(derivedPriv), nil
+}
+
+// signWithStdin prints the message to sign and reads the signature made by
+// an offline or multisig key from STDIN.
+func signWithStdin(info Info, msg []byte) (sig []byte, pub tmcrypto.PubKey, err error) {
+	_, err = fmt.Fprintf(os.Stderr, "Message to sign:\n\n%s\n", msg)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	buf := bufio.NewReader(os.Stdin)
+	_, err = fmt.Fprintf(os.Stderr, "\nEnter Amino-encoded signature:\n")
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// Will block until user inputs the signature
+	signed, err := buf.ReadString('\n')
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if err := cdc.UnmarshalBinaryLengthPrefixed([]byte(signed), &sig); err != nil {
+		return nil, nil, errors.Wrap(err, "failed to decode signature")
+	}
+
+	return sig, info.GetPubKey(), nil
+}
+
 func (kb dbKeybase) writeLocalKey(name string, priv tmcrypto.PrivKey, passphrase string) Info {
 	// encrypt private key using passphrase
 	privArmor := mintkey.EncryptArmorPrivKey(priv, passphrase)
@@ -0,0 +1,491 @@
+package keys
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	bip39 "github.com/cosmos/go-bip39"
+
+	tmcrypto "github.com/tendermint/tendermint/crypto"
+	cryptoAmino "github.com/tendermint/tendermint/crypto/encoding/amino"
+
+	"github.com/cosmos/cosmos-sdk/crypto"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/hd"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/keyerror"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/mintkey"
+	"github.com/cosmos/cosmos-sdk/types"
+)
+
+// Keybase backends.
+const (
+	// BackendLegacy stores keys in a LevelDB, each private key encrypted with
+	// its own passphrase. See New.
+	BackendLegacy = "legacy"
+	// BackendFile stores every entry in its own file, encrypted with a
+	// single keyring passphrase.
+	BackendFile = "file"
+	// BackendPass stores every entry in the pass password store, which
+	// encrypts it with the user's GPG key.
+	BackendPass = "pass"
+	// BackendTest stores every entry in its own unencrypted file. It must
+	// only be used for testing.
+	BackendTest = "test"
+	// BackendMemory keeps entries in memory only.
+	BackendMemory = "memory"
+
+	privKeySuffix = "privkey"
+)
+
+var _ Keybase = keyringKeybase{}
+
+// keyringStore is a minimal secret store holding opaque items under string
+// keys. It is implemented by each keyring backend, which is responsible for
+// protecting the stored items.
+type keyringStore interface {
+	// Get returns the item stored under key, or nil if it does not exist.
+	Get(key string) ([]byte, error)
+	// Set stores an item under key, replacing any existing item.
+	Set(key string, value []byte) error
+	// Remove deletes the item stored under key, if any.
+	Remove(key string) error
+	// Keys returns the keys of all stored items in ascending order.
+	Keys() ([]string, error)
+}
+
+// keyringKeybase is a Keybase on top of a keyringStore. Unlike dbKeybase,
+// private keys are not encrypted with per-key passphrases, since the store
+// itself protects them; passphrase arguments for locally stored keys are
+// therefore ignored.
+type keyringKeybase struct {
+	store keyringStore
+}
+
+// NewKeyring creates a new keybase using the given backend. Keys of the file
+// and test backends are stored under rootDir, while name scopes the entries
+// in the pass password store. The file backend prompts for the keyring
+// passphrase on userInput.
+func NewKeyring(name, backend, rootDir string, userInput io.Reader) (Keybase, error) {
+	var (
+		store keyringStore
+		err   error
+	)
+
+	switch backend {
+	case BackendFile:
+		store, err = newFileStore(filepath.Join(rootDir, "keyring-file"), newPassphrasePrompt(userInput))
+	case BackendTest:
+		store, err = newFileStore(filepath.Join(rootDir, "keyring-test"), nil)
+	case BackendPass:
+		store, err = newPassStore(name)
+	case BackendMemory:
+		store = newMemStore()
+	default:
+		return nil, fmt.Errorf("unknown keyring backend %q", backend)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+	return keyringKeybase{store: store}, nil
+}
+
+// NewInMemoryKeyring creates a keyring keybase on top of in-memory storage,
+// useful for testing and dry runs.
+func NewInMemoryKeyring() Keybase {
+	return keyringKeybase{store: newMemStore()}
+}
+
+// CreateMnemonic generates a new key from a fresh mnemonic and persists it.
+// The passphrase is ignored.
+func (kb keyringKeybase) CreateMnemonic(name string, language Language, _ string, algo SigningAlgo) (info Info, mnemonic string, err error) {
+	if language != English {
+		return nil, "", ErrUnsupportedLanguage
+	}
+	if algo != Secp256k1 {
+		return nil, "", ErrUnsupportedSigningAlgo
+	}
+
+	mnemonic, err = newMnemonic()
+	if err != nil {
+		return nil, "", err
+	}
+
+	seed := bip39.NewSeed(mnemonic, DefaultBIP39Passphrase)
+	info, err = kb.persistDerivedKey(seed, name, types.GetConfig().GetFullFundraiserPath())
+	return info, mnemonic, err
+}
+
+// CreateAccount converts a mnemonic to a private key and persists it. The
+// encryption passphrase is ignored.
+func (kb keyringKeybase) CreateAccount(name, mnemonic, bip39Passwd, encryptPasswd string, account uint32, index uint32) (Info, error) {
+	coinType := types.GetConfig().GetCoinType()
+	hdPath := hd.NewFundraiserParams(account, coinType, index)
+	return kb.Derive(name, mnemonic, bip39Passwd, encryptPasswd, *hdPath)
+}
+
+// Derive computes a BIP39 seed from the mnemonic and bip39Passphrase, derives
+// the private key at the given BIP44 path and persists it. The encryption
+// passphrase is ignored.
+func (kb keyringKeybase) Derive(name, mnemonic, bip39Passphrase, _ string, params hd.BIP44Params) (Info, error) {
+	seed, err := bip39.NewSeedWithErrorChecking(mnemonic, bip39Passphrase)
+	if err != nil {
+		return nil, err
+	}
+
+	return kb.persistDerivedKey(seed, name, params.String())
+}
+
+// CreateLedger creates a new reference to a Ledger keypair.
+func (kb keyringKeybase) CreateLedger(name string, algo SigningAlgo, hrp string, account, index uint32) (Info, error) {
+	if algo != Secp256k1 {
+		return nil, ErrUnsupportedSigningAlgo
+	}
+
+	coinType := types.GetConfig().GetCoinType()
+	hdPath := hd.NewFundraiserParams(account, coinType, index)
+	priv, _, err := crypto.NewPrivKeyLedgerSecp256k1(*hdPath, hrp)
+	if err != nil {
+		return nil, err
+	}
+
+	info := newLedgerInfo(name, priv.PubKey(), *hdPath)
+	return info, kb.writeInfo(name, info)
+}
+
+// CreateOffline creates a new reference to an offline keypair.
+func (kb keyringKeybase) CreateOffline(name string, pub tmcrypto.PubKey) (Info, error) {
+	info := newOfflineInfo(name, pub)
+	return info, kb.writeInfo(name, info)
+}
+
+// CreateMulti creates a new reference to a multisig (offline) keypair.
+func (kb keyringKeybase) CreateMulti(name string, pub tmcrypto.PubKey) (Info, error) {
+	info := NewMultiInfo(name, pub)
+	return info, kb.writeInfo(name, info)
+}
+
+func (kb keyringKeybase) persistDerivedKey(seed []byte, name, fullHdPath string) (Info, error) {
+	derivedPriv, err := derivePrivKey(seed, fullHdPath)
+	if err != nil {
+		return nil, err
+	}
+
+	return kb.writeLocalKey(name, derivedPriv)
+}
+
+// List returns the keys from storage in alphabetical order.
+func (kb keyringKeybase) List() ([]Info, error) {
+	keys, err := kb.store.Keys()
+	if err != nil {
+		return nil, err
+	}
+
+	var res []Info
+	for _, key := range keys {
+		// need to include only keys in storage that have an info suffix
+		if !strings.HasSuffix(key, "."+infoSuffix) {
+			continue
+		}
+
+		bz, err := kb.store.Get(key)
+		if err != nil {
+			return nil, err
+		}
+		if bz == nil {
+			continue
+		}
+
+		info, err := readInfo(bz)
+		if err != nil {
+			return nil, err
+		}
+		res = append(res, info)
+	}
+	return res, nil
+}
+
+// Get returns the public information about one key.
+func (kb keyringKeybase) Get(name string) (Info, error) {
+	bz, err := kb.store.Get(string(infoKey(name)))
+	if err != nil {
+		return nil, err
+	}
+	if len(bz) == 0 {
+		return nil, keyerror.NewErrKeyNotFound(name)
+	}
+	return readInfo(bz)
+}
+
+// GetByAddress returns the public information about the key with the given
+// address.
+func (kb keyringKeybase) GetByAddress(address types.AccAddress) (Info, error) {
+	ik, err := kb.store.Get(string(addrKey(address)))
+	if err != nil {
+		return nil, err
+	}
+	if len(ik) == 0 {
+		return nil, fmt.Errorf("key with address %s not found", address)
+	}
+
+	bz, err := kb.store.Get(string(ik))
+	if err != nil {
+		return nil, err
+	}
+	if len(bz) == 0 {
+		return nil, fmt.Errorf("key with address %s not found", address)
+	}
+	return readInfo(bz)
+}
+
+// Sign signs the msg with the named key. The passphrase is ignored.
+func (kb keyringKeybase) Sign(name, _ string, msg []byte) (sig []byte, pub tmcrypto.PubKey, err error) {
+	info, err := kb.Get(name)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var priv tmcrypto.PrivKey
+
+	switch i := info.(type) {
+	case localInfo:
+		priv, err = kb.readPrivKey(name)
+		if err != nil {
+			return nil, nil, err
+		}
+
+	case ledgerInfo:
+		priv, err = crypto.NewPrivKeyLedgerSecp256k1Unsafe(i.Path)
+		if err != nil {
+			return nil, nil, err
+		}
+
+	case offlineInfo, multiInfo:
+		return signWithStdin(info, msg)
+	}
+
+	sig, err = priv.Sign(msg)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return sig, priv.PubKey(), nil
+}
+
+// ExportPrivateKeyObject returns the private key of a locally stored key. The
+// passphrase is ignored.
+func (kb keyringKeybase) ExportPrivateKeyObject(name string, _ string) (tmcrypto.PrivKey, error) {
+	info, err := kb.Get(name)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, ok := info.(localInfo); !ok {
+		return nil, errors.New("only works on local private keys")
+	}
+
+	return kb.readPrivKey(name)
+}
+
+// Export returns the key info in ASCII armored format.
+func (kb keyringKeybase) Export(name string) (armor string, err error) {
+	bz, err := kb.store.Get(string(infoKey(name)))
+	if err != nil {
+		return "", err
+	}
+	if bz == nil {
+		return "", fmt.Errorf("no key to export with name %s", name)
+	}
+	return mintkey.ArmorInfoBytes(bz), nil
+}
+
+// ExportPubKey returns public keys in ASCII armored format.
+func (kb keyringKeybase) ExportPubKey(name string) (armor string, err error) {
+	info, err := kb.Get(name)
+	if err != nil {
+		return "", fmt.Errorf("no key to export with name %s", name)
+	}
+	return mintkey.ArmorPubKeyBytes(info.GetPubKey().Bytes()), nil
+}
+
+// ExportPrivKey returns a private key in ASCII armored format, encrypted with
+// encryptPassphrase. The decryption passphrase is ignored.
+func (kb keyringKeybase) ExportPrivKey(name string, decryptPassphrase string,
+	encryptPassphrase string) (armor string, err error) {
+	priv, err := kb.ExportPrivateKeyObject(name, decryptPassphrase)
+	if err != nil {
+		return "", err
+	}
+
+	return mintkey.EncryptArmorPrivKey(priv, encryptPassphrase), nil
+}
+
+// ImportPrivKey imports a private key in ASCII armor format, decrypting it
+// with the given passphrase.
+func (kb keyringKeybase) ImportPrivKey(name string, armor string, passphrase string) error {
+	if _, err := kb.Get(name); err == nil {
+		return errors.New("Cannot overwrite key " + name)
+	}
+
+	privKey, err := mintkey.UnarmorDecryptPrivKey(armor, passphrase)
+	if err != nil {
+		return errors.Wrap(err, "couldn't import private key")
+	}
+
+	_, err = kb.writeLocalKey(name, privKey)
+	return err
+}
+
+// Import imports a key info in ASCII armor format. Private keys must be
+// imported with ImportPrivKey.
+func (kb keyringKeybase) Import(name string, armor string) error {
+	if _, err := kb.Get(name); err == nil {
+		return errors.New("Cannot overwrite data for name " + name)
+	}
+
+	infoBytes, err := mintkey.UnarmorInfoBytes(armor)
+	if err != nil {
+		return err
+	}
+
+	info, err := readInfo(infoBytes)
+	if err != nil {
+		return err
+	}
+	if _, ok := info.(localInfo); ok {
+		return errors.New("cannot import local key info, use ImportPrivKey instead")
+	}
+
+	return kb.writeInfo(name, info)
+}
+
+// ImportPubKey imports ASCII-armored public keys as offline keys.
+func (kb keyringKeybase) ImportPubKey(name string, armor string) error {
+	if _, err := kb.Get(name); err == nil {
+		return errors.New("Cannot overwrite data for name " + name)
+	}
+
+	pubBytes, err := mintkey.UnarmorPubKeyBytes(armor)
+	if err != nil {
+		return err
+	}
+
+	pubKey, err := cryptoAmino.PubKeyFromBytes(pubBytes)
+	if err != nil {
+		return err
+	}
+
+	_, err = kb.CreateOffline(name, pubKey)
+	return err
+}
+
+// Delete removes the key forever. The passphrase is ignored.
+func (kb keyringKeybase) Delete(name, _ string, _ bool) error {
+	info, err := kb.Get(name)
+	if err != nil {
+		return err
+	}
+
+	if err := kb.store.Remove(string(addrKey(info.GetAddress()))); err != nil {
+		return err
+	}
+	if err := kb.store.Remove(privKeyKey(name)); err != nil {
+		return err
+	}
+	return kb.store.Remove(string(infoKey(name)))
+}
+
+// Update is not supported, since keys are protected by the keyring backend
+// rather than by per-key passphrases.
+func (kb keyringKeybase) Update(_, _ string, _ func() (string, error)) error {
+	return errors.New("updating the passphrase of a single key is not supported by keyring backends")
+}
+
+// CloseDB is a noop, keyring stores don't hold open resources.
+func (kb keyringKeybase) CloseDB() {}
+
+func (kb keyringKeybase) writeLocalKey(name string, priv tmcrypto.PrivKey) (Info, error) {
+	// the private key is stored separately, so that exported infos never
+	// contain it
+	if err := kb.store.Set(privKeyKey(name), priv.Bytes()); err != nil {
+		return nil, err
+	}
+
+	info := newLocalInfo(name, priv.PubKey(), "")
+	return info, kb.writeInfo(name, info)
+}
+
+func (kb keyringKeybase) readPrivKey(name string) (tmcrypto.PrivKey, error) {
+	bz, err := kb.store.Get(privKeyKey(name))
+	if err != nil {
+		return nil, err
+	}
+	if len(bz) == 0 {
+		return nil, fmt.Errorf("private key not available")
+	}
+	return cryptoAmino.PrivKeyFromBytes(bz)
+}
+
+func (kb keyringKeybase) writeInfo(name string, info Info) error {
+	// write the info by key
+	key := infoKey(name)
+	if err := kb.store.Set(string(key), writeInfo(info)); err != nil {
+		return err
+	}
+	// store a pointer to the infokey by address for fast lookup
+	return kb.store.Set(string(addrKey(info.GetAddress())), key)
+}
+
+func privKeyKey(name string) string {
+	return fmt.Sprintf("%s.%s", name, privKeySuffix)
+}
+
+//----------------------------------------
+// in-memory store
+
+// memStore is a keyringStore keeping its items in memory.
+type memStore map[string][]byte
+
+func newMemStore() memStore {
+	return make(memStore)
+}
+
+func (s memStore) Get(key string) ([]byte, error) {
+	return s[key], nil
+}
+
+func (s memStore) Set(key string, value []byte) error {
+	s[key] = value
+	return nil
+}
+
+func (s memStore) Remove(key string) error {
+	delete(s, key)
+	return nil
+}
+
+func (s memStore) Keys() ([]string, error) {
+	keys := make([]string, 0, len(s))
+	for key := range s {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys, nil
+}
+
+//----------------------------------------
+// helpers
+
+// ensureDir creates the directory of a keyring store if needed, readable by
+// the current user only.
+func ensureDir(dir string) error {
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return fmt.Errorf("failed to create keyring directory: %s", err)
+	}
+	return nil
+}
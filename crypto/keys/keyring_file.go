@@ -0,0 +1,240 @@
+package keys
+
+import (
+	"bufio"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/tendermint/crypto/bcrypt"
+	tmcrypto "github.com/tendermint/tendermint/crypto"
+	"github.com/tendermint/tendermint/crypto/xsalsa20symmetric"
+
+	"github.com/cosmos/cosmos-sdk/client/input"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/keyerror"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/mintkey"
+)
+
+const (
+	// fileItemExt is the extension of item files in a file store.
+	fileItemExt = ".item"
+	// keyhashFile holds the salt of the keyring passphrase and a token
+	// encrypted with the derived key, used to verify the passphrase.
+	keyhashFile = "keyhash"
+	// keyhashToken is the plaintext of the passphrase verification token.
+	keyhashToken = "keyring"
+)
+
+// passphraseFunc returns the keyring passphrase. create is true if the
+// keyring is being created, in which case the passphrase should be confirmed.
+type passphraseFunc func(create bool) (string, error)
+
+// newPassphrasePrompt returns a passphraseFunc reading from userInput.
+func newPassphrasePrompt(userInput io.Reader) passphraseFunc {
+	buf := bufio.NewReader(userInput)
+	return func(create bool) (string, error) {
+		if create {
+			return input.GetCheckPassword(
+				"Enter a passphrase to encrypt your keyring:",
+				"Re-enter the keyring passphrase:", buf)
+		}
+		return input.GetPassword("Enter keyring passphrase:", buf)
+	}
+}
+
+// fileStore is a keyringStore keeping each item in its own file. If a
+// passphrase prompt is given, items are encrypted with a key derived from the
+// keyring passphrase, which is only prompted for once per fileStore.
+// Otherwise items are stored in plain text.
+type fileStore struct {
+	dir    string
+	prompt passphraseFunc
+	key    []byte // derived encryption key, set on first use
+}
+
+func newFileStore(dir string, prompt passphraseFunc) (*fileStore, error) {
+	if err := ensureDir(dir); err != nil {
+		return nil, err
+	}
+	return &fileStore{dir: dir, prompt: prompt}, nil
+}
+
+func (s *fileStore) Get(key string) ([]byte, error) {
+	bz, err := ioutil.ReadFile(s.itemPath(key))
+	if os.IsNotExist(err) {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	if s.prompt == nil {
+		return bz, nil
+	}
+
+	encKey, err := s.encryptionKey()
+	if err != nil {
+		return nil, err
+	}
+
+	value, err := xsalsa20symmetric.DecryptSymmetric(bz, encKey)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decrypt keyring item %q: %v", key, err)
+	}
+	return value, nil
+}
+
+func (s *fileStore) Set(key string, value []byte) error {
+	if s.prompt != nil {
+		encKey, err := s.encryptionKey()
+		if err != nil {
+			return err
+		}
+		value = xsalsa20symmetric.EncryptSymmetric(value, encKey)
+	}
+
+	return writeFileAtomic(s.itemPath(key), value)
+}
+
+func (s *fileStore) Remove(key string) error {
+	err := os.Remove(s.itemPath(key))
+	if err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	return nil
+}
+
+func (s *fileStore) Keys() ([]string, error) {
+	files, err := ioutil.ReadDir(s.dir)
+	if err != nil {
+		return nil, err
+	}
+
+	var keys []string
+	for _, file := range files {
+		name := file.Name()
+		if file.IsDir() || !strings.HasSuffix(name, fileItemExt) {
+			continue
+		}
+
+		key, err := hex.DecodeString(strings.TrimSuffix(name, fileItemExt))
+		if err != nil {
+			continue
+		}
+		keys = append(keys, string(key))
+	}
+
+	sort.Strings(keys)
+	return keys, nil
+}
+
+// itemPath returns the file path of an item. Keys are hex encoded, since key
+// names are chosen by users and may contain path separators.
+func (s *fileStore) itemPath(key string) string {
+	return filepath.Join(s.dir, hex.EncodeToString([]byte(key))+fileItemExt)
+}
+
+// encryptionKey derives the encryption key from the keyring passphrase,
+// prompting for it on first use. A new keyring is initialized with a random
+// salt and a token that lets subsequent runs verify the passphrase.
+func (s *fileStore) encryptionKey() ([]byte, error) {
+	if s.key != nil {
+		return s.key, nil
+	}
+
+	path := filepath.Join(s.dir, keyhashFile)
+	bz, err := ioutil.ReadFile(path)
+	switch {
+	case os.IsNotExist(err):
+		passphrase, err := s.prompt(true)
+		if err != nil {
+			return nil, err
+		}
+
+		salt := tmcrypto.CRandBytes(16)
+		key, err := deriveKey(salt, passphrase)
+		if err != nil {
+			return nil, err
+		}
+
+		token := xsalsa20symmetric.EncryptSymmetric([]byte(keyhashToken), key)
+		content := fmt.Sprintf("%X\n%X\n", salt, token)
+		if err := writeFileAtomic(path, []byte(content)); err != nil {
+			return nil, err
+		}
+
+		s.key = key
+		return key, nil
+
+	case err != nil:
+		return nil, err
+	}
+
+	lines := strings.Fields(string(bz))
+	if len(lines) != 2 {
+		return nil, fmt.Errorf("malformed keyring key hash file %s", path)
+	}
+	salt, err := hex.DecodeString(lines[0])
+	if err != nil {
+		return nil, fmt.Errorf("malformed keyring key hash file %s: %v", path, err)
+	}
+	token, err := hex.DecodeString(lines[1])
+	if err != nil {
+		return nil, fmt.Errorf("malformed keyring key hash file %s: %v", path, err)
+	}
+
+	passphrase, err := s.prompt(false)
+	if err != nil {
+		return nil, err
+	}
+
+	key, err := deriveKey(salt, passphrase)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := xsalsa20symmetric.DecryptSymmetric(token, key); err != nil {
+		return nil, keyerror.NewErrWrongPassword()
+	}
+
+	s.key = key
+	return key, nil
+}
+
+// deriveKey derives a 32 bytes encryption key from a passphrase, using the
+// same bcrypt parameters as the encryption of legacy private keys.
+func deriveKey(salt []byte, passphrase string) ([]byte, error) {
+	key, err := bcrypt.GenerateFromPassword(salt, []byte(passphrase), mintkey.BcryptSecurityParameter)
+	if err != nil {
+		return nil, fmt.Errorf("error generating bcrypt key from passphrase: %v", err)
+	}
+	return tmcrypto.Sha256(key), nil
+}
+
+// writeFileAtomic writes a file readable by the current user only, through a
+// temporary file so that readers never see a partially written item.
+func writeFileAtomic(path string, bz []byte) error {
+	tmp, err := ioutil.TempFile(filepath.Dir(path), ".tmp-")
+	if err != nil {
+		return err
+	}
+
+	_, err = tmp.Write(bz)
+	if closeErr := tmp.Close(); err == nil {
+		err = closeErr
+	}
+	if err == nil {
+		err = os.Chmod(tmp.Name(), 0600)
+	}
+	if err == nil {
+		err = os.Rename(tmp.Name(), path)
+	}
+	if err != nil {
+		_ = os.Remove(tmp.Name())
+	}
+	return err
+}
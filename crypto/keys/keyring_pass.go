@@ -0,0 +1,128 @@
+package keys
+
+import (
+	"bytes"
+	"encoding/base64"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+const (
+	// passCmd is the pass executable, looked up in PATH.
+	passCmd = "pass"
+	// passExt is the extension of entries in the password store.
+	passExt = ".gpg"
+)
+
+// passStore is a keyringStore backed by the pass password store
+// (https://www.passwordstore.org), which keeps every entry in its own GPG
+// encrypted file. Entries are stored base64 encoded under <prefix>/<key>.
+type passStore struct {
+	prefix string
+	dir    string
+}
+
+func newPassStore(prefix string) (*passStore, error) {
+	if prefix == "" {
+		return nil, fmt.Errorf("pass keyring requires a name")
+	}
+	if _, err := exec.LookPath(passCmd); err != nil {
+		return nil, fmt.Errorf("pass keyring backend unavailable: %v", err)
+	}
+
+	dir := os.Getenv("PASSWORD_STORE_DIR")
+	if dir == "" {
+		home, err := os.UserHomeDir()
+		if err != nil {
+			return nil, err
+		}
+		dir = filepath.Join(home, ".password-store")
+	}
+
+	return &passStore{prefix: prefix, dir: dir}, nil
+}
+
+func (s *passStore) Get(key string) ([]byte, error) {
+	if _, err := os.Stat(s.entryPath(key)); os.IsNotExist(err) {
+		return nil, nil
+	}
+
+	out, err := s.run(nil, "show", s.entryName(key))
+	if err != nil {
+		return nil, err
+	}
+
+	return base64.StdEncoding.DecodeString(strings.TrimSpace(string(out)))
+}
+
+func (s *passStore) Set(key string, value []byte) error {
+	encoded := base64.StdEncoding.EncodeToString(value) + "\n"
+	_, err := s.run([]byte(encoded), "insert", "--multiline", "--force", s.entryName(key))
+	return err
+}
+
+func (s *passStore) Remove(key string) error {
+	if _, err := os.Stat(s.entryPath(key)); os.IsNotExist(err) {
+		return nil
+	}
+
+	_, err := s.run(nil, "rm", "--force", s.entryName(key))
+	return err
+}
+
+func (s *passStore) Keys() ([]string, error) {
+	var keys []string
+	err := filepath.Walk(filepath.Join(s.dir, s.prefix), func(path string, info os.FileInfo, err error) error {
+		if os.IsNotExist(err) {
+			return nil
+		} else if err != nil {
+			return err
+		}
+		if info.IsDir() || !strings.HasSuffix(path, passExt) {
+			return nil
+		}
+
+		rel, err := filepath.Rel(filepath.Join(s.dir, s.prefix), path)
+		if err != nil {
+			return err
+		}
+		keys = append(keys, filepath.ToSlash(strings.TrimSuffix(rel, passExt)))
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	sort.Strings(keys)
+	return keys, nil
+}
+
+func (s *passStore) entryName(key string) string {
+	return s.prefix + "/" + key
+}
+
+func (s *passStore) entryPath(key string) string {
+	return filepath.Join(s.dir, filepath.FromSlash(s.entryName(key))+passExt)
+}
+
+// run executes a pass command with the given input, returning its output.
+func (s *passStore) run(stdin []byte, args ...string) ([]byte, error) {
+	cmd := exec.Command(passCmd, args...)
+	cmd.Env = append(os.Environ(), "PASSWORD_STORE_DIR="+s.dir)
+	if stdin != nil {
+		cmd.Stdin = bytes.NewReader(stdin)
+	}
+
+	var stderr bytes.Buffer
+	cmd.Stderr = &stderr
+
+	out, err := cmd.Output()
+	if err != nil {
+		return nil, fmt.Errorf("pass %s failed: %v: %s", args[0], err, strings.TrimSpace(stderr.String()))
+	}
+	return out, nil
+}
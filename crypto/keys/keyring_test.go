@@ -0,0 +1,210 @@
+package keys
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/tendermint/tendermint/crypto/secp256k1"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/keyerror"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// testKeyringBasics runs the basic Keybase operations against a keyring.
+func testKeyringBasics(t *testing.T, kb Keybase) {
+	// empty keyring
+	infos, err := kb.List()
+	require.NoError(t, err)
+	require.Empty(t, infos)
+
+	// create a local key, the passphrase is ignored
+	info, mnemonic, err := kb.CreateMnemonic("alice", English, "", Secp256k1)
+	require.NoError(t, err)
+	require.Equal(t, TypeLocal, info.GetType())
+	require.NotEmpty(t, mnemonic)
+
+	// recovering the key from its mnemonic gives the same key
+	info2, err := kb.CreateAccount("alice2", mnemonic, DefaultBIP39Passphrase, "", 0, 0)
+	require.NoError(t, err)
+	require.Equal(t, info.GetPubKey(), info2.GetPubKey())
+
+	// offline keys
+	pub := secp256k1.GenPrivKey().PubKey()
+	_, err = kb.CreateOffline("bob", pub)
+	require.NoError(t, err)
+
+	infos, err = kb.List()
+	require.NoError(t, err)
+	require.Len(t, infos, 3)
+	require.Equal(t, "alice", infos[0].GetName())
+	require.Equal(t, "alice2", infos[1].GetName())
+	require.Equal(t, "bob", infos[2].GetName())
+
+	got, err := kb.Get("alice")
+	require.NoError(t, err)
+	require.Equal(t, info.GetPubKey(), got.GetPubKey())
+
+	got, err = kb.GetByAddress(sdk.AccAddress(pub.Address()))
+	require.NoError(t, err)
+	require.Equal(t, "bob", got.GetName())
+
+	_, err = kb.Get("carol")
+	require.True(t, keyerror.IsErrKeyNotFound(err))
+
+	// sign and verify, without passphrase
+	msg := []byte("message")
+	sig, signer, err := kb.Sign("alice", "", msg)
+	require.NoError(t, err)
+	require.Equal(t, info.GetPubKey(), signer)
+	require.True(t, signer.VerifyBytes(msg, sig))
+
+	// exported infos never contain the private key
+	armor, err := kb.Export("alice")
+	require.NoError(t, err)
+	require.Error(t, kb.Import("alice3", armor))
+
+	// private keys can be exported and imported with an armor passphrase
+	armor, err = kb.ExportPrivKey("alice", "", "armorpass")
+	require.NoError(t, err)
+	require.Error(t, kb.ImportPrivKey("alice", armor, "armorpass"))
+	require.Error(t, kb.ImportPrivKey("alice3", armor, "wrong"))
+	require.NoError(t, kb.ImportPrivKey("alice3", armor, "armorpass"))
+	got, err = kb.Get("alice3")
+	require.NoError(t, err)
+	require.Equal(t, info.GetPubKey(), got.GetPubKey())
+
+	// non-local infos and public keys can be imported
+	armor, err = kb.Export("bob")
+	require.NoError(t, err)
+	require.NoError(t, kb.Delete("bob", "", false))
+	require.NoError(t, kb.Import("bob", armor))
+	got, err = kb.GetByAddress(sdk.AccAddress(pub.Address()))
+	require.NoError(t, err)
+	require.Equal(t, "bob", got.GetName())
+
+	armor, err = kb.ExportPubKey("bob")
+	require.NoError(t, err)
+	require.NoError(t, kb.ImportPubKey("bob2", armor))
+
+	// passphrase updates are not supported
+	require.Error(t, kb.Update("alice", "", func() (string, error) { return "new", nil }))
+
+	// delete removes the info and the private key
+	require.NoError(t, kb.Delete("alice", "", false))
+	_, err = kb.Get("alice")
+	require.Error(t, err)
+	_, err = kb.ExportPrivateKeyObject("alice", "")
+	require.Error(t, err)
+	_, _, err = kb.Sign("alice3", "", msg)
+	require.NoError(t, err)
+}
+
+func TestInMemoryKeyring(t *testing.T) {
+	testKeyringBasics(t, NewInMemoryKeyring())
+}
+
+func TestTestKeyring(t *testing.T) {
+	dir, err := ioutil.TempDir("", "keyring")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	kb, err := NewKeyring("test", BackendTest, dir, nil)
+	require.NoError(t, err)
+	testKeyringBasics(t, kb)
+
+	// items are persisted across keybase instances
+	kb, err = NewKeyring("test", BackendTest, dir, nil)
+	require.NoError(t, err)
+	infos, err := kb.List()
+	require.NoError(t, err)
+	require.Len(t, infos, 4)
+}
+
+func TestFileKeyring(t *testing.T) {
+	dir, err := ioutil.TempDir("", "keyring")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	// the passphrase is confirmed when creating the keyring
+	kb, err := NewKeyring("test", BackendFile, dir, strings.NewReader("password1\npassword1\n"))
+	require.NoError(t, err)
+	testKeyringBasics(t, kb)
+
+	// items are encrypted on disk
+	files, err := filepath.Glob(filepath.Join(dir, "keyring-file", "*"+fileItemExt))
+	require.NoError(t, err)
+	require.NotEmpty(t, files)
+	for _, file := range files {
+		bz, err := ioutil.ReadFile(file)
+		require.NoError(t, err)
+		require.NotContains(t, string(bz), "alice")
+	}
+
+	// reopening with the right passphrase works
+	kb, err = NewKeyring("test", BackendFile, dir, strings.NewReader("password1\n"))
+	require.NoError(t, err)
+	infos, err := kb.List()
+	require.NoError(t, err)
+	require.Len(t, infos, 4)
+
+	// a wrong passphrase is rejected
+	kb, err = NewKeyring("test", BackendFile, dir, strings.NewReader("password2\n"))
+	require.NoError(t, err)
+	_, err = kb.List()
+	require.Error(t, err)
+}
+
+// fakePass is a stand-in for the pass executable storing entries unencrypted.
+const fakePass = `#!/bin/sh
+set -e
+cmd="$1"; shift
+while [ "${1#--}" != "$1" ]; do shift; done
+file="$PASSWORD_STORE_DIR/$1.gpg"
+case "$cmd" in
+  insert) mkdir -p "$(dirname "$file")"; cat > "$file" ;;
+  show) cat "$file" ;;
+  rm) rm -f "$file" ;;
+  *) exit 1 ;;
+esac
+`
+
+func TestPassKeyring(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("the pass stand-in requires a POSIX shell")
+	}
+
+	dir, err := ioutil.TempDir("", "keyring")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	binDir := filepath.Join(dir, "bin")
+	require.NoError(t, os.Mkdir(binDir, 0700))
+	require.NoError(t, ioutil.WriteFile(filepath.Join(binDir, "pass"), []byte(fakePass), 0700))
+
+	oldPath, oldStore := os.Getenv("PATH"), os.Getenv("PASSWORD_STORE_DIR")
+	defer func() {
+		os.Setenv("PATH", oldPath)
+		os.Setenv("PASSWORD_STORE_DIR", oldStore)
+	}()
+	os.Setenv("PATH", binDir+string(os.PathListSeparator)+oldPath)
+	os.Setenv("PASSWORD_STORE_DIR", filepath.Join(dir, "store"))
+
+	kb, err := NewKeyring("test", BackendPass, "", nil)
+	require.NoError(t, err)
+	testKeyringBasics(t, kb)
+
+	// entries are scoped by name
+	_, err = os.Stat(filepath.Join(dir, "store", "test", "alice3.info.gpg"))
+	require.NoError(t, err)
+}
+
+func TestUnknownKeyringBackend(t *testing.T) {
+	_, err := NewKeyring("test", "unknown", "", nil)
+	require.Error(t, err)
+}
@@ -21,11 +21,45 @@ type BaseConfig struct {
 	// HaltHeight contains a non-zero height at which a node will gracefully halt
 	// and shutdown that can be used to assist upgrades and testing.
 	HaltHeight uint64 `mapstructure:"halt-height"`
+
+	// SnapshotInterval is the block interval at which state sync snapshots are
+	// taken, 0 disables snapshots.
+	SnapshotInterval uint64 `mapstructure:"snapshot-interval"`
+
+	// SnapshotKeepRecent is the number of recent state sync snapshots to keep,
+	// 0 keeps all snapshots.
+	SnapshotKeepRecent uint32 `mapstructure:"snapshot-keep-recent"`
+
+	// QueryWorkers is the maximum number of ABCI queries served concurrently,
+	// 0 uses the number of CPUs.
+	QueryWorkers uint `mapstructure:"query-workers"`
+
+	// BlockStatsRetention is the number of recent blocks whose statistics are
+	// retained for queries, 0 disables it.
+	BlockStatsRetention uint `mapstructure:"block-stats-retention"`
+}
+
+// FeePolicyConfig defines the minimum gas prices of the messages of a route
+// or, if Type is set, of a single message type of a route, overriding the
+// minimum gas prices of the BaseConfig.
+type FeePolicyConfig struct {
+	Route string `mapstructure:"route"`
+	Type  string `mapstructure:"type"`
+
+	// The minimum gas prices of the messages (e.g. 0.25token1;0.0001token2),
+	// empty to accept them without fees.
+	MinGasPrices string `mapstructure:"minimum-gas-prices"`
+
+	// FreeGas is the gas of a transaction that is not charged.
+	FreeGas uint64 `mapstructure:"free-gas"`
 }
 
 // Config defines the server's top level configuration
 type Config struct {
 	BaseConfig `mapstructure:",squash"`
+
+	// FeePolicies defines the node-local fee policies enforced in CheckTx.
+	FeePolicies []FeePolicyConfig `mapstructure:"fee-policies"`
 }
 
 // SetMinGasPrices sets the validator's minimum gas prices.
@@ -55,12 +89,37 @@ func (c *Config) GetMinGasPrices() sdk.DecCoins {
 	return gasPrices
 }
 
+// GetFeePolicies returns the validator's fee policies based on the set
+// configuration.
+func (c *Config) GetFeePolicies() sdk.FeePolicies {
+	policies := make(sdk.FeePolicies, len(c.FeePolicies))
+
+	for i, fpc := range c.FeePolicies {
+		gasPrices, err := sdk.ParseDecCoins(strings.Replace(fpc.MinGasPrices, ";", ",", -1))
+		if err != nil {
+			panic(fmt.Errorf("failed to parse minimum gas prices of fee policy (%s): %s", fpc.MinGasPrices, err))
+		}
+
+		policies[i] = sdk.NewFeePolicy(fpc.Route, fpc.Type, gasPrices, fpc.FreeGas)
+	}
+
+	if err := policies.Validate(); err != nil {
+		panic(err)
+	}
+
+	return policies
+}
+
 // DefaultConfig returns server's default configuration.
 func DefaultConfig() *Config {
 	return &Config{
-		BaseConfig{
-			MinGasPrices: defaultMinGasPrices,
-			HaltHeight:   0,
+		BaseConfig: BaseConfig{
+			MinGasPrices:        defaultMinGasPrices,
+			HaltHeight:          0,
+			SnapshotInterval:    0,
+			SnapshotKeepRecent:  2,
+			QueryWorkers:        0,
+			BlockStatsRetention: 100,
 		},
 	}
 }
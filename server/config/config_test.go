@@ -1,8 +1,12 @@
 package config
 
 import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
 	"testing"
 
+	"github.com/spf13/viper"
 	"github.com/stretchr/testify/require"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -18,3 +22,38 @@ func TestSetMinimumFees(t *testing.T) {
 	cfg.SetMinGasPrices(sdk.DecCoins{sdk.NewInt64DecCoin("foo", 5)})
 	require.Equal(t, "5.000000000000000000foo", cfg.MinGasPrices)
 }
+
+func TestFeePolicies(t *testing.T) {
+	cfg := DefaultConfig()
+	require.Empty(t, cfg.GetFeePolicies())
+
+	cfg.FeePolicies = []FeePolicyConfig{
+		{Route: "gov", Type: "vote"},
+		{Route: "staking", Type: "begin_redelegate", MinGasPrices: "0.5foo;0.01bar", FreeGas: 100},
+	}
+
+	// the policies survive a round trip through the config file
+	dir, err := ioutil.TempDir("", "config")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	path := filepath.Join(dir, "app.toml")
+	WriteConfigFile(path, cfg)
+
+	v := viper.New()
+	v.SetConfigFile(path)
+	require.NoError(t, v.ReadInConfig())
+
+	parsed := DefaultConfig()
+	require.NoError(t, v.Unmarshal(parsed))
+	require.Equal(t, cfg.FeePolicies, parsed.FeePolicies)
+
+	policies := parsed.GetFeePolicies()
+	require.Equal(t, sdk.NewFeePolicy("gov", "vote", nil, 0), policies[0])
+	require.Equal(t, "staking/begin_redelegate", policies[1].Name())
+	require.Equal(t, "0.010000000000000000bar,0.500000000000000000foo", policies[1].MinGasPrices.String())
+
+	// duplicate policies are rejected
+	parsed.FeePolicies = append(parsed.FeePolicies, FeePolicyConfig{Route: "gov", Type: "vote"})
+	require.Panics(t, func() { parsed.GetFeePolicies() })
+}
@@ -21,7 +21,47 @@ minimum-gas-prices = "{{ .BaseConfig.MinGasPrices }}"
 # HaltHeight contains a non-zero height at which a node will gracefully halt
 # and shutdown that can be used to assist upgrades and testing.
 halt-height = {{ .BaseConfig.HaltHeight }}
-`
+
+# QueryWorkers is the maximum number of ABCI queries served concurrently,
+# 0 uses the number of CPUs. Queries are served from committed state and do
+# not block the processing of blocks.
+query-workers = {{ .BaseConfig.QueryWorkers }}
+
+# BlockStatsRetention is the number of recent blocks whose statistics (gas,
+# txs and fees) are retained in memory for queries, 0 disables it. They are
+# not part of the committed state and are lost on restart.
+block-stats-retention = {{ .BaseConfig.BlockStatsRetention }}
+
+##### state sync snapshot options #####
+
+# SnapshotInterval is the block interval at which state sync snapshots are
+# taken, 0 disables snapshots.
+snapshot-interval = {{ .BaseConfig.SnapshotInterval }}
+
+# SnapshotKeepRecent is the number of recent state sync snapshots to keep,
+# 0 keeps all snapshots.
+snapshot-keep-recent = {{ .BaseConfig.SnapshotKeepRecent }}
+
+##### fee policy options #####
+
+# Fee policies override minimum-gas-prices in CheckTx for the messages of a
+# route or, if type is set, of a single message type of a route. A policy of a
+# message type takes precedence over a policy of its route. Gas up to
+# free-gas is not charged, and empty minimum-gas-prices accept the messages
+# without fees. The fee policy applied is reported in the CheckTx log.
+#
+# [[fee-policies]]
+# route = "staking"
+# type = "begin_redelegate"
+# minimum-gas-prices = "0.5token1"
+# free-gas = 0
+{{ range .FeePolicies }}
+[[fee-policies]]
+route = "{{ .Route }}"
+type = "{{ .Type }}"
+minimum-gas-prices = "{{ .MinGasPrices }}"
+free-gas = {{ .FreeGas }}
+{{ end }}`
 
 var configTemplate *template.Template
 
@@ -6,11 +6,13 @@ import (
 	"os"
 	"path/filepath"
 
+	"github.com/spf13/viper"
 	abci "github.com/tendermint/tendermint/abci/types"
 	"github.com/tendermint/tendermint/libs/log"
 	tmtypes "github.com/tendermint/tendermint/types"
 	dbm "github.com/tendermint/tm-db"
 
+	"github.com/cosmos/cosmos-sdk/baseapp"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
@@ -24,6 +26,18 @@ type (
 	AppExporter func(log.Logger, dbm.DB, io.Writer, int64, bool, []string) (json.RawMessage, []tmtypes.GenesisValidator, error)
 )
 
+// DefaultBaseappOptions returns the BaseApp options set by the flags of the
+// start command, or by the app.toml config, for the AppCreator of an app to
+// pass to its BaseApp.
+func DefaultBaseappOptions() []func(*baseapp.BaseApp) {
+	return []func(*baseapp.BaseApp){
+		baseapp.SetMinGasPrices(viper.GetString(FlagMinGasPrices)),
+		baseapp.SetHaltHeight(uint64(viper.GetInt64(FlagHaltHeight))),
+		baseapp.SetQueryWorkers(uint(viper.GetInt(FlagQueryWorkers))),
+		baseapp.SetBlockStatsRetention(uint(viper.GetInt(FlagBlockStatsRetention))),
+	}
+}
+
 func openDB(rootDir string) (dbm.DB, error) {
 	dataDir := filepath.Join(rootDir, "data")
 	db, err := sdk.NewLevelDB("application", dataDir)
@@ -0,0 +1,146 @@
+package server
+
+import (
+	"strings"
+	"sync"
+
+	abcicli "github.com/tendermint/tendermint/abci/client"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/proxy"
+)
+
+var _ proxy.ClientCreator = (*concurrentQueryClientCreator)(nil)
+
+// concurrentQueryClientCreator creates local ABCI clients sharing a single
+// mutex, like proxy.NewLocalClientCreator, except that their store and custom
+// queries are served without holding it. Those queries are served by the
+// BaseApp from committed multistore versions, so they don't wait for the
+// block being executed on the consensus connection. The clients also let the
+// app pre-verify the signatures of a block before it is delivered, see
+// concurrentQueryClient.DeliverTxAsync.
+type concurrentQueryClientCreator struct {
+	mtx *sync.Mutex
+	app abci.Application
+}
+
+// NewConcurrentQueryClientCreator returns a client creator of local ABCI
+// clients serving the store and custom queries concurrently with the other
+// ABCI requests.
+func NewConcurrentQueryClientCreator(app abci.Application) proxy.ClientCreator {
+	return &concurrentQueryClientCreator{
+		mtx: new(sync.Mutex),
+		app: app,
+	}
+}
+
+func (c *concurrentQueryClientCreator) NewABCIClient() (abcicli.Client, error) {
+	return &concurrentQueryClient{
+		Client: abcicli.NewLocalClient(c.mtx, c.app),
+		mtx:    c.mtx,
+		app:    c.app,
+	}, nil
+}
+
+// txPreVerifier is implemented by the apps verifying the signatures of the
+// transactions of a block concurrently, before they are delivered.
+type txPreVerifier interface {
+	PreVerifyTxs(txs [][]byte)
+}
+
+// concurrentQueryClient is a local ABCI client whose store and custom queries
+// don't take the mutex shared with the other connections.
+type concurrentQueryClient struct {
+	abcicli.Client
+	mtx *sync.Mutex
+	app abci.Application
+
+	// pending holds the transactions of the block being delivered
+	pendingMtx sync.Mutex
+	pending    []abci.RequestDeliverTx
+}
+
+// QuerySync implements abcicli.Client. It is the method used by the query
+// connection of Tendermint.
+func (cli *concurrentQueryClient) QuerySync(req abci.RequestQuery) (*abci.ResponseQuery, error) {
+	if !isConcurrentQuery(req.Path) {
+		return cli.Client.QuerySync(req)
+	}
+
+	res := cli.app.Query(req)
+	return &res, nil
+}
+
+// DeliverTxAsync implements abcicli.Client. It is the method used by the
+// consensus connection of Tendermint to deliver the transactions of a block,
+// before it calls EndBlockSync. If the app pre-verifies transactions, the
+// transactions are only delivered on the next synchronous request, once the
+// app has pre-verified all of them: their responses are then passed to the
+// response callback in order, and the returned ReqRes is never done.
+func (cli *concurrentQueryClient) DeliverTxAsync(req abci.RequestDeliverTx) *abcicli.ReqRes {
+	if _, ok := cli.app.(txPreVerifier); !ok {
+		return cli.Client.DeliverTxAsync(req)
+	}
+
+	cli.pendingMtx.Lock()
+	defer cli.pendingMtx.Unlock()
+
+	cli.pending = append(cli.pending, req)
+	return abcicli.NewReqRes(abci.ToRequestDeliverTx(req))
+}
+
+// DeliverTxSync implements abcicli.Client.
+func (cli *concurrentQueryClient) DeliverTxSync(req abci.RequestDeliverTx) (*abci.ResponseDeliverTx, error) {
+	cli.deliverPending()
+	return cli.Client.DeliverTxSync(req)
+}
+
+// EndBlockSync implements abcicli.Client.
+func (cli *concurrentQueryClient) EndBlockSync(req abci.RequestEndBlock) (*abci.ResponseEndBlock, error) {
+	cli.deliverPending()
+	return cli.Client.EndBlockSync(req)
+}
+
+// CommitSync implements abcicli.Client.
+func (cli *concurrentQueryClient) CommitSync() (*abci.ResponseCommit, error) {
+	cli.deliverPending()
+	return cli.Client.CommitSync()
+}
+
+// FlushSync implements abcicli.Client.
+func (cli *concurrentQueryClient) FlushSync() error {
+	cli.deliverPending()
+	return cli.Client.FlushSync()
+}
+
+// deliverPending pre-verifies the pending transactions and delivers them.
+func (cli *concurrentQueryClient) deliverPending() {
+	cli.pendingMtx.Lock()
+	pending := cli.pending
+	cli.pending = nil
+	cli.pendingMtx.Unlock()
+
+	if len(pending) == 0 {
+		return
+	}
+
+	txs := make([][]byte, len(pending))
+	for i, req := range pending {
+		txs[i] = req.Tx
+	}
+
+	cli.mtx.Lock()
+	cli.app.(txPreVerifier).PreVerifyTxs(txs)
+	cli.mtx.Unlock()
+
+	for _, req := range pending {
+		cli.Client.DeliverTxAsync(req)
+	}
+}
+
+// isConcurrentQuery returns whether a query doesn't read the state used for
+// processing blocks and transactions. Other queries, such as the simulations,
+// are serialized with the other ABCI requests.
+func isConcurrentQuery(path string) bool {
+	path = strings.TrimPrefix(path, "/")
+	return strings.HasPrefix(path, "store/") || strings.HasPrefix(path, "custom/")
+}
@@ -0,0 +1,94 @@
+package server
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+)
+
+type queryCountApp struct {
+	abci.BaseApplication
+	queries chan string
+}
+
+func (app queryCountApp) Query(req abci.RequestQuery) abci.ResponseQuery {
+	app.queries <- req.Path
+	return abci.ResponseQuery{}
+}
+
+func TestConcurrentQueryClient(t *testing.T) {
+	app := queryCountApp{queries: make(chan string, 2)}
+	creator := NewConcurrentQueryClientCreator(app).(*concurrentQueryClientCreator)
+
+	cli, err := creator.NewABCIClient()
+	require.NoError(t, err)
+
+	// hold the mutex as the consensus connection does while executing a block
+	creator.mtx.Lock()
+
+	// custom and store queries don't wait for the mutex
+	for _, path := range []string{"/custom/bank/balance", "/store/acc/key"} {
+		_, err = cli.QuerySync(abci.RequestQuery{Path: path})
+		require.NoError(t, err)
+		require.Equal(t, path, <-app.queries)
+	}
+
+	// other queries do
+	go func() { _, _ = cli.QuerySync(abci.RequestQuery{Path: "/app/simulate"}) }()
+	select {
+	case path := <-app.queries:
+		t.Fatalf("query %s served while the mutex is held", path)
+	case <-time.After(50 * time.Millisecond):
+	}
+
+	creator.mtx.Unlock()
+	require.Equal(t, "/app/simulate", <-app.queries)
+}
+
+type preVerifyApp struct {
+	abci.BaseApplication
+	requests []string
+}
+
+func (app *preVerifyApp) PreVerifyTxs(txs [][]byte) {
+	for _, tx := range txs {
+		app.requests = append(app.requests, "preverify "+string(tx))
+	}
+}
+
+func (app *preVerifyApp) DeliverTx(req abci.RequestDeliverTx) abci.ResponseDeliverTx {
+	app.requests = append(app.requests, "deliver "+string(req.Tx))
+	return abci.ResponseDeliverTx{Data: req.Tx}
+}
+
+func (app *preVerifyApp) EndBlock(req abci.RequestEndBlock) abci.ResponseEndBlock {
+	app.requests = append(app.requests, "endblock")
+	return abci.ResponseEndBlock{}
+}
+
+func TestConcurrentQueryClientPreVerifyTxs(t *testing.T) {
+	app := &preVerifyApp{}
+	cli, err := NewConcurrentQueryClientCreator(app).NewABCIClient()
+	require.NoError(t, err)
+
+	var responses []string
+	cli.SetResponseCallback(func(req *abci.Request, res *abci.Response) {
+		if r, ok := res.Value.(*abci.Response_DeliverTx); ok {
+			responses = append(responses, string(r.DeliverTx.Data))
+		}
+	})
+
+	// the txs of the block are delivered after being pre-verified together,
+	// before the block ends
+	cli.DeliverTxAsync(abci.RequestDeliverTx{Tx: []byte("tx1")})
+	cli.DeliverTxAsync(abci.RequestDeliverTx{Tx: []byte("tx2")})
+	require.Empty(t, app.requests)
+
+	_, err = cli.EndBlockSync(abci.RequestEndBlock{})
+	require.NoError(t, err)
+	require.Equal(t, []string{"preverify tx1", "preverify tx2", "deliver tx1", "deliver tx2", "endblock"}, app.requests)
+	require.Equal(t, []string{"tx1", "tx2"}, responses)
+}
@@ -0,0 +1,158 @@
+package server
+
+// DONTCOVER
+
+import (
+	"encoding/hex"
+	"fmt"
+	"path/filepath"
+	"strconv"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/store/snapshots"
+)
+
+// SnapshotApp is implemented by applications that support state sync
+// snapshots, such as apps embedding a BaseApp configured with
+// baseapp.SetSnapshotStore.
+type SnapshotApp interface {
+	ListSnapshots() ([]*snapshots.Snapshot, error)
+	CreateSnapshot(height uint64) (*snapshots.Snapshot, error)
+	RestoreSnapshot(height uint64, format uint32, appHash []byte) error
+}
+
+// OpenSnapshotStore opens the state sync snapshot store in the data directory
+// of the given node home directory.
+func OpenSnapshotStore(rootDir string) (*snapshots.Store, error) {
+	return snapshots.NewStore(filepath.Join(rootDir, "data", "snapshots"))
+}
+
+// SnapshotsCmd returns the command for managing local state sync snapshots.
+func SnapshotsCmd(ctx *Context, appCreator AppCreator) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "snapshots",
+		Short: "Manage local state sync snapshots",
+	}
+
+	cmd.AddCommand(
+		listSnapshotsCmd(ctx, appCreator),
+		createSnapshotCmd(ctx, appCreator),
+		restoreSnapshotCmd(ctx, appCreator),
+	)
+
+	return cmd
+}
+
+func listSnapshotsCmd(ctx *Context, appCreator AppCreator) *cobra.Command {
+	return &cobra.Command{
+		Use:   "list",
+		Short: "List local state sync snapshots",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			app, err := openSnapshotApp(ctx, appCreator)
+			if err != nil {
+				return err
+			}
+
+			list, err := app.ListSnapshots()
+			if err != nil {
+				return err
+			}
+
+			for _, snapshot := range list {
+				fmt.Println(snapshot.String())
+			}
+			return nil
+		},
+	}
+}
+
+func createSnapshotCmd(ctx *Context, appCreator AppCreator) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create",
+		Short: "Create a state sync snapshot of a committed height",
+		Long: `Create a state sync snapshot of a committed height, which defaults to the
+latest committed height. The node must not be running.`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			app, err := openSnapshotApp(ctx, appCreator)
+			if err != nil {
+				return err
+			}
+
+			snapshot, err := app.CreateSnapshot(uint64(viper.GetInt64(flagHeight)))
+			if err != nil {
+				return err
+			}
+
+			fmt.Println(snapshot.String())
+			return nil
+		},
+	}
+
+	cmd.Flags().Int64(flagHeight, 0, "Height to snapshot (0 means latest height)")
+	return cmd
+}
+
+func restoreSnapshotCmd(ctx *Context, appCreator AppCreator) *cobra.Command {
+	return &cobra.Command{
+		Use:   "restore [height] [format] [app-hash]",
+		Short: "Restore app state from a local state sync snapshot",
+		Long: `Restore the app state of an empty node from a local state sync snapshot. The
+restored state is verified against the given hex-encoded app hash, which must
+be taken from a trusted source such as the header of the block following the
+snapshot height.`,
+		Args: cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			height, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid height %q: %v", args[0], err)
+			}
+
+			format, err := strconv.ParseUint(args[1], 10, 32)
+			if err != nil {
+				return fmt.Errorf("invalid format %q: %v", args[1], err)
+			}
+
+			appHash, err := hex.DecodeString(args[2])
+			if err != nil {
+				return fmt.Errorf("invalid app hash %q: %v", args[2], err)
+			}
+
+			app, err := openSnapshotApp(ctx, appCreator)
+			if err != nil {
+				return err
+			}
+
+			err = app.RestoreSnapshot(height, uint32(format), appHash)
+			if err != nil {
+				return err
+			}
+
+			fmt.Printf("restored snapshot at height %d\n", height)
+			return nil
+		},
+	}
+}
+
+// openSnapshotApp creates the app from the node's database, and makes sure it
+// supports state sync snapshots.
+func openSnapshotApp(ctx *Context, appCreator AppCreator) (SnapshotApp, error) {
+	config := ctx.Config
+	config.SetRoot(viper.GetString(flags.FlagHome))
+
+	db, err := openDB(config.RootDir)
+	if err != nil {
+		return nil, err
+	}
+
+	app, ok := appCreator(ctx.Logger, db, nil).(SnapshotApp)
+	if !ok {
+		return nil, fmt.Errorf("app does not support state sync snapshots")
+	}
+
+	return app, nil
+}
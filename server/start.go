@@ -13,7 +13,6 @@ import (
 	"github.com/tendermint/tendermint/node"
 	"github.com/tendermint/tendermint/p2p"
 	pvm "github.com/tendermint/tendermint/privval"
-	"github.com/tendermint/tendermint/proxy"
 )
 
 // Tendermint full-node start flags
@@ -24,6 +23,12 @@ const (
 	flagPruning        = "pruning"
 	FlagMinGasPrices   = "minimum-gas-prices"
 	FlagHaltHeight     = "halt-height"
+	FlagQueryWorkers   = "query-workers"
+
+	FlagBlockStatsRetention = "block-stats-retention"
+
+	FlagSnapshotInterval   = "snapshot-interval"
+	FlagSnapshotKeepRecent = "snapshot-keep-recent"
 )
 
 // StartCmd runs the service passed in, either stand-alone or in-process with
@@ -55,6 +60,10 @@ func StartCmd(ctx *Context, appCreator AppCreator) *cobra.Command {
 		"Minimum gas prices to accept for transactions; Any fee in a tx must meet this minimum (e.g. 0.01photino;0.0001stake)",
 	)
 	cmd.Flags().Uint64(FlagHaltHeight, 0, "Height at which to gracefully halt the chain and shutdown the node")
+	cmd.Flags().Uint(FlagQueryWorkers, 0, "Maximum number of queries served concurrently (0 to use the number of CPUs)")
+	cmd.Flags().Uint(FlagBlockStatsRetention, 100, "Number of recent blocks whose statistics are retained for queries (0 to disable)")
+	cmd.Flags().Uint64(FlagSnapshotInterval, 0, "Block interval at which to take state sync snapshots (0 to disable)")
+	cmd.Flags().Uint32(FlagSnapshotKeepRecent, 2, "Number of recent state sync snapshots to keep (0 to keep all)")
 
 	// add support for all Tendermint-specific command line options
 	tcmd.AddNodeFlags(cmd)
@@ -129,7 +138,7 @@ func startInProcess(ctx *Context, appCreator AppCreator) (*node.Node, error) {
 		cfg,
 		pvm.LoadOrGenFilePV(cfg.PrivValidatorKeyFile(), cfg.PrivValidatorStateFile()),
 		nodeKey,
-		proxy.NewLocalClientCreator(app),
+		NewConcurrentQueryClientCreator(app),
 		node.DefaultGenesisDocProviderFunc(cfg),
 		node.DefaultDBProvider,
 		node.DefaultMetricsProvider(cfg.Instrumentation),
@@ -142,6 +142,7 @@ func AddCommands(
 		flags.LineBreak,
 		tendermintCmd,
 		ExportCmd(ctx, cdc, appExport),
+		SnapshotsCmd(ctx, appCreator),
 		flags.LineBreak,
 		version.Cmd,
 	)
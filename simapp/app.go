@@ -15,9 +15,14 @@ import (
 	"github.com/cosmos/cosmos-sdk/types/module"
 	"github.com/cosmos/cosmos-sdk/version"
 	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/cosmos/cosmos-sdk/x/authz"
 	"github.com/cosmos/cosmos-sdk/x/bank"
+	"github.com/cosmos/cosmos-sdk/x/circuit"
+	circuitclient "github.com/cosmos/cosmos-sdk/x/circuit/client"
 	"github.com/cosmos/cosmos-sdk/x/crisis"
 	distr "github.com/cosmos/cosmos-sdk/x/distribution"
+	"github.com/cosmos/cosmos-sdk/x/evidence"
+	"github.com/cosmos/cosmos-sdk/x/feegrant"
 	"github.com/cosmos/cosmos-sdk/x/genaccounts"
 	"github.com/cosmos/cosmos-sdk/x/genutil"
 	"github.com/cosmos/cosmos-sdk/x/gov"
@@ -27,6 +32,8 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/slashing"
 	"github.com/cosmos/cosmos-sdk/x/staking"
 	"github.com/cosmos/cosmos-sdk/x/supply"
+	"github.com/cosmos/cosmos-sdk/x/upgrade"
+	upgradeclient "github.com/cosmos/cosmos-sdk/x/upgrade/client"
 )
 
 const appName = "SimApp"
@@ -49,11 +56,20 @@ var (
 		staking.AppModuleBasic{},
 		mint.AppModuleBasic{},
 		distr.AppModuleBasic{},
-		gov.NewAppModuleBasic(paramsclient.ProposalHandler, distr.ProposalHandler),
+		gov.NewAppModuleBasic(
+			paramsclient.ProposalHandler, distr.ProposalHandler,
+			upgradeclient.ProposalHandler, upgradeclient.CancelProposalHandler,
+			circuitclient.TripProposalHandler, circuitclient.ResetProposalHandler,
+		),
 		params.AppModuleBasic{},
 		crisis.AppModuleBasic{},
 		slashing.AppModuleBasic{},
 		supply.AppModuleBasic{},
+		upgrade.AppModuleBasic{},
+		feegrant.AppModuleBasic{},
+		authz.AppModuleBasic{},
+		evidence.AppModuleBasic{},
+		circuit.AppModuleBasic{},
 	)
 
 	// module account permissions
@@ -63,6 +79,7 @@ var (
 		mint.ModuleName:           {supply.Minter},
 		staking.BondedPoolName:    {supply.Burner, supply.Staking},
 		staking.NotBondedPoolName: {supply.Burner, supply.Staking},
+		staking.ModuleName:        {supply.Minter, supply.Burner},
 		gov.ModuleName:            {supply.Burner},
 	}
 )
@@ -100,6 +117,11 @@ type SimApp struct {
 	GovKeeper      gov.Keeper
 	CrisisKeeper   crisis.Keeper
 	ParamsKeeper   params.Keeper
+	UpgradeKeeper  upgrade.Keeper
+	FeeGrantKeeper feegrant.Keeper
+	AuthzKeeper    authz.Keeper
+	EvidenceKeeper evidence.Keeper
+	CircuitKeeper  circuit.Keeper
 
 	// the module manager
 	mm *module.Manager
@@ -122,7 +144,8 @@ func NewSimApp(
 
 	keys := sdk.NewKVStoreKeys(bam.MainStoreKey, auth.StoreKey, staking.StoreKey,
 		supply.StoreKey, mint.StoreKey, distr.StoreKey, slashing.StoreKey,
-		gov.StoreKey, params.StoreKey)
+		gov.StoreKey, params.StoreKey, upgrade.StoreKey, feegrant.StoreKey,
+		authz.StoreKey, evidence.StoreKey, circuit.StoreKey)
 	tkeys := sdk.NewTransientStoreKeys(staking.TStoreKey, params.TStoreKey)
 
 	app := &SimApp{
@@ -143,27 +166,52 @@ func NewSimApp(
 	slashingSubspace := app.ParamsKeeper.Subspace(slashing.DefaultParamspace)
 	govSubspace := app.ParamsKeeper.Subspace(gov.DefaultParamspace).WithKeyTable(gov.ParamKeyTable())
 	crisisSubspace := app.ParamsKeeper.Subspace(crisis.DefaultParamspace)
+	circuitSubspace := app.ParamsKeeper.Subspace(circuit.DefaultParamspace)
 
-	// add keepers
-	app.AccountKeeper = auth.NewAccountKeeper(app.cdc, keys[auth.StoreKey], authSubspace, auth.ProtoBaseAccount)
+	// the gas costs of KVStores are parameters of the BaseApp
+	app.SetParamStore(app.ParamsKeeper.Subspace(bam.Paramspace).WithKeyTable(params.BaseAppParamKeyTable()))
+
+	// add keepers, the state stays amino encoded until a store migration to
+	// the protobuf application codec of simapp/codec exists
+	app.AccountKeeper = auth.NewAccountKeeper(auth.NewAminoCodec(cdc), keys[auth.StoreKey], authSubspace, auth.ProtoBaseAccount)
 	app.BankKeeper = bank.NewBaseKeeper(app.AccountKeeper, bankSubspace, bank.DefaultCodespace, app.ModuleAccountAddrs())
 	app.SupplyKeeper = supply.NewKeeper(app.cdc, keys[supply.StoreKey], app.AccountKeeper, app.BankKeeper, maccPerms)
-	stakingKeeper := staking.NewKeeper(app.cdc, keys[staking.StoreKey], tkeys[staking.TStoreKey],
-		app.SupplyKeeper, stakingSubspace, staking.DefaultCodespace)
+	stakingKeeper := staking.NewKeeper(codec.NewAminoCodec(cdc), keys[staking.StoreKey], tkeys[staking.TStoreKey],
+		app.AccountKeeper, app.SupplyKeeper, stakingSubspace, staking.DefaultCodespace)
 	app.MintKeeper = mint.NewKeeper(app.cdc, keys[mint.StoreKey], mintSubspace, &stakingKeeper, app.SupplyKeeper, auth.FeeCollectorName)
 	app.DistrKeeper = distr.NewKeeper(app.cdc, keys[distr.StoreKey], distrSubspace, &stakingKeeper,
 		app.SupplyKeeper, distr.DefaultCodespace, auth.FeeCollectorName, app.ModuleAccountAddrs())
 	app.SlashingKeeper = slashing.NewKeeper(app.cdc, keys[slashing.StoreKey], &stakingKeeper,
 		slashingSubspace, slashing.DefaultCodespace)
 	app.CrisisKeeper = crisis.NewKeeper(crisisSubspace, invCheckPeriod, app.SupplyKeeper, auth.FeeCollectorName)
+	app.UpgradeKeeper = upgrade.NewKeeper(keys[upgrade.StoreKey], app.cdc)
+	app.FeeGrantKeeper = feegrant.NewKeeper(app.cdc, keys[feegrant.StoreKey])
+	app.AuthzKeeper = authz.NewKeeper(app.cdc, keys[authz.StoreKey], app.Router())
+	// governance passes the proposals resetting the circuit breakers, so it
+	// cannot be disabled
+	app.CircuitKeeper = circuit.NewKeeper(app.cdc, keys[circuit.StoreKey], circuitSubspace, gov.RouterKey)
+
+	// register the evidence types handled through MsgSubmitEvidence
+	evidenceRouter := evidence.NewRouter()
+	app.EvidenceKeeper = evidence.NewKeeper(app.cdc, keys[evidence.StoreKey], &stakingKeeper,
+		app.SlashingKeeper, evidence.DefaultCodespace, evidenceRouter)
 
 	// register the proposal types
 	govRouter := gov.NewRouter()
 	govRouter.AddRoute(gov.RouterKey, gov.ProposalHandler).
 		AddRoute(params.RouterKey, params.NewParamChangeProposalHandler(app.ParamsKeeper)).
-		AddRoute(distr.RouterKey, distr.NewCommunityPoolSpendProposalHandler(app.DistrKeeper))
-	app.GovKeeper = gov.NewKeeper(app.cdc, keys[gov.StoreKey], govSubspace,
-		app.SupplyKeeper, &stakingKeeper, gov.DefaultCodespace, govRouter)
+		AddRoute(distr.RouterKey, distr.NewCommunityPoolSpendProposalHandler(app.DistrKeeper)).
+		AddRoute(upgrade.RouterKey, upgrade.NewSoftwareUpgradeProposalHandler(app.UpgradeKeeper)).
+		AddRoute(circuit.RouterKey, circuit.NewCircuitBreakerProposalHandler(app.CircuitKeeper))
+	govKeeper := gov.NewKeeper(gov.NewAminoCodec(cdc), keys[gov.StoreKey], govSubspace,
+		app.SupplyKeeper, &stakingKeeper, gov.DefaultCodespace, govRouter, app.Router())
+
+	// register the governance hooks
+	app.GovKeeper = *govKeeper.SetHooks(
+		gov.NewMultiGovHooks(
+		// insert governance hooks receivers here
+		),
+	)
 
 	// register the staking hooks
 	// NOTE: stakingKeeper above is passed by reference, so that it will contain these hooks
@@ -185,21 +233,30 @@ func NewSimApp(
 		mint.NewAppModule(app.MintKeeper),
 		slashing.NewAppModule(app.SlashingKeeper, app.StakingKeeper),
 		staking.NewAppModule(app.StakingKeeper, app.DistrKeeper, app.AccountKeeper, app.SupplyKeeper),
+		upgrade.NewAppModule(app.UpgradeKeeper),
+		feegrant.NewAppModule(app.FeeGrantKeeper),
+		authz.NewAppModule(app.AuthzKeeper),
+		evidence.NewAppModule(app.EvidenceKeeper),
+		circuit.NewAppModule(app.CircuitKeeper),
+		params.NewAppModule(app.ParamsKeeper),
 	)
 
-	// During begin block slashing happens after distr.BeginBlocker so that
-	// there is nothing left over in the validator fee pool, so as to keep the
-	// CanWithdrawInvariant invariant.
-	app.mm.SetOrderBeginBlockers(mint.ModuleName, distr.ModuleName, slashing.ModuleName)
+	// During begin block slashing and evidence handling happen after
+	// distr.BeginBlocker so that there is nothing left over in the validator fee
+	// pool, so as to keep the CanWithdrawInvariant invariant. The upgrade module
+	// must run first so that no state transition happens on an outdated binary.
+	app.mm.SetOrderBeginBlockers(upgrade.ModuleName, mint.ModuleName, distr.ModuleName,
+		slashing.ModuleName, evidence.ModuleName)
 
-	app.mm.SetOrderEndBlockers(crisis.ModuleName, gov.ModuleName, staking.ModuleName)
+	app.mm.SetOrderEndBlockers(crisis.ModuleName, gov.ModuleName, staking.ModuleName, auth.ModuleName)
 
 	// NOTE: The genutils moodule must occur after staking so that pools are
 	// properly initialized with tokens from genesis accounts.
 	app.mm.SetOrderInitGenesis(
-		genaccounts.ModuleName, distr.ModuleName, staking.ModuleName,
+		params.ModuleName, genaccounts.ModuleName, distr.ModuleName, staking.ModuleName,
 		auth.ModuleName, bank.ModuleName, slashing.ModuleName, gov.ModuleName,
-		mint.ModuleName, supply.ModuleName, crisis.ModuleName, genutil.ModuleName,
+		mint.ModuleName, supply.ModuleName, crisis.ModuleName, feegrant.ModuleName,
+		authz.ModuleName, evidence.ModuleName, circuit.ModuleName, genutil.ModuleName,
 	)
 
 	app.mm.RegisterInvariants(&app.CrisisKeeper)
@@ -215,7 +272,12 @@ func NewSimApp(
 	// initialize BaseApp
 	app.SetInitChainer(app.InitChainer)
 	app.SetBeginBlocker(app.BeginBlocker)
-	app.SetAnteHandler(auth.NewAnteHandler(app.AccountKeeper, app.SupplyKeeper, auth.DefaultSigVerificationGasConsumer))
+	app.SetAnteHandler(auth.NewFeeGrantAnteHandler(
+		app.AccountKeeper, app.SupplyKeeper, app.FeeGrantKeeper, auth.DefaultSigVerificationGasConsumer,
+	))
+	app.SetTxPriority(auth.GasPriceTxPriority(app.AccountKeeper, sdk.DefaultBondDenom))
+	app.SetSigPreVerifier(auth.NewSigPreVerifier(app.AccountKeeper))
+	app.SetCircuitBreaker(app.CircuitKeeper)
 	app.SetEndBlocker(app.EndBlocker)
 
 	if loadLatest {
@@ -0,0 +1,241 @@
+package codec
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	authexported "github.com/cosmos/cosmos-sdk/x/auth/exported"
+	"github.com/cosmos/cosmos-sdk/x/circuit"
+	distr "github.com/cosmos/cosmos-sdk/x/distribution"
+	"github.com/cosmos/cosmos-sdk/x/gov"
+	"github.com/cosmos/cosmos-sdk/x/params"
+	"github.com/cosmos/cosmos-sdk/x/supply"
+	"github.com/cosmos/cosmos-sdk/x/upgrade"
+)
+
+var (
+	_ auth.Codec = (*Codec)(nil)
+	_ gov.Codec  = (*Codec)(nil)
+)
+
+// Codec defines the application-level codec. It encodes the application state
+// with protobuf and is aware of all the concrete account and proposal content
+// types of the application, so it implements the codecs required by x/auth
+// and x/gov. Amino is only kept for JSON, i.e. sign bytes and legacy REST.
+//
+// It cannot decode state written with amino, so an application switching to it
+// needs a store migration of its accounts, proposals and validators.
+type Codec struct {
+	*codec.HybridCodec
+}
+
+// NewAppCodec returns a new application Codec using the given amino codec for
+// JSON.
+func NewAppCodec(amino *codec.Codec) *Codec {
+	return &Codec{HybridCodec: codec.NewHybridCodec(amino)}
+}
+
+// MarshalAccount marshals an Account interface. The account is wrapped in the
+// application Account type.
+func (c *Codec) MarshalAccount(accI authexported.Account) ([]byte, error) {
+	acc := &Account{}
+	if err := acc.SetAccount(accI); err != nil {
+		return nil, err
+	}
+
+	return c.MarshalBinaryBare(acc)
+}
+
+// UnmarshalAccount decodes an Account interface defined by the application
+// Account type.
+func (c *Codec) UnmarshalAccount(bz []byte) (authexported.Account, error) {
+	acc := &Account{}
+	if err := c.UnmarshalBinaryBare(bz, acc); err != nil {
+		return nil, err
+	}
+
+	return acc.GetAccount(), nil
+}
+
+// MarshalProposal marshals a Proposal. The proposal content is wrapped in the
+// application Content type.
+func (c *Codec) MarshalProposal(p gov.Proposal) ([]byte, error) {
+	proposal := &Proposal{
+		Base: gov.ProposalBase{
+			ProposalID:       p.ProposalID,
+			Status:           p.Status,
+			FinalTallyResult: p.FinalTallyResult,
+			SubmitTime:       p.SubmitTime,
+			DepositEndTime:   p.DepositEndTime,
+			TotalDeposit:     p.TotalDeposit,
+			VotingStartTime:  p.VotingStartTime,
+			VotingEndTime:    p.VotingEndTime,
+			ExecutionLog:     p.ExecutionLog,
+			Expedited:        p.Expedited,
+		},
+	}
+
+	// the executable proposal messages are encoded with amino, so its content
+	// is set by the codec
+	if content, ok := p.Content.(gov.ExecutableProposal); ok {
+		executable, err := c.marshalExecutableProposal(content)
+		if err != nil {
+			return nil, err
+		}
+
+		proposal.Content.Sum = &Content_Executable{Executable: executable}
+	} else if err := proposal.Content.SetContent(p.Content); err != nil {
+		return nil, err
+	}
+
+	return c.MarshalBinaryLengthPrefixed(proposal)
+}
+
+// UnmarshalProposal decodes a Proposal defined by the application Proposal
+// type.
+func (c *Codec) UnmarshalProposal(bz []byte) (gov.Proposal, error) {
+	proposal := &Proposal{}
+	if err := c.UnmarshalBinaryLengthPrefixed(bz, proposal); err != nil {
+		return gov.Proposal{}, err
+	}
+
+	content := proposal.Content.GetContent()
+	if sum, ok := proposal.Content.Sum.(*Content_Executable); ok {
+		executable, err := c.unmarshalExecutableProposal(sum.Executable)
+		if err != nil {
+			return gov.Proposal{}, err
+		}
+
+		content = executable
+	}
+
+	return gov.Proposal{
+		Content:          content,
+		ProposalID:       proposal.Base.ProposalID,
+		Status:           proposal.Base.Status,
+		FinalTallyResult: proposal.Base.FinalTallyResult,
+		SubmitTime:       proposal.Base.SubmitTime,
+		DepositEndTime:   proposal.Base.DepositEndTime,
+		TotalDeposit:     sdk.Coins(proposal.Base.TotalDeposit),
+		VotingStartTime:  proposal.Base.VotingStartTime,
+		VotingEndTime:    proposal.Base.VotingEndTime,
+		ExecutionLog:     proposal.Base.ExecutionLog,
+		Expedited:        proposal.Base.Expedited,
+	}, nil
+}
+
+// marshalExecutableProposal returns the application ExecutableProposal of an
+// x/gov ExecutableProposal, encoding each of its messages with amino.
+func (c *Codec) marshalExecutableProposal(p gov.ExecutableProposal) (*ExecutableProposal, error) {
+	msgs := make([][]byte, len(p.Msgs))
+	for i, msg := range p.Msgs {
+		bz, err := c.Amino().MarshalBinaryBare(msg)
+		if err != nil {
+			return nil, err
+		}
+
+		msgs[i] = bz
+	}
+
+	return &ExecutableProposal{Title: p.Title, Description: p.Description, Msgs: msgs}, nil
+}
+
+// unmarshalExecutableProposal returns the x/gov ExecutableProposal of an
+// application ExecutableProposal, decoding each of its messages with amino.
+func (c *Codec) unmarshalExecutableProposal(p *ExecutableProposal) (gov.ExecutableProposal, error) {
+	msgs := make([]sdk.Msg, len(p.Msgs))
+	for i, bz := range p.Msgs {
+		if err := c.Amino().UnmarshalBinaryBare(bz, &msgs[i]); err != nil {
+			return gov.ExecutableProposal{}, err
+		}
+	}
+
+	return gov.NewExecutableProposal(p.Title, p.Description, msgs), nil
+}
+
+// SetAccount sets the oneof field of the Account from a concrete account.
+func (m *Account) SetAccount(accI authexported.Account) error {
+	switch acc := accI.(type) {
+	case *auth.BaseAccount:
+		m.Sum = &Account_BaseAccount{BaseAccount: acc}
+	case *auth.ContinuousVestingAccount:
+		m.Sum = &Account_ContinuousVestingAccount{ContinuousVestingAccount: acc}
+	case *auth.DelayedVestingAccount:
+		m.Sum = &Account_DelayedVestingAccount{DelayedVestingAccount: acc}
+	case *auth.PeriodicVestingAccount:
+		m.Sum = &Account_PeriodicVestingAccount{PeriodicVestingAccount: acc}
+	case *supply.ModuleAccount:
+		m.Sum = &Account_ModuleAccount{ModuleAccount: acc}
+	default:
+		return fmt.Errorf("account %T is not supported by the application codec", accI)
+	}
+
+	return nil
+}
+
+// GetAccount returns the concrete account held by the Account.
+func (m *Account) GetAccount() authexported.Account {
+	switch sum := m.Sum.(type) {
+	case *Account_BaseAccount:
+		return sum.BaseAccount
+	case *Account_ContinuousVestingAccount:
+		return sum.ContinuousVestingAccount
+	case *Account_DelayedVestingAccount:
+		return sum.DelayedVestingAccount
+	case *Account_PeriodicVestingAccount:
+		return sum.PeriodicVestingAccount
+	case *Account_ModuleAccount:
+		return sum.ModuleAccount
+	default:
+		return nil
+	}
+}
+
+// SetContent sets the oneof field of the Content from a concrete proposal
+// content.
+func (m *Content) SetContent(contentI gov.Content) error {
+	switch content := contentI.(type) {
+	case gov.TextProposal:
+		m.Sum = &Content_Text{Text: &content}
+	case params.ParameterChangeProposal:
+		m.Sum = &Content_ParameterChange{ParameterChange: &content}
+	case distr.CommunityPoolSpendProposal:
+		m.Sum = &Content_CommunityPoolSpend{CommunityPoolSpend: &content}
+	case upgrade.SoftwareUpgradeProposal:
+		m.Sum = &Content_SoftwareUpgrade{SoftwareUpgrade: &content}
+	case upgrade.CancelSoftwareUpgradeProposal:
+		m.Sum = &Content_CancelSoftwareUpgrade{CancelSoftwareUpgrade: &content}
+	case circuit.TripCircuitBreakerProposal:
+		m.Sum = &Content_TripCircuitBreaker{TripCircuitBreaker: &content}
+	case circuit.ResetCircuitBreakerProposal:
+		m.Sum = &Content_ResetCircuitBreaker{ResetCircuitBreaker: &content}
+	default:
+		return fmt.Errorf("proposal content %T is not supported by the application codec", contentI)
+	}
+
+	return nil
+}
+
+// GetContent returns the concrete proposal content held by the Content.
+func (m *Content) GetContent() gov.Content {
+	switch sum := m.Sum.(type) {
+	case *Content_Text:
+		return *sum.Text
+	case *Content_ParameterChange:
+		return *sum.ParameterChange
+	case *Content_CommunityPoolSpend:
+		return *sum.CommunityPoolSpend
+	case *Content_SoftwareUpgrade:
+		return *sum.SoftwareUpgrade
+	case *Content_CancelSoftwareUpgrade:
+		return *sum.CancelSoftwareUpgrade
+	case *Content_TripCircuitBreaker:
+		return *sum.TripCircuitBreaker
+	case *Content_ResetCircuitBreaker:
+		return *sum.ResetCircuitBreaker
+	default:
+		return nil
+	}
+}
@@ -0,0 +1,2250 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: simapp/codec/codec.proto
+
+package codec
+
+import (
+	fmt "fmt"
+	types "github.com/cosmos/cosmos-sdk/x/auth/types"
+	circuit "github.com/cosmos/cosmos-sdk/x/circuit"
+	types3 "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	types1 "github.com/cosmos/cosmos-sdk/x/gov/types"
+	types2 "github.com/cosmos/cosmos-sdk/x/params/types"
+	supply "github.com/cosmos/cosmos-sdk/x/supply"
+	upgrade "github.com/cosmos/cosmos-sdk/x/upgrade"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion2 // please upgrade the proto package
+
+// Account defines the application-level Account type, which holds any of the
+// concrete account types the application uses.
+type Account struct {
+	// Types that are valid to be assigned to Sum:
+	//	*Account_BaseAccount
+	//	*Account_ContinuousVestingAccount
+	//	*Account_DelayedVestingAccount
+	//	*Account_PeriodicVestingAccount
+	//	*Account_ModuleAccount
+	Sum isAccount_Sum `protobuf_oneof:"sum"`
+}
+
+func (m *Account) Reset()         { *m = Account{} }
+func (m *Account) String() string { return proto.CompactTextString(m) }
+func (*Account) ProtoMessage()    {}
+func (*Account) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3c6d4085e4065f5a, []int{0}
+}
+func (m *Account) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Account) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Account.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Account) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Account.Merge(m, src)
+}
+func (m *Account) XXX_Size() int {
+	return m.Size()
+}
+func (m *Account) XXX_DiscardUnknown() {
+	xxx_messageInfo_Account.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Account proto.InternalMessageInfo
+
+type isAccount_Sum interface {
+	isAccount_Sum()
+	MarshalTo([]byte) (int, error)
+	Size() int
+}
+
+type Account_BaseAccount struct {
+	BaseAccount *types.BaseAccount `protobuf:"bytes,1,opt,name=base_account,json=baseAccount,proto3,oneof"`
+}
+type Account_ContinuousVestingAccount struct {
+	ContinuousVestingAccount *types.ContinuousVestingAccount `protobuf:"bytes,2,opt,name=continuous_vesting_account,json=continuousVestingAccount,proto3,oneof"`
+}
+type Account_DelayedVestingAccount struct {
+	DelayedVestingAccount *types.DelayedVestingAccount `protobuf:"bytes,3,opt,name=delayed_vesting_account,json=delayedVestingAccount,proto3,oneof"`
+}
+type Account_PeriodicVestingAccount struct {
+	PeriodicVestingAccount *types.PeriodicVestingAccount `protobuf:"bytes,4,opt,name=periodic_vesting_account,json=periodicVestingAccount,proto3,oneof"`
+}
+type Account_ModuleAccount struct {
+	ModuleAccount *supply.ModuleAccount `protobuf:"bytes,5,opt,name=module_account,json=moduleAccount,proto3,oneof"`
+}
+
+func (*Account_BaseAccount) isAccount_Sum()              {}
+func (*Account_ContinuousVestingAccount) isAccount_Sum() {}
+func (*Account_DelayedVestingAccount) isAccount_Sum()    {}
+func (*Account_PeriodicVestingAccount) isAccount_Sum()   {}
+func (*Account_ModuleAccount) isAccount_Sum()            {}
+
+func (m *Account) GetSum() isAccount_Sum {
+	if m != nil {
+		return m.Sum
+	}
+	return nil
+}
+
+func (m *Account) GetBaseAccount() *types.BaseAccount {
+	if x, ok := m.GetSum().(*Account_BaseAccount); ok {
+		return x.BaseAccount
+	}
+	return nil
+}
+
+func (m *Account) GetContinuousVestingAccount() *types.ContinuousVestingAccount {
+	if x, ok := m.GetSum().(*Account_ContinuousVestingAccount); ok {
+		return x.ContinuousVestingAccount
+	}
+	return nil
+}
+
+func (m *Account) GetDelayedVestingAccount() *types.DelayedVestingAccount {
+	if x, ok := m.GetSum().(*Account_DelayedVestingAccount); ok {
+		return x.DelayedVestingAccount
+	}
+	return nil
+}
+
+func (m *Account) GetPeriodicVestingAccount() *types.PeriodicVestingAccount {
+	if x, ok := m.GetSum().(*Account_PeriodicVestingAccount); ok {
+		return x.PeriodicVestingAccount
+	}
+	return nil
+}
+
+func (m *Account) GetModuleAccount() *supply.ModuleAccount {
+	if x, ok := m.GetSum().(*Account_ModuleAccount); ok {
+		return x.ModuleAccount
+	}
+	return nil
+}
+
+// XXX_OneofFuncs is for the internal use of the proto package.
+func (*Account) XXX_OneofFuncs() (func(msg proto.Message, b *proto.Buffer) error, func(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error), func(msg proto.Message) (n int), []interface{}) {
+	return _Account_OneofMarshaler, _Account_OneofUnmarshaler, _Account_OneofSizer, []interface{}{
+		(*Account_BaseAccount)(nil),
+		(*Account_ContinuousVestingAccount)(nil),
+		(*Account_DelayedVestingAccount)(nil),
+		(*Account_PeriodicVestingAccount)(nil),
+		(*Account_ModuleAccount)(nil),
+	}
+}
+
+func _Account_OneofMarshaler(msg proto.Message, b *proto.Buffer) error {
+	m := msg.(*Account)
+	// sum
+	switch x := m.Sum.(type) {
+	case *Account_BaseAccount:
+		_ = b.EncodeVarint(1<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.BaseAccount); err != nil {
+			return err
+		}
+	case *Account_ContinuousVestingAccount:
+		_ = b.EncodeVarint(2<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.ContinuousVestingAccount); err != nil {
+			return err
+		}
+	case *Account_DelayedVestingAccount:
+		_ = b.EncodeVarint(3<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.DelayedVestingAccount); err != nil {
+			return err
+		}
+	case *Account_PeriodicVestingAccount:
+		_ = b.EncodeVarint(4<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.PeriodicVestingAccount); err != nil {
+			return err
+		}
+	case *Account_ModuleAccount:
+		_ = b.EncodeVarint(5<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.ModuleAccount); err != nil {
+			return err
+		}
+	case nil:
+	default:
+		return fmt.Errorf("Account.Sum has unexpected type %T", x)
+	}
+	return nil
+}
+
+func _Account_OneofUnmarshaler(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error) {
+	m := msg.(*Account)
+	switch tag {
+	case 1: // sum.base_account
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(types.BaseAccount)
+		err := b.DecodeMessage(msg)
+		m.Sum = &Account_BaseAccount{msg}
+		return true, err
+	case 2: // sum.continuous_vesting_account
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(types.ContinuousVestingAccount)
+		err := b.DecodeMessage(msg)
+		m.Sum = &Account_ContinuousVestingAccount{msg}
+		return true, err
+	case 3: // sum.delayed_vesting_account
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(types.DelayedVestingAccount)
+		err := b.DecodeMessage(msg)
+		m.Sum = &Account_DelayedVestingAccount{msg}
+		return true, err
+	case 4: // sum.periodic_vesting_account
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(types.PeriodicVestingAccount)
+		err := b.DecodeMessage(msg)
+		m.Sum = &Account_PeriodicVestingAccount{msg}
+		return true, err
+	case 5: // sum.module_account
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(supply.ModuleAccount)
+		err := b.DecodeMessage(msg)
+		m.Sum = &Account_ModuleAccount{msg}
+		return true, err
+	default:
+		return false, nil
+	}
+}
+
+func _Account_OneofSizer(msg proto.Message) (n int) {
+	m := msg.(*Account)
+	// sum
+	switch x := m.Sum.(type) {
+	case *Account_BaseAccount:
+		s := proto.Size(x.BaseAccount)
+		n += 1 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *Account_ContinuousVestingAccount:
+		s := proto.Size(x.ContinuousVestingAccount)
+		n += 1 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *Account_DelayedVestingAccount:
+		s := proto.Size(x.DelayedVestingAccount)
+		n += 1 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *Account_PeriodicVestingAccount:
+		s := proto.Size(x.PeriodicVestingAccount)
+		n += 1 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *Account_ModuleAccount:
+		s := proto.Size(x.ModuleAccount)
+		n += 1 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case nil:
+	default:
+		panic(fmt.Sprintf("proto: unexpected type %T in oneof", x))
+	}
+	return n
+}
+
+// Content defines the application-level proposal Content type, which holds
+// any of the concrete proposal content types the application uses.
+type Content struct {
+	// Types that are valid to be assigned to Sum:
+	//	*Content_Text
+	//	*Content_ParameterChange
+	//	*Content_CommunityPoolSpend
+	//	*Content_SoftwareUpgrade
+	//	*Content_CancelSoftwareUpgrade
+	//	*Content_TripCircuitBreaker
+	//	*Content_ResetCircuitBreaker
+	//	*Content_Executable
+	Sum isContent_Sum `protobuf_oneof:"sum"`
+}
+
+func (m *Content) Reset()         { *m = Content{} }
+func (m *Content) String() string { return proto.CompactTextString(m) }
+func (*Content) ProtoMessage()    {}
+func (*Content) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3c6d4085e4065f5a, []int{1}
+}
+func (m *Content) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Content) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Content.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Content) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Content.Merge(m, src)
+}
+func (m *Content) XXX_Size() int {
+	return m.Size()
+}
+func (m *Content) XXX_DiscardUnknown() {
+	xxx_messageInfo_Content.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Content proto.InternalMessageInfo
+
+type isContent_Sum interface {
+	isContent_Sum()
+	MarshalTo([]byte) (int, error)
+	Size() int
+}
+
+type Content_Text struct {
+	Text *types1.TextProposal `protobuf:"bytes,1,opt,name=text,proto3,oneof"`
+}
+type Content_ParameterChange struct {
+	ParameterChange *types2.ParameterChangeProposal `protobuf:"bytes,2,opt,name=parameter_change,json=parameterChange,proto3,oneof"`
+}
+type Content_CommunityPoolSpend struct {
+	CommunityPoolSpend *types3.CommunityPoolSpendProposal `protobuf:"bytes,3,opt,name=community_pool_spend,json=communityPoolSpend,proto3,oneof"`
+}
+type Content_SoftwareUpgrade struct {
+	SoftwareUpgrade *upgrade.SoftwareUpgradeProposal `protobuf:"bytes,4,opt,name=software_upgrade,json=softwareUpgrade,proto3,oneof"`
+}
+type Content_CancelSoftwareUpgrade struct {
+	CancelSoftwareUpgrade *upgrade.CancelSoftwareUpgradeProposal `protobuf:"bytes,5,opt,name=cancel_software_upgrade,json=cancelSoftwareUpgrade,proto3,oneof"`
+}
+type Content_TripCircuitBreaker struct {
+	TripCircuitBreaker *circuit.TripCircuitBreakerProposal `protobuf:"bytes,6,opt,name=trip_circuit_breaker,json=tripCircuitBreaker,proto3,oneof"`
+}
+type Content_ResetCircuitBreaker struct {
+	ResetCircuitBreaker *circuit.ResetCircuitBreakerProposal `protobuf:"bytes,7,opt,name=reset_circuit_breaker,json=resetCircuitBreaker,proto3,oneof"`
+}
+type Content_Executable struct {
+	Executable *ExecutableProposal `protobuf:"bytes,8,opt,name=executable,proto3,oneof"`
+}
+
+func (*Content_Text) isContent_Sum()                  {}
+func (*Content_ParameterChange) isContent_Sum()       {}
+func (*Content_CommunityPoolSpend) isContent_Sum()    {}
+func (*Content_SoftwareUpgrade) isContent_Sum()       {}
+func (*Content_CancelSoftwareUpgrade) isContent_Sum() {}
+func (*Content_TripCircuitBreaker) isContent_Sum()    {}
+func (*Content_ResetCircuitBreaker) isContent_Sum()   {}
+func (*Content_Executable) isContent_Sum()            {}
+
+func (m *Content) GetSum() isContent_Sum {
+	if m != nil {
+		return m.Sum
+	}
+	return nil
+}
+
+func (m *Content) GetText() *types1.TextProposal {
+	if x, ok := m.GetSum().(*Content_Text); ok {
+		return x.Text
+	}
+	return nil
+}
+
+func (m *Content) GetParameterChange() *types2.ParameterChangeProposal {
+	if x, ok := m.GetSum().(*Content_ParameterChange); ok {
+		return x.ParameterChange
+	}
+	return nil
+}
+
+func (m *Content) GetCommunityPoolSpend() *types3.CommunityPoolSpendProposal {
+	if x, ok := m.GetSum().(*Content_CommunityPoolSpend); ok {
+		return x.CommunityPoolSpend
+	}
+	return nil
+}
+
+func (m *Content) GetSoftwareUpgrade() *upgrade.SoftwareUpgradeProposal {
+	if x, ok := m.GetSum().(*Content_SoftwareUpgrade); ok {
+		return x.SoftwareUpgrade
+	}
+	return nil
+}
+
+func (m *Content) GetCancelSoftwareUpgrade() *upgrade.CancelSoftwareUpgradeProposal {
+	if x, ok := m.GetSum().(*Content_CancelSoftwareUpgrade); ok {
+		return x.CancelSoftwareUpgrade
+	}
+	return nil
+}
+
+func (m *Content) GetTripCircuitBreaker() *circuit.TripCircuitBreakerProposal {
+	if x, ok := m.GetSum().(*Content_TripCircuitBreaker); ok {
+		return x.TripCircuitBreaker
+	}
+	return nil
+}
+
+func (m *Content) GetResetCircuitBreaker() *circuit.ResetCircuitBreakerProposal {
+	if x, ok := m.GetSum().(*Content_ResetCircuitBreaker); ok {
+		return x.ResetCircuitBreaker
+	}
+	return nil
+}
+
+func (m *Content) GetExecutable() *ExecutableProposal {
+	if x, ok := m.GetSum().(*Content_Executable); ok {
+		return x.Executable
+	}
+	return nil
+}
+
+// XXX_OneofFuncs is for the internal use of the proto package.
+func (*Content) XXX_OneofFuncs() (func(msg proto.Message, b *proto.Buffer) error, func(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error), func(msg proto.Message) (n int), []interface{}) {
+	return _Content_OneofMarshaler, _Content_OneofUnmarshaler, _Content_OneofSizer, []interface{}{
+		(*Content_Text)(nil),
+		(*Content_ParameterChange)(nil),
+		(*Content_CommunityPoolSpend)(nil),
+		(*Content_SoftwareUpgrade)(nil),
+		(*Content_CancelSoftwareUpgrade)(nil),
+		(*Content_TripCircuitBreaker)(nil),
+		(*Content_ResetCircuitBreaker)(nil),
+		(*Content_Executable)(nil),
+	}
+}
+
+func _Content_OneofMarshaler(msg proto.Message, b *proto.Buffer) error {
+	m := msg.(*Content)
+	// sum
+	switch x := m.Sum.(type) {
+	case *Content_Text:
+		_ = b.EncodeVarint(1<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.Text); err != nil {
+			return err
+		}
+	case *Content_ParameterChange:
+		_ = b.EncodeVarint(2<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.ParameterChange); err != nil {
+			return err
+		}
+	case *Content_CommunityPoolSpend:
+		_ = b.EncodeVarint(3<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.CommunityPoolSpend); err != nil {
+			return err
+		}
+	case *Content_SoftwareUpgrade:
+		_ = b.EncodeVarint(4<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.SoftwareUpgrade); err != nil {
+			return err
+		}
+	case *Content_CancelSoftwareUpgrade:
+		_ = b.EncodeVarint(5<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.CancelSoftwareUpgrade); err != nil {
+			return err
+		}
+	case *Content_TripCircuitBreaker:
+		_ = b.EncodeVarint(6<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.TripCircuitBreaker); err != nil {
+			return err
+		}
+	case *Content_ResetCircuitBreaker:
+		_ = b.EncodeVarint(7<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.ResetCircuitBreaker); err != nil {
+			return err
+		}
+	case *Content_Executable:
+		_ = b.EncodeVarint(8<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.Executable); err != nil {
+			return err
+		}
+	case nil:
+	default:
+		return fmt.Errorf("Content.Sum has unexpected type %T", x)
+	}
+	return nil
+}
+
+func _Content_OneofUnmarshaler(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error) {
+	m := msg.(*Content)
+	switch tag {
+	case 1: // sum.text
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(types1.TextProposal)
+		err := b.DecodeMessage(msg)
+		m.Sum = &Content_Text{msg}
+		return true, err
+	case 2: // sum.parameter_change
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(types2.ParameterChangeProposal)
+		err := b.DecodeMessage(msg)
+		m.Sum = &Content_ParameterChange{msg}
+		return true, err
+	case 3: // sum.community_pool_spend
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(types3.CommunityPoolSpendProposal)
+		err := b.DecodeMessage(msg)
+		m.Sum = &Content_CommunityPoolSpend{msg}
+		return true, err
+	case 4: // sum.software_upgrade
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(upgrade.SoftwareUpgradeProposal)
+		err := b.DecodeMessage(msg)
+		m.Sum = &Content_SoftwareUpgrade{msg}
+		return true, err
+	case 5: // sum.cancel_software_upgrade
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(upgrade.CancelSoftwareUpgradeProposal)
+		err := b.DecodeMessage(msg)
+		m.Sum = &Content_CancelSoftwareUpgrade{msg}
+		return true, err
+	case 6: // sum.trip_circuit_breaker
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(circuit.TripCircuitBreakerProposal)
+		err := b.DecodeMessage(msg)
+		m.Sum = &Content_TripCircuitBreaker{msg}
+		return true, err
+	case 7: // sum.reset_circuit_breaker
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(circuit.ResetCircuitBreakerProposal)
+		err := b.DecodeMessage(msg)
+		m.Sum = &Content_ResetCircuitBreaker{msg}
+		return true, err
+	case 8: // sum.executable
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(ExecutableProposal)
+		err := b.DecodeMessage(msg)
+		m.Sum = &Content_Executable{msg}
+		return true, err
+	default:
+		return false, nil
+	}
+}
+
+func _Content_OneofSizer(msg proto.Message) (n int) {
+	m := msg.(*Content)
+	// sum
+	switch x := m.Sum.(type) {
+	case *Content_Text:
+		s := proto.Size(x.Text)
+		n += 1 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *Content_ParameterChange:
+		s := proto.Size(x.ParameterChange)
+		n += 1 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *Content_CommunityPoolSpend:
+		s := proto.Size(x.CommunityPoolSpend)
+		n += 1 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *Content_SoftwareUpgrade:
+		s := proto.Size(x.SoftwareUpgrade)
+		n += 1 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *Content_CancelSoftwareUpgrade:
+		s := proto.Size(x.CancelSoftwareUpgrade)
+		n += 1 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *Content_TripCircuitBreaker:
+		s := proto.Size(x.TripCircuitBreaker)
+		n += 1 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *Content_ResetCircuitBreaker:
+		s := proto.Size(x.ResetCircuitBreaker)
+		n += 1 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *Content_Executable:
+		s := proto.Size(x.Executable)
+		n += 1 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case nil:
+	default:
+		panic(fmt.Sprintf("proto: unexpected type %T in oneof", x))
+	}
+	return n
+}
+
+// ExecutableProposal defines the encoding of the x/gov ExecutableProposal. Its
+// messages can be any of the messages of the application, so each of them is
+// encoded with amino.
+type ExecutableProposal struct {
+	Title       string   `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Description string   `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	Msgs        [][]byte `protobuf:"bytes,3,rep,name=msgs,proto3" json:"msgs,omitempty"`
+}
+
+func (m *ExecutableProposal) Reset()         { *m = ExecutableProposal{} }
+func (m *ExecutableProposal) String() string { return proto.CompactTextString(m) }
+func (*ExecutableProposal) ProtoMessage()    {}
+func (*ExecutableProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3c6d4085e4065f5a, []int{2}
+}
+func (m *ExecutableProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ExecutableProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ExecutableProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ExecutableProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExecutableProposal.Merge(m, src)
+}
+func (m *ExecutableProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *ExecutableProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExecutableProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExecutableProposal proto.InternalMessageInfo
+
+// Proposal defines the application-level governance proposal, which holds the
+// proposal fields shared by all the proposals and their Content.
+type Proposal struct {
+	Base    types1.ProposalBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base"`
+	Content Content             `protobuf:"bytes,2,opt,name=content,proto3" json:"content"`
+}
+
+func (m *Proposal) Reset()         { *m = Proposal{} }
+func (m *Proposal) String() string { return proto.CompactTextString(m) }
+func (*Proposal) ProtoMessage()    {}
+func (*Proposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3c6d4085e4065f5a, []int{3}
+}
+func (m *Proposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Proposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Proposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Proposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Proposal.Merge(m, src)
+}
+func (m *Proposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *Proposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_Proposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Proposal proto.InternalMessageInfo
+
+func (m *Proposal) GetBase() types1.ProposalBase {
+	if m != nil {
+		return m.Base
+	}
+	return types1.ProposalBase{}
+}
+
+func (m *Proposal) GetContent() Content {
+	if m != nil {
+		return m.Content
+	}
+	return Content{}
+}
+
+func init() {
+}
+
+func init() { proto.RegisterFile("simapp/codec/codec.proto", fileDescriptor_3c6d4085e4065f5a) }
+
+var fileDescriptor_3c6d4085e4065f5a = []byte{
+	// 765 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x55, 0xcf, 0x6e, 0xe3, 0x44,
+	0x18, 0x4f, 0x48, 0xda, 0xb4, 0xd3, 0x02, 0xd5, 0x90, 0x52, 0x2b, 0x87, 0x34, 0xb4, 0x45, 0x2a,
+	0x02, 0x6c, 0x4a, 0x11, 0x20, 0x38, 0x91, 0x00, 0xea, 0x05, 0x14, 0xa5, 0xc0, 0x01, 0x81, 0xac,
+	0xc9, 0x78, 0xea, 0x0c, 0xb5, 0x3d, 0xa3, 0x99, 0x71, 0x70, 0xde, 0x60, 0x0f, 0xbb, 0xd2, 0x3e,
+	0xc2, 0x3e, 0x4e, 0x8f, 0x3d, 0xee, 0x69, 0xb5, 0x6a, 0x4f, 0xfb, 0x16, 0x2b, 0xcf, 0x4c, 0x12,
+	0x67, 0xed, 0xe4, 0x92, 0x78, 0xe6, 0xf7, 0x4f, 0x9e, 0xef, 0xfb, 0xc6, 0xc0, 0x91, 0x34, 0x46,
+	0x9c, 0x7b, 0x98, 0x05, 0x04, 0x9b, 0x5f, 0x97, 0x0b, 0xa6, 0x18, 0xec, 0x60, 0x26, 0x63, 0x26,
+	0x7d, 0x19, 0xdc, 0xba, 0x86, 0xe4, 0x1a, 0x78, 0x7a, 0xd1, 0x69, 0x87, 0x2c, 0x64, 0x9a, 0xe6,
+	0xe5, 0x4f, 0x46, 0xd1, 0x71, 0x32, 0x0f, 0xa5, 0x6a, 0xe2, 0xa9, 0x19, 0x27, 0xd2, 0xfc, 0x5a,
+	0xe4, 0x34, 0xf3, 0x64, 0xca, 0x79, 0x34, 0xf3, 0x68, 0xa2, 0x88, 0x48, 0x50, 0x54, 0x41, 0x3a,
+	0xca, 0xbc, 0x90, 0x4d, 0x2b, 0x80, 0x4e, 0xe6, 0x71, 0x24, 0x50, 0x2c, 0x2b, 0xb0, 0x5e, 0xe6,
+	0x05, 0x54, 0x2a, 0x41, 0xc7, 0xa9, 0xa2, 0x2c, 0xa9, 0x60, 0x9c, 0x65, 0x5e, 0xca, 0x43, 0x81,
+	0x02, 0xb2, 0x29, 0xfc, 0x2c, 0xf3, 0x30, 0x15, 0x38, 0xa5, 0x6a, 0x03, 0xeb, 0xe4, 0x4d, 0x03,
+	0xb4, 0x7e, 0xc2, 0x98, 0xa5, 0x89, 0x82, 0xbf, 0x82, 0xfd, 0x31, 0x92, 0xc4, 0x47, 0x66, 0xed,
+	0xd4, 0x7b, 0xf5, 0xf3, 0xbd, 0xaf, 0x3f, 0x71, 0x0b, 0xc7, 0x96, 0xb9, 0xf9, 0x79, 0xb8, 0xd3,
+	0x0b, 0xb7, 0x8f, 0x24, 0xb1, 0xc2, 0xab, 0xda, 0x68, 0x6f, 0xbc, 0x5c, 0xc2, 0x04, 0x74, 0x30,
+	0x4b, 0x14, 0x4d, 0x52, 0x96, 0x4a, 0x7f, 0x4a, 0xa4, 0xa2, 0x49, 0xb8, 0x70, 0x7d, 0x4f, 0xbb,
+	0xba, 0xd5, 0xae, 0x83, 0x85, 0xee, 0x2f, 0x23, 0x5b, 0x46, 0x38, 0x78, 0x0d, 0x06, 0x09, 0x38,
+	0x0a, 0x48, 0x84, 0x66, 0x24, 0x28, 0x85, 0x35, 0x74, 0xd8, 0xe7, 0xd5, 0x61, 0x3f, 0x1b, 0x51,
+	0x29, 0xe9, 0x30, 0xa8, 0x02, 0xe0, 0x04, 0x38, 0x9c, 0x08, 0xca, 0x02, 0x8a, 0x4b, 0x39, 0x4d,
+	0x9d, 0xf3, 0x45, 0x75, 0xce, 0xd0, 0xaa, 0x4a, 0x41, 0x1f, 0xf3, 0x4a, 0x04, 0xfe, 0x0e, 0x3e,
+	0x88, 0x59, 0x90, 0x46, 0xcb, 0x52, 0x6c, 0x69, 0xff, 0x4f, 0x57, 0xfd, 0x4d, 0x03, 0xe6, 0x09,
+	0xbf, 0x69, 0xf6, 0xd2, 0xf8, 0xfd, 0xb8, 0xb8, 0xd1, 0xdf, 0x02, 0x0d, 0x99, 0xc6, 0x27, 0xcf,
+	0xb6, 0x41, 0x2b, 0x3f, 0x60, 0x92, 0x28, 0xf8, 0x1d, 0x68, 0x2a, 0x92, 0xad, 0xa9, 0x71, 0xc8,
+	0xa6, 0xb9, 0xeb, 0x1f, 0x24, 0x53, 0x43, 0xc1, 0x38, 0x93, 0x28, 0xba, 0xaa, 0x8d, 0xb4, 0x00,
+	0xfe, 0x03, 0x0e, 0x74, 0xeb, 0x12, 0x45, 0x84, 0x8f, 0x27, 0x28, 0x09, 0x89, 0x2d, 0xa9, 0xb7,
+	0x6a, 0xa2, 0x59, 0x52, 0xbf, 0xff, 0x9c, 0x3f, 0xd0, 0xf4, 0x82, 0xe5, 0x87, 0x7c, 0x15, 0x82,
+	0x11, 0x68, 0x63, 0x16, 0xc7, 0x69, 0x42, 0xd5, 0xcc, 0xe7, 0x8c, 0x45, 0xbe, 0xe4, 0x24, 0x09,
+	0x6c, 0x1d, 0xbf, 0x5f, 0x4d, 0x28, 0x8e, 0x89, 0x69, 0x1e, 0xab, 0x1c, 0x32, 0x16, 0x5d, 0xe7,
+	0xba, 0x42, 0x14, 0xc4, 0x25, 0x14, 0xfe, 0x0b, 0x0e, 0x24, 0xbb, 0x51, 0xff, 0x23, 0x41, 0x7c,
+	0x3b, 0x51, 0xb6, 0x92, 0x5f, 0xad, 0x26, 0x59, 0x30, 0x0f, 0xb9, 0xb6, 0x82, 0x3f, 0xcd, 0x56,
+	0xf1, 0x65, 0xe4, 0x2a, 0x04, 0x39, 0x38, 0xc2, 0x28, 0xc1, 0x24, 0xf2, 0x4b, 0x29, 0xa6, 0x9e,
+	0xdf, 0xae, 0x4d, 0x19, 0x68, 0xdd, 0xfa, 0xac, 0x43, 0x5c, 0x45, 0x80, 0x21, 0x68, 0x2b, 0x41,
+	0xb9, 0x6f, 0x07, 0xdf, 0x1f, 0x0b, 0x82, 0x6e, 0x89, 0x70, 0xb6, 0x75, 0xdc, 0xe5, 0x6a, 0x9c,
+	0x25, 0xe9, 0x4a, 0x0b, 0xca, 0x07, 0x66, 0xd9, 0x37, 0x92, 0xe2, 0xc9, 0xa9, 0x12, 0x0a, 0xff,
+	0x03, 0x87, 0x82, 0x48, 0xa2, 0x4a, 0x49, 0x2d, 0x9d, 0xf4, 0xcd, 0xda, 0xa4, 0x51, 0xae, 0x5a,
+	0x1b, 0xf5, 0x91, 0x28, 0xc3, 0x70, 0x08, 0x00, 0xc9, 0x08, 0x4e, 0x15, 0x1a, 0x47, 0xc4, 0xd9,
+	0x29, 0x5f, 0x1f, 0xef, 0xdc, 0xe5, 0xee, 0x2f, 0x0b, 0x76, 0xc1, 0xba, 0xe0, 0x31, 0x9f, 0x87,
+	0x1b, 0x00, 0xcb, 0x54, 0xd8, 0x06, 0x5b, 0x8a, 0xaa, 0x88, 0xe8, 0xd1, 0xd8, 0x1d, 0x99, 0x05,
+	0xec, 0x81, 0xbd, 0x80, 0x48, 0x2c, 0x28, 0xcf, 0xbb, 0x4d, 0x77, 0xfc, 0xee, 0xa8, 0xb8, 0x05,
+	0x21, 0x68, 0xc6, 0x32, 0x94, 0x4e, 0xa3, 0xd7, 0x38, 0xdf, 0x1f, 0xe9, 0xe7, 0x1f, 0x9a, 0x4f,
+	0x5e, 0x1c, 0xd7, 0x4e, 0x9e, 0xd6, 0xc1, 0xce, 0xc2, 0xfe, 0x47, 0xd0, 0xcc, 0xef, 0xca, 0x8d,
+	0x83, 0x37, 0x27, 0xe7, 0x77, 0x6c, 0xbf, 0x79, 0xf7, 0xea, 0xb8, 0x36, 0xd2, 0x22, 0x38, 0x00,
+	0x2d, 0x6c, 0x06, 0xd8, 0xce, 0xdc, 0xe9, 0xa6, 0x73, 0xb0, 0xb3, 0x6e, 0x1d, 0xe6, 0xca, 0xfe,
+	0xe0, 0xee, 0xa1, 0x5b, 0xbf, 0x7f, 0xe8, 0xd6, 0x5f, 0x3f, 0x74, 0xeb, 0xcf, 0x1f, 0xbb, 0xb5,
+	0xfb, 0xc7, 0x6e, 0xed, 0xe5, 0x63, 0xb7, 0xf6, 0xf7, 0x67, 0x21, 0x55, 0x93, 0x74, 0xec, 0x62,
+	0x16, 0x7b, 0xc6, 0xd7, 0xfe, 0x7d, 0x29, 0x83, 0x5b, 0xaf, 0xf8, 0x5d, 0x1d, 0x6f, 0xeb, 0xcf,
+	0xc7, 0xe5, 0xdb, 0x01, 0x00, 0x0c, 0x6b, 0x6a, 0x48, 0x6e, 0x07, 0x00, 0x00,
+}
+
+func (m *Account) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Account) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Sum != nil {
+		nn1, err := m.Sum.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += nn1
+	}
+	return i, nil
+}
+
+func (m *Account_BaseAccount) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.BaseAccount != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.BaseAccount.Size()))
+		n2, err := m.BaseAccount.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n2
+	}
+	return i, nil
+}
+func (m *Account_ContinuousVestingAccount) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.ContinuousVestingAccount != nil {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.ContinuousVestingAccount.Size()))
+		n3, err := m.ContinuousVestingAccount.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n3
+	}
+	return i, nil
+}
+func (m *Account_DelayedVestingAccount) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.DelayedVestingAccount != nil {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.DelayedVestingAccount.Size()))
+		n4, err := m.DelayedVestingAccount.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n4
+	}
+	return i, nil
+}
+func (m *Account_PeriodicVestingAccount) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.PeriodicVestingAccount != nil {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.PeriodicVestingAccount.Size()))
+		n5, err := m.PeriodicVestingAccount.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n5
+	}
+	return i, nil
+}
+func (m *Account_ModuleAccount) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.ModuleAccount != nil {
+		dAtA[i] = 0x2a
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.ModuleAccount.Size()))
+		n6, err := m.ModuleAccount.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n6
+	}
+	return i, nil
+}
+func (m *Content) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Content) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Sum != nil {
+		nn7, err := m.Sum.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += nn7
+	}
+	return i, nil
+}
+
+func (m *Content_Text) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.Text != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.Text.Size()))
+		n8, err := m.Text.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n8
+	}
+	return i, nil
+}
+func (m *Content_ParameterChange) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.ParameterChange != nil {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.ParameterChange.Size()))
+		n9, err := m.ParameterChange.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n9
+	}
+	return i, nil
+}
+func (m *Content_CommunityPoolSpend) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.CommunityPoolSpend != nil {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.CommunityPoolSpend.Size()))
+		n10, err := m.CommunityPoolSpend.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n10
+	}
+	return i, nil
+}
+func (m *Content_SoftwareUpgrade) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.SoftwareUpgrade != nil {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.SoftwareUpgrade.Size()))
+		n11, err := m.SoftwareUpgrade.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n11
+	}
+	return i, nil
+}
+func (m *Content_CancelSoftwareUpgrade) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.CancelSoftwareUpgrade != nil {
+		dAtA[i] = 0x2a
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.CancelSoftwareUpgrade.Size()))
+		n12, err := m.CancelSoftwareUpgrade.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n12
+	}
+	return i, nil
+}
+func (m *Content_TripCircuitBreaker) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.TripCircuitBreaker != nil {
+		dAtA[i] = 0x32
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.TripCircuitBreaker.Size()))
+		n13, err := m.TripCircuitBreaker.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n13
+	}
+	return i, nil
+}
+func (m *Content_ResetCircuitBreaker) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.ResetCircuitBreaker != nil {
+		dAtA[i] = 0x3a
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.ResetCircuitBreaker.Size()))
+		n14, err := m.ResetCircuitBreaker.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n14
+	}
+	return i, nil
+}
+func (m *Content_Executable) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.Executable != nil {
+		dAtA[i] = 0x42
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.Executable.Size()))
+		n15, err := m.Executable.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n15
+	}
+	return i, nil
+}
+func (m *ExecutableProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ExecutableProposal) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Title) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.Title)))
+		i += copy(dAtA[i:], m.Title)
+	}
+	if len(m.Description) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.Description)))
+		i += copy(dAtA[i:], m.Description)
+	}
+	if len(m.Msgs) > 0 {
+		for _, b := range m.Msgs {
+			dAtA[i] = 0x1a
+			i++
+			i = encodeVarintCodec(dAtA, i, uint64(len(b)))
+			i += copy(dAtA[i:], b)
+		}
+	}
+	return i, nil
+}
+
+func (m *Proposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Proposal) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	dAtA[i] = 0xa
+	i++
+	i = encodeVarintCodec(dAtA, i, uint64(m.Base.Size()))
+	n16, err := m.Base.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n16
+	dAtA[i] = 0x12
+	i++
+	i = encodeVarintCodec(dAtA, i, uint64(m.Content.Size()))
+	n17, err := m.Content.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n17
+	return i, nil
+}
+
+func encodeVarintCodec(dAtA []byte, offset int, v uint64) int {
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return offset + 1
+}
+func (m *Account) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Sum != nil {
+		n += m.Sum.Size()
+	}
+	return n
+}
+
+func (m *Account_BaseAccount) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BaseAccount != nil {
+		l = m.BaseAccount.Size()
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *Account_ContinuousVestingAccount) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ContinuousVestingAccount != nil {
+		l = m.ContinuousVestingAccount.Size()
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *Account_DelayedVestingAccount) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.DelayedVestingAccount != nil {
+		l = m.DelayedVestingAccount.Size()
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *Account_PeriodicVestingAccount) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PeriodicVestingAccount != nil {
+		l = m.PeriodicVestingAccount.Size()
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *Account_ModuleAccount) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ModuleAccount != nil {
+		l = m.ModuleAccount.Size()
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *Content) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Sum != nil {
+		n += m.Sum.Size()
+	}
+	return n
+}
+
+func (m *Content_Text) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Text != nil {
+		l = m.Text.Size()
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *Content_ParameterChange) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ParameterChange != nil {
+		l = m.ParameterChange.Size()
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *Content_CommunityPoolSpend) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CommunityPoolSpend != nil {
+		l = m.CommunityPoolSpend.Size()
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *Content_SoftwareUpgrade) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SoftwareUpgrade != nil {
+		l = m.SoftwareUpgrade.Size()
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *Content_CancelSoftwareUpgrade) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CancelSoftwareUpgrade != nil {
+		l = m.CancelSoftwareUpgrade.Size()
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *Content_TripCircuitBreaker) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TripCircuitBreaker != nil {
+		l = m.TripCircuitBreaker.Size()
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *Content_ResetCircuitBreaker) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ResetCircuitBreaker != nil {
+		l = m.ResetCircuitBreaker.Size()
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *Content_Executable) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Executable != nil {
+		l = m.Executable.Size()
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *ExecutableProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	if len(m.Msgs) > 0 {
+		for _, b := range m.Msgs {
+			l = len(b)
+			n += 1 + l + sovCodec(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *Proposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Base.Size()
+	n += 1 + l + sovCodec(uint64(l))
+	l = m.Content.Size()
+	n += 1 + l + sovCodec(uint64(l))
+	return n
+}
+
+func sovCodec(x uint64) (n int) {
+	for {
+		n++
+		x >>= 7
+		if x == 0 {
+			break
+		}
+	}
+	return n
+}
+func sozCodec(x uint64) (n int) {
+	return sovCodec(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Account) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCodec
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Account: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Account: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseAccount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &types.BaseAccount{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Sum = &Account_BaseAccount{v}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContinuousVestingAccount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &types.ContinuousVestingAccount{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Sum = &Account_ContinuousVestingAccount{v}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelayedVestingAccount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &types.DelayedVestingAccount{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Sum = &Account_DelayedVestingAccount{v}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PeriodicVestingAccount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &types.PeriodicVestingAccount{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Sum = &Account_PeriodicVestingAccount{v}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ModuleAccount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &supply.ModuleAccount{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Sum = &Account_ModuleAccount{v}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCodec(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Content) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCodec
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Content: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Content: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Text", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &types1.TextProposal{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Sum = &Content_Text{v}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ParameterChange", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &types2.ParameterChangeProposal{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Sum = &Content_ParameterChange{v}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommunityPoolSpend", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &types3.CommunityPoolSpendProposal{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Sum = &Content_CommunityPoolSpend{v}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SoftwareUpgrade", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &upgrade.SoftwareUpgradeProposal{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Sum = &Content_SoftwareUpgrade{v}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CancelSoftwareUpgrade", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &upgrade.CancelSoftwareUpgradeProposal{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Sum = &Content_CancelSoftwareUpgrade{v}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TripCircuitBreaker", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &circuit.TripCircuitBreakerProposal{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Sum = &Content_TripCircuitBreaker{v}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResetCircuitBreaker", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &circuit.ResetCircuitBreakerProposal{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Sum = &Content_ResetCircuitBreaker{v}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Executable", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &ExecutableProposal{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Sum = &Content_Executable{v}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCodec(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ExecutableProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCodec
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ExecutableProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ExecutableProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Msgs", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Msgs = append(m.Msgs, make([]byte, postIndex-iNdEx))
+			copy(m.Msgs[len(m.Msgs)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCodec(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Proposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCodec
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Proposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Proposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Base", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Base.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Content", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Content.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCodec(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipCodec(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowCodec
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+			return iNdEx, nil
+		case 1:
+			iNdEx += 8
+			return iNdEx, nil
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthCodec
+			}
+			iNdEx += length
+			if iNdEx < 0 {
+				return 0, ErrInvalidLengthCodec
+			}
+			return iNdEx, nil
+		case 3:
+			for {
+				var innerWire uint64
+				var start int = iNdEx
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return 0, ErrIntOverflowCodec
+					}
+					if iNdEx >= l {
+						return 0, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					innerWire |= (uint64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				innerWireType := int(innerWire & 0x7)
+				if innerWireType == 4 {
+					break
+				}
+				next, err := skipCodec(dAtA[start:])
+				if err != nil {
+					return 0, err
+				}
+				iNdEx = start + next
+				if iNdEx < 0 {
+					return 0, ErrInvalidLengthCodec
+				}
+			}
+			return iNdEx, nil
+		case 4:
+			return iNdEx, nil
+		case 5:
+			iNdEx += 4
+			return iNdEx, nil
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+	}
+	panic("unreachable")
+}
+
+var (
+	ErrInvalidLengthCodec = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowCodec   = fmt.Errorf("proto: integer overflow")
+)
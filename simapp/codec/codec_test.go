@@ -0,0 +1,181 @@
+package codec_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/crypto/ed25519"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/simapp"
+	simappcodec "github.com/cosmos/cosmos-sdk/simapp/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/cosmos/cosmos-sdk/x/bank"
+	"github.com/cosmos/cosmos-sdk/x/circuit"
+	distr "github.com/cosmos/cosmos-sdk/x/distribution"
+	"github.com/cosmos/cosmos-sdk/x/gov"
+	"github.com/cosmos/cosmos-sdk/x/params"
+	"github.com/cosmos/cosmos-sdk/x/staking"
+	"github.com/cosmos/cosmos-sdk/x/supply"
+	"github.com/cosmos/cosmos-sdk/x/upgrade"
+)
+
+var (
+	pubKey   = ed25519.GenPrivKeyFromSecret([]byte("fixture")).PubKey()
+	addr     = sdk.AccAddress(pubKey.Address())
+	valAddr  = sdk.ValAddress(pubKey.Address())
+	coins    = sdk.NewCoins(sdk.NewInt64Coin("atom", 150), sdk.NewInt64Coin("stake", 10))
+	someTime = time.Unix(1575000000, 12).UTC()
+)
+
+func accountFixtures() []auth.Account {
+	baseAcc := auth.NewBaseAccount(addr, coins, pubKey, 7, 3)
+	baseVestingAcc := auth.NewBaseVestingAccount(baseAcc, coins, coins, coins, 1575010000)
+	periods := auth.Periods{auth.NewPeriod(100, coins), auth.NewPeriod(200, coins)}
+
+	return []auth.Account{
+		baseAcc,
+		auth.NewContinuousVestingAccountRaw(baseVestingAcc, 1575000000),
+		auth.NewDelayedVestingAccountRaw(baseVestingAcc),
+		auth.NewPeriodicVestingAccountRaw(baseVestingAcc, 1575000000, periods),
+		supply.NewModuleAccount(baseAcc, "fixture", supply.Minter, supply.Burner),
+	}
+}
+
+func proposalFixtures() []gov.Proposal {
+	contents := []gov.Content{
+		gov.NewTextProposal("title", "description"),
+		params.NewParameterChangeProposal("title", "description", []params.ParamChange{
+			params.NewParamChange("staking", "MaxValidators", "1"),
+			params.NewParamChangeWithSubkey("staking", "Commission", "Rate", "0.1"),
+		}),
+		distr.NewCommunityPoolSpendProposal("title", "description", addr, coins),
+		upgrade.NewSoftwareUpgradeProposal("title", "description", upgrade.NewPlan("v2", 0, someTime, "info")),
+		upgrade.NewCancelSoftwareUpgradeProposal("title", "description"),
+		circuit.NewTripCircuitBreakerProposal("title", "description", []string{"bank/send", "staking"}),
+		circuit.NewResetCircuitBreakerProposal("title", "description", []string{"bank/send"}),
+		gov.NewExecutableProposal("title", "description", []sdk.Msg{
+			bank.MsgSend{FromAddress: supply.NewModuleAddress(gov.ModuleName), ToAddress: addr, Amount: coins},
+			staking.NewMsgDelegate(supply.NewModuleAddress(gov.ModuleName), valAddr, sdk.NewInt64Coin("stake", 5)),
+		}),
+	}
+
+	proposals := make([]gov.Proposal, len(contents))
+	for i, content := range contents {
+		p := gov.NewProposal(content, uint64(i+1), someTime, someTime.Add(time.Hour))
+		p.Status = gov.StatusVotingPeriod
+		p.TotalDeposit = coins
+		p.VotingStartTime = someTime.Add(time.Minute)
+		p.VotingEndTime = someTime.Add(2 * time.Hour)
+		p.FinalTallyResult = gov.NewTallyResult(sdk.NewInt(4), sdk.NewInt(3), sdk.NewInt(2), sdk.NewInt(1))
+		p.ExecutionLog = "execution log"
+		p.Expedited = i%2 == 0
+		proposals[i] = p
+	}
+
+	return proposals
+}
+
+func stateFixtures() []codec.ProtoMarshaler {
+	validator := staking.NewValidator(valAddr, pubKey, staking.NewDescription("moniker", "identity", "website", "contact", "details"))
+	validator.Jailed = true
+	validator.Status = sdk.Unbonding
+	validator.Tokens = sdk.NewInt(1000)
+	validator.DelegatorShares = sdk.NewDecWithPrec(12345, 2)
+	validator.UnbondingHeight = 10
+	validator.UnbondingCompletionTime = someTime
+
+	delegation := staking.NewDelegation(addr, valAddr, sdk.NewDecWithPrec(5, 1))
+	ubd := staking.NewUnbondingDelegation(addr, valAddr, 10, someTime, sdk.NewInt(5))
+	red := staking.NewRedelegation(addr, valAddr, valAddr, 10, someTime, sdk.NewInt(5), sdk.NewDec(5))
+	deposit := gov.NewDeposit(1, addr, coins)
+	vote := gov.NewVote(1, addr, gov.OptionNoWithVeto)
+	weightedVote := gov.NewWeightedVote(1, addr, gov.WeightedVoteOptions{
+		gov.NewWeightedVoteOption(gov.OptionYes, sdk.NewDecWithPrec(6, 1)),
+		gov.NewWeightedVoteOption(gov.OptionAbstain, sdk.NewDecWithPrec(4, 1)),
+	})
+
+	return []codec.ProtoMarshaler{&validator, &delegation, &ubd, &red, &deposit, &vote, &weightedVote}
+}
+
+func TestAccountCodecs(t *testing.T) {
+	amino := simapp.MakeCodec()
+	codecs := []struct {
+		name string
+		cdc  auth.Codec
+	}{
+		{"amino", auth.NewAminoCodec(amino)},
+		{"proto", simappcodec.NewAppCodec(amino)},
+	}
+
+	for _, tc := range codecs {
+		for _, acc := range accountFixtures() {
+			bz, err := tc.cdc.MarshalAccount(acc)
+			require.NoError(t, err, tc.name)
+
+			got, err := tc.cdc.UnmarshalAccount(bz)
+			require.NoError(t, err, tc.name)
+			require.IsType(t, acc, got, tc.name)
+			require.Equal(t, amino.MustMarshalJSON(acc), amino.MustMarshalJSON(got), tc.name)
+		}
+	}
+}
+
+func TestProposalCodecs(t *testing.T) {
+	amino := simapp.MakeCodec()
+	codecs := []struct {
+		name string
+		cdc  gov.Codec
+	}{
+		{"amino", gov.NewAminoCodec(amino)},
+		{"proto", simappcodec.NewAppCodec(amino)},
+	}
+
+	for _, tc := range codecs {
+		for _, p := range proposalFixtures() {
+			bz, err := tc.cdc.MarshalProposal(p)
+			require.NoError(t, err, tc.name)
+
+			got, err := tc.cdc.UnmarshalProposal(bz)
+			require.NoError(t, err, tc.name)
+			require.IsType(t, p.Content, got.Content, tc.name)
+			require.Equal(t, amino.MustMarshalJSON(p), amino.MustMarshalJSON(got), tc.name)
+		}
+	}
+}
+
+func TestStateCodecs(t *testing.T) {
+	amino := simapp.MakeCodec()
+	codecs := []struct {
+		name string
+		cdc  codec.Marshaler
+	}{
+		{"amino", codec.NewAminoCodec(amino)},
+		{"proto", simappcodec.NewAppCodec(amino)},
+	}
+
+	for _, tc := range codecs {
+		for i, o := range stateFixtures() {
+			bz, err := tc.cdc.MarshalBinaryLengthPrefixed(o)
+			require.NoError(t, err, tc.name)
+
+			// decode into a fresh value of the same type
+			got := stateFixtures()[i]
+			got.Reset()
+			require.NoError(t, tc.cdc.UnmarshalBinaryLengthPrefixed(bz, got), tc.name)
+			require.Equal(t, amino.MustMarshalJSON(o), amino.MustMarshalJSON(got), tc.name)
+		}
+	}
+}
+
+func TestAppCodecUnsupportedTypes(t *testing.T) {
+	cdc := simappcodec.NewAppCodec(simapp.MakeCodec())
+
+	_, err := cdc.MarshalAccount(auth.NewBaseVestingAccount(auth.NewBaseAccount(addr, coins, pubKey, 0, 0), coins, nil, nil, 0))
+	require.Error(t, err)
+
+	_, err = cdc.MarshalProposal(gov.NewProposal(nil, 1, someTime, someTime))
+	require.Error(t, err)
+}
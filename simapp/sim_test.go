@@ -19,8 +19,10 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/auth"
 	authsimops "github.com/cosmos/cosmos-sdk/x/auth/simulation/operations"
 	banksimops "github.com/cosmos/cosmos-sdk/x/bank/simulation/operations"
+	"github.com/cosmos/cosmos-sdk/x/circuit"
 	distr "github.com/cosmos/cosmos-sdk/x/distribution"
 	distrsimops "github.com/cosmos/cosmos-sdk/x/distribution/simulation/operations"
+	"github.com/cosmos/cosmos-sdk/x/evidence"
 	"github.com/cosmos/cosmos-sdk/x/gov"
 	govsimops "github.com/cosmos/cosmos-sdk/x/gov/simulation/operations"
 	"github.com/cosmos/cosmos-sdk/x/mint"
@@ -484,6 +486,8 @@ func TestAppImportExport(t *testing.T) {
 		{app.keys[supply.StoreKey], newApp.keys[supply.StoreKey], [][]byte{}},
 		{app.keys[params.StoreKey], newApp.keys[params.StoreKey], [][]byte{}},
 		{app.keys[gov.StoreKey], newApp.keys[gov.StoreKey], [][]byte{}},
+		{app.keys[evidence.StoreKey], newApp.keys[evidence.StoreKey], [][]byte{}},
+		{app.keys[circuit.StoreKey], newApp.keys[circuit.StoreKey], [][]byte{}},
 	}
 
 	for _, storeKeysPrefix := range storeKeysPrefixes {
@@ -213,10 +213,13 @@ func GenGenesisAccounts(
 				endTime++
 			}
 
-			if r.Intn(100) < 50 {
+			switch r.Intn(3) {
+			case 0:
 				vacc = auth.NewContinuousVestingAccount(&bacc, startTime, endTime)
-			} else {
+			case 1:
 				vacc = auth.NewDelayedVestingAccount(&bacc, endTime)
+			default:
+				vacc = auth.NewPeriodicVestingAccount(&bacc, startTime, randomVestingPeriods(r, amount, endTime-startTime))
 			}
 
 			var err error
@@ -234,6 +237,35 @@ func GenGenesisAccounts(
 	genesisState[genaccounts.ModuleName] = cdc.MustMarshalJSON(genesisAccounts)
 }
 
+// randomVestingPeriods splits the vesting of amount bond tokens over a random
+// number of periods of random lengths that last the given duration in total.
+func randomVestingPeriods(r *rand.Rand, amount, duration int64) auth.Periods {
+	n := int64(simulation.RandIntBetween(r, 1, 6))
+	if n > amount {
+		n = amount
+	}
+	if n > duration {
+		n = duration
+	}
+
+	var periods auth.Periods
+	remainingAmt, remainingLength := amount, duration
+	for i := int64(1); i <= n; i++ {
+		length, amt := remainingLength, remainingAmt
+		if i < n {
+			// leave at least one second for each of the remaining periods
+			length = int64(simulation.RandIntBetween(r, 1, int(remainingLength-(n-i))+1))
+			amt = amount / n
+		}
+
+		periods = append(periods, auth.NewPeriod(length, sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(amt)))))
+		remainingAmt -= amt
+		remainingLength -= length
+	}
+
+	return periods
+}
+
 // GenGovGenesisState generates a random GenesisState for gov
 func GenGovGenesisState(cdc *codec.Codec, r *rand.Rand, ap simulation.AppParams, genesisState map[string]json.RawMessage) {
 	var vp time.Duration
@@ -254,8 +286,26 @@ func GenGovGenesisState(cdc *codec.Codec, r *rand.Rand, ap simulation.AppParams,
 				return v
 			}(r),
 			vp,
+			func(r *rand.Rand) sdk.Coins {
+				var v sdk.Coins
+				ap.GetOrGenerate(cdc, simulation.DepositParamsExpeditedMinDeposit, &v, r,
+					func(r *rand.Rand) {
+						v = simulation.ModuleParamSimulator[simulation.DepositParamsExpeditedMinDeposit](r).(sdk.Coins)
+					})
+				return v
+			}(r),
+		),
+		gov.NewVotingParams(
+			vp,
+			func(r *rand.Rand) time.Duration {
+				var v time.Duration
+				ap.GetOrGenerate(cdc, simulation.VotingParamsExpeditedVotingPeriod, &v, r,
+					func(r *rand.Rand) {
+						v = simulation.ModuleParamSimulator[simulation.VotingParamsExpeditedVotingPeriod](r).(time.Duration)
+					})
+				return v
+			}(r),
 		),
-		gov.NewVotingParams(vp),
 		gov.NewTallyParams(
 			func(r *rand.Rand) sdk.Dec {
 				var v sdk.Dec
@@ -281,6 +331,14 @@ func GenGovGenesisState(cdc *codec.Codec, r *rand.Rand, ap simulation.AppParams,
 					})
 				return v
 			}(r),
+			func(r *rand.Rand) sdk.Dec {
+				var v sdk.Dec
+				ap.GetOrGenerate(cdc, simulation.TallyParamsExpeditedThreshold, &v, r,
+					func(r *rand.Rand) {
+						v = simulation.ModuleParamSimulator[simulation.TallyParamsExpeditedThreshold](r).(sdk.Dec)
+					})
+				return v
+			}(r),
 		),
 	)
 
@@ -460,6 +518,8 @@ func GenStakingGenesisState(
 			}(r),
 			7,
 			sdk.DefaultBondDenom,
+			staking.DefaultGlobalLiquidStakingCap,
+			staking.DefaultValidatorLiquidStakingCap,
 		),
 		nil,
 		nil,
@@ -3,11 +3,13 @@ package cachemulti
 import (
 	"fmt"
 	"io"
+	"sort"
 
 	dbm "github.com/tendermint/tm-db"
 
 	"github.com/cosmos/cosmos-sdk/store/cachekv"
 	"github.com/cosmos/cosmos-sdk/store/dbadapter"
+	"github.com/cosmos/cosmos-sdk/store/listenkv"
 	"github.com/cosmos/cosmos-sdk/store/types"
 )
 
@@ -29,10 +31,14 @@ type Store struct {
 
 var _ types.CacheMultiStore = Store{}
 
+// NewFromKVStore creates a new Store cache-wrapping every given store. The
+// writes to a store with listeners are passed to them when the Store is
+// written.
 func NewFromKVStore(
 	store types.KVStore,
 	stores map[types.StoreKey]types.CacheWrapper, keys map[string]types.StoreKey,
 	traceWriter io.Writer, traceContext types.TraceContext,
+	listeners map[types.StoreKey][]types.WriteListener,
 ) Store {
 	cms := Store{
 		db:           cachekv.NewStore(store),
@@ -43,6 +49,10 @@ func NewFromKVStore(
 	}
 
 	for key, store := range stores {
+		if storeListeners := listeners[key]; len(storeListeners) > 0 {
+			store = listenkv.NewStore(store.(types.KVStore), key, storeListeners)
+		}
+
 		if cms.TracingEnabled() {
 			cms.stores[key] = store.CacheWrapWithTrace(cms.traceWriter, cms.traceContext)
 		} else {
@@ -53,12 +63,15 @@ func NewFromKVStore(
 	return cms
 }
 
+// NewStore creates a new Store over the given db and stores, see
+// NewFromKVStore.
 func NewStore(
 	db dbm.DB,
 	stores map[types.StoreKey]types.CacheWrapper, keys map[string]types.StoreKey,
 	traceWriter io.Writer, traceContext types.TraceContext,
+	listeners map[types.StoreKey][]types.WriteListener,
 ) Store {
-	return NewFromKVStore(dbadapter.Store{DB: db}, stores, keys, traceWriter, traceContext)
+	return NewFromKVStore(dbadapter.Store{DB: db}, stores, keys, traceWriter, traceContext, listeners)
 }
 
 func newCacheMultiStoreFromCMS(cms Store) Store {
@@ -66,7 +79,7 @@ func newCacheMultiStoreFromCMS(cms Store) Store {
 	for k, v := range cms.stores {
 		stores[k] = v
 	}
-	return NewFromKVStore(cms.db, stores, nil, cms.traceWriter, cms.traceContext)
+	return NewFromKVStore(cms.db, stores, nil, cms.traceWriter, cms.traceContext, nil)
 }
 
 // SetTracer sets the tracer for the MultiStore that the underlying
@@ -102,11 +115,20 @@ func (cms Store) GetStoreType() types.StoreType {
 	return types.StoreTypeMulti
 }
 
-// Write calls Write on each underlying store.
+// Write calls Write on each underlying store. Stores are written in the order
+// of their names, so that listeners observe the writes in a deterministic
+// order.
 func (cms Store) Write() {
 	cms.db.Write()
-	for _, store := range cms.stores {
-		store.Write()
+
+	keys := make([]types.StoreKey, 0, len(cms.stores))
+	for key := range cms.stores {
+		keys = append(keys, key)
+	}
+	sort.Slice(keys, func(i, j int) bool { return keys[i].Name() < keys[j].Name() })
+
+	for _, key := range keys {
+		cms.stores[key].Write()
 	}
 }
 
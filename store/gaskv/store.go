@@ -14,15 +14,23 @@ type Store struct {
 	gasMeter  types.GasMeter
 	gasConfig types.GasConfig
 	parent    types.KVStore
+	storeName string
 }
 
 // NewStore returns a reference to a new GasKVStore.
 // nolint
 func NewStore(parent types.KVStore, gasMeter types.GasMeter, gasConfig types.GasConfig) *Store {
+	return NewStoreWithName(parent, gasMeter, gasConfig, "")
+}
+
+// NewStoreWithName returns a reference to a new GasKVStore that reports its
+// name along with the gas it consumes if the gas meter is a StoreGasMeter.
+func NewStoreWithName(parent types.KVStore, gasMeter types.GasMeter, gasConfig types.GasConfig, storeName string) *Store {
 	kvs := &Store{
 		gasMeter:  gasMeter,
 		gasConfig: gasConfig,
 		parent:    parent,
+		storeName: storeName,
 	}
 	return kvs
 }
@@ -34,11 +42,11 @@ func (gs *Store) GetStoreType() types.StoreType {
 
 // Implements KVStore.
 func (gs *Store) Get(key []byte) (value []byte) {
-	gs.gasMeter.ConsumeGas(gs.gasConfig.ReadCostFlat, types.GasReadCostFlatDesc)
+	gs.consumeGas(gs.gasConfig.ReadCostFlat, types.GasReadCostFlatDesc)
 	value = gs.parent.Get(key)
 
 	// TODO overflow-safe math?
-	gs.gasMeter.ConsumeGas(gs.gasConfig.ReadCostPerByte*types.Gas(len(value)), types.GasReadPerByteDesc)
+	gs.consumeGas(gs.gasConfig.ReadCostPerByte*types.Gas(len(value)), types.GasReadPerByteDesc)
 
 	return value
 }
@@ -46,22 +54,22 @@ func (gs *Store) Get(key []byte) (value []byte) {
 // Implements KVStore.
 func (gs *Store) Set(key []byte, value []byte) {
 	types.AssertValidValue(value)
-	gs.gasMeter.ConsumeGas(gs.gasConfig.WriteCostFlat, types.GasWriteCostFlatDesc)
+	gs.consumeGas(gs.gasConfig.WriteCostFlat, types.GasWriteCostFlatDesc)
 	// TODO overflow-safe math?
-	gs.gasMeter.ConsumeGas(gs.gasConfig.WriteCostPerByte*types.Gas(len(value)), types.GasWritePerByteDesc)
+	gs.consumeGas(gs.gasConfig.WriteCostPerByte*types.Gas(len(value)), types.GasWritePerByteDesc)
 	gs.parent.Set(key, value)
 }
 
 // Implements KVStore.
 func (gs *Store) Has(key []byte) bool {
-	gs.gasMeter.ConsumeGas(gs.gasConfig.HasCost, types.GasHasDesc)
+	gs.consumeGas(gs.gasConfig.HasCost, types.GasHasDesc)
 	return gs.parent.Has(key)
 }
 
 // Implements KVStore.
 func (gs *Store) Delete(key []byte) {
 	// charge gas to prevent certain attack vectors even though space is being freed
-	gs.gasMeter.ConsumeGas(gs.gasConfig.DeleteCost, types.GasDeleteDesc)
+	gs.consumeGas(gs.gasConfig.DeleteCost, types.GasDeleteDesc)
 	gs.parent.Delete(key)
 }
 
@@ -98,7 +106,7 @@ func (gs *Store) iterator(start, end []byte, ascending bool) types.Iterator {
 		parent = gs.parent.ReverseIterator(start, end)
 	}
 
-	gi := newGasIterator(gs.gasMeter, gs.gasConfig, parent)
+	gi := newGasIterator(gs.gasMeter, gs.gasConfig, gs.storeName, parent)
 	if gi.Valid() {
 		gi.(*gasIterator).consumeSeekGas()
 	}
@@ -109,13 +117,15 @@ func (gs *Store) iterator(start, end []byte, ascending bool) types.Iterator {
 type gasIterator struct {
 	gasMeter  types.GasMeter
 	gasConfig types.GasConfig
+	storeName string
 	parent    types.Iterator
 }
 
-func newGasIterator(gasMeter types.GasMeter, gasConfig types.GasConfig, parent types.Iterator) types.Iterator {
+func newGasIterator(gasMeter types.GasMeter, gasConfig types.GasConfig, storeName string, parent types.Iterator) types.Iterator {
 	return &gasIterator{
 		gasMeter:  gasMeter,
 		gasConfig: gasConfig,
+		storeName: storeName,
 		parent:    parent,
 	}
 }
@@ -165,7 +175,26 @@ func (gi *gasIterator) Close() {
 func (gi *gasIterator) consumeSeekGas() {
 	value := gi.Value()
 
-	gi.gasMeter.ConsumeGas(gi.gasConfig.ReadCostPerByte*types.Gas(len(value)), types.GasValuePerByteDesc)
-	gi.gasMeter.ConsumeGas(gi.gasConfig.IterNextCostFlat, types.GasIterNextCostFlatDesc)
+	gi.consumeGas(gi.gasConfig.ReadCostPerByte*types.Gas(len(value)), types.GasValuePerByteDesc)
+	gi.consumeGas(gi.gasConfig.IterNextCostFlat, types.GasIterNextCostFlatDesc)
+
+}
+
+// consumeGas consumes gas from the meter of the store, along with the name of
+// the store if the meter accounts for the gas of each store.
+func (gs *Store) consumeGas(amount types.Gas, descriptor string) {
+	consumeStoreGas(gs.gasMeter, amount, descriptor, gs.storeName)
+}
+
+func (gi *gasIterator) consumeGas(amount types.Gas, descriptor string) {
+	consumeStoreGas(gi.gasMeter, amount, descriptor, gi.storeName)
+}
+
+func consumeStoreGas(gasMeter types.GasMeter, amount types.Gas, descriptor, storeName string) {
+	if sgm, ok := gasMeter.(types.StoreGasMeter); ok && storeName != "" {
+		sgm.ConsumeStoreGas(amount, descriptor, storeName)
+		return
+	}
 
+	gasMeter.ConsumeGas(amount, descriptor)
 }
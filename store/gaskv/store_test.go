@@ -70,3 +70,32 @@ func TestGasKVStoreOutOfGasIterator(t *testing.T) {
 	iterator.Next()
 	require.Panics(t, func() { iterator.Value() }, "Expected out-of-gas")
 }
+
+func TestGasKVStoreProfile(t *testing.T) {
+	mem := dbadapter.Store{dbm.NewMemDB()}
+	profiler := types.NewGasProfiler()
+	meter := types.NewProfilingGasMeter(types.NewGasMeter(10000), profiler)
+	st := gaskv.NewStoreWithName(mem, meter, types.KVGasConfig(), "acc")
+
+	st.Set(keyFmt(1), valFmt(1))
+	require.Equal(t, valFmt(1), st.Get(keyFmt(1)))
+	iterator := st.Iterator(nil, nil)
+	iterator.Next()
+	iterator.Close()
+
+	profile := profiler.Profile()
+	require.Equal(t, []types.GasProfileEntry{{Name: "acc", Gas: meter.GasConsumed()}}, profile.Stores)
+
+	descriptors := make(map[string]types.Gas)
+	for _, e := range profile.Descriptors {
+		descriptors[e.Name] = e.Gas
+	}
+	require.Equal(t, map[string]types.Gas{
+		types.GasWriteCostFlatDesc:    2000,
+		types.GasWritePerByteDesc:     390,
+		types.GasReadCostFlatDesc:     1000,
+		types.GasReadPerByteDesc:      39,
+		types.GasValuePerByteDesc:     78,
+		types.GasIterNextCostFlatDesc: 60,
+	}, descriptors)
+}
@@ -0,0 +1,105 @@
+package iavl
+
+import (
+	"fmt"
+
+	amino "github.com/tendermint/go-amino"
+	"github.com/tendermint/iavl"
+	dbm "github.com/tendermint/tm-db"
+)
+
+var (
+	nodeKeyFormat = iavl.NewKeyFormat('n', 32) // n<hash>
+	rootKeyFormat = iavl.NewKeyFormat('r', 8)  // r<version>
+)
+
+// ExportNodes walks the IAVL tree persisted in db at the given version and
+// calls fn with the raw database key and value of its root entry and of every
+// node reachable from it. Writing these entries verbatim into an empty
+// database reproduces the tree, including its root hash, at that version.
+func ExportNodes(db dbm.DB, version int64, fn func(key, value []byte) error) error {
+	rootKey := rootKeyFormat.Key(version)
+	rootHash := db.Get(rootKey)
+	if rootHash == nil {
+		return fmt.Errorf("version %d does not exist", version)
+	}
+	if err := fn(rootKey, rootHash); err != nil {
+		return err
+	}
+	if len(rootHash) == 0 {
+		// empty tree
+		return nil
+	}
+
+	stack := [][]byte{rootHash}
+	for len(stack) > 0 {
+		hash := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+
+		key := nodeKeyFormat.Key(hash)
+		bz := db.Get(key)
+		if bz == nil {
+			return fmt.Errorf("node %X not found at version %d", hash, version)
+		}
+		if err := fn(key, bz); err != nil {
+			return err
+		}
+
+		left, right, err := decodeChildHashes(bz)
+		if err != nil {
+			return fmt.Errorf("failed to decode node %X: %v", hash, err)
+		}
+		if right != nil {
+			stack = append(stack, right)
+		}
+		if left != nil {
+			stack = append(stack, left)
+		}
+	}
+
+	return nil
+}
+
+// decodeChildHashes decodes the child hashes of a persisted IAVL node, which
+// is encoded as height, size, version, key and then either the value (leaf
+// nodes) or the left and right child hashes (inner nodes).
+func decodeChildHashes(bz []byte) (left, right []byte, err error) {
+	height, n, err := amino.DecodeInt8(bz)
+	if err != nil {
+		return nil, nil, err
+	}
+	bz = bz[n:]
+
+	// size and version
+	for i := 0; i < 2; i++ {
+		_, n, err = amino.DecodeVarint(bz)
+		if err != nil {
+			return nil, nil, err
+		}
+		bz = bz[n:]
+	}
+
+	// key
+	_, n, err = amino.DecodeByteSlice(bz)
+	if err != nil {
+		return nil, nil, err
+	}
+	bz = bz[n:]
+
+	if height == 0 {
+		return nil, nil, nil
+	}
+
+	left, n, err = amino.DecodeByteSlice(bz)
+	if err != nil {
+		return nil, nil, err
+	}
+	bz = bz[n:]
+
+	right, _, err = amino.DecodeByteSlice(bz)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return left, right, nil
+}
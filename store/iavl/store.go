@@ -64,6 +64,15 @@ type Store struct {
 	// By default this value should be set the same across all nodes,
 	// so that nodes can know the waypoints their peers store.
 	storeEvery int64
+
+	// mtx guards the saved versions of the tree, so that immutable versions
+	// can be loaded for queries while new versions are committed.
+	mtx sync.RWMutex
+
+	// readers counts the readers of each acquired version. A pruned version
+	// still being read is only deleted by a later Commit, once it is released.
+	readers        map[int64]int
+	pendingRelease []int64
 }
 
 // CONTRACT: tree should be fully loaded.
@@ -83,11 +92,7 @@ func UnsafeNewStore(tree *iavl.MutableTree, numRecent int64, storeEvery int64) *
 // been pruned, an error will be returned. Any mutable operations executed will
 // result in a panic.
 func (st *Store) GetImmutable(version int64) (*Store, error) {
-	if !st.VersionExists(version) {
-		return nil, iavl.ErrVersionDoesNotExist
-	}
-
-	iTree, err := st.tree.GetImmutable(version)
+	iTree, err := st.getImmutableTree(version)
 	if err != nil {
 		return nil, err
 	}
@@ -99,8 +104,54 @@ func (st *Store) GetImmutable(version int64) (*Store, error) {
 	}, nil
 }
 
+// getImmutableTree returns the immutable IAVL tree at a saved version.
+func (st *Store) getImmutableTree(version int64) (*iavl.ImmutableTree, error) {
+	st.mtx.RLock()
+	defer st.mtx.RUnlock()
+
+	if !st.tree.VersionExists(version) {
+		return nil, iavl.ErrVersionDoesNotExist
+	}
+
+	return st.tree.GetImmutable(version)
+}
+
+// AcquireVersion prevents a saved version from being pruned until the returned
+// release function is called, so it can be read while new versions are
+// committed. It returns an error if the version does not exist or has been
+// pruned.
+func (st *Store) AcquireVersion(version int64) (release func(), err error) {
+	st.mtx.Lock()
+	defer st.mtx.Unlock()
+
+	if !st.tree.VersionExists(version) {
+		return nil, iavl.ErrVersionDoesNotExist
+	}
+
+	if st.readers == nil {
+		st.readers = make(map[int64]int)
+	}
+	st.readers[version]++
+
+	var once sync.Once
+	return func() {
+		once.Do(func() {
+			st.mtx.Lock()
+			defer st.mtx.Unlock()
+
+			st.readers[version]--
+			if st.readers[version] == 0 {
+				delete(st.readers, version)
+			}
+		})
+	}, nil
+}
+
 // Implements Committer.
 func (st *Store) Commit() types.CommitID {
+	st.mtx.Lock()
+	defer st.mtx.Unlock()
+
 	// Save a new version.
 	hash, version, err := st.tree.SaveVersion()
 	if err != nil {
@@ -113,13 +164,25 @@ func (st *Store) Commit() types.CommitID {
 	if st.numRecent < previous {
 		toRelease := previous - st.numRecent
 		if st.storeEvery == 0 || toRelease%st.storeEvery != 0 {
-			err := st.tree.DeleteVersion(toRelease)
-			if errCause := errors.Cause(err); errCause != nil && errCause != iavl.ErrVersionDoesNotExist {
-				panic(err)
-			}
+			st.pendingRelease = append(st.pendingRelease, toRelease)
 		}
 	}
 
+	// Delete the released versions which are not being read.
+	pending := st.pendingRelease[:0]
+	for _, toRelease := range st.pendingRelease {
+		if st.readers[toRelease] > 0 {
+			pending = append(pending, toRelease)
+			continue
+		}
+
+		err := st.tree.DeleteVersion(toRelease)
+		if errCause := errors.Cause(err); errCause != nil && errCause != iavl.ErrVersionDoesNotExist {
+			panic(err)
+		}
+	}
+	st.pendingRelease = pending
+
 	return types.CommitID{
 		Version: version,
 		Hash:    hash,
@@ -142,6 +205,9 @@ func (st *Store) SetPruning(opt types.PruningOptions) {
 
 // VersionExists returns whether or not a given version is stored.
 func (st *Store) VersionExists(version int64) bool {
+	st.mtx.RLock()
+	defer st.mtx.RUnlock()
+
 	return st.tree.VersionExists(version)
 }
 
@@ -231,30 +297,40 @@ func getHeight(tree Tree, req abci.RequestQuery) int64 {
 // If latest-1 is not present, use latest (which must be present)
 // if you care to have the latest data to see a tx results, you must
 // explicitly set the height you want to see
+//
+// Queries are served from an immutable tree at the chosen height, so they may
+// run concurrently with writes to the working tree and with Commit.
 func (st *Store) Query(req abci.RequestQuery) (res abci.ResponseQuery) {
 	if len(req.Data) == 0 {
 		msg := "Query cannot be zero length"
 		return serrors.ErrTxDecode(msg).QueryResult()
 	}
 
-	tree := st.tree
-
 	// store the height we chose in the response, with 0 being changed to the
 	// latest height
-	res.Height = getHeight(tree, req)
+	st.mtx.RLock()
+	res.Height = getHeight(st.tree, req)
+	st.mtx.RUnlock()
+
+	var tree *iavl.ImmutableTree
+	release, err := st.AcquireVersion(res.Height)
+	if err == nil {
+		defer release()
+		tree, err = st.getImmutableTree(res.Height)
+	}
 
 	switch req.Path {
 	case "/key": // get by key
 		key := req.Data // data holds the key bytes
 
 		res.Key = key
-		if !st.VersionExists(res.Height) {
-			res.Log = cmn.ErrorWrap(iavl.ErrVersionDoesNotExist, "").Error()
+		if err != nil {
+			res.Log = cmn.ErrorWrap(err, "").Error()
 			break
 		}
 
 		if req.Prove {
-			value, proof, err := tree.GetVersionedWithProof(key, res.Height)
+			value, proof, err := tree.GetWithProof(key)
 			if err != nil {
 				res.Log = err.Error()
 				break
@@ -275,7 +351,7 @@ func (st *Store) Query(req abci.RequestQuery) (res abci.ResponseQuery) {
 				res.Proof = &merkle.Proof{Ops: []merkle.ProofOp{iavl.NewIAVLAbsenceOp(key, proof).ProofOp()}}
 			}
 		} else {
-			_, res.Value = tree.GetVersioned(key, res.Height)
+			_, res.Value = tree.Get(key)
 		}
 
 	case "/subspace":
@@ -283,8 +359,12 @@ func (st *Store) Query(req abci.RequestQuery) (res abci.ResponseQuery) {
 
 		subspace := req.Data
 		res.Key = subspace
+		if err != nil {
+			res.Log = cmn.ErrorWrap(err, "").Error()
+			break
+		}
 
-		iterator := types.KVStorePrefixIterator(st, subspace)
+		iterator := newIAVLIterator(tree, subspace, types.PrefixEndBytes(subspace), true)
 		for ; iterator.Valid(); iterator.Next() {
 			KVs = append(KVs, types.KVPair{Key: iterator.Key(), Value: iterator.Value()})
 		}
@@ -467,6 +467,37 @@ func TestIAVLPruneEverything(t *testing.T) {
 	}
 }
 
+func TestIAVLAcquireVersion(t *testing.T) {
+	db := dbm.NewMemDB()
+	tree := iavl.NewMutableTree(db, cacheSize)
+	iavlStore := UnsafeNewStore(tree, int64(0), int64(0))
+	nextVersion(iavlStore)
+
+	_, err := iavlStore.AcquireVersion(2)
+	require.Error(t, err)
+
+	release, err := iavlStore.AcquireVersion(1)
+	require.NoError(t, err)
+
+	// require the acquired version to be kept while newer ones are pruned
+	for i := 2; i < 5; i++ {
+		nextVersion(iavlStore)
+		require.True(t, iavlStore.VersionExists(1))
+		require.True(t, iavlStore.VersionExists(int64(i)))
+		if i > 2 {
+			require.False(t, iavlStore.VersionExists(int64(i-1)))
+		}
+	}
+
+	// require the released version to be pruned on the next commit
+	release()
+	release()
+	require.True(t, iavlStore.VersionExists(1))
+	nextVersion(iavlStore)
+	require.False(t, iavlStore.VersionExists(1))
+	require.True(t, iavlStore.VersionExists(5))
+}
+
 func TestIAVLStoreQuery(t *testing.T) {
 	db := dbm.NewMemDB()
 	tree := iavl.NewMutableTree(db, cacheSize)
@@ -515,6 +546,11 @@ func TestIAVLStoreQuery(t *testing.T) {
 	require.Equal(t, uint32(errors.CodeOK), qres.Code)
 	require.Nil(t, qres.Value)
 
+	// nor in the subspace
+	qres = iavlStore.Query(querySub)
+	require.Equal(t, uint32(errors.CodeOK), qres.Code)
+	require.Equal(t, valExpSubEmpty, qres.Value)
+
 	// but yes on the new version
 	query.Height = cid.Version
 	qres = iavlStore.Query(query)
@@ -522,6 +558,7 @@ func TestIAVLStoreQuery(t *testing.T) {
 	require.Equal(t, v1, qres.Value)
 
 	// and for the subspace
+	querySub.Height = cid.Version
 	qres = iavlStore.Query(querySub)
 	require.Equal(t, uint32(errors.CodeOK), qres.Code)
 	require.Equal(t, valExpSub1, qres.Value)
@@ -548,8 +585,19 @@ func TestIAVLStoreQuery(t *testing.T) {
 	// and for the subspace
 	qres = iavlStore.Query(querySub)
 	require.Equal(t, uint32(errors.CodeOK), qres.Code)
+	require.Equal(t, valExpSub1, qres.Value)
+	querySub.Height = cid.Version
+	qres = iavlStore.Query(querySub)
+	require.Equal(t, uint32(errors.CodeOK), qres.Code)
 	require.Equal(t, valExpSub2, qres.Value)
 
+	// unknown heights are reported in the log
+	querySub.Height = cid.Version + 1
+	qres = iavlStore.Query(querySub)
+	require.Equal(t, uint32(errors.CodeOK), qres.Code)
+	require.NotEmpty(t, qres.Log)
+	require.Nil(t, qres.Value)
+
 	// default (height 0) will show latest -1
 	query0 := abci.RequestQuery{Path: "/key", Data: k1}
 	qres = iavlStore.Query(query0)
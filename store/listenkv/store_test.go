@@ -0,0 +1,60 @@
+package listenkv_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/store/dbadapter"
+	"github.com/cosmos/cosmos-sdk/store/listenkv"
+	"github.com/cosmos/cosmos-sdk/store/types"
+)
+
+var testStoreKey = types.NewKVStoreKey("listen_test")
+
+func newListenKVStore() (*listenkv.Store, *types.MemoryListener) {
+	listener := types.NewMemoryListener()
+	memDB := dbadapter.Store{DB: dbm.NewMemDB()}
+
+	return listenkv.NewStore(memDB, testStoreKey, []types.WriteListener{listener}), listener
+}
+
+func TestListenKVStoreWrites(t *testing.T) {
+	store, listener := newListenKVStore()
+
+	store.Set([]byte("key1"), []byte("value1"))
+	store.Set([]byte("key2"), []byte("value2"))
+	store.Delete([]byte("key1"))
+
+	// reads are not observed
+	require.Nil(t, store.Get([]byte("key1")))
+	require.True(t, store.Has([]byte("key2")))
+
+	require.Equal(t, []types.StoreKVPair{
+		{StoreKey: testStoreKey.Name(), Key: []byte("key1"), Value: []byte("value1")},
+		{StoreKey: testStoreKey.Name(), Key: []byte("key2"), Value: []byte("value2")},
+		{StoreKey: testStoreKey.Name(), Delete: true, Key: []byte("key1")},
+	}, listener.PopStateCache())
+	require.Empty(t, listener.PopStateCache())
+}
+
+func TestListenKVStoreCacheWrap(t *testing.T) {
+	store, listener := newListenKVStore()
+
+	cache := store.CacheWrap().(types.KVStore)
+	cache.Set([]byte("key2"), []byte("value2"))
+	cache.Set([]byte("key1"), []byte("value1"))
+	cache.Delete([]byte("key2"))
+
+	// nothing is observed before the cache is written
+	require.Empty(t, listener.PopStateCache())
+
+	cache.(types.CacheWrap).Write()
+	require.Equal(t, []types.StoreKVPair{
+		{StoreKey: testStoreKey.Name(), Key: []byte("key1"), Value: []byte("value1")},
+		{StoreKey: testStoreKey.Name(), Delete: true, Key: []byte("key2")},
+	}, listener.PopStateCache())
+	require.Equal(t, []byte("value1"), store.Get([]byte("key1")))
+}
@@ -0,0 +1,260 @@
+package rootmulti
+
+import (
+	"bufio"
+	"bytes"
+	"compress/zlib"
+	"fmt"
+	"io"
+	"sort"
+
+	"github.com/pkg/errors"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/store/iavl"
+	"github.com/cosmos/cosmos-sdk/store/snapshots"
+	"github.com/cosmos/cosmos-sdk/store/types"
+)
+
+const (
+	// snapshotChunkSize is the maximum size of a snapshot chunk.
+	snapshotChunkSize = uint64(10e6)
+
+	// snapshotMaxItemSize is the maximum size of a single snapshot item,
+	// which bounds the memory used when decoding a malicious snapshot.
+	snapshotMaxItemSize = int64(64e6)
+
+	// snapshotBatchSize is the number of restored entries written per batch.
+	snapshotBatchSize = 10000
+)
+
+var _ snapshots.Snapshotter = (*Store)(nil)
+
+// snapshotItem is a single entry in a snapshot stream. The first item has an
+// empty Store and carries the commitInfo of the snapshot height, all other
+// items are raw IAVL database entries of the named store.
+type snapshotItem struct {
+	Store string
+	Key   []byte
+	Value []byte
+}
+
+// Snapshot implements snapshots.Snapshotter. It exports the database entries
+// of every mounted IAVL store at the given committed height as a zlib
+// compressed stream of length-prefixed items, split into chunks.
+func (rs *Store) Snapshot(height uint64, format uint32) (<-chan io.ReadCloser, error) {
+	if format != snapshots.CurrentFormat {
+		return nil, errors.Wrapf(snapshots.ErrUnknownFormat, "format %v", format)
+	}
+	if height == 0 {
+		return nil, errors.New("cannot snapshot height 0")
+	}
+	if height > uint64(rs.LastCommitID().Version) {
+		return nil, errors.Errorf("cannot snapshot future height %v", height)
+	}
+
+	cInfo, err := getCommitInfo(rs.db, int64(height))
+	if err != nil {
+		return nil, err
+	}
+
+	params, err := rs.snapshotStores()
+	if err != nil {
+		return nil, err
+	}
+
+	ch := make(chan io.ReadCloser)
+	go func() {
+		chunkWriter := snapshots.NewChunkWriter(ch, snapshotChunkSize)
+		bufWriter := bufio.NewWriter(chunkWriter)
+		zWriter := zlib.NewWriter(bufWriter)
+
+		writeItem := func(item snapshotItem) error {
+			bz, err := cdc.MarshalBinaryLengthPrefixed(item)
+			if err != nil {
+				return err
+			}
+			_, err = zWriter.Write(bz)
+			return err
+		}
+
+		err := writeItem(snapshotItem{
+			Key:   []byte(fmt.Sprintf(commitInfoKeyFmt, height)),
+			Value: cdc.MustMarshalBinaryLengthPrefixed(cInfo),
+		})
+		for _, p := range params {
+			if err != nil {
+				break
+			}
+			name := p.key.Name()
+			err = iavl.ExportNodes(rs.storeDB(p), int64(height), func(key, value []byte) error {
+				return writeItem(snapshotItem{Store: name, Key: key, Value: value})
+			})
+			if err != nil {
+				err = errors.Wrapf(err, "failed to export store %q", name)
+			}
+		}
+		if err == nil {
+			err = zWriter.Close()
+		}
+		if err == nil {
+			err = bufWriter.Flush()
+		}
+		if err != nil {
+			chunkWriter.CloseWithError(err)
+			return
+		}
+		chunkWriter.Close()
+	}()
+
+	return ch, nil
+}
+
+// Restore implements snapshots.Snapshotter. It can only be called on a store
+// that has not committed any versions yet. The restored stores are verified
+// against the snapshot's commitInfo before it is persisted as the latest
+// version; verifying the commitInfo hash against the app hash is left to the
+// caller.
+func (rs *Store) Restore(height uint64, format uint32, chunks <-chan io.ReadCloser) error {
+	if format != snapshots.CurrentFormat {
+		return errors.Wrapf(snapshots.ErrUnknownFormat, "format %v", format)
+	}
+	if height == 0 {
+		return errors.Wrap(snapshots.ErrInvalidSnapshot, "cannot restore snapshot at height 0")
+	}
+	if getLatestVersion(rs.db) != 0 {
+		return errors.New("cannot restore snapshot into a non-empty store")
+	}
+
+	params, err := rs.snapshotStores()
+	if err != nil {
+		return err
+	}
+	paramsByName := make(map[string]storeParams, len(params))
+	for _, p := range params {
+		paramsByName[p.key.Name()] = p
+	}
+
+	chunkReader := snapshots.NewChunkReader(chunks)
+	defer chunkReader.Close()
+	zReader, err := zlib.NewReader(chunkReader)
+	if err != nil {
+		return errors.Wrap(err, "zlib failure")
+	}
+	defer zReader.Close()
+
+	// read the commitInfo header
+	var item snapshotItem
+	_, err = cdc.UnmarshalBinaryLengthPrefixedReader(zReader, &item, snapshotMaxItemSize)
+	if err != nil {
+		return errors.Wrap(err, "invalid snapshot header")
+	}
+	if item.Store != "" || !bytes.Equal(item.Key, []byte(fmt.Sprintf(commitInfoKeyFmt, height))) {
+		return errors.Wrap(snapshots.ErrInvalidSnapshot, "missing commit info")
+	}
+	var cInfo commitInfo
+	err = cdc.UnmarshalBinaryLengthPrefixed(item.Value, &cInfo)
+	if err != nil {
+		return errors.Wrap(err, "invalid commit info")
+	}
+	if cInfo.Version != int64(height) {
+		return errors.Wrapf(snapshots.ErrInvalidSnapshot, "commit info has version %v, expected %v", cInfo.Version, height)
+	}
+
+	// write the store entries
+	batches := make(map[string]dbm.Batch)
+	dbs := make(map[string]dbm.DB)
+	written := 0
+	flush := func() {
+		for name, batch := range batches {
+			batch.Write()
+			batch.Close()
+			delete(batches, name)
+		}
+		written = 0
+	}
+	defer func() {
+		for _, batch := range batches {
+			batch.Close()
+		}
+	}()
+
+	for {
+		item = snapshotItem{}
+		_, err = cdc.UnmarshalBinaryLengthPrefixedReader(zReader, &item, snapshotMaxItemSize)
+		if err == io.EOF {
+			break
+		} else if err != nil {
+			return errors.Wrap(err, "invalid snapshot item")
+		}
+
+		p, ok := paramsByName[item.Store]
+		if !ok {
+			return errors.Wrapf(snapshots.ErrInvalidSnapshot, "unknown store %q", item.Store)
+		}
+		if _, ok := dbs[item.Store]; !ok {
+			dbs[item.Store] = rs.storeDB(p)
+		}
+		batch, ok := batches[item.Store]
+		if !ok {
+			batch = dbs[item.Store].NewBatch()
+			batches[item.Store] = batch
+		}
+		batch.Set(item.Key, item.Value)
+
+		written++
+		if written >= snapshotBatchSize {
+			flush()
+		}
+	}
+	flush()
+
+	// verify every restored store against the commit info
+	infos := make(map[string]storeInfo, len(cInfo.StoreInfos))
+	for _, si := range cInfo.StoreInfos {
+		infos[si.Name] = si
+	}
+	for _, p := range params {
+		name := p.key.Name()
+		si, ok := infos[name]
+		if !ok {
+			return errors.Wrapf(snapshots.ErrInvalidSnapshot, "store %q missing from commit info", name)
+		}
+		store, err := iavl.LoadStore(rs.storeDB(p), si.Core.CommitID, rs.pruningOpts, false)
+		if err != nil {
+			return errors.Wrapf(err, "failed to load restored store %q", name)
+		}
+		if !bytes.Equal(store.LastCommitID().Hash, si.Core.CommitID.Hash) {
+			return errors.Wrapf(snapshots.ErrInvalidSnapshot, "restored store %q has hash %X, expected %X",
+				name, store.LastCommitID().Hash, si.Core.CommitID.Hash)
+		}
+	}
+
+	batch := rs.db.NewBatch()
+	defer batch.Close()
+	setCommitInfo(batch, int64(height), cInfo)
+	setLatestVersion(batch, int64(height))
+	batch.Write()
+
+	return rs.LoadLatestVersion()
+}
+
+// snapshotStores returns the params of the stores included in snapshots,
+// sorted by name. Only IAVL and transient stores are supported.
+func (rs *Store) snapshotStores() ([]storeParams, error) {
+	params := make([]storeParams, 0, len(rs.storesParams))
+	for _, p := range rs.storesParams {
+		switch p.typ {
+		case types.StoreTypeIAVL:
+			params = append(params, p)
+		case types.StoreTypeTransient:
+			// not persisted
+		default:
+			return nil, errors.Errorf("snapshots do not support store %q of type %v", p.key.Name(), p.typ)
+		}
+	}
+	sort.Slice(params, func(i, j int) bool {
+		return params[i].key.Name() < params[j].key.Name()
+	})
+	return params, nil
+}
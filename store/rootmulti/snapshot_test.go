@@ -0,0 +1,107 @@
+package rootmulti
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/store/snapshots"
+	"github.com/cosmos/cosmos-sdk/store/types"
+)
+
+func newSnapshotMultiStore(t *testing.T, versions int) *Store {
+	store := newMultiStoreWithMounts(dbm.NewMemDB())
+	require.NoError(t, store.LoadLatestVersion())
+
+	// store3 is left empty
+	for v := 1; v <= versions; v++ {
+		for i := 0; i < 100; i++ {
+			store.getStoreByName("store1").(types.KVStore).Set([]byte(fmt.Sprintf("key%03d", i)), []byte(fmt.Sprintf("value%d-%d", v, i)))
+			store.getStoreByName("store2").(types.KVStore).Set([]byte(fmt.Sprintf("key%03d", v*100+i)), []byte("value"))
+		}
+		store.Commit()
+	}
+	return store
+}
+
+func readChunks(t *testing.T, chunks <-chan io.ReadCloser) [][]byte {
+	bodies := [][]byte{}
+	for chunk := range chunks {
+		bz, err := ioutil.ReadAll(chunk)
+		require.NoError(t, err)
+		require.NoError(t, chunk.Close())
+		bodies = append(bodies, bz)
+	}
+	return bodies
+}
+
+func chunkChannel(bodies [][]byte) <-chan io.ReadCloser {
+	ch := make(chan io.ReadCloser, len(bodies))
+	for _, bz := range bodies {
+		ch <- ioutil.NopCloser(bytes.NewReader(bz))
+	}
+	close(ch)
+	return ch
+}
+
+func TestMultistoreSnapshotRestore(t *testing.T) {
+	source := newSnapshotMultiStore(t, 3)
+	cInfo, err := getCommitInfo(source.db, 2)
+	require.NoError(t, err)
+	expect := cInfo.CommitID()
+
+	chunks, err := source.Snapshot(2, snapshots.CurrentFormat)
+	require.NoError(t, err)
+	bodies := readChunks(t, chunks)
+	require.NotEmpty(t, bodies)
+
+	// snapshots must be deterministic
+	chunks, err = source.Snapshot(2, snapshots.CurrentFormat)
+	require.NoError(t, err)
+	require.Equal(t, bodies, readChunks(t, chunks))
+
+	target := newMultiStoreWithMounts(dbm.NewMemDB())
+	require.NoError(t, target.LoadLatestVersion())
+	require.NoError(t, target.Restore(2, snapshots.CurrentFormat, chunkChannel(bodies)))
+	require.Equal(t, expect, target.LastCommitID())
+
+	store1 := target.getStoreByName("store1").(types.KVStore)
+	require.Equal(t, []byte("value2-42"), store1.Get([]byte("key042")))
+	store2 := target.getStoreByName("store2").(types.KVStore)
+	require.Equal(t, []byte("value"), store2.Get([]byte("key242")))
+	require.Nil(t, store2.Get([]byte("key342")))
+
+	// the restored store can keep committing
+	store1.Set([]byte("key042"), []byte("new"))
+	target.Commit()
+	require.EqualValues(t, 3, target.LastCommitID().Version)
+
+	// restoring into a non-empty store must fail
+	require.Error(t, target.Restore(2, snapshots.CurrentFormat, chunkChannel(bodies)))
+}
+
+func TestMultistoreSnapshotErrors(t *testing.T) {
+	source := newSnapshotMultiStore(t, 2)
+
+	_, err := source.Snapshot(1, 9)
+	require.Error(t, err)
+	_, err = source.Snapshot(0, snapshots.CurrentFormat)
+	require.Error(t, err)
+	_, err = source.Snapshot(3, snapshots.CurrentFormat)
+	require.Error(t, err)
+
+	chunks, err := source.Snapshot(2, snapshots.CurrentFormat)
+	require.NoError(t, err)
+	bodies := readChunks(t, chunks)
+
+	target := newMultiStoreWithMounts(dbm.NewMemDB())
+	require.NoError(t, target.LoadLatestVersion())
+	require.Error(t, target.Restore(2, 9, chunkChannel(bodies)))
+	require.Error(t, target.Restore(1, snapshots.CurrentFormat, chunkChannel(bodies)))
+	require.Error(t, target.Restore(2, snapshots.CurrentFormat, chunkChannel([][]byte{[]byte("garbage")})))
+}
@@ -14,6 +14,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/store/dbadapter"
 	"github.com/cosmos/cosmos-sdk/store/errors"
 	"github.com/cosmos/cosmos-sdk/store/iavl"
+	"github.com/cosmos/cosmos-sdk/store/listenkv"
 	"github.com/cosmos/cosmos-sdk/store/tracekv"
 	"github.com/cosmos/cosmos-sdk/store/transient"
 	"github.com/cosmos/cosmos-sdk/store/types"
@@ -38,6 +39,8 @@ type Store struct {
 
 	traceWriter  io.Writer
 	traceContext types.TraceContext
+
+	listeners map[types.StoreKey][]types.WriteListener
 }
 
 var _ types.CommitMultiStore = (*Store)(nil)
@@ -50,6 +53,7 @@ func NewStore(db dbm.DB) *Store {
 		storesParams: make(map[types.StoreKey]storeParams),
 		stores:       make(map[types.StoreKey]types.CommitStore),
 		keysByName:   make(map[string]types.StoreKey),
+		listeners:    make(map[types.StoreKey][]types.WriteListener),
 	}
 }
 
@@ -248,6 +252,19 @@ func (rs *Store) TracingEnabled() bool {
 	return rs.traceWriter != nil
 }
 
+// AddListeners adds WriteListeners for the KVStore of the given key. They are
+// notified of the writes made directly to the KVStore and of the writes of its
+// cache-wrapped stores once written, i.e. of every write that is committed.
+func (rs *Store) AddListeners(key types.StoreKey, listeners []types.WriteListener) {
+	rs.listeners[key] = append(rs.listeners[key], listeners...)
+}
+
+// ListeningEnabled returns if listening is enabled for the KVStore of the given
+// key.
+func (rs *Store) ListeningEnabled(key types.StoreKey) bool {
+	return len(rs.listeners[key]) > 0
+}
+
 //----------------------------------------
 // +CommitStore
 
@@ -299,7 +316,7 @@ func (rs *Store) CacheMultiStore() types.CacheMultiStore {
 		stores[k] = v
 	}
 
-	return cachemulti.NewStore(rs.db, stores, rs.keysByName, rs.traceWriter, rs.traceContext)
+	return cachemulti.NewStore(rs.db, stores, rs.keysByName, rs.traceWriter, rs.traceContext, rs.listeners)
 }
 
 // CacheMultiStoreWithVersion is analogous to CacheMultiStore except that it
@@ -320,12 +337,46 @@ func (rs *Store) CacheMultiStoreWithVersion(version int64) (types.CacheMultiStor
 
 			cachedStores[key] = iavlStore
 
+		case types.StoreTypeTransient:
+			// Transient stores are empty at every committed version.
+			cachedStores[key] = transient.NewStore()
+
 		default:
-			cachedStores[key] = store
+			// Other stores don't retain their versions, so they can't be read
+			// concurrently with the blocks being committed.
+			return nil, fmt.Errorf("cannot load store %s of type %v at version %d", key.Name(), store.GetStoreType(), version)
+		}
+	}
+
+	return cachemulti.NewStore(rs.db, cachedStores, rs.keysByName, rs.traceWriter, rs.traceContext, nil), nil
+}
+
+// AcquireVersion prevents a saved version of the IAVL stores from being pruned
+// until the returned release function is called, so that the stores returned by
+// CacheMultiStoreWithVersion can be read while new versions are committed.
+func (rs *Store) AcquireVersion(version int64) (release func(), err error) {
+	var releases []func()
+	release = func() {
+		for _, r := range releases {
+			r()
+		}
+	}
+
+	for _, store := range rs.stores {
+		iavlStore, ok := store.(*iavl.Store)
+		if !ok {
+			continue
+		}
+
+		r, err := iavlStore.AcquireVersion(version)
+		if err != nil {
+			release()
+			return nil, err
 		}
+		releases = append(releases, r)
 	}
 
-	return cachemulti.NewStore(rs.db, cachedStores, rs.keysByName, rs.traceWriter, rs.traceContext), nil
+	return release, nil
 }
 
 // Implements MultiStore.
@@ -340,7 +391,8 @@ func (rs *Store) GetStore(key types.StoreKey) types.Store {
 
 // GetKVStore implements the MultiStore interface. If tracing is enabled on the
 // Store, a wrapped TraceKVStore will be returned with the given
-// tracer, otherwise, the original KVStore will be returned.
+// tracer, otherwise, the original KVStore will be returned. If listening is
+// enabled for the key, the store is wrapped so the listeners observe its writes.
 // If the store does not exist, panics.
 func (rs *Store) GetKVStore(key types.StoreKey) types.KVStore {
 	store := rs.stores[key].(types.KVStore)
@@ -349,6 +401,10 @@ func (rs *Store) GetKVStore(key types.StoreKey) types.KVStore {
 		store = tracekv.NewStore(store, rs.traceWriter, rs.traceContext)
 	}
 
+	if rs.ListeningEnabled(key) {
+		store = listenkv.NewStore(store, key, rs.listeners[key])
+	}
+
 	return store
 }
 
@@ -443,14 +499,7 @@ func parsePath(path string) (storeName string, subpath string, err errors.Error)
 //----------------------------------------
 // Note: why do we use key and params.key in different places. Seems like there should be only one key used.
 func (rs *Store) loadCommitStoreFromParams(key types.StoreKey, id types.CommitID, params storeParams) (store types.CommitStore, err error) {
-	var db dbm.DB
-
-	if params.db != nil {
-		db = dbm.NewPrefixDB(params.db, []byte("s/_/"))
-	} else {
-		prefix := "s/k:" + params.key.Name() + "/"
-		db = dbm.NewPrefixDB(rs.db, []byte(prefix))
-	}
+	db := rs.storeDB(params)
 
 	switch params.typ {
 	case types.StoreTypeMulti:
@@ -475,6 +524,16 @@ func (rs *Store) loadCommitStoreFromParams(key types.StoreKey, id types.CommitID
 	}
 }
 
+// storeDB returns the database a substore persists its data in.
+func (rs *Store) storeDB(params storeParams) dbm.DB {
+	if params.db != nil {
+		return dbm.NewPrefixDB(params.db, []byte("s/_/"))
+	}
+
+	prefix := "s/k:" + params.key.Name() + "/"
+	return dbm.NewPrefixDB(rs.db, []byte(prefix))
+}
+
 //----------------------------------------
 // storeParams
 
@@ -72,6 +72,62 @@ func TestCacheMultiStoreWithVersion(t *testing.T) {
 	})
 }
 
+func TestCacheMultiStoreWithVersionNonIAVL(t *testing.T) {
+	db := dbm.NewMemDB()
+	ms := newMultiStoreWithMounts(db)
+	tkey := types.NewTransientStoreKey("transient")
+	ms.MountStoreWithDB(tkey, types.StoreTypeTransient, nil)
+	require.NoError(t, ms.LoadLatestVersion())
+
+	k, v := []byte("wind"), []byte("blows")
+	ms.GetKVStore(tkey).Set(k, v)
+	cID := ms.Commit()
+	ms.GetKVStore(tkey).Set(k, v)
+
+	// require transient stores to be empty at a committed version
+	cms, err := ms.CacheMultiStoreWithVersion(cID.Version)
+	require.NoError(t, err)
+	require.Nil(t, cms.GetKVStore(tkey).Get(k))
+
+	// require failure when a store doesn't retain its versions
+	ms = newMultiStoreWithMounts(dbm.NewMemDB())
+	ms.MountStoreWithDB(types.NewKVStoreKey("db"), types.StoreTypeDB, nil)
+	require.NoError(t, ms.LoadLatestVersion())
+	cID = ms.Commit()
+
+	_, err = ms.CacheMultiStoreWithVersion(cID.Version)
+	require.Error(t, err)
+}
+
+func TestAcquireVersion(t *testing.T) {
+	db := dbm.NewMemDB()
+	ms := newMultiStoreWithMounts(db)
+	ms.pruningOpts = types.PruneEverything
+	require.NoError(t, ms.LoadLatestVersion())
+
+	k, v := []byte("wind"), []byte("blows")
+	ms.getStoreByName("store1").(types.KVStore).Set(k, v)
+	cID := ms.Commit()
+
+	// require an acquired version not to be pruned until it is released
+	release, err := ms.AcquireVersion(cID.Version)
+	require.NoError(t, err)
+	ms.Commit()
+	ms.Commit()
+
+	cms, err := ms.CacheMultiStoreWithVersion(cID.Version)
+	require.NoError(t, err)
+	require.Equal(t, v, cms.GetKVStore(ms.keysByName["store1"]).Get(k))
+
+	release()
+	ms.Commit()
+
+	_, err = ms.CacheMultiStoreWithVersion(cID.Version)
+	require.Error(t, err)
+	_, err = ms.AcquireVersion(cID.Version)
+	require.Error(t, err)
+}
+
 func TestHashStableWithEmptyCommit(t *testing.T) {
 	var db dbm.DB = dbm.NewMemDB()
 	ms := newMultiStoreWithMounts(db)
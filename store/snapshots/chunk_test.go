@@ -0,0 +1,107 @@
+package snapshots
+
+import (
+	"errors"
+	"io"
+	"io/ioutil"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestChunkWriter(t *testing.T) {
+	ch := make(chan io.ReadCloser, 100)
+	go func() {
+		chunkWriter := NewChunkWriter(ch, 2)
+
+		n, err := chunkWriter.Write([]byte{1, 2, 3})
+		require.NoError(t, err)
+		assert.Equal(t, 3, n)
+
+		n, err = chunkWriter.Write([]byte{4, 5, 6})
+		require.NoError(t, err)
+		assert.Equal(t, 3, n)
+
+		n, err = chunkWriter.Write([]byte{7, 8, 9})
+		require.NoError(t, err)
+		assert.Equal(t, 3, n)
+
+		require.NoError(t, chunkWriter.Close())
+
+		// closed writer should error
+		_, err = chunkWriter.Write([]byte{10})
+		require.Error(t, err)
+
+		// closing again should be fine
+		require.NoError(t, chunkWriter.Close())
+	}()
+
+	assert.Equal(t, [][]byte{{1, 2}, {3, 4}, {5, 6}, {7, 8}, {9}}, readChunks(ch))
+
+	// 0-sized chunks should return the whole body as one chunk
+	ch = make(chan io.ReadCloser, 100)
+	go func() {
+		chunkWriter := NewChunkWriter(ch, 0)
+		_, err := chunkWriter.Write([]byte{1, 2, 3})
+		require.NoError(t, err)
+		_, err = chunkWriter.Write([]byte{4, 5, 6})
+		require.NoError(t, err)
+		require.NoError(t, chunkWriter.Close())
+	}()
+	assert.Equal(t, [][]byte{{1, 2, 3, 4, 5, 6}}, readChunks(ch))
+
+	// closing with error should return the error
+	theErr := errors.New("boom")
+	ch = make(chan io.ReadCloser, 100)
+	go func() {
+		chunkWriter := NewChunkWriter(ch, 2)
+		_, err := chunkWriter.Write([]byte{1, 2, 3})
+		require.NoError(t, err)
+		chunkWriter.CloseWithError(theErr)
+	}()
+	chunk, err := ioutil.ReadAll(<-ch)
+	require.NoError(t, err)
+	assert.Equal(t, []byte{1, 2}, chunk)
+	_, err = ioutil.ReadAll(<-ch)
+	require.Error(t, err)
+	assert.Equal(t, theErr, err)
+	assert.Empty(t, ch)
+}
+
+func TestChunkReader(t *testing.T) {
+	ch := makeChunks([][]byte{{1, 2, 3}, {4}, {}, {5, 6}})
+	chunkReader := NewChunkReader(ch)
+
+	buf := []byte{0, 0, 0, 0}
+	n, err := chunkReader.Read(buf)
+	require.NoError(t, err)
+	assert.Equal(t, 3, n)
+	assert.Equal(t, []byte{1, 2, 3, 0}, buf)
+
+	buf = []byte{0, 0, 0, 0}
+	n, err = chunkReader.Read(buf)
+	require.NoError(t, err)
+	assert.Equal(t, 1, n)
+	assert.Equal(t, []byte{4, 0, 0, 0}, buf)
+
+	buf = []byte{0, 0, 0, 0}
+	n, err = chunkReader.Read(buf)
+	require.NoError(t, err)
+	assert.Equal(t, 2, n)
+	assert.Equal(t, []byte{5, 6, 0, 0}, buf)
+
+	_, err = chunkReader.Read(buf)
+	require.Equal(t, io.EOF, err)
+	require.NoError(t, chunkReader.Close())
+
+	// a reader spanning several chunks reads them as one stream
+	body, err := ioutil.ReadAll(NewChunkReader(makeChunks([][]byte{{1, 2}, {3}, {4, 5}})))
+	require.NoError(t, err)
+	assert.Equal(t, []byte{1, 2, 3, 4, 5}, body)
+
+	// closing should drain the remaining chunks
+	ch = makeChunks([][]byte{{1}, {2}, {3}})
+	require.NoError(t, NewChunkReader(ch).Close())
+	assert.Empty(t, readChunks(ch))
+}
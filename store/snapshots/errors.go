@@ -0,0 +1,24 @@
+package snapshots
+
+import (
+	"errors"
+)
+
+var (
+	// ErrUnknownFormat is returned when an unknown format is used.
+	ErrUnknownFormat = errors.New("unknown snapshot format")
+
+	// ErrChunkHashMismatch is returned when chunk hash verification failed.
+	ErrChunkHashMismatch = errors.New("chunk hash verification failed")
+
+	// ErrInvalidSnapshot is returned when a snapshot is malformed or its
+	// restored state does not match the recorded state.
+	ErrInvalidSnapshot = errors.New("invalid snapshot")
+
+	// ErrNotFound is returned when a snapshot does not exist.
+	ErrNotFound = errors.New("snapshot not found")
+
+	// ErrConflict is returned when there is a conflicting snapshot operation
+	// in progress.
+	ErrConflict = errors.New("conflicting snapshot operation in progress")
+)
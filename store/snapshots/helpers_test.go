@@ -0,0 +1,80 @@
+package snapshots
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"io"
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func checksum(b []byte) []byte {
+	hash := sha256.Sum256(b)
+	return hash[:]
+}
+
+func makeChunks(chunks [][]byte) <-chan io.ReadCloser {
+	ch := make(chan io.ReadCloser, len(chunks))
+	for _, chunk := range chunks {
+		ch <- ioutil.NopCloser(bytes.NewReader(chunk))
+	}
+	close(ch)
+	return ch
+}
+
+func readChunks(chunks <-chan io.ReadCloser) [][]byte {
+	bodies := [][]byte{}
+	for chunk := range chunks {
+		body, err := ioutil.ReadAll(chunk)
+		if err != nil {
+			panic(err)
+		}
+		bodies = append(bodies, body)
+	}
+	return bodies
+}
+
+type mockSnapshotter struct {
+	chunks [][]byte
+}
+
+func (m *mockSnapshotter) Restore(height uint64, format uint32, chunks <-chan io.ReadCloser) error {
+	if format != CurrentFormat {
+		return ErrUnknownFormat
+	}
+	if m.chunks != nil {
+		return ErrConflict
+	}
+	m.chunks = readChunks(chunks)
+	return nil
+}
+
+func (m *mockSnapshotter) Snapshot(height uint64, format uint32) (<-chan io.ReadCloser, error) {
+	if format != CurrentFormat {
+		return nil, ErrUnknownFormat
+	}
+	return makeChunks(m.chunks), nil
+}
+
+// setupStore creates a snapshot store with a few snapshots in it, returning a
+// cleanup function as well.
+func setupStore(t *testing.T) (*Store, func()) {
+	dir, err := ioutil.TempDir("", "snapshots")
+	require.NoError(t, err)
+	store, err := NewStore(dir)
+	require.NoError(t, err)
+
+	_, err = store.Save(1, 1, makeChunks([][]byte{{1, 1, 0}, {1, 1, 1}}))
+	require.NoError(t, err)
+	_, err = store.Save(2, 1, makeChunks([][]byte{{2, 1, 0}, {2, 1, 1}}))
+	require.NoError(t, err)
+	_, err = store.Save(2, 2, makeChunks([][]byte{{2, 2, 0}, {2, 2, 1}, {2, 2, 2}}))
+	require.NoError(t, err)
+	_, err = store.Save(3, 2, makeChunks([][]byte{{3, 2, 0}, {3, 2, 1}, {3, 2, 2}}))
+	require.NoError(t, err)
+
+	return store, func() { os.RemoveAll(dir) }
+}
@@ -0,0 +1,191 @@
+package snapshots
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"io"
+	"io/ioutil"
+	"sync"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	opNone     operation = ""
+	opSnapshot operation = "snapshot"
+	opPrune    operation = "prune"
+	opRestore  operation = "restore"
+)
+
+// operation represents a Manager operation. Only one operation can be in progress at a time.
+type operation string
+
+// Manager manages snapshot and restore operations for an app, making sure only
+// a single long-running operation is in progress at any given time, and
+// verifies snapshot chunks against the snapshot metadata before applying them.
+type Manager struct {
+	store  *Store
+	target Snapshotter
+
+	mtx       sync.Mutex
+	operation operation
+}
+
+// NewManager creates a new manager.
+func NewManager(store *Store, target Snapshotter) *Manager {
+	return &Manager{
+		store:  store,
+		target: target,
+	}
+}
+
+// begin starts an operation, or errors if one is in progress.
+func (m *Manager) begin(op operation) error {
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+	if op == opNone {
+		return errors.New("can't begin a none operation")
+	}
+	if m.operation != opNone {
+		return errors.Wrapf(ErrConflict, "a %v operation is in progress", m.operation)
+	}
+	m.operation = op
+	return nil
+}
+
+// end ends the current operation.
+func (m *Manager) end() {
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+	m.operation = opNone
+}
+
+// Create creates a snapshot and returns its metadata.
+func (m *Manager) Create(height uint64) (*Snapshot, error) {
+	err := m.begin(opSnapshot)
+	if err != nil {
+		return nil, err
+	}
+	defer m.end()
+
+	latest, err := m.store.GetLatest()
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to examine latest snapshot")
+	}
+	if latest != nil && latest.Height >= height {
+		return nil, errors.Wrapf(ErrConflict,
+			"a more recent snapshot already exists at height %v", latest.Height)
+	}
+
+	chunks, err := m.target.Snapshot(height, CurrentFormat)
+	if err != nil {
+		return nil, err
+	}
+	return m.store.Save(height, CurrentFormat, chunks)
+}
+
+// Get fetches snapshot info from the store, or nil if it does not exist.
+func (m *Manager) Get(height uint64, format uint32) (*Snapshot, error) {
+	return m.store.Get(height, format)
+}
+
+// List lists snapshots, newest first.
+func (m *Manager) List() ([]*Snapshot, error) {
+	return m.store.List()
+}
+
+// LoadChunk loads a chunk into a byte slice, mirroring ABCI LoadChunk. It can
+// be called concurrently with other operations. If the chunk does not exist,
+// nil is returned.
+func (m *Manager) LoadChunk(height uint64, format uint32, chunk uint32) ([]byte, error) {
+	reader, err := m.store.LoadChunk(height, format, chunk)
+	if err != nil {
+		return nil, err
+	}
+	if reader == nil {
+		return nil, nil
+	}
+	defer reader.Close()
+
+	return ioutil.ReadAll(reader)
+}
+
+// Prune prunes snapshots, if no other operations are in progress.
+func (m *Manager) Prune(retain uint32) (uint64, error) {
+	err := m.begin(opPrune)
+	if err != nil {
+		return 0, err
+	}
+	defer m.end()
+	return m.store.Prune(retain)
+}
+
+// Restore restores a snapshot from the given chunks, which are verified
+// against the chunk hashes in the snapshot metadata before being passed on
+// to the target. All chunks are consumed and closed.
+func (m *Manager) Restore(snapshot Snapshot, chunks <-chan io.ReadCloser) error {
+	defer DrainChunks(chunks)
+	if snapshot.Chunks == 0 {
+		return errors.Wrap(ErrInvalidSnapshot, "no chunks")
+	}
+	if uint32(len(snapshot.Metadata.ChunkHashes)) != snapshot.Chunks {
+		return errors.Wrapf(ErrInvalidSnapshot, "snapshot has %v chunk hashes, but %v chunks",
+			uint32(len(snapshot.Metadata.ChunkHashes)), snapshot.Chunks)
+	}
+
+	err := m.begin(opRestore)
+	if err != nil {
+		return err
+	}
+	defer m.end()
+
+	verified := make(chan io.ReadCloser)
+	verifyErr := make(chan error, 1)
+	go func() {
+		defer close(verified)
+		index := uint32(0)
+		for chunk := range chunks {
+			bz, err := ioutil.ReadAll(chunk)
+			_ = chunk.Close()
+			if err != nil {
+				verifyErr <- errors.Wrapf(err, "failed to read chunk %v", index)
+				return
+			}
+			if index >= snapshot.Chunks {
+				verifyErr <- errors.Wrapf(ErrInvalidSnapshot, "received more than %v chunks", snapshot.Chunks)
+				return
+			}
+			hash := sha256.Sum256(bz)
+			if !bytes.Equal(hash[:], snapshot.Metadata.ChunkHashes[index]) {
+				verifyErr <- errors.Wrapf(ErrChunkHashMismatch, "chunk %v", index)
+				return
+			}
+			verified <- ioutil.NopCloser(bytes.NewReader(bz))
+			index++
+		}
+		if index != snapshot.Chunks {
+			verifyErr <- errors.Wrapf(ErrInvalidSnapshot, "received %v chunks, expected %v", index, snapshot.Chunks)
+		}
+	}()
+
+	err = m.target.Restore(snapshot.Height, snapshot.Format, verified)
+	DrainChunks(verified)
+	select {
+	case e := <-verifyErr:
+		return e
+	default:
+	}
+	return err
+}
+
+// RestoreLocal restores a snapshot that exists in the local snapshot store.
+func (m *Manager) RestoreLocal(height uint64, format uint32) error {
+	snapshot, chunks, err := m.store.Load(height, format)
+	if err != nil {
+		return err
+	}
+	if snapshot == nil {
+		return errors.Wrapf(ErrNotFound, "height %v format %v", height, format)
+	}
+	return m.Restore(*snapshot, chunks)
+}
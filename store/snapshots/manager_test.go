@@ -0,0 +1,107 @@
+package snapshots
+
+import (
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestManager_Create(t *testing.T) {
+	store, teardown := setupStore(t)
+	defer teardown()
+	target := &mockSnapshotter{chunks: [][]byte{{1, 2, 3}, {4, 5, 6}}}
+	manager := NewManager(store, target)
+
+	// creating a snapshot at an older height should fail
+	_, err := manager.Create(2)
+	require.Error(t, err)
+
+	snapshot, err := manager.Create(5)
+	require.NoError(t, err)
+	assert.Equal(t, &Snapshot{
+		Height: 5,
+		Format: CurrentFormat,
+		Chunks: 2,
+		Hash:   checksum([]byte{1, 2, 3, 4, 5, 6}),
+		Metadata: Metadata{
+			ChunkHashes: [][]byte{checksum([]byte{1, 2, 3}), checksum([]byte{4, 5, 6})},
+		},
+	}, snapshot)
+
+	chunk, err := manager.LoadChunk(5, CurrentFormat, 1)
+	require.NoError(t, err)
+	assert.Equal(t, []byte{4, 5, 6}, chunk)
+
+	chunk, err = manager.LoadChunk(5, CurrentFormat, 2)
+	require.NoError(t, err)
+	assert.Nil(t, chunk)
+}
+
+func TestManager_Prune(t *testing.T) {
+	store, teardown := setupStore(t)
+	defer teardown()
+	manager := NewManager(store, &mockSnapshotter{})
+
+	pruned, err := manager.Prune(2)
+	require.NoError(t, err)
+	assert.EqualValues(t, 1, pruned)
+
+	list, err := manager.List()
+	require.NoError(t, err)
+	assert.Len(t, list, 3)
+
+	// prune should error while a restore is in progress
+	require.NoError(t, manager.begin(opRestore))
+	_, err = manager.Prune(1)
+	require.Error(t, err)
+	manager.end()
+}
+
+func TestManager_Restore(t *testing.T) {
+	store, teardown := setupStore(t)
+	defer teardown()
+	target := &mockSnapshotter{}
+	manager := NewManager(store, target)
+
+	chunks := [][]byte{{1, 2, 3}, {4, 5, 6}}
+	snapshot := Snapshot{
+		Height: 3,
+		Format: CurrentFormat,
+		Chunks: 2,
+		Metadata: Metadata{
+			ChunkHashes: [][]byte{checksum(chunks[0]), checksum(chunks[1])},
+		},
+	}
+
+	// tampered chunks should fail hash verification
+	err := manager.Restore(snapshot, makeChunks([][]byte{{1, 2, 3}, {9, 9, 9}}))
+	require.Error(t, err)
+	assert.Equal(t, ErrChunkHashMismatch, errors.Cause(err))
+	target.chunks = nil
+
+	// missing chunks should fail
+	err = manager.Restore(snapshot, makeChunks(chunks[:1]))
+	require.Error(t, err)
+	target.chunks = nil
+
+	// a snapshot with mismatched metadata should fail
+	invalid := snapshot
+	invalid.Chunks = 3
+	require.Error(t, manager.Restore(invalid, makeChunks(chunks)))
+
+	// an unknown format should fail
+	invalid = snapshot
+	invalid.Format = 9
+	require.Error(t, manager.Restore(invalid, makeChunks(chunks)))
+
+	require.NoError(t, manager.Restore(snapshot, makeChunks(chunks)))
+	assert.Equal(t, chunks, target.chunks)
+
+	// restoring a local snapshot should work, and unknown ones should fail
+	target.chunks = nil
+	require.NoError(t, manager.RestoreLocal(2, 1))
+	assert.Equal(t, [][]byte{{2, 1, 0}, {2, 1, 1}}, target.chunks)
+	require.Error(t, manager.RestoreLocal(9, 9))
+}
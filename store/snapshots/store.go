@@ -0,0 +1,349 @@
+package snapshots
+
+import (
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"math"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"sync"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	// metadataFile is the name of the file holding a snapshot's metadata.
+	// It is written last, so a snapshot without it is incomplete.
+	metadataFile = "metadata.json"
+)
+
+// Store is a snapshot store, containing snapshot metadata and binary chunks.
+// Snapshots are laid out on the filesystem as <dir>/<height>/<format>/<chunk>.
+type Store struct {
+	dir string
+
+	mtx    sync.Mutex
+	saving map[uint64]bool // heights currently being saved
+}
+
+// NewStore creates a new snapshot store in the given directory.
+func NewStore(dir string) (*Store, error) {
+	if dir == "" {
+		return nil, errors.New("snapshot directory not given")
+	}
+	err := os.MkdirAll(dir, 0755)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to create snapshot directory %q", dir)
+	}
+
+	return &Store{
+		dir:    dir,
+		saving: make(map[uint64]bool),
+	}, nil
+}
+
+// Delete deletes a snapshot.
+func (s *Store) Delete(height uint64, format uint32) error {
+	s.mtx.Lock()
+	saving := s.saving[height]
+	s.mtx.Unlock()
+	if saving {
+		return errors.Wrapf(ErrConflict, "snapshot for height %v format %v is currently being saved", height, format)
+	}
+
+	err := os.RemoveAll(s.pathSnapshot(height, format))
+	if err != nil {
+		return errors.Wrapf(err, "failed to delete snapshot for height %v format %v", height, format)
+	}
+
+	// remove the height directory as well once its last format is gone
+	entries, err := ioutil.ReadDir(s.pathHeight(height))
+	if err == nil && len(entries) == 0 {
+		_ = os.Remove(s.pathHeight(height))
+	}
+
+	return nil
+}
+
+// Get fetches snapshot info from the store. It returns nil if the snapshot
+// does not exist.
+func (s *Store) Get(height uint64, format uint32) (*Snapshot, error) {
+	bz, err := ioutil.ReadFile(filepath.Join(s.pathSnapshot(height, format), metadataFile))
+	if os.IsNotExist(err) {
+		return nil, nil
+	} else if err != nil {
+		return nil, errors.Wrapf(err, "failed to fetch snapshot metadata for height %v format %v", height, format)
+	}
+
+	snapshot := &Snapshot{}
+	err = json.Unmarshal(bz, snapshot)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to decode snapshot metadata for height %v format %v", height, format)
+	}
+	if snapshot.Metadata.ChunkHashes == nil {
+		snapshot.Metadata.ChunkHashes = [][]byte{}
+	}
+
+	return snapshot, nil
+}
+
+// GetLatest fetches the latest snapshot from the store, if any.
+func (s *Store) GetLatest() (*Snapshot, error) {
+	snapshots, err := s.List()
+	if err != nil {
+		return nil, err
+	}
+	if len(snapshots) == 0 {
+		return nil, nil
+	}
+
+	return snapshots[0], nil
+}
+
+// List lists snapshots, in reverse order (newest first).
+func (s *Store) List() ([]*Snapshot, error) {
+	heightDirs, err := ioutil.ReadDir(s.dir)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to list snapshots")
+	}
+
+	snapshots := make([]*Snapshot, 0)
+	for _, heightDir := range heightDirs {
+		height, err := strconv.ParseUint(heightDir.Name(), 10, 64)
+		if err != nil || !heightDir.IsDir() {
+			continue
+		}
+
+		formatDirs, err := ioutil.ReadDir(s.pathHeight(height))
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to list snapshots")
+		}
+
+		for _, formatDir := range formatDirs {
+			format, err := strconv.ParseUint(formatDir.Name(), 10, 32)
+			if err != nil || !formatDir.IsDir() {
+				continue
+			}
+
+			snapshot, err := s.Get(height, uint32(format))
+			if err != nil {
+				return nil, err
+			}
+			if snapshot != nil {
+				snapshots = append(snapshots, snapshot)
+			}
+		}
+	}
+
+	sort.Slice(snapshots, func(i, j int) bool {
+		if snapshots[i].Height == snapshots[j].Height {
+			return snapshots[i].Format > snapshots[j].Format
+		}
+		return snapshots[i].Height > snapshots[j].Height
+	})
+
+	return snapshots, nil
+}
+
+// Load loads a snapshot (both metadata and binary chunks). The chunks must be
+// consumed and closed. Returns nil if the snapshot does not exist.
+func (s *Store) Load(height uint64, format uint32) (*Snapshot, <-chan io.ReadCloser, error) {
+	snapshot, err := s.Get(height, format)
+	if err != nil || snapshot == nil {
+		return nil, nil, err
+	}
+
+	ch := make(chan io.ReadCloser)
+	go func() {
+		defer close(ch)
+		for i := uint32(0); i < snapshot.Chunks; i++ {
+			pr, pw := io.Pipe()
+			ch <- pr
+			chunk, err := s.loadChunkFile(height, format, i)
+			if err != nil {
+				pw.CloseWithError(err)
+				return
+			}
+			_, err = io.Copy(pw, chunk)
+			if err != nil {
+				_ = chunk.Close()
+				pw.CloseWithError(err)
+				return
+			}
+			pw.CloseWithError(chunk.Close())
+		}
+	}()
+
+	return snapshot, ch, nil
+}
+
+// LoadChunk loads a chunk from disk, or returns nil if it does not exist. The
+// caller must call Close() on it when done.
+func (s *Store) LoadChunk(height uint64, format uint32, chunk uint32) (io.ReadCloser, error) {
+	path := s.pathChunk(height, format, chunk)
+	file, err := os.Open(path)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	return file, err
+}
+
+// loadChunkFile loads a chunk from disk, and errors if it does not exist.
+func (s *Store) loadChunkFile(height uint64, format uint32, chunk uint32) (io.ReadCloser, error) {
+	path := s.pathChunk(height, format, chunk)
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to open chunk %v of snapshot for height %v format %v", chunk, height, format)
+	}
+	return file, nil
+}
+
+// Prune removes old snapshots. The given number of most recent heights
+// (regardless of format) are retained.
+func (s *Store) Prune(retain uint32) (uint64, error) {
+	snapshots, err := s.List()
+	if err != nil {
+		return 0, err
+	}
+
+	pruned := uint64(0)
+	skip := make(map[uint64]bool)
+	for _, snapshot := range snapshots {
+		switch {
+		case skip[snapshot.Height]:
+			// skip this height, it was already retained
+		case len(skip) < int(retain):
+			skip[snapshot.Height] = true
+		default:
+			err = s.Delete(snapshot.Height, snapshot.Format)
+			if err != nil {
+				return 0, errors.Wrap(err, "failed to prune snapshots")
+			}
+			pruned++
+		}
+	}
+
+	return pruned, nil
+}
+
+// Save saves a snapshot to disk, returning it.
+func (s *Store) Save(height uint64, format uint32, chunks <-chan io.ReadCloser) (*Snapshot, error) {
+	defer DrainChunks(chunks)
+	if height == 0 {
+		return nil, errors.New("snapshot height cannot be 0")
+	}
+
+	s.mtx.Lock()
+	saving := s.saving[height]
+	s.saving[height] = true
+	s.mtx.Unlock()
+
+	if saving {
+		return nil, errors.Wrapf(ErrConflict, "a snapshot for height %v is already being saved", height)
+	}
+	defer func() {
+		s.mtx.Lock()
+		delete(s.saving, height)
+		s.mtx.Unlock()
+	}()
+
+	existing, err := s.Get(height, format)
+	if err != nil {
+		return nil, err
+	}
+	if existing != nil {
+		return nil, errors.Wrapf(ErrConflict, "snapshot already exists for height %v format %v", height, format)
+	}
+
+	// remove any leftovers of a previous incomplete snapshot
+	err = os.RemoveAll(s.pathSnapshot(height, format))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to remove incomplete snapshot")
+	}
+	err = os.MkdirAll(s.pathSnapshot(height, format), 0755)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create snapshot directory")
+	}
+
+	snapshot := &Snapshot{
+		Height:   height,
+		Format:   format,
+		Metadata: Metadata{ChunkHashes: [][]byte{}},
+	}
+	snapshotHasher := sha256.New()
+	index := uint32(0)
+	for chunkBody := range chunks {
+		if uint64(index) >= math.MaxUint32 {
+			_ = chunkBody.Close()
+			return nil, errors.New("snapshot has too many chunks")
+		}
+
+		file, err := os.Create(s.pathChunk(height, format, index))
+		if err != nil {
+			_ = chunkBody.Close()
+			return nil, errors.Wrapf(err, "failed to create snapshot chunk file")
+		}
+
+		chunkHasher := sha256.New()
+		_, err = io.Copy(io.MultiWriter(file, chunkHasher, snapshotHasher), chunkBody)
+		if err != nil {
+			_ = file.Close()
+			_ = chunkBody.Close()
+			return nil, errors.Wrapf(err, "failed to generate snapshot chunk %v", index)
+		}
+
+		err = file.Close()
+		if err != nil {
+			_ = chunkBody.Close()
+			return nil, errors.Wrapf(err, "failed to close snapshot chunk %v", index)
+		}
+
+		err = chunkBody.Close()
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to close snapshot chunk %v", index)
+		}
+
+		snapshot.Metadata.ChunkHashes = append(snapshot.Metadata.ChunkHashes, chunkHasher.Sum(nil))
+		index++
+	}
+
+	snapshot.Chunks = index
+	snapshot.Hash = snapshotHasher.Sum(nil)
+
+	bz, err := json.Marshal(snapshot)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to encode snapshot metadata")
+	}
+
+	err = ioutil.WriteFile(filepath.Join(s.pathSnapshot(height, format), metadataFile), bz, 0644)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to store snapshot metadata")
+	}
+
+	return snapshot, nil
+}
+
+// pathHeight generates the path to a height, containing multiple snapshot formats.
+func (s *Store) pathHeight(height uint64) string {
+	return filepath.Join(s.dir, strconv.FormatUint(height, 10))
+}
+
+// pathSnapshot generates a snapshot path, as a specific format under a height.
+func (s *Store) pathSnapshot(height uint64, format uint32) string {
+	return filepath.Join(s.pathHeight(height), strconv.FormatUint(uint64(format), 10))
+}
+
+// pathChunk generates a snapshot chunk path.
+func (s *Store) pathChunk(height uint64, format uint32, chunk uint32) string {
+	return filepath.Join(s.pathSnapshot(height, format), strconv.FormatUint(uint64(chunk), 10))
+}
+
+// String implements the Stringer interface.
+func (s *Store) String() string {
+	return fmt.Sprintf("snapshot store at %s", s.dir)
+}
@@ -0,0 +1,183 @@
+package snapshots
+
+import (
+	"errors"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewStore(t *testing.T) {
+	dir, err := ioutil.TempDir("", "snapshots")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	_, err = NewStore(dir)
+	require.NoError(t, err)
+
+	_, err = NewStore("")
+	require.Error(t, err)
+}
+
+func TestStore_Get(t *testing.T) {
+	store, teardown := setupStore(t)
+	defer teardown()
+
+	// Loading a missing snapshot should return nil
+	snapshot, err := store.Get(9, 9)
+	require.NoError(t, err)
+	assert.Nil(t, snapshot)
+
+	// Loading a snapshot should returns its metadata
+	snapshot, err = store.Get(2, 1)
+	require.NoError(t, err)
+	assert.Equal(t, &Snapshot{
+		Height: 2,
+		Format: 1,
+		Chunks: 2,
+		Hash:   checksum([]byte{2, 1, 0, 2, 1, 1}),
+		Metadata: Metadata{
+			ChunkHashes: [][]byte{
+				checksum([]byte{2, 1, 0}),
+				checksum([]byte{2, 1, 1}),
+			},
+		},
+	}, snapshot)
+}
+
+func TestStore_GetLatest(t *testing.T) {
+	store, teardown := setupStore(t)
+	defer teardown()
+
+	snapshot, err := store.GetLatest()
+	require.NoError(t, err)
+	require.NotNil(t, snapshot)
+	assert.EqualValues(t, 3, snapshot.Height)
+	assert.EqualValues(t, 2, snapshot.Format)
+}
+
+func TestStore_List(t *testing.T) {
+	store, teardown := setupStore(t)
+	defer teardown()
+
+	snapshots, err := store.List()
+	require.NoError(t, err)
+
+	heights := [][2]uint64{}
+	for _, s := range snapshots {
+		heights = append(heights, [2]uint64{s.Height, uint64(s.Format)})
+	}
+	assert.Equal(t, [][2]uint64{{3, 2}, {2, 2}, {2, 1}, {1, 1}}, heights)
+}
+
+func TestStore_Delete(t *testing.T) {
+	store, teardown := setupStore(t)
+	defer teardown()
+
+	require.NoError(t, store.Delete(2, 2))
+	snapshot, err := store.Get(2, 2)
+	require.NoError(t, err)
+	assert.Nil(t, snapshot)
+
+	// Deleting a missing snapshot is a noop
+	require.NoError(t, store.Delete(9, 9))
+
+	snapshots, err := store.List()
+	require.NoError(t, err)
+	assert.Len(t, snapshots, 3)
+}
+
+func TestStore_Load(t *testing.T) {
+	store, teardown := setupStore(t)
+	defer teardown()
+
+	// Loading a missing snapshot should return nil
+	snapshot, chunks, err := store.Load(9, 9)
+	require.NoError(t, err)
+	assert.Nil(t, snapshot)
+	assert.Nil(t, chunks)
+
+	snapshot, chunks, err = store.Load(2, 2)
+	require.NoError(t, err)
+	assert.EqualValues(t, 3, snapshot.Chunks)
+	assert.Equal(t, [][]byte{{2, 2, 0}, {2, 2, 1}, {2, 2, 2}}, readChunks(chunks))
+}
+
+func TestStore_LoadChunk(t *testing.T) {
+	store, teardown := setupStore(t)
+	defer teardown()
+
+	chunk, err := store.LoadChunk(9, 9, 0)
+	require.NoError(t, err)
+	assert.Nil(t, chunk)
+
+	chunk, err = store.LoadChunk(2, 2, 1)
+	require.NoError(t, err)
+	body, err := ioutil.ReadAll(chunk)
+	require.NoError(t, err)
+	require.NoError(t, chunk.Close())
+	assert.Equal(t, []byte{2, 2, 1}, body)
+}
+
+func TestStore_Prune(t *testing.T) {
+	store, teardown := setupStore(t)
+	defer teardown()
+
+	// Pruning too many snapshots should be a noop
+	pruned, err := store.Prune(4)
+	require.NoError(t, err)
+	assert.EqualValues(t, 0, pruned)
+
+	// Retaining two heights removes both formats at the oldest height
+	pruned, err = store.Prune(2)
+	require.NoError(t, err)
+	assert.EqualValues(t, 1, pruned)
+
+	pruned, err = store.Prune(1)
+	require.NoError(t, err)
+	assert.EqualValues(t, 2, pruned)
+
+	snapshots, err := store.List()
+	require.NoError(t, err)
+	require.Len(t, snapshots, 1)
+	assert.EqualValues(t, 3, snapshots[0].Height)
+}
+
+func TestStore_Save(t *testing.T) {
+	store, teardown := setupStore(t)
+	defer teardown()
+
+	// Saving a snapshot should work
+	snapshot, err := store.Save(4, 1, makeChunks([][]byte{{1}, {2}}))
+	require.NoError(t, err)
+	assert.Equal(t, checksum([]byte{1, 2}), snapshot.Hash)
+
+	// Saving a snapshot at height 0 or an existing snapshot should error
+	_, err = store.Save(0, 1, makeChunks(nil))
+	require.Error(t, err)
+	_, err = store.Save(4, 1, makeChunks(nil))
+	require.Error(t, err)
+
+	// A failing chunk should abort the save and leave no metadata behind
+	ch := make(chan io.ReadCloser, 2)
+	ch <- ioutil.NopCloser(&failingReader{})
+	close(ch)
+	_, err = store.Save(5, 1, ch)
+	require.Error(t, err)
+	snapshot, err = store.Get(5, 1)
+	require.NoError(t, err)
+	assert.Nil(t, snapshot)
+	_, err = os.Stat(filepath.Join(store.pathSnapshot(5, 1), metadataFile))
+	assert.True(t, os.IsNotExist(err))
+}
+
+type failingReader struct{}
+
+func (failingReader) Read([]byte) (int, error) {
+	return 0, errors.New("failure")
+}
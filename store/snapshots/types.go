@@ -0,0 +1,44 @@
+package snapshots
+
+import (
+	"fmt"
+	"io"
+)
+
+// CurrentFormat is the currently used format for snapshots. Snapshots using the same format
+// must be identical across all nodes for a given height, so this must be bumped when the binary
+// snapshot output changes.
+const CurrentFormat uint32 = 1
+
+// Snapshot contains metadata about a state snapshot.
+type Snapshot struct {
+	Height   uint64   `json:"height" yaml:"height"`
+	Format   uint32   `json:"format" yaml:"format"`
+	Chunks   uint32   `json:"chunks" yaml:"chunks"`
+	Hash     []byte   `json:"hash" yaml:"hash"`
+	Metadata Metadata `json:"metadata" yaml:"metadata"`
+}
+
+// Metadata contains the snapshot chunk hashes, used to verify each chunk
+// before it is applied.
+type Metadata struct {
+	ChunkHashes [][]byte `json:"chunk_hashes" yaml:"chunk_hashes"`
+}
+
+// String implements the Stringer interface.
+func (s Snapshot) String() string {
+	return fmt.Sprintf("height: %d format: %d chunks: %d hash: %X", s.Height, s.Format, s.Chunks, s.Hash)
+}
+
+// Snapshotter is something that can create and restore snapshots, consisting
+// of streamed binary chunks - all of which must be read from the channel and
+// closed. If an unsupported format is given, it must return ErrUnknownFormat.
+type Snapshotter interface {
+	// Snapshot creates a state snapshot, returning a channel of snapshot
+	// chunk readers.
+	Snapshot(height uint64, format uint32) (<-chan io.ReadCloser, error)
+
+	// Restore restores a state snapshot, taking snapshot chunk readers as
+	// input. It must verify the restored state against the snapshot.
+	Restore(height uint64, format uint32, chunks <-chan io.ReadCloser) error
+}
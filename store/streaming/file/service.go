@@ -0,0 +1,142 @@
+package file
+
+import (
+	"bytes"
+	"encoding/json"
+	"os"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/cosmos/cosmos-sdk/store/types"
+)
+
+// Record types of the streamed file.
+const (
+	RecordBeginBlock = "begin_block"
+	RecordDeliverTx  = "deliver_tx"
+	RecordEndBlock   = "end_block"
+	RecordWrite      = "write"
+	RecordCommit     = "commit"
+)
+
+// Record is a single JSON line of the streamed file. Marker records hold the
+// ABCI request and response, write records hold the store write.
+type Record struct {
+	Height   int64              `json:"height"`
+	Type     string             `json:"type"`
+	Request  json.RawMessage    `json:"request,omitempty"`
+	Response json.RawMessage    `json:"response,omitempty"`
+	Write    *types.StoreKVPair `json:"write,omitempty"`
+}
+
+// StreamingService appends the blocks processed by the application to a file
+// as JSON lines. Every block is made of its begin_block, deliver_tx and
+// end_block markers, followed by a write record per committed write in the
+// order they were made and a final commit record. A block is only appended
+// once it is committed, so a reader tailing the file never sees a partial
+// block.
+type StreamingService struct {
+	file      *os.File
+	storeKeys []types.StoreKey
+	listener  *types.MemoryListener
+
+	height int64
+	block  bytes.Buffer
+}
+
+// NewStreamingService returns a StreamingService appending to the file at the
+// given path, which is created if needed. The writes to the stores of the
+// given keys are streamed.
+func NewStreamingService(path string, storeKeys []types.StoreKey) (*StreamingService, error) {
+	file, err := os.OpenFile(path, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0600)
+	if err != nil {
+		return nil, err
+	}
+
+	return &StreamingService{
+		file:      file,
+		storeKeys: storeKeys,
+		listener:  types.NewMemoryListener(),
+	}, nil
+}
+
+// Listeners returns the WriteListeners to register for each streamed store.
+func (s *StreamingService) Listeners() map[types.StoreKey][]types.WriteListener {
+	listeners := make(map[types.StoreKey][]types.WriteListener, len(s.storeKeys))
+	for _, key := range s.storeKeys {
+		listeners[key] = []types.WriteListener{s.listener}
+	}
+	return listeners
+}
+
+// ListenBeginBlock starts a new block.
+func (s *StreamingService) ListenBeginBlock(req abci.RequestBeginBlock, res abci.ResponseBeginBlock) error {
+	s.height = req.Header.Height
+	return s.addMarker(RecordBeginBlock, req, res)
+}
+
+// ListenDeliverTx adds a deliver_tx marker to the block.
+func (s *StreamingService) ListenDeliverTx(req abci.RequestDeliverTx, res abci.ResponseDeliverTx) error {
+	return s.addMarker(RecordDeliverTx, req, res)
+}
+
+// ListenEndBlock adds an end_block marker to the block.
+func (s *StreamingService) ListenEndBlock(req abci.RequestEndBlock, res abci.ResponseEndBlock) error {
+	return s.addMarker(RecordEndBlock, req, res)
+}
+
+// ListenCommit adds the writes of the block and the commit record, and appends
+// the block to the file.
+func (s *StreamingService) ListenCommit(res abci.ResponseCommit) error {
+	defer s.block.Reset()
+
+	for _, write := range s.listener.PopStateCache() {
+		write := write
+		if err := s.addRecord(Record{Height: s.height, Type: RecordWrite, Write: &write}); err != nil {
+			return err
+		}
+	}
+
+	if err := s.addMarker(RecordCommit, nil, res); err != nil {
+		return err
+	}
+
+	_, err := s.file.Write(s.block.Bytes())
+	return err
+}
+
+// Close closes the streamed file.
+func (s *StreamingService) Close() error {
+	return s.file.Close()
+}
+
+func (s *StreamingService) addMarker(typ string, req, res interface{}) error {
+	record := Record{Height: s.height, Type: typ}
+
+	if req != nil {
+		bz, err := json.Marshal(req)
+		if err != nil {
+			return err
+		}
+		record.Request = bz
+	}
+
+	bz, err := json.Marshal(res)
+	if err != nil {
+		return err
+	}
+	record.Response = bz
+
+	return s.addRecord(record)
+}
+
+func (s *StreamingService) addRecord(record Record) error {
+	bz, err := json.Marshal(record)
+	if err != nil {
+		return err
+	}
+
+	s.block.Write(bz)
+	s.block.WriteByte('\n')
+	return nil
+}
@@ -0,0 +1,101 @@
+package file_test
+
+import (
+	"bufio"
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/store/rootmulti"
+	"github.com/cosmos/cosmos-sdk/store/streaming/file"
+	"github.com/cosmos/cosmos-sdk/store/types"
+)
+
+var _ baseapp.StreamingService = (*file.StreamingService)(nil)
+
+func readRecords(t *testing.T, path string) []file.Record {
+	f, err := os.Open(path)
+	require.NoError(t, err)
+	defer f.Close()
+
+	var records []file.Record
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		var record file.Record
+		require.NoError(t, json.Unmarshal(scanner.Bytes(), &record))
+		records = append(records, record)
+	}
+	require.NoError(t, scanner.Err())
+
+	return records
+}
+
+func TestStreamingService(t *testing.T) {
+	dir, err := ioutil.TempDir("", "streaming")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "blocks.jsonl")
+
+	key1, key2 := types.NewKVStoreKey("store1"), types.NewKVStoreKey("store2")
+	service, err := file.NewStreamingService(path, []types.StoreKey{key1})
+	require.NoError(t, err)
+	defer service.Close()
+
+	cms := rootmulti.NewStore(dbm.NewMemDB())
+	cms.MountStoreWithDB(key1, types.StoreTypeIAVL, nil)
+	cms.MountStoreWithDB(key2, types.StoreTypeIAVL, nil)
+	require.NoError(t, cms.LoadLatestVersion())
+	for key, listeners := range service.Listeners() {
+		cms.AddListeners(key, listeners)
+	}
+	require.True(t, cms.ListeningEnabled(key1))
+	require.False(t, cms.ListeningEnabled(key2))
+
+	// process a block
+	deliverState := cms.CacheMultiStore()
+	require.NoError(t, service.ListenBeginBlock(abci.RequestBeginBlock{Header: abci.Header{Height: 1}}, abci.ResponseBeginBlock{}))
+
+	txState := deliverState.CacheMultiStore()
+	txState.GetKVStore(key1).Set([]byte("b"), []byte("1"))
+	txState.GetKVStore(key1).Set([]byte("a"), []byte("2"))
+	txState.GetKVStore(key2).Set([]byte("c"), []byte("3"))
+	txState.Write()
+	require.NoError(t, service.ListenDeliverTx(abci.RequestDeliverTx{Tx: []byte("tx")}, abci.ResponseDeliverTx{}))
+
+	deliverState.GetKVStore(key1).Delete([]byte("b"))
+	require.NoError(t, service.ListenEndBlock(abci.RequestEndBlock{Height: 1}, abci.ResponseEndBlock{}))
+
+	// nothing is streamed before the block is committed
+	require.Empty(t, readRecords(t, path))
+
+	deliverState.Write()
+	commitID := cms.Commit()
+	require.NoError(t, service.ListenCommit(abci.ResponseCommit{Data: commitID.Hash}))
+
+	records := readRecords(t, path)
+	recordTypes := make([]string, len(records))
+	for i, record := range records {
+		require.Equal(t, int64(1), record.Height)
+		recordTypes[i] = record.Type
+	}
+	require.Equal(t, []string{
+		file.RecordBeginBlock, file.RecordDeliverTx, file.RecordEndBlock,
+		file.RecordWrite, file.RecordWrite, file.RecordCommit,
+	}, recordTypes)
+
+	// only the writes of the listened store are streamed, as flushed on commit
+	require.Equal(t, &types.StoreKVPair{StoreKey: "store1", Key: []byte("a"), Value: []byte("2")}, records[3].Write)
+	require.Equal(t, &types.StoreKVPair{StoreKey: "store1", Delete: true, Key: []byte("b")}, records[4].Write)
+
+	var res abci.ResponseCommit
+	require.NoError(t, json.Unmarshal(records[5].Response, &res))
+	require.Equal(t, commitID.Hash, res.Data)
+}
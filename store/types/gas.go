@@ -1,6 +1,11 @@
 package types
 
-import "math"
+import (
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+)
 
 // Gas consumption descriptors.
 const (
@@ -138,15 +143,156 @@ func (g *infiniteGasMeter) IsOutOfGas() bool {
 	return false
 }
 
-// GasConfig defines gas cost for each operation on KVStores
+// StoreGasMeter is a GasMeter that accounts for the gas consumed by each store.
+type StoreGasMeter interface {
+	GasMeter
+	ConsumeStoreGas(amount Gas, descriptor, storeName string)
+}
+
+// GasProfiler accumulates the gas consumed per descriptor and per store by
+// the gas meters it profiles. It is not safe for concurrent use.
+type GasProfiler struct {
+	descriptors map[string]Gas
+	stores      map[string]Gas
+}
+
+// NewGasProfiler returns a new GasProfiler.
+func NewGasProfiler() *GasProfiler {
+	return &GasProfiler{
+		descriptors: make(map[string]Gas),
+		stores:      make(map[string]Gas),
+	}
+}
+
+// Profile returns the gas consumed so far per descriptor and per store.
+func (gp *GasProfiler) Profile() GasProfile {
+	return GasProfile{
+		Descriptors: newGasProfileEntries(gp.descriptors),
+		Stores:      newGasProfileEntries(gp.stores),
+	}
+}
+
+func (gp *GasProfiler) record(amount Gas, descriptor, storeName string) {
+	gp.descriptors[descriptor] += amount
+	if storeName != "" {
+		gp.stores[storeName] += amount
+	}
+}
+
+type profilingGasMeter struct {
+	GasMeter
+	profiler *GasProfiler
+}
+
+// NewProfilingGasMeter returns a GasMeter that records the gas consumed from
+// the given meter in the profiler. The gas is recorded before it is consumed,
+// so the profile includes the operation that ran out of gas.
+func NewProfilingGasMeter(meter GasMeter, profiler *GasProfiler) StoreGasMeter {
+	// do not profile the gas twice
+	if pgm, ok := meter.(*profilingGasMeter); ok {
+		meter = pgm.GasMeter
+	}
+
+	return &profilingGasMeter{
+		GasMeter: meter,
+		profiler: profiler,
+	}
+}
+
+func (g *profilingGasMeter) ConsumeGas(amount Gas, descriptor string) {
+	g.ConsumeStoreGas(amount, descriptor, "")
+}
+
+func (g *profilingGasMeter) ConsumeStoreGas(amount Gas, descriptor, storeName string) {
+	g.profiler.record(amount, descriptor, storeName)
+	g.GasMeter.ConsumeGas(amount, descriptor)
+}
+
+// GasProfileEntry is the gas consumed by a descriptor or by a store.
+type GasProfileEntry struct {
+	Name string `json:"name" yaml:"name"`
+	Gas  Gas    `json:"gas" yaml:"gas"`
+}
+
+// GasProfile is the gas consumed per descriptor and per store, sorted by
+// decreasing gas.
+type GasProfile struct {
+	Descriptors []GasProfileEntry `json:"descriptors" yaml:"descriptors"`
+	Stores      []GasProfileEntry `json:"stores" yaml:"stores"`
+}
+
+func newGasProfileEntries(gas map[string]Gas) []GasProfileEntry {
+	if len(gas) == 0 {
+		return nil
+	}
+
+	entries := make([]GasProfileEntry, 0, len(gas))
+	for name, amount := range gas {
+		entries = append(entries, GasProfileEntry{Name: name, Gas: amount})
+	}
+
+	sort.Slice(entries, func(i, j int) bool {
+		if entries[i].Gas != entries[j].Gas {
+			return entries[i].Gas > entries[j].Gas
+		}
+		return entries[i].Name < entries[j].Name
+	})
+
+	return entries
+}
+
+func (gp GasProfile) String() string {
+	var sb strings.Builder
+
+	sb.WriteString("Descriptors:")
+	for _, e := range gp.Descriptors {
+		sb.WriteString(fmt.Sprintf("\n  %s: %d", e.Name, e.Gas))
+	}
+
+	sb.WriteString("\nStores:")
+	for _, e := range gp.Stores {
+		sb.WriteString(fmt.Sprintf("\n  %s: %d", e.Name, e.Gas))
+	}
+
+	return sb.String()
+}
+
+// GasConfig defines gas cost for each operation on KVStores. Its JSON omits
+// zero costs, so that a parameter change of a few costs leaves the others
+// unchanged.
 type GasConfig struct {
-	HasCost          Gas
-	DeleteCost       Gas
-	ReadCostFlat     Gas
-	ReadCostPerByte  Gas
-	WriteCostFlat    Gas
-	WriteCostPerByte Gas
-	IterNextCostFlat Gas
+	HasCost          Gas `json:"has_cost,omitempty" yaml:"has_cost"`
+	DeleteCost       Gas `json:"delete_cost,omitempty" yaml:"delete_cost"`
+	ReadCostFlat     Gas `json:"read_cost_flat,omitempty" yaml:"read_cost_flat"`
+	ReadCostPerByte  Gas `json:"read_cost_per_byte,omitempty" yaml:"read_cost_per_byte"`
+	WriteCostFlat    Gas `json:"write_cost_flat,omitempty" yaml:"write_cost_flat"`
+	WriteCostPerByte Gas `json:"write_cost_per_byte,omitempty" yaml:"write_cost_per_byte"`
+	IterNextCostFlat Gas `json:"iter_next_cost_flat,omitempty" yaml:"iter_next_cost_flat"`
+}
+
+// Validate ensures every operation of the gas config has a cost, so that no
+// operation on a KVStore is free.
+func (gc GasConfig) Validate() error {
+	costs := []struct {
+		name string
+		cost Gas
+	}{
+		{"has", gc.HasCost},
+		{"delete", gc.DeleteCost},
+		{"flat read", gc.ReadCostFlat},
+		{"per byte read", gc.ReadCostPerByte},
+		{"flat write", gc.WriteCostFlat},
+		{"per byte write", gc.WriteCostPerByte},
+		{"flat iterator next", gc.IterNextCostFlat},
+	}
+
+	for _, c := range costs {
+		if c.cost == 0 {
+			return fmt.Errorf("%s cost must be positive", c.name)
+		}
+	}
+
+	return nil
 }
 
 // KVGasConfig returns a default gas config for KVStores.
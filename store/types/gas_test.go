@@ -68,3 +68,39 @@ func TestAddUint64Overflow(t *testing.T) {
 		)
 	}
 }
+
+func TestProfilingGasMeter(t *testing.T) {
+	profiler := NewGasProfiler()
+	meter := NewProfilingGasMeter(NewGasMeter(100), profiler)
+
+	meter.ConsumeGas(10, "txSize")
+	meter.ConsumeStoreGas(20, GasReadCostFlatDesc, "acc")
+	meter.ConsumeStoreGas(30, GasReadCostFlatDesc, "bank")
+	meter.ConsumeStoreGas(5, GasWriteCostFlatDesc, "acc")
+	require.Equal(t, Gas(65), meter.GasConsumed())
+
+	// wrapping the meter again does not record the gas twice
+	meter = NewProfilingGasMeter(meter, profiler)
+	meter.ConsumeGas(5, "txSize")
+	require.Equal(t, Gas(70), meter.GasConsumed())
+
+	// the gas that runs out of gas is recorded
+	require.Panics(t, func() { meter.ConsumeStoreGas(40, GasWriteCostFlatDesc, "bank") })
+
+	profile := profiler.Profile()
+	require.Equal(t, []GasProfileEntry{
+		{GasReadCostFlatDesc, 50},
+		{GasWriteCostFlatDesc, 45},
+		{"txSize", 15},
+	}, profile.Descriptors)
+	require.Equal(t, []GasProfileEntry{{"bank", 70}, {"acc", 25}}, profile.Stores)
+}
+
+func TestGasConfigValidate(t *testing.T) {
+	require.NoError(t, KVGasConfig().Validate())
+	require.NoError(t, TransientGasConfig().Validate())
+
+	gasConfig := KVGasConfig()
+	gasConfig.IterNextCostFlat = 0
+	require.Error(t, gasConfig.Validate())
+}
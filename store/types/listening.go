@@ -0,0 +1,49 @@
+package types
+
+// WriteListener is notified of every write made to the KVStores it is
+// registered on.
+type WriteListener interface {
+	// OnWrite is called with the key of the store that was written to. The
+	// value is nil when the key is deleted.
+	OnWrite(storeKey StoreKey, key []byte, value []byte, delete bool) error
+}
+
+// StoreKVPair is a single write to a KVStore.
+type StoreKVPair struct {
+	StoreKey string `json:"store_key"`
+	Delete   bool   `json:"delete"`
+	Key      []byte `json:"key"`
+	Value    []byte `json:"value"`
+}
+
+// MemoryListener is a WriteListener that buffers the writes in memory until
+// they are popped.
+type MemoryListener struct {
+	stateCache []StoreKVPair
+}
+
+var _ WriteListener = (*MemoryListener)(nil)
+
+// NewMemoryListener returns a new, empty MemoryListener.
+func NewMemoryListener() *MemoryListener {
+	return &MemoryListener{}
+}
+
+// OnWrite implements the WriteListener interface.
+func (ml *MemoryListener) OnWrite(storeKey StoreKey, key []byte, value []byte, delete bool) error {
+	ml.stateCache = append(ml.stateCache, StoreKVPair{
+		StoreKey: storeKey.Name(),
+		Delete:   delete,
+		Key:      key,
+		Value:    value,
+	})
+	return nil
+}
+
+// PopStateCache returns the buffered writes in the order they were made and
+// clears the buffer.
+func (ml *MemoryListener) PopStateCache() []StoreKVPair {
+	res := ml.stateCache
+	ml.stateCache = nil
+	return res
+}
@@ -151,6 +151,14 @@ type CommitMultiStore interface {
 	// must be idempotent (return the same commit id). Otherwise the behavior is
 	// undefined.
 	LoadVersion(ver int64) error
+
+	// AddListeners adds WriteListeners for the KVStore of the given key, which
+	// are notified of every committed write to it.
+	AddListeners(key StoreKey, listeners []WriteListener)
+
+	// ListeningEnabled returns if listening is enabled for the KVStore of the
+	// given key.
+	ListeningEnabled(key StoreKey) bool
 }
 
 //---------subsp-------------------------------
@@ -0,0 +1,87 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+)
+
+// BlockStatsQuerierRoute is the custom querier route registered by the BaseApp
+// to serve the statistics of the recent blocks, e.g. "custom/blockstats" for
+// all of them and "custom/blockstats/<height>" for the block at a height.
+const BlockStatsQuerierRoute = "blockstats"
+
+// BlockStats defines the usage statistics of a block, as collected by the
+// BaseApp while the block is delivered.
+type BlockStats struct {
+	Height       int64  `json:"height" yaml:"height"`
+	GasUsed      uint64 `json:"gas_used" yaml:"gas_used"`
+	MaxGas       uint64 `json:"max_gas" yaml:"max_gas"` // 0 if the block gas is unlimited
+	NumTxs       uint64 `json:"num_txs" yaml:"num_txs"`
+	NumFailedTxs uint64 `json:"num_failed_txs" yaml:"num_failed_txs"`
+	TxBytes      uint64 `json:"tx_bytes" yaml:"tx_bytes"`
+	Fees         Coins  `json:"fees" yaml:"fees"`
+}
+
+// NewBlockStats returns the empty statistics of the block at the given height.
+func NewBlockStats(height int64, maxGas uint64) BlockStats {
+	return BlockStats{
+		Height: height,
+		MaxGas: maxGas,
+		Fees:   Coins{},
+	}
+}
+
+// AddTx records a delivered transaction of the given size.
+func (bs *BlockStats) AddTx(size int, ok bool) {
+	bs.NumTxs++
+	bs.TxBytes += uint64(size)
+
+	if !ok {
+		bs.NumFailedTxs++
+	}
+}
+
+// AddFees records the fees paid by a delivered transaction.
+func (bs *BlockStats) AddFees(fees Coins) {
+	bs.Fees = bs.Fees.Add(fees)
+}
+
+// Event returns the block_stats event of the statistics.
+func (bs BlockStats) Event() Event {
+	return NewEvent(
+		EventTypeBlockStats,
+		NewAttribute(AttributeKeyHeight, fmt.Sprintf("%d", bs.Height)),
+		NewAttribute(AttributeKeyGasUsed, fmt.Sprintf("%d", bs.GasUsed)),
+		NewAttribute(AttributeKeyMaxGas, fmt.Sprintf("%d", bs.MaxGas)),
+		NewAttribute(AttributeKeyNumTxs, fmt.Sprintf("%d", bs.NumTxs)),
+		NewAttribute(AttributeKeyNumFailedTxs, fmt.Sprintf("%d", bs.NumFailedTxs)),
+		NewAttribute(AttributeKeyTxBytes, fmt.Sprintf("%d", bs.TxBytes)),
+		NewAttribute(AttributeKeyFees, bs.Fees.String()),
+	)
+}
+
+func (bs BlockStats) String() string {
+	return fmt.Sprintf(`BlockStats:
+  Height:         %d
+  Gas Used:       %d
+  Max Gas:        %d
+  Txs:            %d
+  Failed Txs:     %d
+  Tx Bytes:       %d
+  Fees:           %s`,
+		bs.Height, bs.GasUsed, bs.MaxGas, bs.NumTxs, bs.NumFailedTxs, bs.TxBytes, bs.Fees,
+	)
+}
+
+// BlockStatsList defines the statistics of a range of blocks, by ascending
+// height.
+type BlockStatsList []BlockStats
+
+func (bsl BlockStatsList) String() string {
+	strs := make([]string, len(bsl))
+	for i, bs := range bsl {
+		strs[i] = bs.String()
+	}
+
+	return strings.Join(strs, "\n")
+}
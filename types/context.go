@@ -32,6 +32,11 @@ type Context struct {
 	blockGasMeter GasMeter
 	checkTx       bool
 	minGasPrice   DecCoins
+	feePolicies   FeePolicies
+	sigCache      *SigCache
+	gasProfiler   *GasProfiler
+	kvGasConfig   GasConfig
+	tGasConfig    GasConfig
 	consParams    *abci.ConsensusParams
 	eventManager  *EventManager
 }
@@ -40,19 +45,24 @@ type Context struct {
 type Request = Context
 
 // Read-only accessors
-func (c Context) Context() context.Context    { return c.ctx }
-func (c Context) MultiStore() MultiStore      { return c.ms }
-func (c Context) BlockHeight() int64          { return c.header.Height }
-func (c Context) BlockTime() time.Time        { return c.header.Time }
-func (c Context) ChainID() string             { return c.chainID }
-func (c Context) TxBytes() []byte             { return c.txBytes }
-func (c Context) Logger() log.Logger          { return c.logger }
-func (c Context) VoteInfos() []abci.VoteInfo  { return c.voteInfo }
-func (c Context) GasMeter() GasMeter          { return c.gasMeter }
-func (c Context) BlockGasMeter() GasMeter     { return c.blockGasMeter }
-func (c Context) IsCheckTx() bool             { return c.checkTx }
-func (c Context) MinGasPrices() DecCoins      { return c.minGasPrice }
-func (c Context) EventManager() *EventManager { return c.eventManager }
+func (c Context) Context() context.Context      { return c.ctx }
+func (c Context) MultiStore() MultiStore        { return c.ms }
+func (c Context) BlockHeight() int64            { return c.header.Height }
+func (c Context) BlockTime() time.Time          { return c.header.Time }
+func (c Context) ChainID() string               { return c.chainID }
+func (c Context) TxBytes() []byte               { return c.txBytes }
+func (c Context) Logger() log.Logger            { return c.logger }
+func (c Context) VoteInfos() []abci.VoteInfo    { return c.voteInfo }
+func (c Context) GasMeter() GasMeter            { return c.gasMeter }
+func (c Context) BlockGasMeter() GasMeter       { return c.blockGasMeter }
+func (c Context) IsCheckTx() bool               { return c.checkTx }
+func (c Context) MinGasPrices() DecCoins        { return c.minGasPrice }
+func (c Context) FeePolicies() FeePolicies      { return c.feePolicies }
+func (c Context) SigCache() *SigCache           { return c.sigCache }
+func (c Context) GasProfiler() *GasProfiler     { return c.gasProfiler }
+func (c Context) KVGasConfig() GasConfig        { return c.kvGasConfig }
+func (c Context) TransientGasConfig() GasConfig { return c.tGasConfig }
+func (c Context) EventManager() *EventManager   { return c.eventManager }
 
 // clone the header before returning
 func (c Context) BlockHeader() abci.Header {
@@ -78,6 +88,8 @@ func NewContext(ms MultiStore, header abci.Header, isCheckTx bool, logger log.Lo
 		gasMeter:     stypes.NewInfiniteGasMeter(),
 		minGasPrice:  DecCoins{},
 		eventManager: NewEventManager(),
+		kvGasConfig:  stypes.KVGasConfig(),
+		tGasConfig:   stypes.TransientGasConfig(),
 	}
 }
 
@@ -137,11 +149,28 @@ func (c Context) WithVoteInfos(voteInfo []abci.VoteInfo) Context {
 	return c
 }
 
+// WithGasMeter sets the gas meter of the context, which records its gas in the
+// gas profiler of the context if any.
 func (c Context) WithGasMeter(meter GasMeter) Context {
+	if c.gasProfiler != nil {
+		meter = NewProfilingGasMeter(meter, c.gasProfiler)
+	}
+
 	c.gasMeter = meter
 	return c
 }
 
+// WithGasProfiler sets a gas profiler on the context, which records the gas
+// consumed from the gas meters of the context per descriptor and per store.
+func (c Context) WithGasProfiler(profiler *GasProfiler) Context {
+	c.gasProfiler = profiler
+	if c.gasMeter != nil {
+		return c.WithGasMeter(c.gasMeter)
+	}
+
+	return c
+}
+
 func (c Context) WithBlockGasMeter(meter GasMeter) Context {
 	c.blockGasMeter = meter
 	return c
@@ -157,6 +186,30 @@ func (c Context) WithMinGasPrices(gasPrices DecCoins) Context {
 	return c
 }
 
+func (c Context) WithFeePolicies(policies FeePolicies) Context {
+	c.feePolicies = policies
+	return c
+}
+
+func (c Context) WithSigCache(cache *SigCache) Context {
+	c.sigCache = cache
+	return c
+}
+
+// WithKVGasConfig sets the gas costs of the operations on the KVStores of the
+// context.
+func (c Context) WithKVGasConfig(gasConfig GasConfig) Context {
+	c.kvGasConfig = gasConfig
+	return c
+}
+
+// WithTransientGasConfig sets the gas costs of the operations on the
+// transient stores of the context.
+func (c Context) WithTransientGasConfig(gasConfig GasConfig) Context {
+	c.tGasConfig = gasConfig
+	return c
+}
+
 func (c Context) WithConsensusParams(params *abci.ConsensusParams) Context {
 	c.consParams = params
 	return c
@@ -197,12 +250,12 @@ func (c Context) Value(key interface{}) interface{} {
 
 // KVStore fetches a KVStore from the MultiStore.
 func (c Context) KVStore(key StoreKey) KVStore {
-	return gaskv.NewStore(c.MultiStore().GetKVStore(key), c.GasMeter(), stypes.KVGasConfig())
+	return gaskv.NewStoreWithName(c.MultiStore().GetKVStore(key), c.GasMeter(), c.kvGasConfig, key.Name())
 }
 
 // TransientStore fetches a TransientStore from the MultiStore.
 func (c Context) TransientStore(key StoreKey) KVStore {
-	return gaskv.NewStore(c.MultiStore().GetKVStore(key), c.GasMeter(), stypes.TransientGasConfig())
+	return gaskv.NewStoreWithName(c.MultiStore().GetKVStore(key), c.GasMeter(), c.tGasConfig, key.Name())
 }
 
 // CacheContext returns a new Context with the multi-store cached and a new
@@ -567,6 +567,36 @@ func (d *Dec) UnmarshalAmino(text string) (err error) {
 	return nil
 }
 
+// Marshal implements the gogo proto custom type interface.
+func (d Dec) Marshal() ([]byte, error) {
+	if d.Int == nil {
+		d.Int = new(big.Int)
+	}
+	return d.Int.MarshalText()
+}
+
+// MarshalTo implements the gogo proto custom type interface.
+func (d *Dec) MarshalTo(data []byte) (n int, err error) {
+	bz, err := d.Marshal()
+	if err != nil {
+		return 0, err
+	}
+
+	copy(data, bz)
+	return len(bz), nil
+}
+
+// Unmarshal implements the gogo proto custom type interface.
+func (d *Dec) Unmarshal(data []byte) error {
+	return d.UnmarshalAmino(string(data))
+}
+
+// Size implements the gogo proto custom type interface.
+func (d *Dec) Size() int {
+	bz, _ := d.Marshal()
+	return len(bz)
+}
+
 // MarshalJSON marshals the decimal
 func (d Dec) MarshalJSON() ([]byte, error) {
 	if d.Int == nil {
@@ -46,6 +46,8 @@ const (
 	CodeTooManySignatures CodeType = 15
 	CodeGasOverflow       CodeType = 16
 	CodeNoSignatures      CodeType = 17
+	CodeTxTimeout         CodeType = 18
+	CodeMsgDisabled       CodeType = 19
 
 	// CodespaceRoot is a codespace for error codes in this file only.
 	// Notice that 0 is an "unset" codespace, which can be overridden with
@@ -93,6 +95,10 @@ func CodeToDefaultMsg(code CodeType) string {
 		return "maximum numer of signatures exceeded"
 	case CodeNoSignatures:
 		return "no signatures supplied"
+	case CodeTxTimeout:
+		return "tx timeout height passed"
+	case CodeMsgDisabled:
+		return "message disabled"
 	default:
 		return unknownCodeMsg(code)
 	}
@@ -154,6 +160,12 @@ func ErrNoSignatures(msg string) Error {
 func ErrGasOverflow(msg string) Error {
 	return newErrorWithRootCodespace(CodeGasOverflow, msg)
 }
+func ErrTxTimeout(msg string) Error {
+	return newErrorWithRootCodespace(CodeTxTimeout, msg)
+}
+func ErrMsgDisabled(msg string) Error {
+	return newErrorWithRootCodespace(CodeMsgDisabled, msg)
+}
 
 //----------------------------------------
 // Error & sdkError
@@ -133,11 +133,26 @@ func toBytes(i interface{}) []byte {
 // Common event types and attribute keys
 var (
 	EventTypeMessage = "message"
+	EventTypeTx      = "tx"
 
-	AttributeKeyAction = "action"
-	AttributeKeyModule = "module"
-	AttributeKeySender = "sender"
-	AttributeKeyAmount = "amount"
+	AttributeKeyAction   = "action"
+	AttributeKeyModule   = "module"
+	AttributeKeySender   = "sender"
+	AttributeKeyAmount   = "amount"
+	AttributeKeyPriority = "priority"
+)
+
+// Block statistics event type and attribute keys, see BlockStats
+var (
+	EventTypeBlockStats = "block_stats"
+
+	AttributeKeyHeight       = "height"
+	AttributeKeyGasUsed      = "gas_used"
+	AttributeKeyMaxGas       = "max_gas"
+	AttributeKeyNumTxs       = "num_txs"
+	AttributeKeyNumFailedTxs = "num_failed_txs"
+	AttributeKeyTxBytes      = "tx_bytes"
+	AttributeKeyFees         = "fees"
 )
 
 type (
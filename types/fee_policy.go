@@ -0,0 +1,122 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+)
+
+// FeePolicy defines the node-local minimum gas prices of the messages of a
+// route or, if Type is set, of a single message type of a route. It replaces
+// the minimum gas prices of the node for these messages. Gas up to FreeGas is
+// not charged.
+type FeePolicy struct {
+	Route        string   `json:"route" yaml:"route"`
+	Type         string   `json:"type" yaml:"type"`
+	MinGasPrices DecCoins `json:"min_gas_prices" yaml:"min_gas_prices"`
+	FreeGas      uint64   `json:"free_gas" yaml:"free_gas"`
+}
+
+// NewFeePolicy returns a new FeePolicy. An empty msgType applies the policy to
+// all the messages of the route.
+func NewFeePolicy(route, msgType string, minGasPrices DecCoins, freeGas uint64) FeePolicy {
+	return FeePolicy{
+		Route:        route,
+		Type:         msgType,
+		MinGasPrices: minGasPrices,
+		FreeGas:      freeGas,
+	}
+}
+
+// Name returns the route of the policy, followed by its message type if any.
+func (fp FeePolicy) Name() string {
+	if fp.Type == "" {
+		return fp.Route
+	}
+
+	return fmt.Sprintf("%s/%s", fp.Route, fp.Type)
+}
+
+// RequiredFees returns the fees required by the policy for the given gas
+// limit, where fee = ceil(minGasPrice * (gasLimit - freeGas)).
+func (fp FeePolicy) RequiredFees(gasLimit uint64) Coins {
+	if fp.MinGasPrices.IsZero() || gasLimit <= fp.FreeGas {
+		return nil
+	}
+
+	requiredFees := make(Coins, len(fp.MinGasPrices))
+
+	glDec := NewDec(int64(gasLimit - fp.FreeGas))
+	for i, gp := range fp.MinGasPrices {
+		fee := gp.Amount.Mul(glDec)
+		requiredFees[i] = NewCoin(gp.Denom, fee.Ceil().RoundInt())
+	}
+
+	return requiredFees
+}
+
+// Validate performs a basic validation of the policy.
+func (fp FeePolicy) Validate() error {
+	if strings.TrimSpace(fp.Route) == "" {
+		return fmt.Errorf("fee policy route cannot be blank")
+	}
+
+	if !fp.MinGasPrices.IsValid() {
+		return fmt.Errorf("invalid minimum gas prices of fee policy %s: %s", fp.Name(), fp.MinGasPrices)
+	}
+
+	return nil
+}
+
+func (fp FeePolicy) String() string {
+	return fmt.Sprintf(`FeePolicy:
+  Name:           %s
+  Min Gas Prices: %s
+  Free Gas:       %d`, fp.Name(), fp.MinGasPrices, fp.FreeGas)
+}
+
+// FeePolicies defines a list of FeePolicy.
+type FeePolicies []FeePolicy
+
+// Match returns the policy of the message. A policy of the message type takes
+// precedence over a policy of the whole route. It returns false if no policy
+// matches.
+func (fps FeePolicies) Match(msg Msg) (FeePolicy, bool) {
+	var (
+		match FeePolicy
+		found bool
+	)
+
+	for _, fp := range fps {
+		if fp.Route != msg.Route() {
+			continue
+		}
+
+		switch fp.Type {
+		case msg.Type():
+			return fp, true
+		case "":
+			match, found = fp, true
+		}
+	}
+
+	return match, found
+}
+
+// Validate performs a basic validation of the policies and ensures there is at
+// most one policy per route or message type.
+func (fps FeePolicies) Validate() error {
+	seen := make(map[string]bool, len(fps))
+
+	for _, fp := range fps {
+		if err := fp.Validate(); err != nil {
+			return err
+		}
+
+		if seen[fp.Name()] {
+			return fmt.Errorf("duplicate fee policy %s", fp.Name())
+		}
+		seen[fp.Name()] = true
+	}
+
+	return nil
+}
@@ -0,0 +1,48 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestFeePolicyRequiredFees(t *testing.T) {
+	fp := NewFeePolicy("bank", "send", DecCoins{NewDecCoinFromDec("stake", NewDecWithPrec(5, 1))}, 100)
+	require.Equal(t, "bank/send", fp.Name())
+	require.Nil(t, fp.RequiredFees(100))
+	require.Equal(t, NewCoins(NewInt64Coin("stake", 1)), fp.RequiredFees(101))
+	require.Equal(t, NewCoins(NewInt64Coin("stake", 50)), fp.RequiredFees(200))
+
+	free := NewFeePolicy("gov", "", nil, 0)
+	require.Equal(t, "gov", free.Name())
+	require.Nil(t, free.RequiredFees(1000000))
+}
+
+func TestFeePoliciesMatch(t *testing.T) {
+	route := NewFeePolicy("TestMsg", "", nil, 0)
+	msgType := NewFeePolicy("TestMsg", "Test message", nil, 10)
+	other := NewFeePolicy("TestMsg", "other", nil, 20)
+	msg := NewTestMsg()
+
+	_, ok := FeePolicies{}.Match(msg)
+	require.False(t, ok)
+
+	_, ok = FeePolicies{other}.Match(msg)
+	require.False(t, ok)
+
+	fp, ok := FeePolicies{route, other}.Match(msg)
+	require.True(t, ok)
+	require.Equal(t, route, fp)
+
+	fp, ok = FeePolicies{msgType, route}.Match(msg)
+	require.True(t, ok)
+	require.Equal(t, msgType, fp)
+}
+
+func TestFeePoliciesValidate(t *testing.T) {
+	require.NoError(t, FeePolicies{}.Validate())
+	require.NoError(t, FeePolicies{NewFeePolicy("gov", "", nil, 0), NewFeePolicy("gov", "vote", nil, 0)}.Validate())
+	require.Error(t, FeePolicies{NewFeePolicy(" ", "", nil, 0)}.Validate())
+	require.Error(t, FeePolicies{NewFeePolicy("gov", "vote", nil, 0), NewFeePolicy("gov", "vote", nil, 0)}.Validate())
+	require.Error(t, FeePolicies{NewFeePolicy("gov", "", DecCoins{NewInt64DecCoin("stake", 0)}, 0)}.Validate())
+}
@@ -6,3 +6,70 @@ type Handler func(ctx Context, msg Msg) Result
 // AnteHandler authenticates transactions, before their internal messages are handled.
 // If newCtx.IsZero(), ctx is used instead.
 type AnteHandler func(ctx Context, tx Tx, simulate bool) (newCtx Context, result Result, abort bool)
+
+// PostHandler runs after the messages of a transaction are handled
+// successfully, with the context of the transaction, before its state changes
+// are written. Its events are added to those of the transaction, and aborting
+// fails the transaction with the returned result, or with an internal error if
+// the result is OK.
+type PostHandler func(ctx Context, tx Tx, simulate bool) (result Result, abort bool)
+
+// HandlerMiddleware wraps the Handler of every message route, to run custom
+// logic before and after each message is handled. A failed result aborts the
+// transaction.
+type HandlerMiddleware func(next Handler) Handler
+
+// CircuitBreaker decides whether a message may be executed. A message it
+// doesn't allow fails the transaction before its Handler runs.
+type CircuitBreaker interface {
+	IsAllowed(ctx Context, msg Msg) bool
+}
+
+// TxPriority returns the priority of a transaction in the mempool and the
+// sender slot it takes, e.g. its signer and sequence. A transaction may
+// replace a pending transaction of the same slot with a lower priority. An
+// empty slot disables replacement.
+type TxPriority func(ctx Context, tx Tx) (priority int64, slot string)
+
+// SigPreVerifier returns the signatures of the transactions of a block, to be
+// verified concurrently before the transactions are delivered. The context
+// holds the state at the beginning of the block, so the signatures depending on
+// the state changes of the block may be guessed wrong: the verification of a
+// transaction only consults the signature cache, which is keyed by the signed
+// bytes, so a wrong guess is only verified again.
+type SigPreVerifier func(ctx Context, txs []Tx) []SigVerification
+
+// AnteDecorator wraps the next AnteHandler to perform custom pre- and
+// post-processing. A decorator calls next to continue the chain, or returns
+// with abort set to stop it.
+type AnteDecorator interface {
+	AnteHandle(ctx Context, tx Tx, simulate bool, next AnteHandler) (newCtx Context, result Result, abort bool)
+}
+
+// ChainAnteDecorators chains the AnteDecorators into a single AnteHandler.
+// Each decorator wraps the decorators further along the chain, so the first
+// decorator runs first and can observe the result of the rest of the chain.
+// The chain is terminated by an AnteHandler that returns the context it is
+// given. It returns nil if no decorators are given.
+func ChainAnteDecorators(chain ...AnteDecorator) AnteHandler {
+	if len(chain) == 0 {
+		return nil
+	}
+
+	next := terminateAnteChain
+	for i := len(chain) - 1; i >= 0; i-- {
+		next = wrapAnteDecorator(chain[i], next)
+	}
+
+	return next
+}
+
+func wrapAnteDecorator(decorator AnteDecorator, next AnteHandler) AnteHandler {
+	return func(ctx Context, tx Tx, simulate bool) (Context, Result, bool) {
+		return decorator.AnteHandle(ctx, tx, simulate, next)
+	}
+}
+
+func terminateAnteChain(ctx Context, _ Tx, _ bool) (Context, Result, bool) {
+	return ctx, Result{}, false
+}
@@ -0,0 +1,56 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+type testAnteDecorator struct {
+	name  string
+	calls *[]string
+	abort bool
+}
+
+func (d testAnteDecorator) AnteHandle(
+	ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler,
+) (sdk.Context, sdk.Result, bool) {
+	*d.calls = append(*d.calls, d.name)
+	if d.abort {
+		return ctx, sdk.ErrUnauthorized(d.name).Result(), true
+	}
+
+	newCtx, res, abort := next(ctx, tx, simulate)
+	*d.calls = append(*d.calls, d.name+" done")
+	return newCtx, res, abort
+}
+
+func TestChainAnteDecorators(t *testing.T) {
+	require.Nil(t, sdk.ChainAnteDecorators())
+
+	var calls []string
+	anteHandler := sdk.ChainAnteDecorators(
+		testAnteDecorator{name: "first", calls: &calls},
+		testAnteDecorator{name: "second", calls: &calls},
+	)
+
+	_, res, abort := anteHandler(sdk.Context{}, nil, false)
+	require.False(t, abort)
+	require.True(t, res.IsOK())
+	require.Equal(t, []string{"first", "second", "second done", "first done"}, calls)
+
+	// an aborting decorator stops the chain
+	calls = nil
+	anteHandler = sdk.ChainAnteDecorators(
+		testAnteDecorator{name: "first", calls: &calls},
+		testAnteDecorator{name: "second", calls: &calls, abort: true},
+		testAnteDecorator{name: "third", calls: &calls},
+	)
+
+	_, res, abort = anteHandler(sdk.Context{}, nil, false)
+	require.True(t, abort)
+	require.Equal(t, sdk.CodeUnauthorized, res.Code)
+	require.Equal(t, []string{"first", "second", "first done"}, calls)
+}
@@ -336,6 +336,39 @@ func (i *Int) UnmarshalAmino(text string) error {
 	return unmarshalAmino(i.i, text)
 }
 
+// Marshal implements the gogo proto custom type interface.
+func (i Int) Marshal() ([]byte, error) {
+	if i.i == nil {
+		i.i = new(big.Int)
+	}
+	return i.i.MarshalText()
+}
+
+// MarshalTo implements the gogo proto custom type interface.
+func (i *Int) MarshalTo(data []byte) (n int, err error) {
+	bz, err := i.Marshal()
+	if err != nil {
+		return 0, err
+	}
+
+	copy(data, bz)
+	return len(bz), nil
+}
+
+// Unmarshal implements the gogo proto custom type interface.
+func (i *Int) Unmarshal(data []byte) error {
+	if i.i == nil {
+		i.i = new(big.Int)
+	}
+	return unmarshalText(i.i, string(data))
+}
+
+// Size implements the gogo proto custom type interface.
+func (i *Int) Size() int {
+	bz, _ := i.Marshal()
+	return len(bz)
+}
+
 // MarshalJSON defines custom encoding scheme
 func (i Int) MarshalJSON() ([]byte, error) {
 	if i.i == nil { // Necessary since default Uint initialization has i.i as nil
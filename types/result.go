@@ -35,6 +35,10 @@ type Result struct {
 	// Events contains a slice of Event objects that were emitted during some
 	// execution.
 	Events Events
+
+	// GasProfile is the gas consumed per descriptor and per store, only set
+	// when simulating.
+	GasProfile *GasProfile
 }
 
 // TODO: In the future, more codes may be OK.
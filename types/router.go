@@ -1,8 +1,10 @@
 package types
 
-// Router provides handlers for each transaction type.
+// Router provides handlers for each transaction type. The handlers it
+// provides are wrapped by its middleware.
 type Router interface {
 	AddRoute(r string, h Handler) Router
+	AddMiddleware(mw HandlerMiddleware) Router
 	Route(path string) Handler
 }
 
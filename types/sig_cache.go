@@ -0,0 +1,293 @@
+package types
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"runtime"
+	"sync"
+
+	"github.com/tendermint/tendermint/crypto"
+	"github.com/tendermint/tendermint/crypto/multisig"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+)
+
+// multisigCdc decodes threshold multisig signatures, which contain no
+// interfaces.
+var multisigCdc = codec.New()
+
+// SigVerification defines a signature to verify: the signature of the sign
+// bytes by the public key.
+type SigVerification struct {
+	PubKey    crypto.PubKey
+	SignBytes []byte
+	Signature []byte
+}
+
+// NewSigVerification returns a new SigVerification.
+func NewSigVerification(pubKey crypto.PubKey, signBytes, sig []byte) SigVerification {
+	return SigVerification{
+		PubKey:    pubKey,
+		SignBytes: signBytes,
+		Signature: sig,
+	}
+}
+
+// key returns the hash identifying the verification in a SigCache. The hash
+// covers the public key, the sign bytes and the signature, so a cached
+// signature is never accepted for other sign bytes, e.g. for another sequence.
+func (sv SigVerification) key() string {
+	h := sha256.New()
+	buf := make([]byte, binary.MaxVarintLen64)
+	for _, bz := range [][]byte{sv.PubKey.Bytes(), sv.SignBytes, sv.Signature} {
+		n := binary.PutUvarint(buf, uint64(len(bz)))
+		h.Write(buf[:n])
+		h.Write(bz)
+	}
+
+	return string(h.Sum(nil))
+}
+
+// SigCache is a cache of valid signatures that is safe for concurrent use.
+// Signatures can be pre-verified concurrently with PreVerify, after which
+// VerifyBytes only consults the cache. Only valid signatures are cached and
+// verification is deterministic, so the result of VerifyBytes does not depend
+// on the content of the cache. Once full, the oldest signatures are evicted.
+type SigCache struct {
+	mtx   sync.RWMutex
+	valid map[string]struct{}
+	keys  []string // ring of the cached keys, in insertion order
+	next  int      // index of the next key to evict in keys
+}
+
+// NewSigCache returns a new SigCache holding up to size signatures.
+func NewSigCache(size int) *SigCache {
+	if size <= 0 {
+		panic("signature cache size must be positive")
+	}
+
+	return &SigCache{
+		valid: make(map[string]struct{}, size),
+		keys:  make([]string, 0, size),
+	}
+}
+
+// Len returns the number of cached signatures.
+func (c *SigCache) Len() int {
+	c.mtx.RLock()
+	defer c.mtx.RUnlock()
+
+	return len(c.valid)
+}
+
+// VerifyBytes verifies the signature of msg by pubKey, consulting the cache
+// first, and caches it if valid. The subkey signatures of a threshold multisig
+// public key are verified and cached individually. A nil cache verifies the
+// signature directly.
+func (c *SigCache) VerifyBytes(pubKey crypto.PubKey, msg, sig []byte) bool {
+	if c == nil {
+		return pubKey.VerifyBytes(msg, sig)
+	}
+
+	sv := NewSigVerification(pubKey, msg, sig)
+	key := sv.key()
+	if c.has(key) {
+		return true
+	}
+
+	var ok bool
+	if multisigPubKey, isMultisig := pubKey.(multisig.PubKeyMultisigThreshold); isMultisig {
+		ok = c.verifyMultisig(multisigPubKey, msg, sig)
+	} else {
+		ok = pubKey.VerifyBytes(msg, sig)
+	}
+
+	if ok {
+		c.add(key)
+	}
+
+	return ok
+}
+
+// PreVerify verifies the given signatures concurrently, with one worker per
+// CPU, and caches the valid ones. The subkey signatures of threshold multisig
+// public keys are verified concurrently as well.
+func (c *SigCache) PreVerify(svs []SigVerification) {
+	if c == nil || len(svs) == 0 {
+		return
+	}
+
+	// verify the subkey signatures of multisigs first, so that verifying the
+	// multisigs below only consults the cache
+	var subSigs []SigVerification
+	for _, sv := range svs {
+		if pubKey, ok := sv.PubKey.(multisig.PubKeyMultisigThreshold); ok {
+			subSigs = append(subSigs, multisigSubVerifications(pubKey, sv.SignBytes, sv.Signature)...)
+		}
+	}
+	c.verifyAll(subSigs)
+	c.verifyAll(svs)
+}
+
+// verifyAll verifies the given signatures concurrently.
+func (c *SigCache) verifyAll(svs []SigVerification) {
+	workers := runtime.NumCPU()
+	if workers > len(svs) {
+		workers = len(svs)
+	}
+
+	jobs := make(chan SigVerification, len(svs))
+	for _, sv := range svs {
+		jobs <- sv
+	}
+	close(jobs)
+
+	var wg sync.WaitGroup
+	wg.Add(workers)
+	for i := 0; i < workers; i++ {
+		go func() {
+			defer wg.Done()
+			for sv := range jobs {
+				c.preVerify(sv)
+			}
+		}()
+	}
+	wg.Wait()
+}
+
+// preVerify verifies a signature in a worker. The malformed signatures making
+// the verification panic are left to the transaction to report.
+func (c *SigCache) preVerify(sv SigVerification) {
+	if sv.PubKey == nil || !verifiable(sv.PubKey, sv.Signature) {
+		return
+	}
+
+	c.VerifyBytes(sv.PubKey, sv.SignBytes, sv.Signature)
+}
+
+// verifiable returns whether verifying the signature doesn't panic. Only the
+// threshold multisig signatures with more set bits than signatures, possibly
+// nested in a multisig, make the verification panic.
+func verifiable(pubKey crypto.PubKey, marshalledSig []byte) bool {
+	multisigPubKey, ok := pubKey.(multisig.PubKeyMultisigThreshold)
+	if !ok {
+		return true
+	}
+
+	sig, ok := decodeMultisignature(multisigPubKey, marshalledSig)
+	if !ok {
+		return true
+	}
+
+	if sig.BitArray.NumTrueBitsBefore(len(multisigPubKey.PubKeys)) > len(sig.Sigs) {
+		return false
+	}
+
+	sigIndex := 0
+	for i := range multisigPubKey.PubKeys {
+		if sig.BitArray.GetIndex(i) {
+			if !verifiable(multisigPubKey.PubKeys[i], sig.Sigs[sigIndex]) {
+				return false
+			}
+			sigIndex++
+		}
+	}
+
+	return true
+}
+
+// verifyMultisig has the same semantics as the VerifyBytes method of a
+// threshold multisig public key, but verifies the subkey signatures through
+// the cache.
+func (c *SigCache) verifyMultisig(pubKey multisig.PubKeyMultisigThreshold, msg, marshalledSig []byte) bool {
+	sig, ok := decodeMultisignature(pubKey, marshalledSig)
+	if !ok {
+		return false
+	}
+
+	// more set bits than signatures make the public key panic, which is left
+	// to it so that the result is the same
+	if sig.BitArray.NumTrueBitsBefore(len(pubKey.PubKeys)) > len(sig.Sigs) {
+		return pubKey.VerifyBytes(msg, marshalledSig)
+	}
+
+	sigIndex := 0
+	for i := range pubKey.PubKeys {
+		if sig.BitArray.GetIndex(i) {
+			if !c.VerifyBytes(pubKey.PubKeys[i], msg, sig.Sigs[sigIndex]) {
+				return false
+			}
+			sigIndex++
+		}
+	}
+
+	return true
+}
+
+// multisigSubVerifications returns the subkey signatures of a threshold
+// multisig signature, or nil if the signature is malformed.
+func multisigSubVerifications(pubKey multisig.PubKeyMultisigThreshold, msg, marshalledSig []byte) []SigVerification {
+	sig, ok := decodeMultisignature(pubKey, marshalledSig)
+	if !ok {
+		return nil
+	}
+
+	svs := make([]SigVerification, 0, len(sig.Sigs))
+	sigIndex := 0
+	for i := range pubKey.PubKeys {
+		if sig.BitArray.GetIndex(i) && sigIndex < len(sig.Sigs) {
+			svs = append(svs, NewSigVerification(pubKey.PubKeys[i], msg, sig.Sigs[sigIndex]))
+			sigIndex++
+		}
+	}
+
+	return svs
+}
+
+// decodeMultisignature decodes a threshold multisig signature and performs the
+// same structural checks as the VerifyBytes method of the public key.
+func decodeMultisignature(pubKey multisig.PubKeyMultisigThreshold, marshalledSig []byte) (multisig.Multisignature, bool) {
+	var sig multisig.Multisignature
+	if err := multisigCdc.UnmarshalBinaryBare(marshalledSig, &sig); err != nil {
+		return sig, false
+	}
+
+	size := sig.BitArray.Size()
+	if len(pubKey.PubKeys) != size {
+		return sig, false
+	}
+	if len(sig.Sigs) < int(pubKey.K) || len(sig.Sigs) > size {
+		return sig, false
+	}
+	if sig.BitArray.NumTrueBitsBefore(size) < int(pubKey.K) {
+		return sig, false
+	}
+
+	return sig, true
+}
+
+func (c *SigCache) has(key string) bool {
+	c.mtx.RLock()
+	defer c.mtx.RUnlock()
+
+	_, ok := c.valid[key]
+	return ok
+}
+
+func (c *SigCache) add(key string) {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+
+	if _, ok := c.valid[key]; ok {
+		return
+	}
+
+	if len(c.keys) < cap(c.keys) {
+		c.keys = append(c.keys, key)
+	} else {
+		delete(c.valid, c.keys[c.next])
+		c.keys[c.next] = key
+		c.next = (c.next + 1) % len(c.keys)
+	}
+	c.valid[key] = struct{}{}
+}
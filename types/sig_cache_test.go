@@ -0,0 +1,108 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/crypto"
+	"github.com/tendermint/tendermint/crypto/multisig"
+	"github.com/tendermint/tendermint/crypto/secp256k1"
+)
+
+func TestSigCacheVerifyBytes(t *testing.T) {
+	priv := secp256k1.GenPrivKey()
+	msg := []byte("sign bytes")
+	sig, err := priv.Sign(msg)
+	require.NoError(t, err)
+
+	cache := NewSigCache(2)
+
+	// invalid signatures are not cached
+	require.False(t, cache.VerifyBytes(priv.PubKey(), []byte("other bytes"), sig))
+	require.Equal(t, 0, cache.Len())
+
+	require.True(t, cache.VerifyBytes(priv.PubKey(), msg, sig))
+	require.Equal(t, 1, cache.Len())
+	require.True(t, cache.VerifyBytes(priv.PubKey(), msg, sig))
+	require.Equal(t, 1, cache.Len())
+
+	// a cached signature is not accepted for other sign bytes
+	require.False(t, cache.VerifyBytes(priv.PubKey(), []byte("other bytes"), sig))
+
+	// a nil cache verifies signatures directly
+	var nilCache *SigCache
+	require.True(t, nilCache.VerifyBytes(priv.PubKey(), msg, sig))
+	require.False(t, nilCache.VerifyBytes(priv.PubKey(), []byte("other bytes"), sig))
+}
+
+func TestSigCacheEviction(t *testing.T) {
+	cache := NewSigCache(2)
+
+	svs := make([]SigVerification, 3)
+	for i := range svs {
+		svs[i] = newTestSigVerification(t, secp256k1.GenPrivKey(), []byte("sign bytes"))
+		require.True(t, cache.VerifyBytes(svs[i].PubKey, svs[i].SignBytes, svs[i].Signature))
+	}
+
+	require.Equal(t, 2, cache.Len())
+	require.False(t, cache.has(svs[0].key()))
+	require.True(t, cache.has(svs[1].key()))
+	require.True(t, cache.has(svs[2].key()))
+}
+
+func TestSigCachePreVerify(t *testing.T) {
+	msg := []byte("sign bytes")
+	privs := []crypto.PrivKey{secp256k1.GenPrivKey(), secp256k1.GenPrivKey(), secp256k1.GenPrivKey()}
+	pubKeys := make([]crypto.PubKey, len(privs))
+	for i, priv := range privs {
+		pubKeys[i] = priv.PubKey()
+	}
+
+	// a 2 of 3 multisig signed by the first and last keys
+	multisigKey := multisig.NewPubKeyMultisigThreshold(2, pubKeys)
+	multisignature := multisig.NewMultisig(len(pubKeys))
+	for _, i := range []int{0, 2} {
+		sig, err := privs[i].Sign(msg)
+		require.NoError(t, err)
+		require.NoError(t, multisignature.AddSignatureFromPubKey(sig, pubKeys[i], pubKeys))
+	}
+	multisigSV := NewSigVerification(multisigKey, msg, multisignature.Marshal())
+
+	single := newTestSigVerification(t, privs[1], msg)
+	invalid := NewSigVerification(pubKeys[1], []byte("other bytes"), single.Signature)
+	malformed := NewSigVerification(multisigKey, msg, []byte("malformed"))
+
+	cache := NewSigCache(10)
+	cache.PreVerify([]SigVerification{multisigSV, single, invalid, malformed})
+
+	// the multisig, its 2 subkey signatures and the single signature
+	require.Equal(t, 4, cache.Len())
+	require.True(t, cache.has(multisigSV.key()))
+	require.True(t, cache.has(single.key()))
+	require.False(t, cache.has(invalid.key()))
+
+	// the cache gives the same results as the public keys
+	for _, sv := range []SigVerification{multisigSV, single, invalid, malformed} {
+		require.Equal(t, sv.PubKey.VerifyBytes(sv.SignBytes, sv.Signature), cache.VerifyBytes(sv.PubKey, sv.SignBytes, sv.Signature))
+	}
+
+	// more set bits than signatures make the multisig public key panic, which
+	// pre-verification ignores
+	multisignature = multisig.NewMultisig(len(pubKeys))
+	for _, i := range []int{0, 1} {
+		sig, err := privs[i].Sign(msg)
+		require.NoError(t, err)
+		require.NoError(t, multisignature.AddSignatureFromPubKey(sig, pubKeys[i], pubKeys))
+	}
+	multisignature.BitArray.SetIndex(2, true)
+	panicking := NewSigVerification(multisigKey, msg, multisignature.Marshal())
+	require.Panics(t, func() { multisigKey.VerifyBytes(msg, panicking.Signature) })
+	require.NotPanics(t, func() { cache.PreVerify([]SigVerification{panicking}) })
+	require.Panics(t, func() { cache.VerifyBytes(multisigKey, msg, panicking.Signature) })
+}
+
+func newTestSigVerification(t *testing.T, priv crypto.PrivKey, msg []byte) SigVerification {
+	sig, err := priv.Sign(msg)
+	require.NoError(t, err)
+	return NewSigVerification(priv.PubKey(), msg, sig)
+}
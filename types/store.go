@@ -128,6 +128,10 @@ type KVPair = types.KVPair
 // every trace operation.
 type TraceContext = types.TraceContext
 
+// WriteListener is notified of every write made to the KVStores it is
+// registered on.
+type WriteListener = types.WriteListener
+
 // --------------------------------------
 
 // nolint - reexport
@@ -142,6 +146,16 @@ func NewGasMeter(limit Gas) GasMeter {
 	return types.NewGasMeter(limit)
 }
 
+// nolint - reexport
+func KVGasConfig() GasConfig {
+	return types.KVGasConfig()
+}
+
+// nolint - reexport
+func TransientGasConfig() GasConfig {
+	return types.TransientGasConfig()
+}
+
 // nolint - reexport
 type (
 	ErrorOutOfGas    = types.ErrorOutOfGas
@@ -152,3 +166,21 @@ type (
 func NewInfiniteGasMeter() GasMeter {
 	return types.NewInfiniteGasMeter()
 }
+
+// nolint - reexport
+type (
+	StoreGasMeter   = types.StoreGasMeter
+	GasProfiler     = types.GasProfiler
+	GasProfile      = types.GasProfile
+	GasProfileEntry = types.GasProfileEntry
+)
+
+// nolint - reexport
+func NewGasProfiler() *GasProfiler {
+	return types.NewGasProfiler()
+}
+
+// nolint - reexport
+func NewProfilingGasMeter(meter GasMeter, profiler *GasProfiler) StoreGasMeter {
+	return types.NewProfilingGasMeter(meter, profiler)
+}
@@ -40,6 +40,15 @@ type Tx interface {
 	ValidateBasic() Error
 }
 
+// FeeTx defines a transaction that pays fees, reported in the statistics of
+// the block it is delivered in.
+type FeeTx interface {
+	Tx
+
+	// GetFee returns the fees paid by the transaction.
+	GetFee() Coins
+}
+
 //__________________________________________________________
 
 // TxDecoder unmarshals transaction bytes
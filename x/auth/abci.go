@@ -0,0 +1,11 @@
+package auth
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// EndBlocker called every block, removes the used nonces of the unordered
+// transactions that timed out.
+func EndBlocker(ctx sdk.Context, ak AccountKeeper) {
+	ak.RemoveExpiredUnorderedNonces(ctx, uint64(ctx.BlockHeight()))
+}
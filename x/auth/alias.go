@@ -25,54 +25,83 @@ const (
 	DefaultSigVerifyCostED25519   = types.DefaultSigVerifyCostED25519
 	DefaultSigVerifyCostSecp256k1 = types.DefaultSigVerifyCostSecp256k1
 	QueryAccount                  = types.QueryAccount
+	DefaultMaxUnorderedTxTimeout  = ante.DefaultMaxUnorderedTxTimeout
+	GasPricePriorityPrecision     = ante.GasPricePriorityPrecision
 )
 
 var (
 	// functions aliases
-	NewAnteHandler                    = ante.NewAnteHandler
-	GetSignerAcc                      = ante.GetSignerAcc
-	ValidateSigCount                  = ante.ValidateSigCount
-	ValidateMemo                      = ante.ValidateMemo
-	ProcessPubKey                     = ante.ProcessPubKey
-	DefaultSigVerificationGasConsumer = ante.DefaultSigVerificationGasConsumer
-	DeductFees                        = ante.DeductFees
-	EnsureSufficientMempoolFees       = ante.EnsureSufficientMempoolFees
-	SetGasMeter                       = ante.SetGasMeter
-	GetSignBytes                      = ante.GetSignBytes
-	NewAccountKeeper                  = keeper.NewAccountKeeper
-	NewQuerier                        = keeper.NewQuerier
-	NewBaseAccount                    = types.NewBaseAccount
-	ProtoBaseAccount                  = types.ProtoBaseAccount
-	NewBaseAccountWithAddress         = types.NewBaseAccountWithAddress
-	NewBaseVestingAccount             = types.NewBaseVestingAccount
-	NewContinuousVestingAccountRaw    = types.NewContinuousVestingAccountRaw
-	NewContinuousVestingAccount       = types.NewContinuousVestingAccount
-	NewDelayedVestingAccountRaw       = types.NewDelayedVestingAccountRaw
-	NewDelayedVestingAccount          = types.NewDelayedVestingAccount
-	NewAccountRetriever               = types.NewAccountRetriever
-	RegisterCodec                     = types.RegisterCodec
-	NewGenesisState                   = types.NewGenesisState
-	DefaultGenesisState               = types.DefaultGenesisState
-	ValidateGenesis                   = types.ValidateGenesis
-	AddressStoreKey                   = types.AddressStoreKey
-	NewParams                         = types.NewParams
-	ParamKeyTable                     = types.ParamKeyTable
-	DefaultParams                     = types.DefaultParams
-	NewQueryAccountParams             = types.NewQueryAccountParams
-	NewStdTx                          = types.NewStdTx
-	CountSubKeys                      = types.CountSubKeys
-	NewStdFee                         = types.NewStdFee
-	StdSignBytes                      = types.StdSignBytes
-	DefaultTxDecoder                  = types.DefaultTxDecoder
-	DefaultTxEncoder                  = types.DefaultTxEncoder
-	NewTxBuilder                      = types.NewTxBuilder
-	NewTxBuilderFromCLI               = types.NewTxBuilderFromCLI
-	MakeSignature                     = types.MakeSignature
+	NewAnteHandler                     = ante.NewAnteHandler
+	GasPriceTxPriority                 = ante.GasPriceTxPriority
+	NewSigPreVerifier                  = ante.NewSigPreVerifier
+	NewFeeGrantAnteHandler             = ante.NewFeeGrantAnteHandler
+	DefaultAnteDecorators              = ante.DefaultAnteDecorators
+	NewSetUpContextDecorator           = ante.NewSetUpContextDecorator
+	NewMempoolFeeDecorator             = ante.NewMempoolFeeDecorator
+	NewValidateBasicDecorator          = ante.NewValidateBasicDecorator
+	NewValidateMemoDecorator           = ante.NewValidateMemoDecorator
+	NewConsumeTxSizeDecorator          = ante.NewConsumeTxSizeDecorator
+	NewDeductFeeDecorator              = ante.NewDeductFeeDecorator
+	NewValidateSigCountDecorator       = ante.NewValidateSigCountDecorator
+	NewSetPubKeyDecorator              = ante.NewSetPubKeyDecorator
+	NewSigGasConsumeDecorator          = ante.NewSigGasConsumeDecorator
+	NewSigVerificationDecorator        = ante.NewSigVerificationDecorator
+	NewTxTimeoutHeightDecorator        = ante.NewTxTimeoutHeightDecorator
+	NewUnorderedTxDecorator            = ante.NewUnorderedTxDecorator
+	NewIncrementSequenceDecorator      = ante.NewIncrementSequenceDecorator
+	GetSignerAcc                       = ante.GetSignerAcc
+	GetFeePayerAcc                     = ante.GetFeePayerAcc
+	ValidateSigCount                   = ante.ValidateSigCount
+	ValidateMemo                       = ante.ValidateMemo
+	ProcessPubKey                      = ante.ProcessPubKey
+	DefaultSigVerificationGasConsumer  = ante.DefaultSigVerificationGasConsumer
+	DeductFees                         = ante.DeductFees
+	EnsureSufficientMempoolFees        = ante.EnsureSufficientMempoolFees
+	EnsureSufficientMempoolFeePolicies = ante.EnsureSufficientMempoolFeePolicies
+	SetGasMeter                        = ante.SetGasMeter
+	GetSignBytes                       = ante.GetSignBytes
+	NewAccountKeeper                   = keeper.NewAccountKeeper
+	NewQuerier                         = keeper.NewQuerier
+	NewBaseAccount                     = types.NewBaseAccount
+	ProtoBaseAccount                   = types.ProtoBaseAccount
+	NewBaseAccountWithAddress          = types.NewBaseAccountWithAddress
+	NewBaseVestingAccount              = types.NewBaseVestingAccount
+	NewContinuousVestingAccountRaw     = types.NewContinuousVestingAccountRaw
+	NewContinuousVestingAccount        = types.NewContinuousVestingAccount
+	NewDelayedVestingAccountRaw        = types.NewDelayedVestingAccountRaw
+	NewDelayedVestingAccount           = types.NewDelayedVestingAccount
+	NewPeriodicVestingAccountRaw       = types.NewPeriodicVestingAccountRaw
+	NewPeriodicVestingAccount          = types.NewPeriodicVestingAccount
+	NewPeriod                          = types.NewPeriod
+	NewAccountRetriever                = types.NewAccountRetriever
+	RegisterCodec                      = types.RegisterCodec
+	NewAminoCodec                      = types.NewAminoCodec
+	NewGenesisState                    = types.NewGenesisState
+	DefaultGenesisState                = types.DefaultGenesisState
+	ValidateGenesis                    = types.ValidateGenesis
+	AddressStoreKey                    = types.AddressStoreKey
+	UnorderedNoncesByHeightKey         = types.UnorderedNoncesByHeightKey
+	UnorderedNonceKey                  = types.UnorderedNonceKey
+	NewParams                          = types.NewParams
+	ParamKeyTable                      = types.ParamKeyTable
+	DefaultParams                      = types.DefaultParams
+	NewQueryAccountParams              = types.NewQueryAccountParams
+	NewStdTx                           = types.NewStdTx
+	CountSubKeys                       = types.CountSubKeys
+	NewStdFee                          = types.NewStdFee
+	StdSignBytes                       = types.StdSignBytes
+	StdSignBytesWithTimeout            = types.StdSignBytesWithTimeout
+	DefaultTxDecoder                   = types.DefaultTxDecoder
+	DefaultTxEncoder                   = types.DefaultTxEncoder
+	NewTxBuilder                       = types.NewTxBuilder
+	NewTxBuilderFromCLI                = types.NewTxBuilderFromCLI
+	MakeSignature                      = types.MakeSignature
 
 	// variable aliases
 	ModuleCdc                 = types.ModuleCdc
 	AddressStoreKeyPrefix     = types.AddressStoreKeyPrefix
 	GlobalAccountNumberKey    = types.GlobalAccountNumberKey
+	UnorderedNonceKeyPrefix   = types.UnorderedNonceKeyPrefix
 	KeyMaxMemoCharacters      = types.KeyMaxMemoCharacters
 	KeyTxSigLimit             = types.KeyTxSigLimit
 	KeyTxSizeCostPerByte      = types.KeyTxSizeCostPerByte
@@ -82,6 +111,19 @@ var (
 
 type (
 	SignatureVerificationGasConsumer = ante.SignatureVerificationGasConsumer
+	SetUpContextDecorator            = ante.SetUpContextDecorator
+	MempoolFeeDecorator              = ante.MempoolFeeDecorator
+	ValidateBasicDecorator           = ante.ValidateBasicDecorator
+	ValidateMemoDecorator            = ante.ValidateMemoDecorator
+	ConsumeTxSizeDecorator           = ante.ConsumeTxSizeDecorator
+	DeductFeeDecorator               = ante.DeductFeeDecorator
+	ValidateSigCountDecorator        = ante.ValidateSigCountDecorator
+	SetPubKeyDecorator               = ante.SetPubKeyDecorator
+	SigGasConsumeDecorator           = ante.SigGasConsumeDecorator
+	SigVerificationDecorator         = ante.SigVerificationDecorator
+	TxTimeoutHeightDecorator         = ante.TxTimeoutHeightDecorator
+	UnorderedTxDecorator             = ante.UnorderedTxDecorator
+	IncrementSequenceDecorator       = ante.IncrementSequenceDecorator
 	Account                          = exported.Account
 	VestingAccount                   = exported.VestingAccount
 	AccountKeeper                    = keeper.AccountKeeper
@@ -89,7 +131,12 @@ type (
 	BaseVestingAccount               = types.BaseVestingAccount
 	ContinuousVestingAccount         = types.ContinuousVestingAccount
 	DelayedVestingAccount            = types.DelayedVestingAccount
+	PeriodicVestingAccount           = types.PeriodicVestingAccount
+	Period                           = types.Period
+	Periods                          = types.Periods
 	NodeQuerier                      = types.NodeQuerier
+	Codec                            = types.Codec
+	AminoCodec                       = types.AminoCodec
 	AccountRetriever                 = types.AccountRetriever
 	GenesisState                     = types.GenesisState
 	Params                           = types.Params
@@ -38,121 +38,45 @@ type SignatureVerificationGasConsumer = func(meter sdk.GasMeter, sig []byte, pub
 // numbers, checks signatures & account numbers, and deducts fees from the first
 // signer.
 func NewAnteHandler(ak keeper.AccountKeeper, supplyKeeper types.SupplyKeeper, sigGasConsumer SignatureVerificationGasConsumer) sdk.AnteHandler {
-	return func(
-		ctx sdk.Context, tx sdk.Tx, simulate bool,
-	) (newCtx sdk.Context, res sdk.Result, abort bool) {
-
-		if addr := supplyKeeper.GetModuleAddress(types.FeeCollectorName); addr == nil {
-			panic(fmt.Sprintf("%s module account has not been set", types.FeeCollectorName))
-		}
-
-		// all transactions must be of type auth.StdTx
-		stdTx, ok := tx.(types.StdTx)
-		if !ok {
-			// Set a gas meter with limit 0 as to prevent an infinite gas meter attack
-			// during runTx.
-			newCtx = SetGasMeter(simulate, ctx, 0)
-			return newCtx, sdk.ErrInternal("tx must be StdTx").Result(), true
-		}
-
-		params := ak.GetParams(ctx)
-
-		// Ensure that the provided fees meet a minimum threshold for the validator,
-		// if this is a CheckTx. This is only for local mempool purposes, and thus
-		// is only ran on check tx.
-		if ctx.IsCheckTx() && !simulate {
-			res := EnsureSufficientMempoolFees(ctx, stdTx.Fee)
-			if !res.IsOK() {
-				return newCtx, res, true
-			}
-		}
-
-		newCtx = SetGasMeter(simulate, ctx, stdTx.Fee.Gas)
-
-		// AnteHandlers must have their own defer/recover in order for the BaseApp
-		// to know how much gas was used! This is because the GasMeter is created in
-		// the AnteHandler, but if it panics the context won't be set properly in
-		// runTx's recover call.
-		defer func() {
-			if r := recover(); r != nil {
-				switch rType := r.(type) {
-				case sdk.ErrorOutOfGas:
-					log := fmt.Sprintf(
-						"out of gas in location: %v; gasWanted: %d, gasUsed: %d",
-						rType.Descriptor, stdTx.Fee.Gas, newCtx.GasMeter().GasConsumed(),
-					)
-					res = sdk.ErrOutOfGas(log).Result()
-
-					res.GasWanted = stdTx.Fee.Gas
-					res.GasUsed = newCtx.GasMeter().GasConsumed()
-					abort = true
-				default:
-					panic(r)
-				}
-			}
-		}()
-
-		if res := ValidateSigCount(stdTx, params); !res.IsOK() {
-			return newCtx, res, true
-		}
-
-		if err := tx.ValidateBasic(); err != nil {
-			return newCtx, err.Result(), true
-		}
-
-		newCtx.GasMeter().ConsumeGas(params.TxSizeCostPerByte*sdk.Gas(len(newCtx.TxBytes())), "txSize")
-
-		if res := ValidateMemo(stdTx, params); !res.IsOK() {
-			return newCtx, res, true
-		}
-
-		// stdSigs contains the sequence number, account number, and signatures.
-		// When simulating, this would just be a 0-length slice.
-		signerAddrs := stdTx.GetSigners()
-		signerAccs := make([]exported.Account, len(signerAddrs))
-		isGenesis := ctx.BlockHeight() == 0
-
-		// fetch first signer, who's going to pay the fees
-		signerAccs[0], res = GetSignerAcc(newCtx, ak, signerAddrs[0])
-		if !res.IsOK() {
-			return newCtx, res, true
-		}
-
-		// deduct the fees
-		if !stdTx.Fee.Amount.IsZero() {
-			res = DeductFees(supplyKeeper, newCtx, signerAccs[0], stdTx.Fee.Amount)
-			if !res.IsOK() {
-				return newCtx, res, true
-			}
+	return NewFeeGrantAnteHandler(ak, supplyKeeper, nil, sigGasConsumer)
+}
 
-			// reload the account as fees have been deducted
-			signerAccs[0] = ak.GetAccount(newCtx, signerAccs[0].GetAddress())
-		}
+// NewFeeGrantAnteHandler returns an AnteHandler that behaves like the one
+// returned by NewAnteHandler, except that when the transaction fee names a
+// granter, the fees are deducted from the granter's account provided it has
+// granted a valid fee allowance to the first signer. A nil feeGrantKeeper
+// disables fee grants and rejects any transaction whose fee names a granter.
+func NewFeeGrantAnteHandler(
+	ak keeper.AccountKeeper, supplyKeeper types.SupplyKeeper, feeGrantKeeper types.FeeGrantKeeper,
+	sigGasConsumer SignatureVerificationGasConsumer,
+) sdk.AnteHandler {
 
-		// stdSigs contains the sequence number, account number, and signatures.
-		// When simulating, this would just be a 0-length slice.
-		stdSigs := stdTx.GetSignatures()
-
-		for i := 0; i < len(stdSigs); i++ {
-			// skip the fee payer, account is cached and fees were deducted already
-			if i != 0 {
-				signerAccs[i], res = GetSignerAcc(newCtx, ak, signerAddrs[i])
-				if !res.IsOK() {
-					return newCtx, res, true
-				}
-			}
-
-			// check signature, return account with incremented nonce
-			signBytes := GetSignBytes(newCtx.ChainID(), stdTx, signerAccs[i], isGenesis)
-			signerAccs[i], res = processSig(newCtx, signerAccs[i], stdSigs[i], signBytes, simulate, params, sigGasConsumer)
-			if !res.IsOK() {
-				return newCtx, res, true
-			}
-
-			ak.SetAccount(newCtx, signerAccs[i])
-		}
+	return sdk.ChainAnteDecorators(DefaultAnteDecorators(ak, supplyKeeper, feeGrantKeeper, sigGasConsumer)...)
+}
 
-		return newCtx, sdk.Result{GasWanted: stdTx.Fee.Gas}, false // continue...
+// DefaultAnteDecorators returns the chain of AnteDecorators of the
+// AnteHandler returned by NewFeeGrantAnteHandler. Apps may insert their own
+// decorators into the chain and build their AnteHandler with
+// sdk.ChainAnteDecorators. The SetUpContextDecorator must stay first.
+func DefaultAnteDecorators(
+	ak keeper.AccountKeeper, supplyKeeper types.SupplyKeeper, feeGrantKeeper types.FeeGrantKeeper,
+	sigGasConsumer SignatureVerificationGasConsumer,
+) []sdk.AnteDecorator {
+
+	return []sdk.AnteDecorator{
+		NewSetUpContextDecorator(),
+		NewMempoolFeeDecorator(),
+		NewValidateSigCountDecorator(ak),
+		NewValidateBasicDecorator(),
+		NewTxTimeoutHeightDecorator(),
+		NewConsumeTxSizeDecorator(ak),
+		NewValidateMemoDecorator(ak),
+		NewDeductFeeDecorator(ak, supplyKeeper, feeGrantKeeper),
+		NewSetPubKeyDecorator(ak),
+		NewSigGasConsumeDecorator(ak, sigGasConsumer),
+		NewSigVerificationDecorator(ak),
+		NewUnorderedTxDecorator(ak, DefaultMaxUnorderedTxTimeout),
+		NewIncrementSequenceDecorator(ak),
 	}
 }
 
@@ -165,6 +89,35 @@ func GetSignerAcc(ctx sdk.Context, ak keeper.AccountKeeper, addr sdk.AccAddress)
 	return nil, sdk.ErrUnknownAddress(fmt.Sprintf("account %s does not exist", addr)).Result()
 }
 
+// GetFeePayerAcc returns the account that pays the fees of a transaction. This
+// is the first signer unless the fee names a granter other than the first
+// signer, in which case the granted fee allowance is consumed and the granter's
+// account is returned.
+func GetFeePayerAcc(
+	ctx sdk.Context, ak keeper.AccountKeeper, feeGrantKeeper types.FeeGrantKeeper,
+	fee types.StdFee, firstSigner exported.Account,
+) (exported.Account, sdk.Result) {
+
+	if fee.Granter.Empty() || fee.Granter.Equals(firstSigner.GetAddress()) {
+		return firstSigner, sdk.Result{}
+	}
+
+	if feeGrantKeeper == nil {
+		return nil, sdk.ErrUnauthorized("fee grants are not enabled").Result()
+	}
+
+	if err := feeGrantKeeper.UseGrantedFees(ctx, fee.Granter, firstSigner.GetAddress(), fee.Amount); err != nil {
+		return nil, err.Result()
+	}
+
+	granterAcc := ak.GetAccount(ctx, fee.Granter)
+	if granterAcc == nil {
+		return nil, sdk.ErrUnknownAddress(fmt.Sprintf("fee granter %s does not exist", fee.Granter)).Result()
+	}
+
+	return granterAcc, sdk.Result{}
+}
+
 // ValidateSigCount validates that the transaction has a valid cumulative total
 // amount of signatures.
 func ValidateSigCount(stdTx types.StdTx, params types.Params) sdk.Result {
@@ -198,46 +151,6 @@ func ValidateMemo(stdTx types.StdTx, params types.Params) sdk.Result {
 	return sdk.Result{}
 }
 
-// verify the signature and increment the sequence. If the account doesn't have
-// a pubkey, set it.
-func processSig(
-	ctx sdk.Context, acc exported.Account, sig types.StdSignature, signBytes []byte, simulate bool, params types.Params,
-	sigGasConsumer SignatureVerificationGasConsumer,
-) (updatedAcc exported.Account, res sdk.Result) {
-
-	pubKey, res := ProcessPubKey(acc, sig, simulate)
-	if !res.IsOK() {
-		return nil, res
-	}
-
-	err := acc.SetPubKey(pubKey)
-	if err != nil {
-		return nil, sdk.ErrInternal("setting PubKey on signer's account").Result()
-	}
-
-	if simulate {
-		// Simulated txs should not contain a signature and are not required to
-		// contain a pubkey, so we must account for tx size of including a
-		// StdSignature (Amino encoding) and simulate gas consumption
-		// (assuming a SECP256k1 simulation key).
-		consumeSimSigGas(ctx.GasMeter(), pubKey, sig, params)
-	}
-
-	if res := sigGasConsumer(ctx.GasMeter(), sig.Signature, pubKey, params); !res.IsOK() {
-		return nil, res
-	}
-
-	if !simulate && !pubKey.VerifyBytes(signBytes, sig.Signature) {
-		return nil, sdk.ErrUnauthorized("signature verification failed; verify correct account sequence and chain-id").Result()
-	}
-
-	if err := acc.SetSequence(acc.GetSequence() + 1); err != nil {
-		panic(err)
-	}
-
-	return acc, res
-}
-
 func consumeSimSigGas(gasmeter sdk.GasMeter, pubkey crypto.PubKey, sig types.StdSignature, params types.Params) {
 	simSig := types.StdSignature{PubKey: pubkey}
 	if len(sig.Signature) == 0 {
@@ -374,28 +287,72 @@ func DeductFees(supplyKeeper types.SupplyKeeper, ctx sdk.Context, acc exported.A
 // Contract: This should only be called during CheckTx as it cannot be part of
 // consensus.
 func EnsureSufficientMempoolFees(ctx sdk.Context, stdFee types.StdFee) sdk.Result {
-	minGasPrices := ctx.MinGasPrices()
-	if !minGasPrices.IsZero() {
-		requiredFees := make(sdk.Coins, len(minGasPrices))
-
-		// Determine the required fees by multiplying each required minimum gas
-		// price by the gas limit, where fee = ceil(minGasPrice * gasLimit).
-		glDec := sdk.NewDec(int64(stdFee.Gas))
-		for i, gp := range minGasPrices {
-			fee := gp.Amount.Mul(glDec)
-			requiredFees[i] = sdk.NewCoin(gp.Denom, fee.Ceil().RoundInt())
+	requiredFees := sdk.FeePolicy{MinGasPrices: ctx.MinGasPrices()}.RequiredFees(stdFee.Gas)
+	if !requiredFees.Empty() && !stdFee.Amount.IsAnyGTE(requiredFees) {
+		return sdk.ErrInsufficientFee(
+			fmt.Sprintf(
+				"insufficient fees; got: %q required: %q", stdFee.Amount, requiredFees,
+			),
+		).Result()
+	}
+
+	return sdk.Result{}
+}
+
+// EnsureSufficientMempoolFeePolicies verifies that the given transaction has
+// supplied enough fees to cover the fee policy of each of its messages, and
+// the proposer's minimum fees for the messages without a policy. It returns
+// the names of the fee policies applied to the transaction.
+//
+// Contract: This should only be called during CheckTx as it cannot be part of
+// consensus.
+func EnsureSufficientMempoolFeePolicies(ctx sdk.Context, stdTx types.StdTx) ([]string, sdk.Result) {
+	var (
+		policies   []string
+		minGasFees bool
+	)
+
+	for _, msg := range stdTx.GetMsgs() {
+		fp, ok := ctx.FeePolicies().Match(msg)
+		if !ok {
+			minGasFees = true
+			continue
 		}
 
-		if !stdFee.Amount.IsAnyGTE(requiredFees) {
-			return sdk.ErrInsufficientFee(
+		if containsString(policies, fp.Name()) {
+			continue
+		}
+
+		requiredFees := fp.RequiredFees(stdTx.Fee.Gas)
+		if !requiredFees.Empty() && !stdTx.Fee.Amount.IsAnyGTE(requiredFees) {
+			return nil, sdk.ErrInsufficientFee(
 				fmt.Sprintf(
-					"insufficient fees; got: %q required: %q", stdFee.Amount, requiredFees,
+					"insufficient fees for fee policy %s; got: %q required: %q",
+					fp.Name(), stdTx.Fee.Amount, requiredFees,
 				),
 			).Result()
 		}
+
+		policies = append(policies, fp.Name())
+	}
+
+	if minGasFees {
+		if res := EnsureSufficientMempoolFees(ctx, stdTx.Fee); !res.IsOK() {
+			return nil, res
+		}
 	}
 
-	return sdk.Result{}
+	return policies, sdk.Result{}
+}
+
+func containsString(strs []string, str string) bool {
+	for _, s := range strs {
+		if s == str {
+			return true
+		}
+	}
+
+	return false
 }
 
 // SetGasMeter returns a new context with a gas meter set from a given context.
@@ -417,7 +374,5 @@ func GetSignBytes(chainID string, stdTx types.StdTx, acc exported.Account, genes
 		accNum = acc.GetAccountNumber()
 	}
 
-	return types.StdSignBytes(
-		chainID, accNum, acc.GetSequence(), stdTx.Fee, stdTx.Msgs, stdTx.Memo,
-	)
+	return stdTx.SignBytes(chainID, accNum, acc.GetSequence())
 }
@@ -2,6 +2,7 @@ package ante_test
 
 import (
 	"fmt"
+	"math"
 	"math/rand"
 	"strings"
 	"testing"
@@ -819,3 +820,332 @@ func TestCustomSignatureVerificationGasConsumer(t *testing.T) {
 	tx = types.NewTestTx(ctx, msgs, privs, accnums, seqs, fee)
 	checkValidTx(t, anteHandler, ctx, tx, false)
 }
+
+// rejectMsgsDecorator rejects any transaction with more than max messages.
+type rejectMsgsDecorator struct {
+	max int
+}
+
+func (rmd rejectMsgsDecorator) AnteHandle(
+	ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler,
+) (sdk.Context, sdk.Result, bool) {
+
+	if len(tx.GetMsgs()) > rmd.max {
+		return ctx, sdk.ErrUnknownRequest("too many messages").Result(), true
+	}
+
+	return next(ctx, tx, simulate)
+}
+
+func TestCustomAnteDecorators(t *testing.T) {
+	// setup
+	app, ctx := createTestApp(true)
+	ctx = ctx.WithBlockHeight(1)
+
+	// insert a custom decorator right after the context is set up
+	decorators := ante.DefaultAnteDecorators(app.AccountKeeper, app.SupplyKeeper, nil, ante.DefaultSigVerificationGasConsumer)
+	decorators = append(decorators[:1], append([]sdk.AnteDecorator{rejectMsgsDecorator{max: 1}}, decorators[1:]...)...)
+	anteHandler := sdk.ChainAnteDecorators(decorators...)
+
+	priv1, _, addr1 := types.KeyTestPubAddr()
+	acc1 := app.AccountKeeper.NewAccountWithAddress(ctx, addr1)
+	require.NoError(t, acc1.SetCoins(sdk.NewCoins(sdk.NewInt64Coin("atom", 150))))
+	app.AccountKeeper.SetAccount(ctx, acc1)
+
+	privs, accnums, seqs := []crypto.PrivKey{priv1}, []uint64{0}, []uint64{0}
+	fee := types.NewTestStdFee()
+
+	// the custom decorator rejects the tx before the sequence is incremented
+	msgs := []sdk.Msg{types.NewTestMsg(addr1), types.NewTestMsg(addr1)}
+	tx := types.NewTestTx(ctx, msgs, privs, accnums, seqs, fee)
+	checkInvalidTx(t, anteHandler, ctx, tx, false, sdk.CodeUnknownRequest)
+	require.Equal(t, uint64(0), app.AccountKeeper.GetAccount(ctx, addr1).GetSequence())
+
+	// the rest of the default chain still applies
+	msgs = []sdk.Msg{types.NewTestMsg(addr1)}
+	tx = types.NewTestTx(ctx, msgs, privs, accnums, seqs, fee)
+	checkValidTx(t, anteHandler, ctx, tx, false)
+	require.Equal(t, uint64(1), app.AccountKeeper.GetAccount(ctx, addr1).GetSequence())
+}
+
+func TestEnsureSufficientMempoolFeePolicies(t *testing.T) {
+	// setup
+	_, ctx := createTestApp(true)
+	ctx = ctx.WithMinGasPrices(sdk.NewDecCoins(sdk.NewCoins(sdk.NewInt64Coin("stake", 1))))
+
+	_, _, addr1 := types.KeyTestPubAddr()
+	msgs := []sdk.Msg{sdk.NewTestMsg(addr1)}
+	photino := func(amt int64) sdk.Coins { return sdk.NewCoins(sdk.NewInt64Coin("photino", amt)) }
+
+	testCases := []struct {
+		name       string
+		policies   sdk.FeePolicies
+		fee        types.StdFee
+		expectedOK bool
+		applied    []string
+	}{
+		{"no policy", nil, types.NewStdFee(1000, photino(1000)), false, nil},
+		{
+			"free route",
+			sdk.FeePolicies{sdk.NewFeePolicy("TestMsg", "", nil, 0)},
+			types.NewStdFee(1000, sdk.Coins{}), true, []string{"TestMsg"},
+		},
+		{
+			"type takes precedence over route",
+			sdk.FeePolicies{
+				sdk.NewFeePolicy("TestMsg", "", nil, 0),
+				sdk.NewFeePolicy("TestMsg", "Test message", sdk.NewDecCoins(photino(2)), 0),
+			},
+			types.NewStdFee(1000, photino(1999)), false, nil,
+		},
+		{
+			"sufficient fees",
+			sdk.FeePolicies{sdk.NewFeePolicy("TestMsg", "Test message", sdk.NewDecCoins(photino(2)), 0)},
+			types.NewStdFee(1000, photino(2000)), true, []string{"TestMsg/Test message"},
+		},
+		{
+			"free gas",
+			sdk.FeePolicies{sdk.NewFeePolicy("TestMsg", "Test message", sdk.NewDecCoins(photino(2)), 400)},
+			types.NewStdFee(1000, photino(1200)), true, []string{"TestMsg/Test message"},
+		},
+		{
+			"other type",
+			sdk.FeePolicies{sdk.NewFeePolicy("TestMsg", "other", nil, 0)},
+			types.NewStdFee(1000, sdk.Coins{}), false, nil,
+		},
+	}
+
+	for _, tc := range testCases {
+		tx := types.NewStdTx(msgs, tc.fee, nil, "")
+		applied, res := ante.EnsureSufficientMempoolFeePolicies(ctx.WithFeePolicies(tc.policies), tx)
+		require.Equal(t, tc.expectedOK, res.IsOK(), "%s: %s", tc.name, res.Log)
+		require.Equal(t, tc.applied, applied, tc.name)
+	}
+}
+
+func TestAnteHandlerFeePolicyLog(t *testing.T) {
+	// setup
+	app, ctx := createTestApp(true)
+	ctx = ctx.WithBlockHeight(1).
+		WithMinGasPrices(sdk.NewDecCoins(sdk.NewCoins(sdk.NewInt64Coin("stake", 1)))).
+		WithFeePolicies(sdk.FeePolicies{sdk.NewFeePolicy("TestMsg", "", nil, 0)})
+	anteHandler := ante.NewAnteHandler(app.AccountKeeper, app.SupplyKeeper, ante.DefaultSigVerificationGasConsumer)
+
+	priv1, _, addr1 := types.KeyTestPubAddr()
+	acc1 := app.AccountKeeper.NewAccountWithAddress(ctx, addr1)
+	app.AccountKeeper.SetAccount(ctx, acc1)
+
+	// the tx pays no fees under the policy of its message
+	msgs := []sdk.Msg{types.NewTestMsg(addr1)}
+	fee := types.NewStdFee(50000, sdk.Coins{})
+	tx := types.NewTestTx(ctx, msgs, []crypto.PrivKey{priv1}, []uint64{0}, []uint64{0}, fee)
+
+	_, res, abort := anteHandler(ctx, tx, false)
+	require.False(t, abort, res.Log)
+	require.Equal(t, "fee policy: TestMsg", res.Log)
+
+	// the policy is not reported outside of CheckTx
+	tx = types.NewTestTx(ctx, msgs, []crypto.PrivKey{priv1}, []uint64{0}, []uint64{1}, fee)
+	_, res, abort = anteHandler(ctx.WithIsCheckTx(false), tx, false)
+	require.False(t, abort, res.Log)
+	require.Empty(t, res.Log)
+}
+
+func TestAnteHandlerTimeoutHeight(t *testing.T) {
+	// setup
+	app, ctx := createTestApp(false)
+	ctx = ctx.WithBlockHeight(10)
+	anteHandler := ante.NewAnteHandler(app.AccountKeeper, app.SupplyKeeper, ante.DefaultSigVerificationGasConsumer)
+
+	priv1, _, addr1 := types.KeyTestPubAddr()
+	acc1 := app.AccountKeeper.NewAccountWithAddress(ctx, addr1)
+	require.NoError(t, acc1.SetCoins(types.NewTestCoins()))
+	require.NoError(t, acc1.SetAccountNumber(0))
+	app.AccountKeeper.SetAccount(ctx, acc1)
+
+	msgs := []sdk.Msg{types.NewTestMsg(addr1)}
+	privs, accnums := []crypto.PrivKey{priv1}, []uint64{0}
+	fee := types.NewTestStdFee()
+
+	// the tx is rejected past its timeout height
+	tx := types.NewTestTxWithTimeout(ctx, msgs, privs, accnums, []uint64{0}, fee, 9, 0)
+	checkInvalidTx(t, anteHandler, ctx, tx, false, sdk.CodeTxTimeout)
+
+	// the tx is accepted up to its timeout height
+	tx = types.NewTestTxWithTimeout(ctx, msgs, privs, accnums, []uint64{0}, fee, 10, 0)
+	checkValidTx(t, anteHandler, ctx, tx, false)
+
+	// the timeout height is signed
+	stdTx := types.NewTestTxWithTimeout(ctx, msgs, privs, accnums, []uint64{1}, fee, 10, 0).(types.StdTx)
+	checkInvalidTx(t, anteHandler, ctx, stdTx.WithTimeoutHeight(11), false, sdk.CodeUnauthorized)
+}
+
+func TestAnteHandlerUnorderedTx(t *testing.T) {
+	// setup
+	app, ctx := createTestApp(false)
+	ctx = ctx.WithBlockHeight(10)
+	anteHandler := ante.NewAnteHandler(app.AccountKeeper, app.SupplyKeeper, ante.DefaultSigVerificationGasConsumer)
+
+	priv1, _, addr1 := types.KeyTestPubAddr()
+	acc1 := app.AccountKeeper.NewAccountWithAddress(ctx, addr1)
+	require.NoError(t, acc1.SetCoins(types.NewTestCoins()))
+	require.NoError(t, acc1.SetAccountNumber(0))
+	require.NoError(t, acc1.SetSequence(5))
+	app.AccountKeeper.SetAccount(ctx, acc1)
+
+	msgs := []sdk.Msg{types.NewTestMsg(addr1)}
+	privs, accnums, seqs := []crypto.PrivKey{priv1}, []uint64{0}, []uint64{5}
+	fee := types.NewTestStdFee()
+
+	// unordered txs with distinct nonces are accepted in any order and do not
+	// consume the sequence
+	for _, nonce := range []uint64{3, 1, 2} {
+		tx := types.NewTestTxWithTimeout(ctx, msgs, privs, accnums, seqs, fee, 20, nonce)
+		checkValidTx(t, anteHandler, ctx, tx, false)
+	}
+	require.Equal(t, uint64(5), app.AccountKeeper.GetAccount(ctx, addr1).GetSequence())
+
+	// a replayed unordered tx is rejected
+	tx := types.NewTestTxWithTimeout(ctx, msgs, privs, accnums, seqs, fee, 20, 1)
+	checkInvalidTx(t, anteHandler, ctx, tx, false, sdk.CodeInvalidSequence)
+
+	// the nonce may be reused with another timeout height
+	tx = types.NewTestTxWithTimeout(ctx, msgs, privs, accnums, seqs, fee, 21, 1)
+	checkValidTx(t, anteHandler, ctx, tx, false)
+
+	// the timeout height may not be too far ahead
+	tx = types.NewTestTxWithTimeout(ctx, msgs, privs, accnums, seqs, fee, 11+ante.DefaultMaxUnorderedTxTimeout, 4)
+	checkInvalidTx(t, anteHandler, ctx, tx, false, sdk.CodeUnknownRequest)
+
+	// ordered txs still consume the sequence
+	tx = types.NewTestTx(ctx, msgs, privs, accnums, seqs, fee)
+	checkValidTx(t, anteHandler, ctx, tx, false)
+	require.Equal(t, uint64(6), app.AccountKeeper.GetAccount(ctx, addr1).GetSequence())
+
+	// the used nonces are removed once they time out, when the tx is rejected
+	// for its timeout height instead
+	app.AccountKeeper.RemoveExpiredUnorderedNonces(ctx, 20)
+	require.False(t, app.AccountKeeper.HasUnorderedNonce(ctx, addr1, 1, 20))
+	require.True(t, app.AccountKeeper.HasUnorderedNonce(ctx, addr1, 1, 21))
+
+	tx = types.NewTestTxWithTimeout(ctx, msgs, privs, accnums, seqs, fee, 20, 1)
+	checkInvalidTx(t, anteHandler, ctx.WithBlockHeight(21), tx, false, sdk.CodeTxTimeout)
+}
+
+func TestGasPriceTxPriority(t *testing.T) {
+	// setup
+	app, ctx := createTestApp(false)
+	txPriority := ante.GasPriceTxPriority(app.AccountKeeper, "atom")
+
+	priv1, _, addr1 := types.KeyTestPubAddr()
+	acc1 := app.AccountKeeper.NewAccountWithAddress(ctx, addr1)
+	require.NoError(t, acc1.SetSequence(3))
+	app.AccountKeeper.SetAccount(ctx, acc1)
+
+	msgs := []sdk.Msg{types.NewTestMsg(addr1)}
+	privs, accnums, seqs := []crypto.PrivKey{priv1}, []uint64{0}, []uint64{3}
+	slot := fmt.Sprintf("%s/3", addr1)
+
+	testCases := []struct {
+		fee      types.StdFee
+		priority int64
+	}{
+		{types.NewStdFee(100000, sdk.NewCoins(sdk.NewInt64Coin("atom", 150))), 1500},
+		{types.NewStdFee(100000, sdk.NewCoins(sdk.NewInt64Coin("atom", 1))), 10},
+		{types.NewStdFee(100000, sdk.NewCoins(sdk.NewInt64Coin("photon", 150))), 0},
+		{types.NewStdFee(0, sdk.NewCoins(sdk.NewInt64Coin("atom", 150))), 0},
+		{types.NewStdFee(1, sdk.NewCoins(sdk.NewCoin("atom", sdk.NewIntWithDecimal(1, 30)))), math.MaxInt64},
+	}
+
+	for i, tc := range testCases {
+		tx := types.NewTestTx(ctx, msgs, privs, accnums, seqs, tc.fee)
+		priority, txSlot := txPriority(ctx, tx)
+		require.Equal(t, tc.priority, priority, "test case %d", i)
+		require.Equal(t, slot, txSlot, "test case %d", i)
+	}
+
+	// unordered txs have no slot
+	tx := types.NewTestTxWithTimeout(ctx, msgs, privs, accnums, seqs, types.NewTestStdFee(), 20, 1)
+	priority, txSlot := txPriority(ctx, tx)
+	require.Equal(t, int64(3000), priority)
+	require.Empty(t, txSlot)
+}
+
+func TestSigVerificationCache(t *testing.T) {
+	// setup
+	app, ctx := createTestApp(false)
+	ctx = ctx.WithBlockHeight(1)
+	anteHandler := ante.NewAnteHandler(app.AccountKeeper, app.SupplyKeeper, ante.DefaultSigVerificationGasConsumer)
+
+	priv1, _, addr1 := types.KeyTestPubAddr()
+	priv2, _, addr2 := types.KeyTestPubAddr()
+	acc1 := app.AccountKeeper.NewAccountWithAddress(ctx, addr1)
+	require.NoError(t, acc1.SetCoins(types.NewTestCoins()))
+	require.NoError(t, acc1.SetAccountNumber(0))
+	app.AccountKeeper.SetAccount(ctx, acc1)
+	acc2 := app.AccountKeeper.NewAccountWithAddress(ctx, addr2)
+	require.NoError(t, acc2.SetAccountNumber(1))
+	app.AccountKeeper.SetAccount(ctx, acc2)
+
+	msgs := []sdk.Msg{types.NewTestMsg(addr1, addr2)}
+	privs, accnums, seqs := []crypto.PrivKey{priv1, priv2}, []uint64{0, 1}, []uint64{0, 0}
+	tx := types.NewTestTx(ctx, msgs, privs, accnums, seqs, types.NewTestStdFee())
+
+	// the signatures verified through the cache consume the same gas
+	serialCtx, _ := ctx.CacheContext()
+	serialCtx, res, abort := anteHandler(serialCtx, tx, false)
+	require.False(t, abort, res.Log)
+
+	cache := sdk.NewSigCache(10)
+	cachedCtx, _ := ctx.CacheContext()
+	cachedCtx, res, abort = anteHandler(cachedCtx.WithSigCache(cache), tx, false)
+	require.False(t, abort, res.Log)
+	require.Equal(t, serialCtx.GasMeter().GasConsumed(), cachedCtx.GasMeter().GasConsumed())
+	require.Equal(t, 2, cache.Len())
+
+	// a cached signature is not valid for another sequence
+	acc1 = app.AccountKeeper.GetAccount(ctx, addr1)
+	require.NoError(t, acc1.SetSequence(1))
+	app.AccountKeeper.SetAccount(ctx, acc1)
+	checkInvalidTx(t, anteHandler, ctx.WithSigCache(cache), tx, false, sdk.CodeUnauthorized)
+}
+
+func TestSigPreVerifier(t *testing.T) {
+	// setup
+	app, ctx := createTestApp(false)
+	ctx = ctx.WithBlockHeight(1)
+	anteHandler := ante.NewAnteHandler(app.AccountKeeper, app.SupplyKeeper, ante.DefaultSigVerificationGasConsumer)
+	preVerifier := ante.NewSigPreVerifier(app.AccountKeeper)
+
+	priv1, _, addr1 := types.KeyTestPubAddr()
+	acc1 := app.AccountKeeper.NewAccountWithAddress(ctx, addr1)
+	require.NoError(t, acc1.SetCoins(types.NewTestCoins()))
+	require.NoError(t, acc1.SetAccountNumber(0))
+	app.AccountKeeper.SetAccount(ctx, acc1)
+
+	// two ordered txs and an unordered tx of the same signer in a block
+	msgs := []sdk.Msg{types.NewTestMsg(addr1)}
+	privs, accnums := []crypto.PrivKey{priv1}, []uint64{0}
+	txs := []sdk.Tx{
+		types.NewTestTx(ctx, msgs, privs, accnums, []uint64{0}, types.NewTestStdFee()),
+		types.NewTestTxWithTimeout(ctx, msgs, privs, accnums, []uint64{0}, types.NewTestStdFee(), 20, 1),
+		types.NewTestTx(ctx, msgs, privs, accnums, []uint64{1}, types.NewTestStdFee()),
+	}
+
+	cache := sdk.NewSigCache(10)
+	cache.PreVerify(preVerifier(ctx, txs))
+	require.Equal(t, 3, cache.Len())
+
+	// the txs only consult the cache, consuming the same gas as without it
+	for _, tx := range txs {
+		serialCtx, _ := ctx.CacheContext()
+		serialCtx, res, abort := anteHandler(serialCtx, tx, false)
+		require.False(t, abort, res.Log)
+
+		ctx, res, abort = anteHandler(ctx.WithSigCache(cache), tx, false)
+		require.False(t, abort, res.Log)
+		require.Equal(t, serialCtx.GasMeter().GasConsumed(), ctx.GasMeter().GasConsumed())
+		require.Equal(t, 3, cache.Len())
+		ctx = ctx.WithGasMeter(sdk.NewInfiniteGasMeter())
+	}
+}
@@ -0,0 +1,83 @@
+package ante
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth/keeper"
+	"github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+var (
+	_ sdk.AnteDecorator = ValidateBasicDecorator{}
+	_ sdk.AnteDecorator = ValidateMemoDecorator{}
+	_ sdk.AnteDecorator = ConsumeTxSizeDecorator{}
+)
+
+// ValidateBasicDecorator calls ValidateBasic on the transaction.
+type ValidateBasicDecorator struct{}
+
+// NewValidateBasicDecorator returns a new ValidateBasicDecorator.
+func NewValidateBasicDecorator() ValidateBasicDecorator {
+	return ValidateBasicDecorator{}
+}
+
+// AnteHandle implements the AnteDecorator interface.
+func (vbd ValidateBasicDecorator) AnteHandle(
+	ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler,
+) (sdk.Context, sdk.Result, bool) {
+
+	if err := tx.ValidateBasic(); err != nil {
+		return ctx, err.Result(), true
+	}
+
+	return next(ctx, tx, simulate)
+}
+
+// ValidateMemoDecorator validates that the memo of the transaction does not
+// exceed the MaxMemoCharacters param.
+type ValidateMemoDecorator struct {
+	ak keeper.AccountKeeper
+}
+
+// NewValidateMemoDecorator returns a new ValidateMemoDecorator.
+func NewValidateMemoDecorator(ak keeper.AccountKeeper) ValidateMemoDecorator {
+	return ValidateMemoDecorator{ak: ak}
+}
+
+// AnteHandle implements the AnteDecorator interface.
+func (vmd ValidateMemoDecorator) AnteHandle(
+	ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler,
+) (sdk.Context, sdk.Result, bool) {
+
+	stdTx, ok := tx.(types.StdTx)
+	if !ok {
+		return ctx, sdk.ErrInternal("tx must be StdTx").Result(), true
+	}
+
+	if res := ValidateMemo(stdTx, vmd.ak.GetParams(withoutGas(ctx))); !res.IsOK() {
+		return ctx, res, true
+	}
+
+	return next(ctx, tx, simulate)
+}
+
+// ConsumeTxSizeDecorator consumes gas proportional to the size of the
+// transaction bytes, as set by the TxSizeCostPerByte param.
+type ConsumeTxSizeDecorator struct {
+	ak keeper.AccountKeeper
+}
+
+// NewConsumeTxSizeDecorator returns a new ConsumeTxSizeDecorator.
+func NewConsumeTxSizeDecorator(ak keeper.AccountKeeper) ConsumeTxSizeDecorator {
+	return ConsumeTxSizeDecorator{ak: ak}
+}
+
+// AnteHandle implements the AnteDecorator interface.
+func (ctd ConsumeTxSizeDecorator) AnteHandle(
+	ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler,
+) (sdk.Context, sdk.Result, bool) {
+
+	params := ctd.ak.GetParams(withoutGas(ctx))
+	ctx.GasMeter().ConsumeGas(params.TxSizeCostPerByte*sdk.Gas(len(ctx.TxBytes())), "txSize")
+
+	return next(ctx, tx, simulate)
+}
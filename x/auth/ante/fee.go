@@ -0,0 +1,106 @@
+package ante
+
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth/keeper"
+	"github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+var (
+	_ sdk.AnteDecorator = MempoolFeeDecorator{}
+	_ sdk.AnteDecorator = DeductFeeDecorator{}
+)
+
+// MempoolFeeDecorator checks that the fees of the transaction meet the
+// validator's fee policies and minimum gas prices during CheckTx, and reports
+// the fee policies applied in the log of the result. It does nothing in
+// DeliverTx and when simulating, as the check is not part of consensus.
+type MempoolFeeDecorator struct{}
+
+// NewMempoolFeeDecorator returns a new MempoolFeeDecorator.
+func NewMempoolFeeDecorator() MempoolFeeDecorator {
+	return MempoolFeeDecorator{}
+}
+
+// AnteHandle implements the AnteDecorator interface.
+func (mfd MempoolFeeDecorator) AnteHandle(
+	ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler,
+) (sdk.Context, sdk.Result, bool) {
+
+	stdTx, ok := tx.(types.StdTx)
+	if !ok {
+		return ctx, sdk.ErrInternal("tx must be StdTx").Result(), true
+	}
+
+	if !ctx.IsCheckTx() || simulate {
+		return next(ctx, tx, simulate)
+	}
+
+	policies, res := EnsureSufficientMempoolFeePolicies(ctx, stdTx)
+	if !res.IsOK() {
+		return ctx, res, true
+	}
+
+	newCtx, res, abort := next(ctx, tx, simulate)
+	if !abort && len(policies) > 0 {
+		res.Log = fmt.Sprintf("fee policy: %s", strings.Join(policies, ", "))
+	}
+
+	return newCtx, res, abort
+}
+
+// DeductFeeDecorator deducts the fees of the transaction from the fee payer,
+// which is the first signer unless the fee names a granter that granted a
+// valid fee allowance to the first signer. A nil FeeGrantKeeper disables fee
+// grants.
+type DeductFeeDecorator struct {
+	ak             keeper.AccountKeeper
+	supplyKeeper   types.SupplyKeeper
+	feeGrantKeeper types.FeeGrantKeeper
+}
+
+// NewDeductFeeDecorator returns a new DeductFeeDecorator.
+func NewDeductFeeDecorator(
+	ak keeper.AccountKeeper, supplyKeeper types.SupplyKeeper, feeGrantKeeper types.FeeGrantKeeper,
+) DeductFeeDecorator {
+	return DeductFeeDecorator{ak: ak, supplyKeeper: supplyKeeper, feeGrantKeeper: feeGrantKeeper}
+}
+
+// AnteHandle implements the AnteDecorator interface.
+func (dfd DeductFeeDecorator) AnteHandle(
+	ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler,
+) (sdk.Context, sdk.Result, bool) {
+
+	if addr := dfd.supplyKeeper.GetModuleAddress(types.FeeCollectorName); addr == nil {
+		panic(fmt.Sprintf("%s module account has not been set", types.FeeCollectorName))
+	}
+
+	stdTx, ok := tx.(types.StdTx)
+	if !ok {
+		return ctx, sdk.ErrInternal("tx must be StdTx").Result(), true
+	}
+
+	// fetch first signer, who's going to pay the fees
+	firstSigner, res := GetSignerAcc(ctx, dfd.ak, stdTx.GetSigners()[0])
+	if !res.IsOK() {
+		return ctx, res, true
+	}
+
+	// fetch the fee payer, which is the first signer unless a fee granter is
+	// set on the fee
+	feePayer, res := GetFeePayerAcc(ctx, dfd.ak, dfd.feeGrantKeeper, stdTx.Fee, firstSigner)
+	if !res.IsOK() {
+		return ctx, res, true
+	}
+
+	if !stdTx.Fee.Amount.IsZero() {
+		if res := DeductFees(dfd.supplyKeeper, ctx, feePayer, stdTx.Fee.Amount); !res.IsOK() {
+			return ctx, res, true
+		}
+	}
+
+	return next(ctx, tx, simulate)
+}
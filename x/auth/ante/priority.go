@@ -0,0 +1,61 @@
+package ante
+
+import (
+	"fmt"
+	"math"
+	"math/big"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth/keeper"
+	"github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+// GasPricePriorityPrecision is the precision of the gas prices returned as
+// priorities by GasPriceTxPriority, i.e. they are in millionths of the fee
+// denomination per unit of gas.
+const GasPricePriorityPrecision = 1000000
+
+// GasPriceTxPriority returns a TxPriority that ranks StdTxs by the effective
+// gas price of their fee in the given denomination, i.e. the fee divided by
+// the gas limit. The slot of a StdTx is its first signer and the account
+// sequence it consumes, so a StdTx can be replaced by one with the same signer
+// and sequence paying a higher gas price. Unordered StdTxs cannot be replaced.
+func GasPriceTxPriority(ak keeper.AccountKeeper, denom string) sdk.TxPriority {
+	return func(ctx sdk.Context, tx sdk.Tx) (int64, string) {
+		stdTx, ok := tx.(types.StdTx)
+		if !ok {
+			return 0, ""
+		}
+
+		priority := gasPricePriority(stdTx.Fee, denom)
+
+		signers := stdTx.GetSigners()
+		if len(signers) == 0 || stdTx.IsUnordered() {
+			return priority, ""
+		}
+
+		acc := ak.GetAccount(ctx, signers[0])
+		if acc == nil {
+			return priority, ""
+		}
+
+		return priority, fmt.Sprintf("%s/%d", signers[0], acc.GetSequence())
+	}
+}
+
+// gasPricePriority returns the gas price of the fee in the given denomination
+// with GasPricePriorityPrecision, capped to the maximum int64.
+func gasPricePriority(fee types.StdFee, denom string) int64 {
+	if fee.Gas == 0 {
+		return 0
+	}
+
+	price := fee.Amount.AmountOf(denom).
+		MulRaw(GasPricePriorityPrecision).
+		Quo(sdk.NewIntFromBigInt(new(big.Int).SetUint64(fee.Gas)))
+	if !price.IsInt64() {
+		return math.MaxInt64
+	}
+
+	return price.Int64()
+}
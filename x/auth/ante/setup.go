@@ -0,0 +1,68 @@
+package ante
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+var _ sdk.AnteDecorator = SetUpContextDecorator{}
+
+// SetUpContextDecorator sets the gas meter of the context to the gas limit of
+// the transaction's fee and recovers from out of gas panics raised by the
+// decorators further along the chain. It must be the first decorator of the
+// chain, so that the gas used is reported in every case.
+type SetUpContextDecorator struct{}
+
+// NewSetUpContextDecorator returns a new SetUpContextDecorator.
+func NewSetUpContextDecorator() SetUpContextDecorator {
+	return SetUpContextDecorator{}
+}
+
+// AnteHandle implements the AnteDecorator interface.
+func (sud SetUpContextDecorator) AnteHandle(
+	ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler,
+) (newCtx sdk.Context, res sdk.Result, abort bool) {
+
+	// all transactions must be of type auth.StdTx
+	stdTx, ok := tx.(types.StdTx)
+	if !ok {
+		// Set a gas meter with limit 0 as to prevent an infinite gas meter attack
+		// during runTx.
+		newCtx = SetGasMeter(simulate, ctx, 0)
+		return newCtx, sdk.ErrInternal("tx must be StdTx").Result(), true
+	}
+
+	newCtx = SetGasMeter(simulate, ctx, stdTx.Fee.Gas)
+
+	// AnteHandlers must have their own defer/recover in order for the BaseApp
+	// to know how much gas was used! This is because the GasMeter is created in
+	// the AnteHandler, but if it panics the context won't be set properly in
+	// runTx's recover call.
+	defer func() {
+		if r := recover(); r != nil {
+			switch rType := r.(type) {
+			case sdk.ErrorOutOfGas:
+				log := fmt.Sprintf(
+					"out of gas in location: %v; gasWanted: %d, gasUsed: %d",
+					rType.Descriptor, stdTx.Fee.Gas, newCtx.GasMeter().GasConsumed(),
+				)
+				res = sdk.ErrOutOfGas(log).Result()
+
+				res.GasWanted = stdTx.Fee.Gas
+				res.GasUsed = newCtx.GasMeter().GasConsumed()
+				abort = true
+			default:
+				panic(r)
+			}
+		}
+	}()
+
+	newCtx, res, abort = next(newCtx, tx, simulate)
+	if !abort {
+		res.GasWanted = stdTx.Fee.Gas
+	}
+
+	return newCtx, res, abort
+}
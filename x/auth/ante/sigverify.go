@@ -0,0 +1,303 @@
+package ante
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth/keeper"
+	"github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+var (
+	_ sdk.AnteDecorator = ValidateSigCountDecorator{}
+	_ sdk.AnteDecorator = SetPubKeyDecorator{}
+	_ sdk.AnteDecorator = SigGasConsumeDecorator{}
+	_ sdk.AnteDecorator = SigVerificationDecorator{}
+	_ sdk.AnteDecorator = IncrementSequenceDecorator{}
+)
+
+// The signer accounts are charged once for reading, in the SetPubKeyDecorator,
+// and once for writing, in the IncrementSequenceDecorator. The decorators in
+// between access them through withoutGas, as the transaction already paid for
+// them.
+
+// ValidateSigCountDecorator validates that the total number of signatures of
+// the transaction, counting the keys of multisig public keys, does not exceed
+// the TxSigLimit param.
+type ValidateSigCountDecorator struct {
+	ak keeper.AccountKeeper
+}
+
+// NewValidateSigCountDecorator returns a new ValidateSigCountDecorator.
+func NewValidateSigCountDecorator(ak keeper.AccountKeeper) ValidateSigCountDecorator {
+	return ValidateSigCountDecorator{ak: ak}
+}
+
+// AnteHandle implements the AnteDecorator interface.
+func (vscd ValidateSigCountDecorator) AnteHandle(
+	ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler,
+) (sdk.Context, sdk.Result, bool) {
+
+	stdTx, ok := tx.(types.StdTx)
+	if !ok {
+		return ctx, sdk.ErrInternal("tx must be StdTx").Result(), true
+	}
+
+	if res := ValidateSigCount(stdTx, vscd.ak.GetParams(withoutGas(ctx))); !res.IsOK() {
+		return ctx, res, true
+	}
+
+	return next(ctx, tx, simulate)
+}
+
+// SetPubKeyDecorator sets the public key of every signer that has none from
+// its signature, after checking it matches the signer's address. When
+// simulating, a secp256k1 key is set instead so that gas is estimated for the
+// most expensive key.
+type SetPubKeyDecorator struct {
+	ak keeper.AccountKeeper
+}
+
+// NewSetPubKeyDecorator returns a new SetPubKeyDecorator.
+func NewSetPubKeyDecorator(ak keeper.AccountKeeper) SetPubKeyDecorator {
+	return SetPubKeyDecorator{ak: ak}
+}
+
+// AnteHandle implements the AnteDecorator interface.
+func (spkd SetPubKeyDecorator) AnteHandle(
+	ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler,
+) (sdk.Context, sdk.Result, bool) {
+
+	stdTx, ok := tx.(types.StdTx)
+	if !ok {
+		return ctx, sdk.ErrInternal("tx must be StdTx").Result(), true
+	}
+
+	stdSigs := stdTx.GetSignatures()
+	for i, addr := range stdTx.GetSigners() {
+		acc, res := GetSignerAcc(ctx, spkd.ak, addr)
+		if !res.IsOK() {
+			return ctx, res, true
+		}
+
+		pubKey, res := ProcessPubKey(acc, stdSigs[i], simulate)
+		if !res.IsOK() {
+			return ctx, res, true
+		}
+
+		if acc.GetPubKey() != nil {
+			continue
+		}
+
+		if err := acc.SetPubKey(pubKey); err != nil {
+			return ctx, sdk.ErrInternal("setting PubKey on signer's account").Result(), true
+		}
+
+		spkd.ak.SetAccount(withoutGas(ctx), acc)
+	}
+
+	return next(ctx, tx, simulate)
+}
+
+// SigGasConsumeDecorator consumes the gas of verifying every signature with
+// the given SignatureVerificationGasConsumer, which may also reject public key
+// types. It expects the public keys to be set by the SetPubKeyDecorator.
+type SigGasConsumeDecorator struct {
+	ak             keeper.AccountKeeper
+	sigGasConsumer SignatureVerificationGasConsumer
+}
+
+// NewSigGasConsumeDecorator returns a new SigGasConsumeDecorator.
+func NewSigGasConsumeDecorator(ak keeper.AccountKeeper, sigGasConsumer SignatureVerificationGasConsumer) SigGasConsumeDecorator {
+	return SigGasConsumeDecorator{ak: ak, sigGasConsumer: sigGasConsumer}
+}
+
+// AnteHandle implements the AnteDecorator interface.
+func (sgcd SigGasConsumeDecorator) AnteHandle(
+	ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler,
+) (sdk.Context, sdk.Result, bool) {
+
+	stdTx, ok := tx.(types.StdTx)
+	if !ok {
+		return ctx, sdk.ErrInternal("tx must be StdTx").Result(), true
+	}
+
+	params := sgcd.ak.GetParams(withoutGas(ctx))
+	stdSigs := stdTx.GetSignatures()
+
+	for i, addr := range stdTx.GetSigners() {
+		acc, res := GetSignerAcc(withoutGas(ctx), sgcd.ak, addr)
+		if !res.IsOK() {
+			return ctx, res, true
+		}
+
+		pubKey := acc.GetPubKey()
+		if simulate {
+			// Simulated txs should not contain a signature and are not required to
+			// contain a pubkey, so we must account for tx size of including a
+			// StdSignature (Amino encoding) and simulate gas consumption
+			// (assuming a SECP256k1 simulation key).
+			consumeSimSigGas(ctx.GasMeter(), pubKey, stdSigs[i], params)
+		}
+
+		if res := sgcd.sigGasConsumer(ctx.GasMeter(), stdSigs[i].Signature, pubKey, params); !res.IsOK() {
+			return ctx, res, true
+		}
+	}
+
+	return next(ctx, tx, simulate)
+}
+
+// SigVerificationDecorator verifies the signature of every signer over the
+// sign bytes of the transaction, which include the signer's account number and
+// sequence. Signatures are not verified when simulating.
+type SigVerificationDecorator struct {
+	ak keeper.AccountKeeper
+}
+
+// NewSigVerificationDecorator returns a new SigVerificationDecorator.
+func NewSigVerificationDecorator(ak keeper.AccountKeeper) SigVerificationDecorator {
+	return SigVerificationDecorator{ak: ak}
+}
+
+// AnteHandle implements the AnteDecorator interface.
+func (svd SigVerificationDecorator) AnteHandle(
+	ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler,
+) (sdk.Context, sdk.Result, bool) {
+
+	if simulate {
+		return next(ctx, tx, simulate)
+	}
+
+	stdTx, ok := tx.(types.StdTx)
+	if !ok {
+		return ctx, sdk.ErrInternal("tx must be StdTx").Result(), true
+	}
+
+	isGenesis := ctx.BlockHeight() == 0
+	stdSigs := stdTx.GetSignatures()
+
+	// the signer accounts are checked to exist by the SetPubKeyDecorator
+	signers := stdTx.GetSigners()
+	svs := make([]sdk.SigVerification, 0, len(signers))
+	for i, addr := range signers {
+		acc, res := GetSignerAcc(withoutGas(ctx), svd.ak, addr)
+		if !res.IsOK() {
+			return ctx, res, true
+		}
+
+		signBytes := GetSignBytes(ctx.ChainID(), stdTx, acc, isGenesis)
+		svs = append(svs, sdk.NewSigVerification(acc.GetPubKey(), signBytes, stdSigs[i].Signature))
+	}
+
+	for _, sv := range svs {
+		if !ctx.SigCache().VerifyBytes(sv.PubKey, sv.SignBytes, sv.Signature) {
+			return ctx, sdk.ErrUnauthorized("signature verification failed; verify correct account sequence and chain-id").Result(), true
+		}
+	}
+
+	return next(ctx, tx, simulate)
+}
+
+// NewSigPreVerifier returns a SigPreVerifier finding the signatures the
+// SigVerificationDecorator verifies. The sequence of a signer is guessed from
+// its previous ordered transactions in the block, and its public key is taken
+// from its signature if its account has none yet.
+func NewSigPreVerifier(ak keeper.AccountKeeper) sdk.SigPreVerifier {
+	return func(ctx sdk.Context, txs []sdk.Tx) []sdk.SigVerification {
+		isGenesis := ctx.BlockHeight() == 0
+		sequences := make(map[string]uint64)
+
+		var svs []sdk.SigVerification
+		for _, tx := range txs {
+			stdTx, ok := tx.(types.StdTx)
+			if !ok {
+				continue
+			}
+
+			stdSigs := stdTx.GetSignatures()
+			signers := stdTx.GetSigners()
+			if len(stdSigs) != len(signers) {
+				continue
+			}
+
+			for i, addr := range signers {
+				acc := ak.GetAccount(ctx, addr)
+				if acc == nil {
+					continue
+				}
+
+				pubKey := acc.GetPubKey()
+				if pubKey == nil {
+					pubKey = stdSigs[i].PubKey
+				}
+				if pubKey == nil {
+					continue
+				}
+
+				sequence, ok := sequences[addr.String()]
+				if !ok {
+					sequence = acc.GetSequence()
+				}
+				if !stdTx.IsUnordered() {
+					sequences[addr.String()] = sequence + 1
+				}
+
+				if err := acc.SetSequence(sequence); err != nil {
+					continue
+				}
+
+				signBytes := GetSignBytes(ctx.ChainID(), stdTx, acc, isGenesis)
+				svs = append(svs, sdk.NewSigVerification(pubKey, signBytes, stdSigs[i].Signature))
+			}
+		}
+
+		return svs
+	}
+}
+
+// IncrementSequenceDecorator increments the sequence of every signer, which
+// prevents the transaction from being replayed. Unordered transactions do not
+// consume the sequence of their signers, see UnorderedTxDecorator.
+type IncrementSequenceDecorator struct {
+	ak keeper.AccountKeeper
+}
+
+// NewIncrementSequenceDecorator returns a new IncrementSequenceDecorator.
+func NewIncrementSequenceDecorator(ak keeper.AccountKeeper) IncrementSequenceDecorator {
+	return IncrementSequenceDecorator{ak: ak}
+}
+
+// AnteHandle implements the AnteDecorator interface.
+func (isd IncrementSequenceDecorator) AnteHandle(
+	ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler,
+) (sdk.Context, sdk.Result, bool) {
+
+	stdTx, ok := tx.(types.StdTx)
+	if !ok {
+		return ctx, sdk.ErrInternal("tx must be StdTx").Result(), true
+	}
+
+	if stdTx.IsUnordered() {
+		return next(ctx, tx, simulate)
+	}
+
+	for _, addr := range stdTx.GetSigners() {
+		acc, res := GetSignerAcc(withoutGas(ctx), isd.ak, addr)
+		if !res.IsOK() {
+			return ctx, res, true
+		}
+
+		if err := acc.SetSequence(acc.GetSequence() + 1); err != nil {
+			panic(err)
+		}
+
+		isd.ak.SetAccount(ctx, acc)
+	}
+
+	return next(ctx, tx, simulate)
+}
+
+// withoutGas returns a context that does not consume gas from the transaction,
+// to access state the transaction has already paid for.
+func withoutGas(ctx sdk.Context) sdk.Context {
+	return ctx.WithGasMeter(sdk.NewInfiniteGasMeter())
+}
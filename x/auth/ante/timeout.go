@@ -0,0 +1,97 @@
+package ante
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth/keeper"
+	"github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+// DefaultMaxUnorderedTxTimeout is the default maximum number of blocks an
+// unordered transaction may be valid for.
+const DefaultMaxUnorderedTxTimeout uint64 = 1000
+
+var (
+	_ sdk.AnteDecorator = TxTimeoutHeightDecorator{}
+	_ sdk.AnteDecorator = UnorderedTxDecorator{}
+)
+
+// TxTimeoutHeightDecorator rejects the transaction if the current block height
+// is past its timeout height, if any.
+type TxTimeoutHeightDecorator struct{}
+
+// NewTxTimeoutHeightDecorator returns a new TxTimeoutHeightDecorator.
+func NewTxTimeoutHeightDecorator() TxTimeoutHeightDecorator {
+	return TxTimeoutHeightDecorator{}
+}
+
+// AnteHandle implements the AnteDecorator interface.
+func (thd TxTimeoutHeightDecorator) AnteHandle(
+	ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler,
+) (sdk.Context, sdk.Result, bool) {
+
+	stdTx, ok := tx.(types.StdTx)
+	if !ok {
+		return ctx, sdk.ErrInternal("tx must be StdTx").Result(), true
+	}
+
+	if stdTx.TimeoutHeight > 0 && uint64(ctx.BlockHeight()) > stdTx.TimeoutHeight {
+		return ctx, sdk.ErrTxTimeout(
+			fmt.Sprintf("block height %d is past the tx timeout height %d", ctx.BlockHeight(), stdTx.TimeoutHeight),
+		).Result(), true
+	}
+
+	return next(ctx, tx, simulate)
+}
+
+// UnorderedTxDecorator prevents unordered transactions from being replayed.
+// It rejects an unordered transaction if its first signer already used its
+// nonce for an unordered transaction with the same timeout height, and marks
+// the nonce as used otherwise. The used nonces are removed once they time
+// out, so the timeout height of an unordered transaction may not be more than
+// maxTimeout blocks ahead.
+type UnorderedTxDecorator struct {
+	ak         keeper.AccountKeeper
+	maxTimeout uint64
+}
+
+// NewUnorderedTxDecorator returns a new UnorderedTxDecorator.
+func NewUnorderedTxDecorator(ak keeper.AccountKeeper, maxTimeout uint64) UnorderedTxDecorator {
+	return UnorderedTxDecorator{ak: ak, maxTimeout: maxTimeout}
+}
+
+// AnteHandle implements the AnteDecorator interface.
+func (utd UnorderedTxDecorator) AnteHandle(
+	ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler,
+) (sdk.Context, sdk.Result, bool) {
+
+	stdTx, ok := tx.(types.StdTx)
+	if !ok {
+		return ctx, sdk.ErrInternal("tx must be StdTx").Result(), true
+	}
+
+	if !stdTx.IsUnordered() {
+		return next(ctx, tx, simulate)
+	}
+
+	if maxTimeoutHeight := uint64(ctx.BlockHeight()) + utd.maxTimeout; stdTx.TimeoutHeight > maxTimeoutHeight {
+		return ctx, sdk.ErrUnknownRequest(
+			fmt.Sprintf(
+				"unordered tx timeout height %d exceeds the maximum of %d",
+				stdTx.TimeoutHeight, maxTimeoutHeight,
+			),
+		).Result(), true
+	}
+
+	signer := stdTx.GetSigners()[0]
+	if utd.ak.HasUnorderedNonce(ctx, signer, stdTx.Nonce, stdTx.TimeoutHeight) {
+		return ctx, sdk.ErrInvalidSequence(
+			fmt.Sprintf("unordered tx nonce %d of %s already used", stdTx.Nonce, signer),
+		).Result(), true
+	}
+
+	utd.ak.SetUnorderedNonce(ctx, signer, stdTx.Nonce, stdTx.TimeoutHeight)
+
+	return next(ctx, tx, simulate)
+}
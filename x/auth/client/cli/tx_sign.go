@@ -228,10 +228,7 @@ func printAndValidateSigs(
 				return false
 			}
 
-			sigBytes := types.StdSignBytes(
-				chainID, acc.GetAccountNumber(), acc.GetSequence(),
-				stdTx.Fee, stdTx.GetMsgs(), stdTx.GetMemo(),
-			)
+			sigBytes := stdTx.SignBytes(chainID, acc.GetAccountNumber(), acc.GetSequence())
 
 			if ok := sig.VerifyBytes(sigBytes, sig.Signature); !ok {
 				sigSanity = "ERROR: signature invalid"
@@ -21,11 +21,16 @@ import (
 
 // GasEstimateResponse defines a response definition for tx gas estimation.
 type GasEstimateResponse struct {
-	GasEstimate uint64 `json:"gas_estimate" yaml:"gas_estimate"`
+	GasEstimate uint64          `json:"gas_estimate" yaml:"gas_estimate"`
+	GasProfile  *sdk.GasProfile `json:"gas_profile,omitempty" yaml:"gas_profile,omitempty"`
 }
 
 func (gr GasEstimateResponse) String() string {
-	return fmt.Sprintf("gas estimate: %d", gr.GasEstimate)
+	if gr.GasProfile == nil {
+		return fmt.Sprintf("gas estimate: %d", gr.GasEstimate)
+	}
+
+	return fmt.Sprintf("gas estimate: %d\ngas profile:\n%s", gr.GasEstimate, gr.GasProfile)
 }
 
 // GenerateOrBroadcastMsgs creates a StdTx given a series of messages. If
@@ -54,12 +59,18 @@ func CompleteAndBroadcastTxCLI(txBldr authtypes.TxBuilder, cliCtx context.CLICon
 	fromName := cliCtx.GetFromName()
 
 	if txBldr.SimulateAndExecute() || cliCtx.Simulate {
-		txBldr, err = EnrichWithGas(txBldr, cliCtx, msgs)
+		result, adjusted, err := simulateMsgs(txBldr, cliCtx, msgs)
 		if err != nil {
 			return err
 		}
 
+		txBldr = txBldr.WithGas(adjusted)
+
+		// the gas profile of the simulation is only shown on dry runs
 		gasEst := GasEstimateResponse{GasEstimate: txBldr.Gas()}
+		if cliCtx.Simulate {
+			gasEst.GasProfile = result.GasProfile
+		}
 		_, _ = fmt.Fprintf(os.Stderr, "%s\n", gasEst.String())
 	}
 
@@ -133,18 +144,29 @@ func CalculateGas(
 
 	// run a simulation (via /app/simulate query) to
 	// estimate gas and update TxBuilder accordingly
-	rawRes, _, err := queryFunc("/app/simulate", txBytes)
+	result, err := SimulateTx(queryFunc, cdc, txBytes)
 	if err != nil {
 		return estimate, adjusted, err
 	}
 
-	estimate, err = parseQueryResponse(cdc, rawRes)
+	estimate = result.GasUsed
+	adjusted = adjustGasEstimate(estimate, adjustment)
+	return estimate, adjusted, nil
+}
+
+// SimulateTx simulates the execution of a transaction with the /app/simulate
+// query and returns its result, which includes the gas profile of the
+// simulation.
+func SimulateTx(
+	queryFunc func(string, []byte) ([]byte, int64, error), cdc *codec.Codec, txBytes []byte,
+) (sdk.Result, error) {
+
+	rawRes, _, err := queryFunc("/app/simulate", txBytes)
 	if err != nil {
-		return
+		return sdk.Result{}, err
 	}
 
-	adjusted = adjustGasEstimate(estimate, adjustment)
-	return estimate, adjusted, nil
+	return parseSimulationResult(cdc, rawRes)
 }
 
 // PrintUnsignedStdTx builds an unsigned StdTx and prints it to os.Stdout.
@@ -272,14 +294,19 @@ func GetTxEncoder(cdc *codec.Codec) (encoder sdk.TxEncoder) {
 }
 
 // nolint
-// SimulateMsgs simulates the transaction and returns the gas estimate and the adjusted value.
-func simulateMsgs(txBldr authtypes.TxBuilder, cliCtx context.CLIContext, msgs []sdk.Msg) (estimated, adjusted uint64, err error) {
+// SimulateMsgs simulates the transaction and returns its result and the adjusted gas estimate.
+func simulateMsgs(txBldr authtypes.TxBuilder, cliCtx context.CLIContext, msgs []sdk.Msg) (result sdk.Result, adjusted uint64, err error) {
 	txBytes, err := txBldr.BuildTxForSim(msgs)
 	if err != nil {
 		return
 	}
 
-	estimated, adjusted, err = CalculateGas(cliCtx.QueryWithData, cliCtx.Codec, txBytes, txBldr.GasAdjustment())
+	result, err = SimulateTx(cliCtx.QueryWithData, cliCtx.Codec, txBytes)
+	if err != nil {
+		return
+	}
+
+	adjusted = adjustGasEstimate(result.GasUsed, txBldr.GasAdjustment())
 	return
 }
 
@@ -288,14 +315,23 @@ func adjustGasEstimate(estimate uint64, adjustment float64) uint64 {
 }
 
 func parseQueryResponse(cdc *codec.Codec, rawRes []byte) (uint64, error) {
-	var simulationResult sdk.Result
-	if err := cdc.UnmarshalBinaryLengthPrefixed(rawRes, &simulationResult); err != nil {
+	simulationResult, err := parseSimulationResult(cdc, rawRes)
+	if err != nil {
 		return 0, err
 	}
 
 	return simulationResult.GasUsed, nil
 }
 
+func parseSimulationResult(cdc *codec.Codec, rawRes []byte) (sdk.Result, error) {
+	var simulationResult sdk.Result
+	if err := cdc.UnmarshalBinaryLengthPrefixed(rawRes, &simulationResult); err != nil {
+		return sdk.Result{}, err
+	}
+
+	return simulationResult, nil
+}
+
 // PrepareTxBuilder populates a TxBuilder in preparation for the build of a Tx.
 func PrepareTxBuilder(txBldr authtypes.TxBuilder, cliCtx context.CLIContext) (authtypes.TxBuilder, error) {
 	from := cliCtx.GetFromAddress()
@@ -344,7 +380,7 @@ func buildUnsignedStdTxOffline(txBldr authtypes.TxBuilder, cliCtx context.CLICon
 		return stdTx, nil
 	}
 
-	return authtypes.NewStdTx(stdSignMsg.Msgs, stdSignMsg.Fee, nil, stdSignMsg.Memo), nil
+	return stdSignMsg.StdTx(nil), nil
 }
 
 func isTxSigner(user sdk.AccAddress, signers []sdk.AccAddress) bool {
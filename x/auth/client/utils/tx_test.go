@@ -32,6 +32,25 @@ func TestParseQueryResponse(t *testing.T) {
 	assert.Error(t, err)
 }
 
+func TestSimulateTx(t *testing.T) {
+	cdc := makeCodec()
+	profile := &sdk.GasProfile{
+		Descriptors: []sdk.GasProfileEntry{{Name: "ReadFlat", Gas: 1000}},
+		Stores:      []sdk.GasProfileEntry{{Name: "acc", Gas: 1000}},
+	}
+	queryFunc := func(string, []byte) ([]byte, int64, error) {
+		return cdc.MustMarshalBinaryLengthPrefixed(sdk.Result{GasUsed: 1000, GasProfile: profile}), 0, nil
+	}
+
+	result, err := SimulateTx(queryFunc, cdc, nil)
+	require.NoError(t, err)
+	require.Equal(t, uint64(1000), result.GasUsed)
+	require.Equal(t, profile, result.GasProfile)
+
+	gasEst := GasEstimateResponse{GasEstimate: 1000, GasProfile: result.GasProfile}
+	require.Equal(t, "gas estimate: 1000\ngas profile:\nDescriptors:\n  ReadFlat: 1000\nStores:\n  acc: 1000", gasEst.String())
+}
+
 func TestCalculateGas(t *testing.T) {
 	cdc := makeCodec()
 	makeQueryFunc := func(gasUsed uint64, wantErr bool) func(string, []byte) ([]byte, int64, error) {
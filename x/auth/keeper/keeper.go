@@ -3,18 +3,18 @@ package keeper
 import (
 	"fmt"
 
+	gogotypes "github.com/gogo/protobuf/types"
 	"github.com/tendermint/tendermint/crypto"
 	"github.com/tendermint/tendermint/libs/log"
 
-	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/auth/exported"
 	"github.com/cosmos/cosmos-sdk/x/auth/types"
 	"github.com/cosmos/cosmos-sdk/x/params/subspace"
 )
 
-// AccountKeeper encodes/decodes accounts using the go-amino (binary)
-// encoding/decoding library.
+// AccountKeeper encodes/decodes accounts using the x/auth Codec provided by
+// the application.
 type AccountKeeper struct {
 	// The (unexposed) key used to access the store from the Context.
 	key sdk.StoreKey
@@ -22,17 +22,17 @@ type AccountKeeper struct {
 	// The prototypical Account constructor.
 	proto func() exported.Account
 
-	// The codec codec for binary encoding/decoding of accounts.
-	cdc *codec.Codec
+	// The codec for binary encoding/decoding of accounts.
+	cdc types.Codec
 
 	paramSubspace subspace.Subspace
 }
 
-// NewAccountKeeper returns a new sdk.AccountKeeper that uses go-amino to
+// NewAccountKeeper returns a new sdk.AccountKeeper that uses the given Codec to
 // (binary) encode and decode concrete sdk.Accounts.
 // nolint
 func NewAccountKeeper(
-	cdc *codec.Codec, key sdk.StoreKey, paramstore subspace.Subspace, proto func() exported.Account,
+	cdc types.Codec, key sdk.StoreKey, paramstore subspace.Subspace, proto func() exported.Account,
 ) AccountKeeper {
 
 	return AccountKeeper{
@@ -48,6 +48,11 @@ func (ak AccountKeeper) Logger(ctx sdk.Context) log.Logger {
 	return ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
 }
 
+// GetCodec returns the codec used to encode and decode accounts.
+func (ak AccountKeeper) GetCodec() types.Codec {
+	return ak.cdc
+}
+
 // GetPubKey Returns the PubKey of the account at address
 func (ak AccountKeeper) GetPubKey(ctx sdk.Context, addr sdk.AccAddress) (crypto.PubKey, sdk.Error) {
 	acc := ak.GetAccount(ctx, addr)
@@ -76,13 +81,17 @@ func (ak AccountKeeper) GetNextAccountNumber(ctx sdk.Context) uint64 {
 		// initialize the account numbers
 		accNumber = 0
 	} else {
-		err := ak.cdc.UnmarshalBinaryLengthPrefixed(bz, &accNumber)
+		val := gogotypes.UInt64Value{}
+
+		err := ak.cdc.UnmarshalBinaryLengthPrefixed(bz, &val)
 		if err != nil {
 			panic(err)
 		}
+
+		accNumber = val.GetValue()
 	}
 
-	bz = ak.cdc.MustMarshalBinaryLengthPrefixed(accNumber + 1)
+	bz = ak.cdc.MustMarshalBinaryLengthPrefixed(&gogotypes.UInt64Value{Value: accNumber + 1})
 	store.Set(types.GlobalAccountNumberKey, bz)
 
 	return accNumber
@@ -91,10 +100,10 @@ func (ak AccountKeeper) GetNextAccountNumber(ctx sdk.Context) uint64 {
 // -----------------------------------------------------------------------------
 // Misc.
 
-func (ak AccountKeeper) decodeAccount(bz []byte) (acc exported.Account) {
-	err := ak.cdc.UnmarshalBinaryBare(bz, &acc)
+func (ak AccountKeeper) decodeAccount(bz []byte) exported.Account {
+	acc, err := ak.cdc.UnmarshalAccount(bz)
 	if err != nil {
 		panic(err)
 	}
-	return
+	return acc
 }
@@ -0,0 +1,42 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+// HasUnorderedNonce returns true if the signer already used the nonce for an
+// unordered transaction with the given timeout height.
+func (ak AccountKeeper) HasUnorderedNonce(ctx sdk.Context, addr sdk.AccAddress, nonce, timeoutHeight uint64) bool {
+	store := ctx.KVStore(ak.key)
+	return store.Has(types.UnorderedNonceKey(timeoutHeight, addr, nonce))
+}
+
+// SetUnorderedNonce marks the nonce as used by the signer for an unordered
+// transaction with the given timeout height.
+func (ak AccountKeeper) SetUnorderedNonce(ctx sdk.Context, addr sdk.AccAddress, nonce, timeoutHeight uint64) {
+	store := ctx.KVStore(ak.key)
+	store.Set(types.UnorderedNonceKey(timeoutHeight, addr, nonce), []byte{0x01})
+}
+
+// RemoveExpiredUnorderedNonces removes the used nonces of the unordered
+// transactions with a timeout height up to the given height. These
+// transactions are rejected past their timeout height, so their nonces are no
+// longer needed to prevent replays.
+func (ak AccountKeeper) RemoveExpiredUnorderedNonces(ctx sdk.Context, height uint64) {
+	store := ctx.KVStore(ak.key)
+
+	iterator := store.Iterator(
+		types.UnorderedNonceKeyPrefix, sdk.PrefixEndBytes(types.UnorderedNoncesByHeightKey(height)),
+	)
+	defer iterator.Close()
+
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
@@ -70,11 +70,13 @@ func (AppModuleBasic) GetQueryCmd(cdc *codec.Codec) *cobra.Command {
 //____________________________________________________________________________
 
 // AppModuleSimulation defines the module simulation functions used by the auth module.
-type AppModuleSimulation struct{}
+type AppModuleSimulation struct {
+	cdc types.Codec
+}
 
 // RegisterStoreDecoder registers a decoder for auth module's types
-func (AppModuleSimulation) RegisterStoreDecoder(sdr sdk.StoreDecoderRegistry) {
-	sdr[StoreKey] = simulation.DecodeStore
+func (ams AppModuleSimulation) RegisterStoreDecoder(sdr sdk.StoreDecoderRegistry) {
+	sdr[StoreKey] = simulation.NewDecodeStore(ams.cdc)
 }
 
 //____________________________________________________________________________
@@ -91,7 +93,7 @@ type AppModule struct {
 func NewAppModule(accountKeeper AccountKeeper) AppModule {
 	return AppModule{
 		AppModuleBasic:      AppModuleBasic{},
-		AppModuleSimulation: AppModuleSimulation{},
+		AppModuleSimulation: AppModuleSimulation{cdc: accountKeeper.GetCodec()},
 		accountKeeper:       accountKeeper,
 	}
 }
@@ -141,6 +143,7 @@ func (AppModule) BeginBlock(_ sdk.Context, _ abci.RequestBeginBlock) {}
 
 // EndBlock returns the end blocker for the auth module. It returns no validator
 // updates.
-func (AppModule) EndBlock(_ sdk.Context, _ abci.RequestEndBlock) []abci.ValidatorUpdate {
+func (am AppModule) EndBlock(ctx sdk.Context, _ abci.RequestEndBlock) []abci.ValidatorUpdate {
+	EndBlocker(ctx, am.accountKeeper)
 	return []abci.ValidatorUpdate{}
 }
@@ -4,27 +4,35 @@ import (
 	"bytes"
 	"fmt"
 
+	gogotypes "github.com/gogo/protobuf/types"
 	cmn "github.com/tendermint/tendermint/libs/common"
 
 	"github.com/cosmos/cosmos-sdk/codec"
-	"github.com/cosmos/cosmos-sdk/x/auth/exported"
 	"github.com/cosmos/cosmos-sdk/x/auth/types"
 )
 
-// DecodeStore unmarshals the KVPair's Value to the corresponding auth type
-func DecodeStore(cdc *codec.Codec, kvA, kvB cmn.KVPair) string {
-	switch {
-	case bytes.Equal(kvA.Key[:1], types.AddressStoreKeyPrefix):
-		var accA, accB exported.Account
-		cdc.MustUnmarshalBinaryBare(kvA.Value, &accA)
-		cdc.MustUnmarshalBinaryBare(kvB.Value, &accB)
-		return fmt.Sprintf("%v\n%v", accA, accB)
-	case bytes.Equal(kvA.Key, types.GlobalAccountNumberKey):
-		var globalAccNumberA, globalAccNumberB uint64
-		cdc.MustUnmarshalBinaryLengthPrefixed(kvA.Value, &globalAccNumberA)
-		cdc.MustUnmarshalBinaryLengthPrefixed(kvB.Value, &globalAccNumberB)
-		return fmt.Sprintf("GlobalAccNumberA: %d\nGlobalAccNumberB: %d", globalAccNumberA, globalAccNumberB)
-	default:
-		panic(fmt.Sprintf("invalid account key %X", kvA.Key))
+// NewDecodeStore returns a function that unmarshals the KVPair's Value to the
+// corresponding auth type using the given x/auth codec.
+func NewDecodeStore(cdc types.Codec) func(*codec.Codec, cmn.KVPair, cmn.KVPair) string {
+	return func(_ *codec.Codec, kvA, kvB cmn.KVPair) string {
+		switch {
+		case bytes.Equal(kvA.Key[:1], types.AddressStoreKeyPrefix):
+			accA, err := cdc.UnmarshalAccount(kvA.Value)
+			if err != nil {
+				panic(err)
+			}
+			accB, err := cdc.UnmarshalAccount(kvB.Value)
+			if err != nil {
+				panic(err)
+			}
+			return fmt.Sprintf("%v\n%v", accA, accB)
+		case bytes.Equal(kvA.Key, types.GlobalAccountNumberKey):
+			var globalAccNumberA, globalAccNumberB gogotypes.UInt64Value
+			cdc.MustUnmarshalBinaryLengthPrefixed(kvA.Value, &globalAccNumberA)
+			cdc.MustUnmarshalBinaryLengthPrefixed(kvB.Value, &globalAccNumberB)
+			return fmt.Sprintf("GlobalAccNumberA: %d\nGlobalAccNumberB: %d", globalAccNumberA.Value, globalAccNumberB.Value)
+		default:
+			panic(fmt.Sprintf("invalid account key %X", kvA.Key))
+		}
 	}
 }
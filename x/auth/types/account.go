@@ -6,8 +6,10 @@ import (
 	"time"
 
 	"github.com/tendermint/tendermint/crypto"
+	cryptoamino "github.com/tendermint/tendermint/crypto/encoding/amino"
 	yaml "gopkg.in/yaml.v2"
 
+	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/auth/exported"
 )
@@ -169,6 +171,79 @@ func (acc BaseAccount) MarshalYAML() (interface{}, error) {
 	return string(bs), err
 }
 
+// Marshal implements codec.ProtoMarshaler. The encoding is written by hand
+// since the public key is an interface, which is stored with its amino
+// encoding.
+func (acc *BaseAccount) Marshal() ([]byte, error) {
+	var err error
+
+	bz := codec.AppendProtoBytes(nil, 1, acc.Address)
+	for i := range acc.Coins {
+		if bz, err = codec.AppendProtoMessage(bz, 2, &acc.Coins[i]); err != nil {
+			return nil, err
+		}
+	}
+	if acc.PubKey != nil {
+		bz = codec.AppendProtoBytes(bz, 3, acc.PubKey.Bytes())
+	}
+	bz = codec.AppendProtoVarint(bz, 4, acc.AccountNumber)
+	bz = codec.AppendProtoVarint(bz, 5, acc.Sequence)
+
+	return bz, nil
+}
+
+// MarshalTo implements codec.ProtoMarshaler.
+func (acc *BaseAccount) MarshalTo(data []byte) (int, error) {
+	bz, err := acc.Marshal()
+	if err != nil {
+		return 0, err
+	}
+
+	return copy(data, bz), nil
+}
+
+// Size implements codec.ProtoMarshaler.
+func (acc *BaseAccount) Size() int {
+	bz, _ := acc.Marshal()
+	return len(bz)
+}
+
+// Unmarshal implements codec.ProtoMarshaler.
+func (acc *BaseAccount) Unmarshal(bz []byte) error {
+	*acc = BaseAccount{}
+
+	for len(bz) > 0 {
+		field, v, data, n, err := codec.DecodeProtoField(bz)
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+
+		switch field {
+		case 1:
+			acc.Address = append(sdk.AccAddress{}, data...)
+		case 2:
+			var coin sdk.Coin
+			if err := coin.Unmarshal(data); err != nil {
+				return err
+			}
+			acc.Coins = append(acc.Coins, coin)
+		case 3:
+			pubKey, err := cryptoamino.PubKeyFromBytes(data)
+			if err != nil {
+				return err
+			}
+			acc.PubKey = pubKey
+		case 4:
+			acc.AccountNumber = v
+		case 5:
+			acc.Sequence = v
+		}
+	}
+
+	return nil
+}
+
 //-----------------------------------------------------------------------------
 // Base Vesting Account
 
@@ -535,3 +610,141 @@ func (dva *DelayedVestingAccount) GetStartTime() int64 {
 func (dva *DelayedVestingAccount) GetEndTime() int64 {
 	return dva.EndTime
 }
+
+//-----------------------------------------------------------------------------
+// Periodic Vesting Account
+
+var _ exported.VestingAccount = (*PeriodicVestingAccount)(nil)
+
+// PeriodicVestingAccount implements the VestingAccount interface. It vests
+// coins according to a schedule of consecutive periods: the coins of a period
+// are unlocked at once when the period ends.
+type PeriodicVestingAccount struct {
+	*BaseVestingAccount
+
+	StartTime      int64   `json:"start_time"`      // when the coins start to vest
+	VestingPeriods Periods `json:"vesting_periods"` // the vesting schedule
+}
+
+// NewPeriodicVestingAccountRaw creates a new PeriodicVestingAccount object from
+// BaseVestingAccount
+func NewPeriodicVestingAccountRaw(bva *BaseVestingAccount,
+	startTime int64, periods Periods) *PeriodicVestingAccount {
+
+	return &PeriodicVestingAccount{
+		BaseVestingAccount: bva,
+		StartTime:          startTime,
+		VestingPeriods:     periods,
+	}
+}
+
+// NewPeriodicVestingAccount returns a new PeriodicVestingAccount. The original
+// vesting amount is the sum of the coins of the vesting periods and the vesting
+// ends once all the periods have elapsed.
+func NewPeriodicVestingAccount(
+	baseAcc *BaseAccount, startTime int64, periods Periods,
+) *PeriodicVestingAccount {
+
+	baseVestingAcc := &BaseVestingAccount{
+		BaseAccount:     baseAcc,
+		OriginalVesting: periods.TotalAmount(),
+		EndTime:         startTime + periods.TotalLength(),
+	}
+
+	return &PeriodicVestingAccount{
+		BaseVestingAccount: baseVestingAcc,
+		StartTime:          startTime,
+		VestingPeriods:     periods,
+	}
+}
+
+func (pva PeriodicVestingAccount) String() string {
+	var pubkey string
+
+	if pva.PubKey != nil {
+		pubkey = sdk.MustBech32ifyAccPub(pva.PubKey)
+	}
+
+	return fmt.Sprintf(`Periodic Vesting Account:
+  Address:          %s
+  Pubkey:           %s
+  Coins:            %s
+  AccountNumber:    %d
+  Sequence:         %d
+  OriginalVesting:  %s
+  DelegatedFree:    %s
+  DelegatedVesting: %s
+  StartTime:        %d
+  EndTime:          %d
+  VestingPeriods:   %d `,
+		pva.Address, pubkey, pva.Coins, pva.AccountNumber, pva.Sequence,
+		pva.OriginalVesting, pva.DelegatedFree, pva.DelegatedVesting,
+		pva.StartTime, pva.EndTime, len(pva.VestingPeriods),
+	)
+}
+
+// GetVestedCoins returns the total number of vested coins. If no coins are vested,
+// nil is returned.
+func (pva PeriodicVestingAccount) GetVestedCoins(blockTime time.Time) sdk.Coins {
+	var vestedCoins sdk.Coins
+
+	// We must handle the case where the start time for a vesting account has
+	// been set into the future or when the start of the chain is not exactly
+	// known.
+	if blockTime.Unix() <= pva.StartTime {
+		return vestedCoins
+	} else if blockTime.Unix() >= pva.EndTime {
+		return pva.OriginalVesting
+	}
+
+	// track the start time of the next period
+	currentPeriodStartTime := pva.StartTime
+
+	// sum the amounts of all the periods that have elapsed
+	for _, period := range pva.VestingPeriods {
+		x := blockTime.Unix() - currentPeriodStartTime
+		if x < period.Length {
+			break
+		}
+
+		vestedCoins = vestedCoins.Add(period.Amount)
+		currentPeriodStartTime += period.Length
+	}
+
+	return vestedCoins
+}
+
+// GetVestingCoins returns the total number of vesting coins. If no coins are
+// vesting, nil is returned.
+func (pva PeriodicVestingAccount) GetVestingCoins(blockTime time.Time) sdk.Coins {
+	return pva.OriginalVesting.Sub(pva.GetVestedCoins(blockTime))
+}
+
+// SpendableCoins returns the total number of spendable coins per denom for a
+// periodic vesting account.
+func (pva PeriodicVestingAccount) SpendableCoins(blockTime time.Time) sdk.Coins {
+	return pva.spendableCoins(pva.GetVestingCoins(blockTime))
+}
+
+// TrackDelegation tracks a desired delegation amount by setting the appropriate
+// values for the amount of delegated vesting, delegated free, and reducing the
+// overall amount of base coins.
+func (pva *PeriodicVestingAccount) TrackDelegation(blockTime time.Time, amount sdk.Coins) {
+	pva.trackDelegation(pva.GetVestingCoins(blockTime), amount)
+}
+
+// GetStartTime returns the time when vesting starts for a periodic vesting
+// account.
+func (pva *PeriodicVestingAccount) GetStartTime() int64 {
+	return pva.StartTime
+}
+
+// GetEndTime returns the time when vesting ends for a periodic vesting account.
+func (pva *PeriodicVestingAccount) GetEndTime() int64 {
+	return pva.EndTime
+}
+
+// GetVestingPeriods returns the vesting schedule of a periodic vesting account.
+func (pva PeriodicVestingAccount) GetVestingPeriods() Periods {
+	return pva.VestingPeriods
+}
@@ -5,6 +5,45 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/auth/exported"
 )
 
+// Codec defines the interface needed to serialize x/auth state. It must be
+// aware of all the concrete account types an application uses, so it is
+// implemented by the application.
+type Codec interface {
+	codec.Marshaler
+
+	MarshalAccount(acc exported.Account) ([]byte, error)
+	UnmarshalAccount(bz []byte) (exported.Account, error)
+}
+
+// AminoCodec defines an x/auth Codec that encodes accounts with their amino
+// interface encoding. Accounts are decoded into any concrete type registered
+// on the underlying amino codec.
+type AminoCodec struct {
+	*codec.AminoCodec
+}
+
+var _ Codec = (*AminoCodec)(nil)
+
+// NewAminoCodec returns a new x/auth AminoCodec using the given amino codec.
+func NewAminoCodec(amino *codec.Codec) *AminoCodec {
+	return &AminoCodec{AminoCodec: codec.NewAminoCodec(amino)}
+}
+
+// MarshalAccount marshals an Account interface with amino.
+func (ac *AminoCodec) MarshalAccount(acc exported.Account) ([]byte, error) {
+	return ac.Amino().MarshalBinaryBare(acc)
+}
+
+// UnmarshalAccount unmarshals an Account interface with amino.
+func (ac *AminoCodec) UnmarshalAccount(bz []byte) (exported.Account, error) {
+	var acc exported.Account
+	if err := ac.Amino().UnmarshalBinaryBare(bz, &acc); err != nil {
+		return nil, err
+	}
+
+	return acc, nil
+}
+
 // RegisterCodec registers concrete types on the codec
 func RegisterCodec(cdc *codec.Codec) {
 	cdc.RegisterInterface((*exported.Account)(nil), nil)
@@ -13,6 +52,7 @@ func RegisterCodec(cdc *codec.Codec) {
 	cdc.RegisterConcrete(&BaseVestingAccount{}, "cosmos-sdk/BaseVestingAccount", nil)
 	cdc.RegisterConcrete(&ContinuousVestingAccount{}, "cosmos-sdk/ContinuousVestingAccount", nil)
 	cdc.RegisterConcrete(&DelayedVestingAccount{}, "cosmos-sdk/DelayedVestingAccount", nil)
+	cdc.RegisterConcrete(&PeriodicVestingAccount{}, "cosmos-sdk/PeriodicVestingAccount", nil)
 	cdc.RegisterConcrete(StdTx{}, "cosmos-sdk/StdTx", nil)
 }
 
@@ -24,9 +24,26 @@ var (
 
 	// param key for global account number
 	GlobalAccountNumberKey = []byte("globalAccountNumber")
+
+	// UnorderedNonceKeyPrefix prefix for the used nonces of unordered
+	// transactions, indexed by timeout height
+	UnorderedNonceKeyPrefix = []byte{0x02}
 )
 
 // AddressStoreKey turn an address to key used to get it from the account store
 func AddressStoreKey(addr sdk.AccAddress) []byte {
 	return append(AddressStoreKeyPrefix, addr.Bytes()...)
 }
+
+// UnorderedNoncesByHeightKey returns the key prefix of the used nonces of the
+// unordered transactions with the given timeout height.
+func UnorderedNoncesByHeightKey(timeoutHeight uint64) []byte {
+	return append(UnorderedNonceKeyPrefix, sdk.Uint64ToBigEndian(timeoutHeight)...)
+}
+
+// UnorderedNonceKey returns the key of a used nonce of an unordered
+// transaction of the given signer with the given timeout height.
+func UnorderedNonceKey(timeoutHeight uint64, addr sdk.AccAddress, nonce uint64) []byte {
+	key := append(UnorderedNoncesByHeightKey(timeoutHeight), addr.Bytes()...)
+	return append(key, sdk.Uint64ToBigEndian(nonce)...)
+}
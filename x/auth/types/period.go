@@ -0,0 +1,86 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Period defines a length of time and amount of coins that will vest
+type Period struct {
+	Length int64     `json:"length" yaml:"length"` // length of the period, in seconds
+	Amount sdk.Coins `json:"amount" yaml:"amount"` // amount of coins vesting during this period
+}
+
+// NewPeriod returns a new vesting period
+func NewPeriod(length int64, amount sdk.Coins) Period {
+	return Period{Length: length, Amount: amount}
+}
+
+// String implements the fmt.Stringer interface
+func (p Period) String() string {
+	return fmt.Sprintf(`Length: %d
+Amount: %s`, p.Length, p.Amount)
+}
+
+// Validate performs a basic validation of a vesting period
+func (p Period) Validate() error {
+	if p.Length <= 0 {
+		return fmt.Errorf("invalid period length: %d", p.Length)
+	}
+	if !p.Amount.IsValid() || !p.Amount.IsAllPositive() {
+		return fmt.Errorf("invalid period amount: %s", p.Amount)
+	}
+
+	return nil
+}
+
+// Periods stores all vesting periods passed as part of a PeriodicVestingAccount
+type Periods []Period
+
+// String implements the fmt.Stringer interface
+func (vp Periods) String() string {
+	periodsListString := make([]string, len(vp))
+	for i, period := range vp {
+		periodsListString[i] = period.String()
+	}
+
+	return strings.TrimSpace(fmt.Sprintf(`Vesting Periods:
+%s`, strings.Join(periodsListString, ",\n")))
+}
+
+// TotalLength returns the total length in seconds of the vesting periods
+func (vp Periods) TotalLength() int64 {
+	var total int64
+	for _, period := range vp {
+		total += period.Length
+	}
+
+	return total
+}
+
+// TotalAmount returns the sum of the coins vesting during the periods
+func (vp Periods) TotalAmount() sdk.Coins {
+	total := sdk.Coins{}
+	for _, period := range vp {
+		total = total.Add(period.Amount)
+	}
+
+	return total
+}
+
+// Validate performs a basic validation of every vesting period
+func (vp Periods) Validate() error {
+	if len(vp) == 0 {
+		return fmt.Errorf("no vesting periods")
+	}
+
+	for i, period := range vp {
+		if err := period.Validate(); err != nil {
+			return fmt.Errorf("vesting period %d: %s", i, err)
+		}
+	}
+
+	return nil
+}
@@ -14,9 +14,20 @@ type StdSignMsg struct {
 	Fee           StdFee    `json:"fee" yaml:"fee"`
 	Msgs          []sdk.Msg `json:"msgs" yaml:"msgs"`
 	Memo          string    `json:"memo" yaml:"memo"`
+	TimeoutHeight uint64    `json:"timeout_height,omitempty" yaml:"timeout_height,omitempty"`
+	Nonce         uint64    `json:"nonce,omitempty" yaml:"nonce,omitempty"`
 }
 
 // get message bytes
 func (msg StdSignMsg) Bytes() []byte {
-	return StdSignBytes(msg.ChainID, msg.AccountNumber, msg.Sequence, msg.Fee, msg.Msgs, msg.Memo)
+	return StdSignBytesWithTimeout(
+		msg.ChainID, msg.AccountNumber, msg.Sequence, msg.TimeoutHeight, msg.Nonce, msg.Fee, msg.Msgs, msg.Memo,
+	)
+}
+
+// StdTx returns the StdTx of the message with the given signatures.
+func (msg StdSignMsg) StdTx(sigs []StdSignature) StdTx {
+	return NewStdTx(msg.Msgs, msg.Fee, sigs, msg.Memo).
+		WithTimeoutHeight(msg.TimeoutHeight).
+		WithNonce(msg.Nonce)
 }
@@ -13,18 +13,27 @@ import (
 )
 
 var (
-	_ sdk.Tx = (*StdTx)(nil)
+	_ sdk.Tx    = (*StdTx)(nil)
+	_ sdk.FeeTx = (*StdTx)(nil)
 
 	maxGasWanted = uint64((1 << 63) - 1)
 )
 
 // StdTx is a standard way to wrap a Msg with Fee and Signatures.
 // NOTE: the first signature is the fee payer (Signatures must not be nil).
+//
+// If TimeoutHeight is set, the transaction is rejected in blocks past this
+// height. A non-zero Nonce makes the transaction unordered: it is signed with
+// a zero sequence and does not consume the sequence of its signers. Instead,
+// the nonce must be unique among the unordered transactions of the first
+// signer with the same timeout height, which is required.
 type StdTx struct {
-	Msgs       []sdk.Msg      `json:"msg" yaml:"msg"`
-	Fee        StdFee         `json:"fee" yaml:"fee"`
-	Signatures []StdSignature `json:"signatures" yaml:"signatures"`
-	Memo       string         `json:"memo" yaml:"memo"`
+	Msgs          []sdk.Msg      `json:"msg" yaml:"msg"`
+	Fee           StdFee         `json:"fee" yaml:"fee"`
+	Signatures    []StdSignature `json:"signatures" yaml:"signatures"`
+	Memo          string         `json:"memo" yaml:"memo"`
+	TimeoutHeight uint64         `json:"timeout_height,omitempty" yaml:"timeout_height,omitempty"`
+	Nonce         uint64         `json:"nonce,omitempty" yaml:"nonce,omitempty"`
 }
 
 func NewStdTx(msgs []sdk.Msg, fee StdFee, sigs []StdSignature, memo string) StdTx {
@@ -36,6 +45,35 @@ func NewStdTx(msgs []sdk.Msg, fee StdFee, sigs []StdSignature, memo string) StdT
 	}
 }
 
+// WithTimeoutHeight returns a copy of the transaction with the given timeout
+// height set.
+func (tx StdTx) WithTimeoutHeight(height uint64) StdTx {
+	tx.TimeoutHeight = height
+	return tx
+}
+
+// WithNonce returns a copy of the transaction with the given unordered nonce
+// set.
+func (tx StdTx) WithNonce(nonce uint64) StdTx {
+	tx.Nonce = nonce
+	return tx
+}
+
+// IsUnordered returns true if the transaction is unordered, i.e. it has a
+// nonce instead of consuming the sequence of its signers.
+func (tx StdTx) IsUnordered() bool { return tx.Nonce != 0 }
+
+// SignBytes returns the bytes to sign for the transaction by a signer with the
+// given account number and sequence. Unordered transactions are signed with a
+// zero sequence.
+func (tx StdTx) SignBytes(chainID string, accnum, sequence uint64) []byte {
+	if tx.IsUnordered() {
+		sequence = 0
+	}
+
+	return StdSignBytesWithTimeout(chainID, accnum, sequence, tx.TimeoutHeight, tx.Nonce, tx.Fee, tx.Msgs, tx.Memo)
+}
+
 // GetMsgs returns the all the transaction's messages.
 func (tx StdTx) GetMsgs() []sdk.Msg { return tx.Msgs }
 
@@ -56,6 +94,9 @@ func (tx StdTx) ValidateBasic() sdk.Error {
 	if len(stdSigs) != len(tx.GetSigners()) {
 		return sdk.ErrUnauthorized("wrong number of signers")
 	}
+	if tx.IsUnordered() && tx.TimeoutHeight == 0 {
+		return sdk.ErrUnknownRequest("unordered tx must have a timeout height")
+	}
 
 	return nil
 }
@@ -97,6 +138,9 @@ func (tx StdTx) GetSigners() []sdk.AccAddress {
 // GetMemo returns the memo
 func (tx StdTx) GetMemo() string { return tx.Memo }
 
+// GetFee returns the fees paid by the tx.
+func (tx StdTx) GetFee() sdk.Coins { return tx.Fee.Amount }
+
 // GetSignatures returns the signature of signers who signed the Msg.
 // GetSignatures returns the signature of signers who signed the Msg.
 // CONTRACT: Length returned is same as length of
@@ -112,9 +156,14 @@ func (tx StdTx) GetSignatures() []StdSignature { return tx.Signatures }
 // StdFee includes the amount of coins paid in fees and the maximum
 // gas to be used by the transaction. The ratio yields an effective "gasprice",
 // which must be above some miminum to be accepted into the mempool.
+//
+// If Granter is set, the fees are paid by the granter account from a fee
+// allowance it has granted to the first signer instead of by the first signer
+// itself.
 type StdFee struct {
-	Amount sdk.Coins `json:"amount" yaml:"amount"`
-	Gas    uint64    `json:"gas" yaml:"gas"`
+	Amount  sdk.Coins      `json:"amount" yaml:"amount"`
+	Gas     uint64         `json:"gas" yaml:"gas"`
+	Granter sdk.AccAddress `json:"granter,omitempty" yaml:"granter,omitempty"`
 }
 
 // NewStdFee returns a new instance of StdFee
@@ -125,6 +174,12 @@ func NewStdFee(gas uint64, amount sdk.Coins) StdFee {
 	}
 }
 
+// WithGranter returns a copy of the fee with the given fee granter set.
+func (fee StdFee) WithGranter(granter sdk.AccAddress) StdFee {
+	fee.Granter = granter
+	return fee
+}
+
 // Bytes for signing later
 func (fee StdFee) Bytes() []byte {
 	// normalize. XXX
@@ -157,6 +212,8 @@ func (fee StdFee) GasPrices() sdk.DecCoins {
 // as well as the ChainID (prevent cross chain replay)
 // and the Sequence numbers for each signature (prevent
 // inchain replay and enforce tx ordering per account).
+// Unordered transactions are signed with a zero sequence
+// and their nonce instead.
 type StdSignDoc struct {
 	AccountNumber uint64            `json:"account_number" yaml:"account_number"`
 	ChainID       string            `json:"chain_id" yaml:"chain_id"`
@@ -164,10 +221,22 @@ type StdSignDoc struct {
 	Memo          string            `json:"memo" yaml:"memo"`
 	Msgs          []json.RawMessage `json:"msgs" yaml:"msgs"`
 	Sequence      uint64            `json:"sequence" yaml:"sequence"`
+	TimeoutHeight uint64            `json:"timeout_height,omitempty" yaml:"timeout_height,omitempty"`
+	Nonce         uint64            `json:"nonce,omitempty" yaml:"nonce,omitempty"`
 }
 
 // StdSignBytes returns the bytes to sign for a transaction.
 func StdSignBytes(chainID string, accnum uint64, sequence uint64, fee StdFee, msgs []sdk.Msg, memo string) []byte {
+	return StdSignBytesWithTimeout(chainID, accnum, sequence, 0, 0, fee, msgs, memo)
+}
+
+// StdSignBytesWithTimeout returns the bytes to sign for a transaction with a
+// timeout height and, if it is unordered, a nonce. The bytes are the same as
+// the ones of StdSignBytes if both are zero.
+func StdSignBytesWithTimeout(
+	chainID string, accnum, sequence, timeoutHeight, nonce uint64, fee StdFee, msgs []sdk.Msg, memo string,
+) []byte {
+
 	var msgsBytes []json.RawMessage
 	for _, msg := range msgs {
 		msgsBytes = append(msgsBytes, json.RawMessage(msg.GetSignBytes()))
@@ -179,6 +248,8 @@ func StdSignBytes(chainID string, accnum uint64, sequence uint64, fee StdFee, ms
 		Memo:          memo,
 		Msgs:          msgsBytes,
 		Sequence:      sequence,
+		TimeoutHeight: timeoutHeight,
+		Nonce:         nonce,
 	})
 	if err != nil {
 		panic(err)
@@ -51,6 +51,10 @@ func TestStdSignBytes(t *testing.T) {
 			args{"1234", 3, 6, defaultFee, []sdk.Msg{sdk.NewTestMsg(addr)}, "memo"},
 			fmt.Sprintf("{\"account_number\":\"3\",\"chain_id\":\"1234\",\"fee\":{\"amount\":[{\"amount\":\"150\",\"denom\":\"atom\"}],\"gas\":\"50000\"},\"memo\":\"memo\",\"msgs\":[[\"%s\"]],\"sequence\":\"6\"}", addr),
 		},
+		{
+			args{"1234", 3, 6, defaultFee.WithGranter(addr), []sdk.Msg{sdk.NewTestMsg(addr)}, "memo"},
+			fmt.Sprintf("{\"account_number\":\"3\",\"chain_id\":\"1234\",\"fee\":{\"amount\":[{\"amount\":\"150\",\"denom\":\"atom\"}],\"gas\":\"50000\",\"granter\":\"%s\"},\"memo\":\"memo\",\"msgs\":[[\"%s\"]],\"sequence\":\"6\"}", addr, addr),
+		},
 	}
 	for i, tc := range tests {
 		got := string(StdSignBytes(tc.args.chainID, tc.args.accnum, tc.args.sequence, tc.args.fee, tc.args.msgs, tc.args.memo))
@@ -58,6 +62,27 @@ func TestStdSignBytes(t *testing.T) {
 	}
 }
 
+func TestStdSignBytesWithTimeout(t *testing.T) {
+	msgs := []sdk.Msg{sdk.NewTestMsg(addr)}
+	fee := NewTestStdFee()
+
+	// zero timeout height and nonce are omitted
+	require.Equal(t,
+		StdSignBytes("1234", 3, 6, fee, msgs, "memo"),
+		StdSignBytesWithTimeout("1234", 3, 6, 0, 0, fee, msgs, "memo"),
+	)
+
+	got := string(StdSignBytesWithTimeout("1234", 3, 0, 100, 7, fee, msgs, "memo"))
+	want := fmt.Sprintf("{\"account_number\":\"3\",\"chain_id\":\"1234\",\"fee\":{\"amount\":[{\"amount\":\"150\",\"denom\":\"atom\"}],\"gas\":\"50000\"},\"memo\":\"memo\",\"msgs\":[[\"%s\"]],\"nonce\":\"7\",\"sequence\":\"0\",\"timeout_height\":\"100\"}", addr)
+	require.Equal(t, want, got)
+
+	// unordered transactions are signed with a zero sequence
+	tx := NewStdTx(msgs, fee, nil, "memo").WithTimeoutHeight(100)
+	require.Equal(t, StdSignBytesWithTimeout("1234", 3, 6, 100, 0, fee, msgs, "memo"), tx.SignBytes("1234", 3, 6))
+	tx = tx.WithNonce(7)
+	require.Equal(t, []byte(want), tx.SignBytes("1234", 3, 6))
+}
+
 func TestTxValidateBasic(t *testing.T) {
 	ctx := sdk.NewContext(nil, abci.Header{ChainID: "mychainid"}, false, log.NewNopLogger())
 
@@ -105,8 +130,15 @@ func TestTxValidateBasic(t *testing.T) {
 	require.Error(t, err)
 	require.Equal(t, sdk.CodeGasOverflow, err.Result().Code)
 
-	// require to pass when above criteria are matched
+	// require to fail validation when an unordered tx has no timeout height
 	privs, accNums, seqs = []crypto.PrivKey{priv1, priv2}, []uint64{0, 1}, []uint64{0, 0}
+	tx = NewTestTxWithTimeout(ctx, msgs, privs, accNums, seqs, fee, 0, 1)
+
+	err = tx.ValidateBasic()
+	require.Error(t, err)
+	require.Equal(t, sdk.CodeUnknownRequest, err.Result().Code)
+
+	// require to pass when above criteria are matched
 	tx = NewTestTx(ctx, msgs, privs, accNums, seqs, fee)
 
 	err = tx.ValidateBasic()
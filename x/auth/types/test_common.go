@@ -66,6 +66,23 @@ func NewTestTxWithMemo(ctx sdk.Context, msgs []sdk.Msg, privs []crypto.PrivKey,
 	return tx
 }
 
+func NewTestTxWithTimeout(ctx sdk.Context, msgs []sdk.Msg, privs []crypto.PrivKey, accNums []uint64, seqs []uint64, fee StdFee, timeoutHeight, nonce uint64) sdk.Tx {
+	tx := NewStdTx(msgs, fee, nil, "").WithTimeoutHeight(timeoutHeight).WithNonce(nonce)
+
+	sigs := make([]StdSignature, len(privs))
+	for i, priv := range privs {
+		sig, err := priv.Sign(tx.SignBytes(ctx.ChainID(), accNums[i], seqs[i]))
+		if err != nil {
+			panic(err)
+		}
+
+		sigs[i] = StdSignature{PubKey: priv.PubKey(), Signature: sig}
+	}
+
+	tx.Signatures = sigs
+	return tx
+}
+
 func NewTestTxWithSignBytes(msgs []sdk.Msg, privs []crypto.PrivKey, accNums []uint64, seqs []uint64, fee StdFee, signBytes []byte, memo string) sdk.Tx {
 	sigs := make([]StdSignature, len(privs))
 	for i, priv := range privs {
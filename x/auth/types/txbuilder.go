@@ -26,6 +26,9 @@ type TxBuilder struct {
 	memo               string
 	fees               sdk.Coins
 	gasPrices          sdk.DecCoins
+	feeGranter         sdk.AccAddress
+	timeoutHeight      uint64
+	nonce              uint64
 }
 
 // NewTxBuilder returns a new initialized TxBuilder.
@@ -65,11 +68,22 @@ func NewTxBuilderFromCLI() TxBuilder {
 		simulateAndExecute: flags.GasFlagVar.Simulate,
 		chainID:            viper.GetString(flags.FlagChainID),
 		memo:               viper.GetString(flags.FlagMemo),
+		timeoutHeight:      uint64(viper.GetInt64(flags.FlagTimeoutHeight)),
+		nonce:              uint64(viper.GetInt64(flags.FlagNonce)),
 	}
 
 	txbldr = txbldr.WithFees(viper.GetString(flags.FlagFees))
 	txbldr = txbldr.WithGasPrices(viper.GetString(flags.FlagGasPrices))
 
+	if granter := viper.GetString(flags.FlagFeeGranter); granter != "" {
+		addr, err := sdk.AccAddressFromBech32(granter)
+		if err != nil {
+			panic(err)
+		}
+
+		txbldr = txbldr.WithFeeGranter(addr)
+	}
+
 	return txbldr
 }
 
@@ -107,6 +121,16 @@ func (bldr TxBuilder) Fees() sdk.Coins { return bldr.fees }
 // GasPrices returns the gas prices set for the transaction, if any.
 func (bldr TxBuilder) GasPrices() sdk.DecCoins { return bldr.gasPrices }
 
+// FeeGranter returns the account paying the fees from a fee allowance, if any.
+func (bldr TxBuilder) FeeGranter() sdk.AccAddress { return bldr.feeGranter }
+
+// TimeoutHeight returns the height after which the transaction is rejected, if
+// any.
+func (bldr TxBuilder) TimeoutHeight() uint64 { return bldr.timeoutHeight }
+
+// Nonce returns the nonce of an unordered transaction, if any.
+func (bldr TxBuilder) Nonce() uint64 { return bldr.nonce }
+
 // WithTxEncoder returns a copy of the context with an updated codec.
 func (bldr TxBuilder) WithTxEncoder(txEncoder sdk.TxEncoder) TxBuilder {
 	bldr.txEncoder = txEncoder
@@ -147,6 +171,26 @@ func (bldr TxBuilder) WithGasPrices(gasPrices string) TxBuilder {
 	return bldr
 }
 
+// WithFeeGranter returns a copy of the context with an updated fee granter.
+func (bldr TxBuilder) WithFeeGranter(granter sdk.AccAddress) TxBuilder {
+	bldr.feeGranter = granter
+	return bldr
+}
+
+// WithTimeoutHeight returns a copy of the context with an updated timeout
+// height.
+func (bldr TxBuilder) WithTimeoutHeight(height uint64) TxBuilder {
+	bldr.timeoutHeight = height
+	return bldr
+}
+
+// WithNonce returns a copy of the context with an updated unordered nonce.
+// Unordered transactions are signed with a zero sequence.
+func (bldr TxBuilder) WithNonce(nonce uint64) TxBuilder {
+	bldr.nonce = nonce
+	return bldr
+}
+
 // WithKeybase returns a copy of the context with updated keybase.
 func (bldr TxBuilder) WithKeybase(keybase crkeys.Keybase) TxBuilder {
 	bldr.keybase = keybase
@@ -199,10 +243,12 @@ func (bldr TxBuilder) BuildSignMsg(msgs []sdk.Msg) (StdSignMsg, error) {
 	return StdSignMsg{
 		ChainID:       bldr.chainID,
 		AccountNumber: bldr.accountNumber,
-		Sequence:      bldr.sequence,
+		Sequence:      bldr.signSequence(),
 		Memo:          bldr.memo,
 		Msgs:          msgs,
-		Fee:           NewStdFee(bldr.gas, fees),
+		Fee:           NewStdFee(bldr.gas, fees).WithGranter(bldr.feeGranter),
+		TimeoutHeight: bldr.timeoutHeight,
+		Nonce:         bldr.nonce,
 	}, nil
 }
 
@@ -214,7 +260,7 @@ func (bldr TxBuilder) Sign(name, passphrase string, msg StdSignMsg) ([]byte, err
 		return nil, err
 	}
 
-	return bldr.txEncoder(NewStdTx(msg.Msgs, msg.Fee, []StdSignature{sig}, msg.Memo))
+	return bldr.txEncoder(msg.StdTx([]StdSignature{sig}))
 }
 
 // BuildAndSign builds a single message to be signed, and signs a transaction
@@ -238,7 +284,7 @@ func (bldr TxBuilder) BuildTxForSim(msgs []sdk.Msg) ([]byte, error) {
 
 	// the ante handler will populate with a sentinel pubkey
 	sigs := []StdSignature{{}}
-	return bldr.txEncoder(NewStdTx(signMsg.Msgs, signMsg.Fee, sigs, signMsg.Memo))
+	return bldr.txEncoder(signMsg.StdTx(sigs))
 }
 
 // SignStdTx appends a signature to a StdTx and returns a copy of it. If append
@@ -248,14 +294,21 @@ func (bldr TxBuilder) SignStdTx(name, passphrase string, stdTx StdTx, appendSig
 		return StdTx{}, fmt.Errorf("chain ID required but not specified")
 	}
 
-	stdSignature, err := MakeSignature(bldr.keybase, name, passphrase, StdSignMsg{
+	signMsg := StdSignMsg{
 		ChainID:       bldr.chainID,
 		AccountNumber: bldr.accountNumber,
 		Sequence:      bldr.sequence,
 		Fee:           stdTx.Fee,
 		Msgs:          stdTx.GetMsgs(),
 		Memo:          stdTx.GetMemo(),
-	})
+		TimeoutHeight: stdTx.TimeoutHeight,
+		Nonce:         stdTx.Nonce,
+	}
+	if stdTx.IsUnordered() {
+		signMsg.Sequence = 0
+	}
+
+	stdSignature, err := MakeSignature(bldr.keybase, name, passphrase, signMsg)
 	if err != nil {
 		return
 	}
@@ -266,10 +319,20 @@ func (bldr TxBuilder) SignStdTx(name, passphrase string, stdTx StdTx, appendSig
 	} else {
 		sigs = append(sigs, stdSignature)
 	}
-	signedStdTx = NewStdTx(stdTx.GetMsgs(), stdTx.Fee, sigs, stdTx.GetMemo())
+	signedStdTx = signMsg.StdTx(sigs)
 	return
 }
 
+// signSequence returns the sequence to sign, which is zero for unordered
+// transactions.
+func (bldr TxBuilder) signSequence() uint64 {
+	if bldr.nonce != 0 {
+		return 0
+	}
+
+	return bldr.sequence
+}
+
 // MakeSignature builds a StdSignature given keybase, key name, passphrase, and a StdSignMsg.
 func MakeSignature(keybase crkeys.Keybase, name, passphrase string,
 	msg StdSignMsg) (sig StdSignature, err error) {
@@ -0,0 +1,75 @@
+// nolint
+// autogenerated code using github.com/rigelrozanski/multitool
+// aliases generated for the following subdirectories:
+// ALIASGEN: github.com/cosmos/cosmos-sdk/x/authz/internal/keeper
+// ALIASGEN: github.com/cosmos/cosmos-sdk/x/authz/internal/types
+package authz
+
+import (
+	"github.com/cosmos/cosmos-sdk/x/authz/internal/keeper"
+	"github.com/cosmos/cosmos-sdk/x/authz/internal/types"
+)
+
+const (
+	ModuleName                   = types.ModuleName
+	StoreKey                     = types.StoreKey
+	RouterKey                    = types.RouterKey
+	QuerierRoute                 = types.QuerierRoute
+	QueryGrants                  = types.QueryGrants
+	DefaultCodespace             = types.DefaultCodespace
+	CodeInvalidAuthorization     = types.CodeInvalidAuthorization
+	CodeNoAuthorization          = types.CodeNoAuthorization
+	CodeInvalidExpiration        = types.CodeInvalidExpiration
+	CodeSpendLimitExceeded       = types.CodeSpendLimitExceeded
+	CodeInvalidMsgs              = types.CodeInvalidMsgs
+	EventTypeGrantAuthorization  = types.EventTypeGrantAuthorization
+	EventTypeRevokeAuthorization = types.EventTypeRevokeAuthorization
+	EventTypeExecAuthorized      = types.EventTypeExecAuthorized
+	AttributeKeyGranter          = types.AttributeKeyGranter
+	AttributeKeyGrantee          = types.AttributeKeyGrantee
+	AttributeKeyMsgType          = types.AttributeKeyMsgType
+	AttributeValueCategory       = types.AttributeValueCategory
+)
+
+var (
+	// functions aliases
+	NewKeeper                 = keeper.NewKeeper
+	NewQuerier                = keeper.NewQuerier
+	GetMsgType                = types.GetMsgType
+	RegisterCodec             = types.RegisterCodec
+	NewDelegateAuthorization  = types.NewDelegateAuthorization
+	ErrInvalidAuthorization   = types.ErrInvalidAuthorization
+	ErrNoAuthorization        = types.ErrNoAuthorization
+	ErrInvalidExpiration      = types.ErrInvalidExpiration
+	ErrSpendLimitExceeded     = types.ErrSpendLimitExceeded
+	ErrInvalidMsgs            = types.ErrInvalidMsgs
+	NewGenericAuthorization   = types.NewGenericAuthorization
+	NewGenesisState           = types.NewGenesisState
+	DefaultGenesisState       = types.DefaultGenesisState
+	ValidateGenesis           = types.ValidateGenesis
+	NewAuthorizationGrant     = types.NewAuthorizationGrant
+	GrantKey                  = types.GrantKey
+	GrantPrefixByGranter      = types.GrantPrefixByGranter
+	NewMsgGrantAuthorization  = types.NewMsgGrantAuthorization
+	NewMsgRevokeAuthorization = types.NewMsgRevokeAuthorization
+	NewMsgExec                = types.NewMsgExec
+	NewSendAuthorization      = types.NewSendAuthorization
+
+	// variable aliases
+	ModuleCdc      = types.ModuleCdc
+	GrantKeyPrefix = types.GrantKeyPrefix
+)
+
+type (
+	Keeper                 = keeper.Keeper
+	Authorization          = types.Authorization
+	DelegateAuthorization  = types.DelegateAuthorization
+	GenericAuthorization   = types.GenericAuthorization
+	GenesisState           = types.GenesisState
+	AuthorizationGrant     = types.AuthorizationGrant
+	AuthorizationGrants    = types.AuthorizationGrants
+	MsgGrantAuthorization  = types.MsgGrantAuthorization
+	MsgRevokeAuthorization = types.MsgRevokeAuthorization
+	MsgExec                = types.MsgExec
+	SendAuthorization      = types.SendAuthorization
+)
@@ -0,0 +1,119 @@
+package authz_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	"github.com/cosmos/cosmos-sdk/x/bank"
+	"github.com/cosmos/cosmos-sdk/x/gov"
+)
+
+var (
+	granter   = sdk.AccAddress("granter_____________")
+	grantee   = sdk.AccAddress("grantee_____________")
+	recipient = sdk.AccAddress("recipient___________")
+)
+
+func createTestApp() (*simapp.SimApp, sdk.Context, sdk.Handler) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, abci.Header{Height: 10, Time: time.Now()})
+
+	acc := app.AccountKeeper.NewAccountWithAddress(ctx, granter)
+	if err := acc.SetCoins(sdk.NewCoins(sdk.NewInt64Coin("atom", 1000))); err != nil {
+		panic(err)
+	}
+	app.AccountKeeper.SetAccount(ctx, acc)
+
+	return app, ctx, authz.NewHandler(app.AuthzKeeper)
+}
+
+func TestExecWithSendAuthorization(t *testing.T) {
+	app, ctx, handler := createTestApp()
+
+	send := bank.MsgSend{FromAddress: granter, ToAddress: recipient, Amount: sdk.NewCoins(sdk.NewInt64Coin("atom", 60))}
+	exec := authz.NewMsgExec(grantee, []sdk.Msg{send})
+	require.NoError(t, exec.ValidateBasic())
+
+	// no authorization
+	res := handler(ctx, exec)
+	require.False(t, res.IsOK())
+	require.Equal(t, authz.CodeNoAuthorization, res.Code)
+
+	authorization := authz.NewSendAuthorization(sdk.NewCoins(sdk.NewInt64Coin("atom", 100)))
+
+	// expiration must be in the future
+	res = handler(ctx, authz.NewMsgGrantAuthorization(granter, grantee, authorization, ctx.BlockTime()))
+	require.Equal(t, authz.CodeInvalidExpiration, res.Code)
+
+	res = handler(ctx, authz.NewMsgGrantAuthorization(granter, grantee, authorization, ctx.BlockTime().Add(time.Hour)))
+	require.True(t, res.IsOK(), res.Log)
+
+	res = handler(ctx, exec)
+	require.True(t, res.IsOK(), res.Log)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("atom", 60)), app.AccountKeeper.GetAccount(ctx, recipient).GetCoins())
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("atom", 940)), app.AccountKeeper.GetAccount(ctx, granter).GetCoins())
+	require.Equal(t,
+		authz.NewSendAuthorization(sdk.NewCoins(sdk.NewInt64Coin("atom", 40))),
+		app.AuthzKeeper.GetAuthorization(ctx, granter, grantee, "bank/send"),
+	)
+
+	// the remaining spend limit doesn't cover another send
+	res = handler(ctx, exec)
+	require.Equal(t, authz.CodeSpendLimitExceeded, res.Code)
+
+	// expired authorizations are ignored
+	expiredCtx := ctx.WithBlockTime(ctx.BlockTime().Add(2 * time.Hour))
+	send.Amount = sdk.NewCoins(sdk.NewInt64Coin("atom", 10))
+	res = handler(expiredCtx, authz.NewMsgExec(grantee, []sdk.Msg{send}))
+	require.Equal(t, authz.CodeNoAuthorization, res.Code)
+
+	// using up the spend limit removes the authorization
+	send.Amount = sdk.NewCoins(sdk.NewInt64Coin("atom", 40))
+	res = handler(ctx, authz.NewMsgExec(grantee, []sdk.Msg{send}))
+	require.True(t, res.IsOK(), res.Log)
+	require.Nil(t, app.AuthzKeeper.GetAuthorization(ctx, granter, grantee, "bank/send"))
+}
+
+func TestRevokeAndQueryGrants(t *testing.T) {
+	app, ctx, handler := createTestApp()
+	expiration := ctx.BlockTime().Add(time.Hour)
+
+	res := handler(ctx, authz.NewMsgGrantAuthorization(granter, grantee, authz.NewSendAuthorization(nil), expiration))
+	require.True(t, res.IsOK(), res.Log)
+
+	msgType := authz.GetMsgType(gov.MsgVote{})
+	res = handler(ctx, authz.NewMsgGrantAuthorization(granter, grantee, authz.NewGenericAuthorization(msgType), expiration))
+	require.True(t, res.IsOK(), res.Log)
+
+	querier := authz.NewQuerier(app.AuthzKeeper)
+	path := []string{authz.QueryGrants, granter.String()}
+
+	bz, err := querier(ctx, path, abci.RequestQuery{})
+	require.NoError(t, err)
+
+	var grants authz.AuthorizationGrants
+	require.NoError(t, app.Codec().UnmarshalJSON(bz, &grants))
+	require.Len(t, grants, 2)
+
+	// expired grants are not listed
+	bz, err = querier(ctx.WithBlockTime(expiration), path, abci.RequestQuery{})
+	require.NoError(t, err)
+	require.Equal(t, "[]", string(bz))
+
+	res = handler(ctx, authz.NewMsgRevokeAuthorization(granter, grantee, msgType))
+	require.True(t, res.IsOK(), res.Log)
+
+	res = handler(ctx, authz.NewMsgRevokeAuthorization(granter, grantee, msgType))
+	require.Equal(t, authz.CodeNoAuthorization, res.Code, fmt.Sprint(res))
+
+	genesis := authz.ExportGenesis(ctx, app.AuthzKeeper)
+	require.Len(t, genesis.Grants, 1)
+	require.NoError(t, authz.ValidateGenesis(genesis))
+}
@@ -0,0 +1,63 @@
+package cli
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz/internal/types"
+)
+
+// GetQueryCmd returns the cli query commands for the authz module.
+func GetQueryCmd(cdc *codec.Codec) *cobra.Command {
+	authzQueryCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Querying commands for the authz module",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	authzQueryCmd.AddCommand(
+		client.GetCommands(
+			GetCmdQueryGrants(cdc),
+		)...,
+	)
+
+	return authzQueryCmd
+}
+
+// GetCmdQueryGrants implements the command to query the active grants of a
+// granter.
+func GetCmdQueryGrants(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "grants [granter]",
+		Short: "Query all the active authorizations given by an address",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			granter, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			route := fmt.Sprintf("custom/%s/%s/%s", types.QuerierRoute, types.QueryGrants, granter)
+			res, _, err := cliCtx.QueryWithData(route, nil)
+			if err != nil {
+				return err
+			}
+
+			var grants types.AuthorizationGrants
+			if err := cdc.UnmarshalJSON(res, &grants); err != nil {
+				return err
+			}
+
+			return cliCtx.PrintOutput(grants)
+		},
+	}
+}
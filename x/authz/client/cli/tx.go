@@ -0,0 +1,169 @@
+package cli
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/cosmos/cosmos-sdk/x/auth/client/utils"
+	"github.com/cosmos/cosmos-sdk/x/authz/internal/types"
+)
+
+// flags for the authz commands
+const (
+	FlagSpendLimit = "spend-limit"
+	FlagExpiration = "expiration"
+	FlagMsgType    = "msg-type"
+)
+
+// GetTxCmd returns the transaction commands for this module
+func GetTxCmd(cdc *codec.Codec) *cobra.Command {
+	authzTxCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Authorization transaction subcommands",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	authzTxCmd.AddCommand(client.PostCommands(
+		GetCmdGrantAuthorization(cdc),
+		GetCmdRevokeAuthorization(cdc),
+		GetCmdExec(cdc),
+	)...)
+
+	return authzTxCmd
+}
+
+// GetCmdGrantAuthorization implements the command to grant an authorization.
+func GetCmdGrantAuthorization(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "grant [grantee] [send|delegate|generic]",
+		Short: "Grant an address the authorization to execute messages on your behalf",
+		Long: `Grant the grantee the authorization to execute messages of a single type on behalf of the signer
+until the expiration time. The send and delegate authorizations permit bank send and staking delegate
+messages up to an optional total --spend-limit. The generic authorization permits any number of messages
+of the type given with --msg-type, formatted as <route>/<type>.
+
+Example:
+$ <appcli> tx authz grant cosmos1... send --spend-limit 1000stake --expiration 2021-01-01T00:00:00Z --from mykey
+$ <appcli> tx authz grant cosmos1... generic --msg-type gov/vote --expiration 2021-01-01T00:00:00Z --from mykey
+`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			txBldr := auth.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			grantee, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			spendLimit, err := sdk.ParseCoins(viper.GetString(FlagSpendLimit))
+			if err != nil {
+				return err
+			}
+
+			var authorization types.Authorization
+			switch args[1] {
+			case "send":
+				authorization = types.NewSendAuthorization(spendLimit)
+			case "delegate":
+				authorization = types.NewDelegateAuthorization(spendLimit)
+			case "generic":
+				authorization = types.NewGenericAuthorization(viper.GetString(FlagMsgType))
+			default:
+				return fmt.Errorf("invalid authorization type %q", args[1])
+			}
+
+			expiration, err := time.Parse(time.RFC3339, viper.GetString(FlagExpiration))
+			if err != nil {
+				return fmt.Errorf("invalid expiration time: %s", err)
+			}
+
+			msg := types.NewMsgGrantAuthorization(cliCtx.GetFromAddress(), grantee, authorization, expiration)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+
+	cmd.Flags().String(FlagSpendLimit, "", "Total amount of coins the grantee may spend; unlimited if empty")
+	cmd.Flags().String(FlagMsgType, "", "Type of the messages permitted by a generic authorization, e.g. gov/vote")
+	cmd.Flags().String(FlagExpiration, "", "Block time (RFC3339) at which the authorization expires")
+	cmd.MarkFlagRequired(FlagExpiration)
+
+	return cmd
+}
+
+// GetCmdRevokeAuthorization implements the command to revoke an authorization.
+func GetCmdRevokeAuthorization(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "revoke [grantee] [msg-type]",
+		Short: "Revoke the authorization given to an address for a message type",
+		Long: `Revoke the authorization given to the grantee for a message type, formatted as <route>/<type>.
+
+Example:
+$ <appcli> tx authz revoke cosmos1... bank/send --from mykey
+`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			txBldr := auth.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			grantee, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgRevokeAuthorization(cliCtx.GetFromAddress(), grantee, args[1])
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
+
+// GetCmdExec implements the command to execute the messages of a transaction
+// on behalf of their signers.
+func GetCmdExec(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "exec [tx-json-file]",
+		Short: "Execute the messages of a transaction on behalf of their signers",
+		Long: `Execute the messages of an unsigned transaction, as generated with --generate-only, on behalf of
+their signers, who must have granted the signer of this command an authorization for each message.
+
+Example:
+$ <appcli> tx bank send cosmos1<granter> cosmos1... 10stake --generate-only > tx.json
+$ <appcli> tx authz exec tx.json --from mykey
+`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			txBldr := auth.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			stdTx, err := utils.ReadStdTxFromFile(cdc, args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgExec(cliCtx.GetFromAddress(), stdTx.GetMsgs())
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
@@ -0,0 +1,21 @@
+/*
+Package authz allows an account (the granter) to authorize another account
+(the grantee) to execute messages on its behalf.
+
+A granter issues a MsgGrantAuthorization with an Authorization for a single
+message type and an expiration time. The following authorizations are
+provided:
+
+	- GenericAuthorization: any number of messages of a given type, eg.
+	  "gov/vote".
+	- SendAuthorization: bank.MsgSend with an optional total spend limit.
+	- DelegateAuthorization: staking.MsgDelegate with an optional total spend
+	  limit.
+
+The grantee signs a MsgExec wrapping the messages to execute. Each message is
+routed through the app's router as if it had been signed by its own signer,
+after the signer's authorization for the message type has been checked and
+consumed. Authorizations whose spend limit is used up are removed, and a
+granter revokes an authorization with MsgRevokeAuthorization.
+*/
+package authz
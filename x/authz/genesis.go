@@ -0,0 +1,26 @@
+package authz
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// InitGenesis stores the grants of a *previously validated* GenesisState
+func InitGenesis(ctx sdk.Context, k Keeper, data GenesisState) {
+	for _, g := range data.Grants {
+		k.Grant(ctx, g.Granter, g.Grantee, g.Authorization, g.Expiration)
+	}
+}
+
+// ExportGenesis returns a GenesisState with all the grants that have not
+// expired yet.
+func ExportGenesis(ctx sdk.Context, k Keeper) GenesisState {
+	grants := []AuthorizationGrant{}
+	k.IterateGrants(ctx, func(grant AuthorizationGrant) bool {
+		if !grant.IsExpired(ctx.BlockTime()) {
+			grants = append(grants, grant)
+		}
+		return false
+	})
+
+	return NewGenesisState(grants)
+}
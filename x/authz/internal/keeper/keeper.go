@@ -0,0 +1,216 @@
+package keeper
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz/internal/types"
+)
+
+// Keeper of the authz store
+type Keeper struct {
+	storeKey sdk.StoreKey
+	cdc      *codec.Codec
+	router   sdk.Router
+}
+
+// NewKeeper constructs an authz Keeper. The router is used to dispatch the
+// messages executed on behalf of granters, it is typically the app's
+// baseapp.Router.
+func NewKeeper(cdc *codec.Codec, storeKey sdk.StoreKey, router sdk.Router) Keeper {
+	return Keeper{
+		storeKey: storeKey,
+		cdc:      cdc,
+		router:   router,
+	}
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
+}
+
+// Grant stores an authorization from the granter to the grantee that expires
+// at the given time, overwriting any grant for the same message type.
+func (k Keeper) Grant(
+	ctx sdk.Context, granter, grantee sdk.AccAddress, authorization types.Authorization, expiration time.Time,
+) {
+
+	grant := types.NewAuthorizationGrant(granter, grantee, authorization, expiration)
+	k.setGrant(ctx, grant)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeGrantAuthorization,
+			sdk.NewAttribute(types.AttributeKeyGranter, granter.String()),
+			sdk.NewAttribute(types.AttributeKeyGrantee, grantee.String()),
+			sdk.NewAttribute(types.AttributeKeyMsgType, authorization.MsgType()),
+		),
+	)
+}
+
+// Revoke removes the authorization for the given message type from the
+// granter to the grantee. It returns an error if there is none.
+func (k Keeper) Revoke(ctx sdk.Context, granter, grantee sdk.AccAddress, msgType string) sdk.Error {
+	store := ctx.KVStore(k.storeKey)
+	key := types.GrantKey(granter, grantee, msgType)
+
+	if !store.Has(key) {
+		return types.ErrNoAuthorization(types.DefaultCodespace, msgType)
+	}
+
+	store.Delete(key)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeRevokeAuthorization,
+			sdk.NewAttribute(types.AttributeKeyGranter, granter.String()),
+			sdk.NewAttribute(types.AttributeKeyGrantee, grantee.String()),
+			sdk.NewAttribute(types.AttributeKeyMsgType, msgType),
+		),
+	)
+
+	return nil
+}
+
+// GetAuthorization returns the active authorization for the given message
+// type from the granter to the grantee, or nil if there is none or it has
+// expired.
+func (k Keeper) GetAuthorization(
+	ctx sdk.Context, granter, grantee sdk.AccAddress, msgType string,
+) types.Authorization {
+
+	grant, found := k.GetGrant(ctx, granter, grantee, msgType)
+	if !found || grant.IsExpired(ctx.BlockTime()) {
+		return nil
+	}
+
+	return grant.Authorization
+}
+
+// GetGrant returns the stored grant for the given message type from the
+// granter to the grantee, regardless of its expiration.
+func (k Keeper) GetGrant(
+	ctx sdk.Context, granter, grantee sdk.AccAddress, msgType string,
+) (grant types.AuthorizationGrant, found bool) {
+
+	store := ctx.KVStore(k.storeKey)
+
+	bz := store.Get(types.GrantKey(granter, grantee, msgType))
+	if bz == nil {
+		return grant, false
+	}
+
+	k.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &grant)
+	return grant, true
+}
+
+// IterateGranterGrants iterates over all the grants of the given granter,
+// including expired ones. The iteration stops when the callback returns true.
+func (k Keeper) IterateGranterGrants(
+	ctx sdk.Context, granter sdk.AccAddress, cb func(grant types.AuthorizationGrant) (stop bool),
+) {
+
+	k.iterateGrants(ctx, types.GrantPrefixByGranter(granter), cb)
+}
+
+// IterateGrants iterates over all the stored grants, including expired ones.
+// The iteration stops when the callback returns true.
+func (k Keeper) IterateGrants(ctx sdk.Context, cb func(grant types.AuthorizationGrant) (stop bool)) {
+	k.iterateGrants(ctx, types.GrantKeyPrefix, cb)
+}
+
+// DispatchActions executes the given messages on behalf of their signers. A
+// message signed by someone else than the grantee is only executed if its
+// signer granted the grantee an active authorization that accepts it. The
+// authorization is updated, or removed, as it is consumed.
+func (k Keeper) DispatchActions(ctx sdk.Context, grantee sdk.AccAddress, msgs []sdk.Msg) sdk.Result {
+	var data []byte
+
+	for _, msg := range msgs {
+		signers := msg.GetSigners()
+		if len(signers) != 1 {
+			return types.ErrInvalidMsgs(types.DefaultCodespace, "each message must have exactly one signer").Result()
+		}
+
+		granter := signers[0]
+		if !granter.Equals(grantee) {
+			if err := k.consumeAuthorization(ctx, granter, grantee, msg); err != nil {
+				return err.Result()
+			}
+		}
+
+		handler := k.router.Route(msg.Route())
+		if handler == nil {
+			return sdk.ErrUnknownRequest(fmt.Sprintf("unrecognized message route: %s", msg.Route())).Result()
+		}
+
+		res := handler(ctx, msg)
+		if !res.IsOK() {
+			return res
+		}
+
+		data = append(data, res.Data...)
+		ctx.EventManager().EmitEvents(res.Events)
+	}
+
+	return sdk.Result{Data: data, Events: ctx.EventManager().Events()}
+}
+
+func (k Keeper) consumeAuthorization(ctx sdk.Context, granter, grantee sdk.AccAddress, msg sdk.Msg) sdk.Error {
+	msgType := types.GetMsgType(msg)
+
+	grant, found := k.GetGrant(ctx, granter, grantee, msgType)
+	if !found || grant.IsExpired(ctx.BlockTime()) {
+		return types.ErrNoAuthorization(types.DefaultCodespace, msgType)
+	}
+
+	updated, remove, err := grant.Authorization.Accept(msg, ctx.BlockHeader())
+	if err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeExecAuthorized,
+			sdk.NewAttribute(types.AttributeKeyGranter, granter.String()),
+			sdk.NewAttribute(types.AttributeKeyGrantee, grantee.String()),
+			sdk.NewAttribute(types.AttributeKeyMsgType, msgType),
+		),
+	)
+
+	if remove {
+		return k.Revoke(ctx, granter, grantee, msgType)
+	}
+
+	grant.Authorization = updated
+	k.setGrant(ctx, grant)
+
+	return nil
+}
+
+func (k Keeper) setGrant(ctx sdk.Context, grant types.AuthorizationGrant) {
+	store := ctx.KVStore(k.storeKey)
+	key := types.GrantKey(grant.Granter, grant.Grantee, grant.Authorization.MsgType())
+	store.Set(key, k.cdc.MustMarshalBinaryLengthPrefixed(grant))
+}
+
+func (k Keeper) iterateGrants(ctx sdk.Context, prefix []byte, cb func(grant types.AuthorizationGrant) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+
+	iter := sdk.KVStorePrefixIterator(store, prefix)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		var grant types.AuthorizationGrant
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(iter.Value(), &grant)
+
+		if cb(grant) {
+			break
+		}
+	}
+}
@@ -0,0 +1,51 @@
+package keeper
+
+import (
+	"fmt"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz/internal/types"
+)
+
+// NewQuerier creates a new querier for the authz module
+func NewQuerier(k Keeper) sdk.Querier {
+	return func(ctx sdk.Context, path []string, req abci.RequestQuery) ([]byte, sdk.Error) {
+		switch path[0] {
+		case types.QueryGrants:
+			return queryGrants(ctx, path[1:], k)
+
+		default:
+			return nil, sdk.ErrUnknownRequest(fmt.Sprintf("unknown authz query endpoint: %s", path[0]))
+		}
+	}
+}
+
+// queryGrants returns the active grants of a granter
+func queryGrants(ctx sdk.Context, args []string, k Keeper) ([]byte, sdk.Error) {
+	if len(args) == 0 {
+		return nil, sdk.ErrUnknownRequest("missing granter address")
+	}
+
+	granter, err := sdk.AccAddressFromBech32(args[0])
+	if err != nil {
+		return nil, sdk.ErrInvalidAddress(fmt.Sprintf("invalid address %s", args[0]))
+	}
+
+	grants := types.AuthorizationGrants{}
+	k.IterateGranterGrants(ctx, granter, func(grant types.AuthorizationGrant) bool {
+		if !grant.IsExpired(ctx.BlockTime()) {
+			grants = append(grants, grant)
+		}
+		return false
+	})
+
+	bz, err := codec.MarshalJSONIndent(k.cdc, grants)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("failed to marshal JSON", err.Error()))
+	}
+
+	return bz, nil
+}
@@ -0,0 +1,31 @@
+package types
+
+import (
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Authorization represents the permission a granter gives a grantee to
+// execute messages of a single type on its behalf.
+type Authorization interface {
+	// MsgType returns the type of the messages, as returned by GetMsgType,
+	// that this authorization permits.
+	MsgType() string
+
+	// Accept determines whether this authorization permits the given message
+	// to be executed. If so, it returns the updated authorization to store
+	// for later messages. If remove is true, the authorization is deleted
+	// (eg. when its spend limit is used up).
+	Accept(msg sdk.Msg, block abci.Header) (updated Authorization, remove bool, err sdk.Error)
+
+	// ValidateBasic performs a stateless validation of the authorization.
+	ValidateBasic() sdk.Error
+}
+
+// GetMsgType returns the type of a message as used to identify the
+// authorizations that permit it, composed of the message route and type, eg.
+// "bank/send".
+func GetMsgType(msg sdk.Msg) string {
+	return msg.Route() + "/" + msg.Type()
+}
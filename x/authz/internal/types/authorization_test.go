@@ -0,0 +1,82 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/bank"
+	staking "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+var (
+	granter = sdk.AccAddress("granter_____________")
+	grantee = sdk.AccAddress("grantee_____________")
+	valAddr = sdk.ValAddress("validator___________")
+)
+
+func TestSendAuthorization(t *testing.T) {
+	limit := sdk.NewCoins(sdk.NewInt64Coin("atom", 100))
+	auth := NewSendAuthorization(limit)
+	require.NoError(t, auth.ValidateBasic())
+	require.Equal(t, "bank/send", auth.MsgType())
+
+	send := bank.MsgSend{FromAddress: granter, ToAddress: grantee, Amount: sdk.NewCoins(sdk.NewInt64Coin("atom", 60))}
+
+	updated, remove, err := auth.Accept(send, abci.Header{})
+	require.NoError(t, err)
+	require.False(t, remove)
+	require.Equal(t, NewSendAuthorization(sdk.NewCoins(sdk.NewInt64Coin("atom", 40))), updated)
+
+	_, _, err = updated.Accept(send, abci.Header{})
+	require.Error(t, err)
+	require.Equal(t, CodeSpendLimitExceeded, err.Code())
+
+	send.Amount = sdk.NewCoins(sdk.NewInt64Coin("atom", 40))
+	_, remove, err = updated.Accept(send, abci.Header{})
+	require.NoError(t, err)
+	require.True(t, remove)
+
+	// no spend limit
+	unlimited := NewSendAuthorization(nil)
+	updated, remove, err = unlimited.Accept(send, abci.Header{})
+	require.NoError(t, err)
+	require.False(t, remove)
+	require.Equal(t, unlimited, updated)
+
+	// wrong message
+	delegate := staking.NewMsgDelegate(granter, valAddr, sdk.NewInt64Coin("atom", 10))
+	_, _, err = auth.Accept(delegate, abci.Header{})
+	require.Error(t, err)
+}
+
+func TestDelegateAuthorization(t *testing.T) {
+	auth := NewDelegateAuthorization(sdk.NewCoins(sdk.NewInt64Coin("atom", 100)))
+	require.NoError(t, auth.ValidateBasic())
+	require.Equal(t, "staking/delegate", auth.MsgType())
+
+	delegate := staking.NewMsgDelegate(granter, valAddr, sdk.NewInt64Coin("atom", 100))
+	_, remove, err := auth.Accept(delegate, abci.Header{})
+	require.NoError(t, err)
+	require.True(t, remove)
+
+	delegate.Amount = sdk.NewInt64Coin("stake", 1)
+	_, _, err = auth.Accept(delegate, abci.Header{})
+	require.Error(t, err)
+	require.Equal(t, CodeSpendLimitExceeded, err.Code())
+}
+
+func TestGenericAuthorization(t *testing.T) {
+	require.Error(t, NewGenericAuthorization(" ").ValidateBasic())
+
+	auth := NewGenericAuthorization("staking/delegate")
+	require.NoError(t, auth.ValidateBasic())
+
+	delegate := staking.NewMsgDelegate(granter, valAddr, sdk.NewInt64Coin("atom", 100))
+	updated, remove, err := auth.Accept(delegate, abci.Header{})
+	require.NoError(t, err)
+	require.False(t, remove)
+	require.Equal(t, auth, updated)
+}
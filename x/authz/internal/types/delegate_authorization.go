@@ -0,0 +1,60 @@
+package types
+
+import (
+	"fmt"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	staking "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// DelegateAuthorization grants the permission to delegate the granter's coins
+// with staking.MsgDelegate.
+type DelegateAuthorization struct {
+	// SpendLimit is the total amount of coins that may be delegated. It is
+	// updated as coins are delegated. If it is empty, any amount of coins may
+	// be delegated.
+	SpendLimit sdk.Coins `json:"spend_limit" yaml:"spend_limit"`
+}
+
+var _ Authorization = DelegateAuthorization{}
+
+// NewDelegateAuthorization creates a new DelegateAuthorization object.
+func NewDelegateAuthorization(spendLimit sdk.Coins) DelegateAuthorization {
+	return DelegateAuthorization{SpendLimit: spendLimit}
+}
+
+// MsgType implements Authorization.
+func (a DelegateAuthorization) MsgType() string {
+	return GetMsgType(staking.MsgDelegate{})
+}
+
+// Accept implements Authorization. The delegated amount is deducted from the
+// spend limit and the authorization is removed once the limit is used up.
+func (a DelegateAuthorization) Accept(msg sdk.Msg, _ abci.Header) (Authorization, bool, sdk.Error) {
+	delegate, ok := msg.(staking.MsgDelegate)
+	if !ok {
+		return nil, false, ErrInvalidMsgs(DefaultCodespace, fmt.Sprintf("expected %T, got %T", staking.MsgDelegate{}, msg))
+	}
+
+	if a.SpendLimit.Empty() {
+		return a, false, nil
+	}
+
+	amount := sdk.NewCoins(delegate.Amount)
+	left, invalid := a.SpendLimit.SafeSub(amount)
+	if invalid {
+		return nil, false, ErrSpendLimitExceeded(DefaultCodespace, fmt.Sprintf("%s > %s", amount, a.SpendLimit))
+	}
+
+	return DelegateAuthorization{SpendLimit: left}, left.IsZero(), nil
+}
+
+// ValidateBasic implements Authorization.
+func (a DelegateAuthorization) ValidateBasic() sdk.Error {
+	if !a.SpendLimit.IsValid() {
+		return ErrInvalidAuthorization(DefaultCodespace, "invalid spend limit "+a.SpendLimit.String())
+	}
+	return nil
+}
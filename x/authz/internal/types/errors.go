@@ -0,0 +1,46 @@
+package types
+
+// DONTCOVER
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Codes for authz errors
+const (
+	DefaultCodespace sdk.CodespaceType = ModuleName
+
+	CodeInvalidAuthorization sdk.CodeType = 1
+	CodeNoAuthorization      sdk.CodeType = 2
+	CodeInvalidExpiration    sdk.CodeType = 3
+	CodeSpendLimitExceeded   sdk.CodeType = 4
+	CodeInvalidMsgs          sdk.CodeType = 5
+)
+
+// ErrInvalidAuthorization error for an authorization that failed validation
+func ErrInvalidAuthorization(codespace sdk.CodespaceType, msg string) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidAuthorization, fmt.Sprintf("invalid authorization: %s", msg))
+}
+
+// ErrNoAuthorization error if the granter didn't grant the grantee an active
+// authorization for the message type
+func ErrNoAuthorization(codespace sdk.CodespaceType, msgType string) sdk.Error {
+	return sdk.NewError(codespace, CodeNoAuthorization, fmt.Sprintf("no authorization found for message type %s", msgType))
+}
+
+// ErrInvalidExpiration error for a grant expiration that is not in the future
+func ErrInvalidExpiration(codespace sdk.CodespaceType) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidExpiration, "expiration must be in the future")
+}
+
+// ErrSpendLimitExceeded error if a message spends more than the authorization allows
+func ErrSpendLimitExceeded(codespace sdk.CodespaceType, msg string) sdk.Error {
+	return sdk.NewError(codespace, CodeSpendLimitExceeded, fmt.Sprintf("spend limit exceeded: %s", msg))
+}
+
+// ErrInvalidMsgs error for messages that can't be executed on behalf of a granter
+func ErrInvalidMsgs(codespace sdk.CodespaceType, msg string) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidMsgs, fmt.Sprintf("invalid messages: %s", msg))
+}
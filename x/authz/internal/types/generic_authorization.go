@@ -0,0 +1,42 @@
+package types
+
+import (
+	"strings"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// GenericAuthorization grants the permission to execute any number of messages
+// of the given type without limits.
+type GenericAuthorization struct {
+	// Msg is the type of the permitted messages, eg. "gov/vote".
+	Msg string `json:"msg" yaml:"msg"`
+}
+
+var _ Authorization = GenericAuthorization{}
+
+// NewGenericAuthorization creates a new GenericAuthorization object.
+func NewGenericAuthorization(msgType string) GenericAuthorization {
+	return GenericAuthorization{Msg: msgType}
+}
+
+// MsgType implements Authorization.
+func (a GenericAuthorization) MsgType() string {
+	return a.Msg
+}
+
+// Accept implements Authorization. Every message of the authorized type is
+// accepted.
+func (a GenericAuthorization) Accept(msg sdk.Msg, _ abci.Header) (Authorization, bool, sdk.Error) {
+	return a, false, nil
+}
+
+// ValidateBasic implements Authorization.
+func (a GenericAuthorization) ValidateBasic() sdk.Error {
+	if strings.TrimSpace(a.Msg) == "" {
+		return ErrInvalidAuthorization(DefaultCodespace, "missing message type")
+	}
+	return nil
+}
@@ -0,0 +1,26 @@
+package types
+
+// GenesisState contains the authorization grants, persisted from the store
+type GenesisState struct {
+	Grants []AuthorizationGrant `json:"grants" yaml:"grants"`
+}
+
+// NewGenesisState creates a new GenesisState object
+func NewGenesisState(grants []AuthorizationGrant) GenesisState {
+	return GenesisState{Grants: grants}
+}
+
+// DefaultGenesisState returns an empty genesis state
+func DefaultGenesisState() GenesisState {
+	return GenesisState{Grants: []AuthorizationGrant{}}
+}
+
+// ValidateGenesis ensures all grants in the genesis state are valid
+func ValidateGenesis(data GenesisState) error {
+	for _, g := range data.Grants {
+		if err := g.ValidateBasic(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
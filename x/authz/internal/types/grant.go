@@ -0,0 +1,77 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// AuthorizationGrant is stored in the KVStore to record an authorization the
+// granter gave to the grantee until the expiration time.
+type AuthorizationGrant struct {
+	Granter       sdk.AccAddress `json:"granter" yaml:"granter"`
+	Grantee       sdk.AccAddress `json:"grantee" yaml:"grantee"`
+	Authorization Authorization  `json:"authorization" yaml:"authorization"`
+	Expiration    time.Time      `json:"expiration" yaml:"expiration"`
+}
+
+// NewAuthorizationGrant creates a new AuthorizationGrant object.
+func NewAuthorizationGrant(
+	granter, grantee sdk.AccAddress, authorization Authorization, expiration time.Time,
+) AuthorizationGrant {
+
+	return AuthorizationGrant{
+		Granter:       granter,
+		Grantee:       grantee,
+		Authorization: authorization,
+		Expiration:    expiration,
+	}
+}
+
+// ValidateBasic performs a stateless validation of the grant.
+func (g AuthorizationGrant) ValidateBasic() sdk.Error {
+	if g.Granter.Empty() {
+		return sdk.ErrInvalidAddress("missing granter address")
+	}
+	if g.Grantee.Empty() {
+		return sdk.ErrInvalidAddress("missing grantee address")
+	}
+	if g.Granter.Equals(g.Grantee) {
+		return sdk.ErrInvalidAddress("granter and grantee cannot be the same")
+	}
+	if g.Authorization == nil {
+		return ErrInvalidAuthorization(DefaultCodespace, "missing authorization")
+	}
+	if g.Expiration.IsZero() {
+		return ErrInvalidExpiration(DefaultCodespace)
+	}
+
+	return g.Authorization.ValidateBasic()
+}
+
+// IsExpired returns true if the grant has expired at the given block time.
+func (g AuthorizationGrant) IsExpired(blockTime time.Time) bool {
+	return !blockTime.Before(g.Expiration)
+}
+
+// String implements the fmt.Stringer interface
+func (g AuthorizationGrant) String() string {
+	return fmt.Sprintf(`Authorization Grant:
+  Granter:       %s
+  Grantee:       %s
+  Authorization: %+v
+  Expiration:    %s`, g.Granter, g.Grantee, g.Authorization, g.Expiration)
+}
+
+// AuthorizationGrants is a collection of authorization grants
+type AuthorizationGrants []AuthorizationGrant
+
+// String implements the fmt.Stringer interface
+func (g AuthorizationGrants) String() (out string) {
+	for _, grant := range g {
+		out += grant.String() + "\n"
+	}
+	return strings.TrimSpace(out)
+}
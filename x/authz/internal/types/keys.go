@@ -0,0 +1,37 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// ModuleName is the module name constant used in many places
+	ModuleName = "authz"
+
+	// StoreKey is the store key string for the authz module
+	StoreKey = ModuleName
+
+	// RouterKey is the message route for the authz module
+	RouterKey = ModuleName
+
+	// QuerierRoute is the querier route for the authz module
+	QuerierRoute = ModuleName
+)
+
+var (
+	// GrantKeyPrefix is the prefix of the kvstore for authorization grants
+	GrantKeyPrefix = []byte{0x01}
+)
+
+// GrantKey is the canonical key to store an authorization grant. Grants are
+// stored by granter first so that all the grants of a granter can be listed.
+func GrantKey(granter, grantee sdk.AccAddress, msgType string) []byte {
+	key := append(GrantPrefixByGranter(granter), grantee...)
+	return append(key, []byte(msgType)...)
+}
+
+// GrantPrefixByGranter returns a prefix to scan for all the grants of the
+// given granter.
+func GrantPrefixByGranter(granter sdk.AccAddress) []byte {
+	return append(GrantKeyPrefix, granter...)
+}
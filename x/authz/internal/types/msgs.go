@@ -0,0 +1,165 @@
+package types
+
+import (
+	"encoding/json"
+	"strings"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// ensure Msg interface compliance at compile time
+var (
+	_ sdk.Msg = MsgGrantAuthorization{}
+	_ sdk.Msg = MsgRevokeAuthorization{}
+	_ sdk.Msg = MsgExec{}
+)
+
+// MsgGrantAuthorization grants the Grantee the permission to execute messages
+// on behalf of the Granter as described by Authorization until Expiration. It
+// overwrites any existing grant for the same message type.
+type MsgGrantAuthorization struct {
+	Granter       sdk.AccAddress `json:"granter" yaml:"granter"`
+	Grantee       sdk.AccAddress `json:"grantee" yaml:"grantee"`
+	Authorization Authorization  `json:"authorization" yaml:"authorization"`
+	Expiration    time.Time      `json:"expiration" yaml:"expiration"`
+}
+
+// NewMsgGrantAuthorization creates a new MsgGrantAuthorization object.
+func NewMsgGrantAuthorization(
+	granter, grantee sdk.AccAddress, authorization Authorization, expiration time.Time,
+) MsgGrantAuthorization {
+
+	return MsgGrantAuthorization{
+		Granter:       granter,
+		Grantee:       grantee,
+		Authorization: authorization,
+		Expiration:    expiration,
+	}
+}
+
+// Route implements the sdk.Msg interface
+func (msg MsgGrantAuthorization) Route() string { return RouterKey }
+
+// Type implements the sdk.Msg interface
+func (msg MsgGrantAuthorization) Type() string { return "grant_authorization" }
+
+// ValidateBasic implements the sdk.Msg interface
+func (msg MsgGrantAuthorization) ValidateBasic() sdk.Error {
+	return NewAuthorizationGrant(msg.Granter, msg.Grantee, msg.Authorization, msg.Expiration).ValidateBasic()
+}
+
+// GetSignBytes implements the sdk.Msg interface
+func (msg MsgGrantAuthorization) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners implements the sdk.Msg interface
+func (msg MsgGrantAuthorization) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Granter}
+}
+
+// MsgRevokeAuthorization revokes the authorization the Granter gave the
+// Grantee for messages of the given type.
+type MsgRevokeAuthorization struct {
+	Granter sdk.AccAddress `json:"granter" yaml:"granter"`
+	Grantee sdk.AccAddress `json:"grantee" yaml:"grantee"`
+	MsgType string         `json:"msg_type" yaml:"msg_type"`
+}
+
+// NewMsgRevokeAuthorization creates a new MsgRevokeAuthorization object.
+func NewMsgRevokeAuthorization(granter, grantee sdk.AccAddress, msgType string) MsgRevokeAuthorization {
+	return MsgRevokeAuthorization{Granter: granter, Grantee: grantee, MsgType: msgType}
+}
+
+// Route implements the sdk.Msg interface
+func (msg MsgRevokeAuthorization) Route() string { return RouterKey }
+
+// Type implements the sdk.Msg interface
+func (msg MsgRevokeAuthorization) Type() string { return "revoke_authorization" }
+
+// ValidateBasic implements the sdk.Msg interface
+func (msg MsgRevokeAuthorization) ValidateBasic() sdk.Error {
+	if msg.Granter.Empty() {
+		return sdk.ErrInvalidAddress("missing granter address")
+	}
+	if msg.Grantee.Empty() {
+		return sdk.ErrInvalidAddress("missing grantee address")
+	}
+	if strings.TrimSpace(msg.MsgType) == "" {
+		return ErrInvalidAuthorization(DefaultCodespace, "missing message type")
+	}
+	return nil
+}
+
+// GetSignBytes implements the sdk.Msg interface
+func (msg MsgRevokeAuthorization) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners implements the sdk.Msg interface
+func (msg MsgRevokeAuthorization) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Granter}
+}
+
+// MsgExec executes Msgs on behalf of their signers. Each message must have a
+// single signer, which either is the Grantee or has granted the Grantee an
+// authorization for the message type.
+type MsgExec struct {
+	Grantee sdk.AccAddress `json:"grantee" yaml:"grantee"`
+	Msgs    []sdk.Msg      `json:"msgs" yaml:"msgs"`
+}
+
+// NewMsgExec creates a new MsgExec object.
+func NewMsgExec(grantee sdk.AccAddress, msgs []sdk.Msg) MsgExec {
+	return MsgExec{Grantee: grantee, Msgs: msgs}
+}
+
+// Route implements the sdk.Msg interface
+func (msg MsgExec) Route() string { return RouterKey }
+
+// Type implements the sdk.Msg interface
+func (msg MsgExec) Type() string { return "exec" }
+
+// ValidateBasic implements the sdk.Msg interface
+func (msg MsgExec) ValidateBasic() sdk.Error {
+	if msg.Grantee.Empty() {
+		return sdk.ErrInvalidAddress("missing grantee address")
+	}
+	if len(msg.Msgs) == 0 {
+		return ErrInvalidMsgs(DefaultCodespace, "no messages to execute")
+	}
+
+	for _, m := range msg.Msgs {
+		if len(m.GetSigners()) != 1 {
+			return ErrInvalidMsgs(DefaultCodespace, "each message must have exactly one signer")
+		}
+		if err := m.ValidateBasic(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// GetSignBytes implements the sdk.Msg interface. The inner messages are
+// included through their own sign bytes, so they don't need to be registered
+// on the module codec.
+func (msg MsgExec) GetSignBytes() []byte {
+	msgs := make([]json.RawMessage, len(msg.Msgs))
+	for i, m := range msg.Msgs {
+		msgs[i] = json.RawMessage(m.GetSignBytes())
+	}
+
+	bz := ModuleCdc.MustMarshalJSON(struct {
+		Grantee sdk.AccAddress    `json:"grantee"`
+		Msgs    []json.RawMessage `json:"msgs"`
+	}{msg.Grantee, msgs})
+
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners implements the sdk.Msg interface
+func (msg MsgExec) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Grantee}
+}
@@ -0,0 +1,6 @@
+package types
+
+// query endpoints supported by the authz Querier
+const (
+	QueryGrants = "grants"
+)
@@ -0,0 +1,58 @@
+package types
+
+import (
+	"fmt"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/bank"
+)
+
+// SendAuthorization grants the permission to send coins from the granter's
+// account with bank.MsgSend.
+type SendAuthorization struct {
+	// SpendLimit is the total amount of coins that may be sent. It is updated
+	// as coins are sent. If it is empty, any amount of coins may be sent.
+	SpendLimit sdk.Coins `json:"spend_limit" yaml:"spend_limit"`
+}
+
+var _ Authorization = SendAuthorization{}
+
+// NewSendAuthorization creates a new SendAuthorization object.
+func NewSendAuthorization(spendLimit sdk.Coins) SendAuthorization {
+	return SendAuthorization{SpendLimit: spendLimit}
+}
+
+// MsgType implements Authorization.
+func (a SendAuthorization) MsgType() string {
+	return GetMsgType(bank.MsgSend{})
+}
+
+// Accept implements Authorization. The sent amount is deducted from the spend
+// limit and the authorization is removed once the limit is used up.
+func (a SendAuthorization) Accept(msg sdk.Msg, _ abci.Header) (Authorization, bool, sdk.Error) {
+	send, ok := msg.(bank.MsgSend)
+	if !ok {
+		return nil, false, ErrInvalidMsgs(DefaultCodespace, fmt.Sprintf("expected %T, got %T", bank.MsgSend{}, msg))
+	}
+
+	if a.SpendLimit.Empty() {
+		return a, false, nil
+	}
+
+	left, invalid := a.SpendLimit.SafeSub(send.Amount)
+	if invalid {
+		return nil, false, ErrSpendLimitExceeded(DefaultCodespace, fmt.Sprintf("%s > %s", send.Amount, a.SpendLimit))
+	}
+
+	return SendAuthorization{SpendLimit: left}, left.IsZero(), nil
+}
+
+// ValidateBasic implements Authorization.
+func (a SendAuthorization) ValidateBasic() sdk.Error {
+	if !a.SpendLimit.IsValid() {
+		return ErrInvalidAuthorization(DefaultCodespace, "invalid spend limit "+a.SpendLimit.String())
+	}
+	return nil
+}
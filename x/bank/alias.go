@@ -10,26 +10,32 @@ import (
 )
 
 const (
-	DefaultCodespace         = types.DefaultCodespace
-	CodeSendDisabled         = types.CodeSendDisabled
-	CodeInvalidInputsOutputs = types.CodeInvalidInputsOutputs
-	ModuleName               = types.ModuleName
-	RouterKey                = types.RouterKey
-	QuerierRoute             = types.QuerierRoute
-	DefaultParamspace        = types.DefaultParamspace
+	DefaultCodespace           = types.DefaultCodespace
+	CodeSendDisabled           = types.CodeSendDisabled
+	CodeInvalidInputsOutputs   = types.CodeInvalidInputsOutputs
+	CodeInvalidVestingSchedule = types.CodeInvalidVestingSchedule
+	CodeAccountExists          = types.CodeAccountExists
+	ModuleName                 = types.ModuleName
+	RouterKey                  = types.RouterKey
+	QuerierRoute               = types.QuerierRoute
+	DefaultParamspace          = types.DefaultParamspace
 )
 
 var (
 	// functions aliases
-	RegisterCodec          = types.RegisterCodec
-	ErrNoInputs            = types.ErrNoInputs
-	ErrNoOutputs           = types.ErrNoOutputs
-	ErrInputOutputMismatch = types.ErrInputOutputMismatch
-	ErrSendDisabled        = types.ErrSendDisabled
-	NewBaseKeeper          = keeper.NewBaseKeeper
-	NewInput               = types.NewInput
-	NewOutput              = types.NewOutput
-	ParamKeyTable          = types.ParamKeyTable
+	RegisterCodec                      = types.RegisterCodec
+	ErrNoInputs                        = types.ErrNoInputs
+	ErrNoOutputs                       = types.ErrNoOutputs
+	ErrInputOutputMismatch             = types.ErrInputOutputMismatch
+	ErrSendDisabled                    = types.ErrSendDisabled
+	ErrInvalidVestingSchedule          = types.ErrInvalidVestingSchedule
+	ErrAccountExists                   = types.ErrAccountExists
+	NewBaseKeeper                      = keeper.NewBaseKeeper
+	NewInput                           = types.NewInput
+	NewOutput                          = types.NewOutput
+	NewMsgCreateVestingAccount         = types.NewMsgCreateVestingAccount
+	NewMsgCreatePeriodicVestingAccount = types.NewMsgCreatePeriodicVestingAccount
+	ParamKeyTable                      = types.ParamKeyTable
 
 	// variable aliases
 	ModuleCdc                = types.ModuleCdc
@@ -37,10 +43,11 @@ var (
 )
 
 type (
-	BaseKeeper   = keeper.BaseKeeper // ibc module depends on this
-	Keeper       = keeper.Keeper
-	MsgSend      = types.MsgSend
-	MsgMultiSend = types.MsgMultiSend
-	Input        = types.Input
-	Output       = types.Output
+	BaseKeeper              = keeper.BaseKeeper // ibc module depends on this
+	Keeper                  = keeper.Keeper
+	MsgSend                 = types.MsgSend
+	MsgMultiSend            = types.MsgMultiSend
+	MsgCreateVestingAccount = types.MsgCreateVestingAccount
+	Input                   = types.Input
+	Output                  = types.Output
 )
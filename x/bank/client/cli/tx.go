@@ -1,7 +1,12 @@
 package cli
 
 import (
+	"fmt"
+	"io/ioutil"
+	"strconv"
+
 	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/context"
@@ -12,6 +17,11 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/bank/internal/types"
 )
 
+const (
+	flagStartTime = "start-time"
+	flagDelayed   = "delayed"
+)
+
 // GetTxCmd returns the transaction commands for this module
 func GetTxCmd(cdc *codec.Codec) *cobra.Command {
 	txCmd := &cobra.Command{
@@ -23,6 +33,8 @@ func GetTxCmd(cdc *codec.Codec) *cobra.Command {
 	}
 	txCmd.AddCommand(
 		SendTxCmd(cdc),
+		CreateVestingAccountCmd(cdc),
+		CreatePeriodicVestingAccountCmd(cdc),
 	)
 	return txCmd
 }
@@ -58,3 +70,101 @@ func SendTxCmd(cdc *codec.Codec) *cobra.Command {
 
 	return cmd
 }
+
+// CreateVestingAccountCmd will create a tx creating a continuous or delayed
+// vesting account and sign it with the given key.
+func CreateVestingAccountCmd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create-vesting-account [to_address] [amount] [end_time]",
+		Short: "Create a new vesting account funded with an allocation of tokens",
+		Long: `Create a new vesting account funded with an allocation of tokens. The
+tokens vest continuously from --start-time until the end time, or all at once at
+the end time if --delayed is set. Times are given as unix epochs.`,
+		Args: cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			txBldr := auth.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			to, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			amount, err := sdk.ParseCoins(args[1])
+			if err != nil {
+				return err
+			}
+
+			endTime, err := strconv.ParseInt(args[2], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgCreateVestingAccount(
+				cliCtx.GetFromAddress(), to, amount,
+				viper.GetInt64(flagStartTime), endTime, viper.GetBool(flagDelayed),
+			)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+
+	cmd.Flags().Int64(flagStartTime, 0, "schedule start time (unix epoch) of a continuous vesting account")
+	cmd.Flags().Bool(flagDelayed, false, "create a delayed vesting account instead of a continuous one")
+
+	cmd = client.PostCommands(cmd)[0]
+
+	return cmd
+}
+
+// CreatePeriodicVestingAccountCmd will create a tx creating a periodic vesting
+// account and sign it with the given key.
+func CreatePeriodicVestingAccountCmd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create-periodic-vesting-account [to_address] [periods_file] [start_time]",
+		Short: "Create a new periodic vesting account funded with an allocation of tokens",
+		Long: `Create a new periodic vesting account funded with an allocation of tokens.
+The periods file is a JSON list of {"length":<seconds>,"amount":[<coins>]}, and
+the amount of each period vests once its length has elapsed after the end of the
+previous period. The start time is given as a unix epoch.`,
+		Args: cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			txBldr := auth.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			to, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			bz, err := ioutil.ReadFile(args[1])
+			if err != nil {
+				return err
+			}
+
+			var periods auth.Periods
+			if err := cdc.UnmarshalJSON(bz, &periods); err != nil {
+				return fmt.Errorf("failed to parse vesting periods: %v", err)
+			}
+
+			startTime, err := strconv.ParseInt(args[2], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgCreatePeriodicVestingAccount(cliCtx.GetFromAddress(), to, startTime, periods)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+
+	cmd = client.PostCommands(cmd)[0]
+
+	return cmd
+}
@@ -20,6 +20,9 @@ func NewHandler(k keeper.Keeper) sdk.Handler {
 		case types.MsgMultiSend:
 			return handleMsgMultiSend(ctx, k, msg)
 
+		case types.MsgCreateVestingAccount:
+			return handleMsgCreateVestingAccount(ctx, k, msg)
+
 		default:
 			errMsg := fmt.Sprintf("unrecognized bank message type: %T", msg)
 			return sdk.ErrUnknownRequest(errMsg).Result()
@@ -79,3 +82,28 @@ func handleMsgMultiSend(ctx sdk.Context, k keeper.Keeper, msg types.MsgMultiSend
 
 	return sdk.Result{Events: ctx.EventManager().Events()}
 }
+
+// Handle MsgCreateVestingAccount.
+func handleMsgCreateVestingAccount(ctx sdk.Context, k keeper.Keeper, msg types.MsgCreateVestingAccount) sdk.Result {
+	if !k.GetSendEnabled(ctx) {
+		return types.ErrSendDisabled(k.Codespace()).Result()
+	}
+
+	if k.BlacklistedAddr(msg.ToAddress) {
+		return sdk.ErrUnauthorized(fmt.Sprintf("%s is not allowed to receive transactions", msg.ToAddress)).Result()
+	}
+
+	err := k.CreateVestingAccount(ctx, msg.FromAddress, msg.VestingAccount())
+	if err != nil {
+		return err.Result()
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		),
+	)
+
+	return sdk.Result{Events: ctx.EventManager().Events()}
+}
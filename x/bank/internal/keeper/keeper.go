@@ -21,6 +21,8 @@ type Keeper interface {
 
 	DelegateCoins(ctx sdk.Context, delegatorAddr, moduleAccAddr sdk.AccAddress, amt sdk.Coins) sdk.Error
 	UndelegateCoins(ctx sdk.Context, moduleAccAddr, delegatorAddr sdk.AccAddress, amt sdk.Coins) sdk.Error
+
+	CreateVestingAccount(ctx sdk.Context, fromAddr sdk.AccAddress, vacc exported.VestingAccount) sdk.Error
 }
 
 // BaseKeeper manages transfers between accounts. It implements the Keeper interface.
@@ -131,6 +133,26 @@ func (keeper BaseKeeper) UndelegateCoins(ctx sdk.Context, moduleAccAddr, delegat
 	return nil
 }
 
+// CreateVestingAccount stores the given vesting account under a fresh account
+// number and funds it with its original vesting coins, which are transferred
+// from the fromAddr account. An error is returned if an account already exists
+// at the vesting account's address.
+func (keeper BaseKeeper) CreateVestingAccount(ctx sdk.Context, fromAddr sdk.AccAddress, vacc exported.VestingAccount) sdk.Error {
+	addr := vacc.GetAddress()
+	if keeper.ak.GetAccount(ctx, addr) != nil {
+		return types.ErrAccountExists(keeper.Codespace(), addr)
+	}
+
+	acc := keeper.ak.NewAccountWithAddress(ctx, addr)
+	if err := vacc.SetAccountNumber(acc.GetAccountNumber()); err != nil {
+		return sdk.ErrInternal(fmt.Sprintf("failed to set account number: %v", err))
+	}
+
+	keeper.ak.SetAccount(ctx, vacc)
+
+	return keeper.SendCoins(ctx, fromAddr, addr, vacc.GetOriginalVesting())
+}
+
 // SendKeeper defines a module interface that facilitates the transfer of coins
 // between accounts without the possibility of creating coins.
 type SendKeeper interface {
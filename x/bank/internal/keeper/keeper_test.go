@@ -338,3 +338,49 @@ func TestUndelegateCoins(t *testing.T) {
 	require.Equal(t, origCoins, vacc.GetCoins())
 	require.True(t, macc.GetCoins().Empty())
 }
+
+func TestCreateVestingAccount(t *testing.T) {
+	app, ctx := createTestApp(false)
+	now := tmtime.Now()
+	ctx = ctx.WithBlockHeader(abci.Header{Time: now})
+
+	origCoins := sdk.NewCoins(sdk.NewInt64Coin("stake", 100))
+	vestingCoins := sdk.NewCoins(sdk.NewInt64Coin("stake", 40))
+
+	addr1 := sdk.AccAddress([]byte("addr1"))
+	addr2 := sdk.AccAddress([]byte("addr2"))
+
+	acc := app.AccountKeeper.NewAccountWithAddress(ctx, addr1)
+	app.AccountKeeper.SetAccount(ctx, acc)
+	app.BankKeeper.SetCoins(ctx, addr1, origCoins)
+
+	periods := auth.Periods{
+		auth.NewPeriod(60, sdk.NewCoins(sdk.NewInt64Coin("stake", 10))),
+		auth.NewPeriod(60, sdk.NewCoins(sdk.NewInt64Coin("stake", 30))),
+	}
+	bacc := auth.NewBaseAccountWithAddress(addr2)
+	vacc := auth.NewPeriodicVestingAccount(&bacc, now.Unix(), periods)
+
+	err := app.BankKeeper.CreateVestingAccount(ctx, addr1, vacc)
+	require.NoError(t, err)
+	require.Equal(t, origCoins.Sub(vestingCoins), app.BankKeeper.GetCoins(ctx, addr1))
+
+	// require the new account is stored with a fresh account number and funded
+	// by the sender
+	stored, ok := app.AccountKeeper.GetAccount(ctx, addr2).(*auth.PeriodicVestingAccount)
+	require.True(t, ok)
+	require.Equal(t, vestingCoins, stored.GetCoins())
+	require.Equal(t, acc.GetAccountNumber()+1, stored.GetAccountNumber())
+	require.True(t, stored.SpendableCoins(now).IsZero())
+
+	// require an existing account cannot be replaced
+	err = app.BankKeeper.CreateVestingAccount(ctx, addr1, vacc)
+	require.Error(t, err)
+
+	// require the sender must be able to fund the account
+	addr3 := sdk.AccAddress([]byte("addr3"))
+	bacc = auth.NewBaseAccountWithAddress(addr3)
+	vacc = auth.NewPeriodicVestingAccount(&bacc, now.Unix(), periods)
+	err = app.BankKeeper.CreateVestingAccount(ctx, addr2, vacc)
+	require.Error(t, err)
+}
@@ -1,6 +1,8 @@
 package types
 
 import (
+	"fmt"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
@@ -8,8 +10,10 @@ import (
 const (
 	DefaultCodespace sdk.CodespaceType = ModuleName
 
-	CodeSendDisabled         sdk.CodeType = 101
-	CodeInvalidInputsOutputs sdk.CodeType = 102
+	CodeSendDisabled           sdk.CodeType = 101
+	CodeInvalidInputsOutputs   sdk.CodeType = 102
+	CodeInvalidVestingSchedule sdk.CodeType = 103
+	CodeAccountExists          sdk.CodeType = 104
 )
 
 // ErrNoInputs is an error
@@ -31,3 +35,13 @@ func ErrInputOutputMismatch(codespace sdk.CodespaceType) sdk.Error {
 func ErrSendDisabled(codespace sdk.CodespaceType) sdk.Error {
 	return sdk.NewError(codespace, CodeSendDisabled, "send transactions are currently disabled")
 }
+
+// ErrInvalidVestingSchedule is an error
+func ErrInvalidVestingSchedule(codespace sdk.CodespaceType, msg string) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidVestingSchedule, fmt.Sprintf("invalid vesting schedule: %s", msg))
+}
+
+// ErrAccountExists is an error
+func ErrAccountExists(codespace sdk.CodespaceType, addr sdk.AccAddress) sdk.Error {
+	return sdk.NewError(codespace, CodeAccountExists, fmt.Sprintf("account %s already exists", addr))
+}
@@ -2,6 +2,8 @@ package types
 
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth/exported"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 )
 
 // RouterKey is they name of the bank module
@@ -183,3 +185,132 @@ func ValidateInputsOutputs(inputs []Input, outputs []Output) sdk.Error {
 
 	return nil
 }
+
+// MsgCreateVestingAccount - transaction that creates a new vesting account
+// funded with coins of the sender. The coins vest continuously from the start
+// time until the end time, all at once at the end time if the account is
+// delayed, or at the end of each of the vesting periods if any are given, in
+// which case the end time is derived from the periods.
+type MsgCreateVestingAccount struct {
+	FromAddress    sdk.AccAddress    `json:"from_address" yaml:"from_address"`
+	ToAddress      sdk.AccAddress    `json:"to_address" yaml:"to_address"`
+	Amount         sdk.Coins         `json:"amount" yaml:"amount"`
+	StartTime      int64             `json:"start_time" yaml:"start_time"`
+	EndTime        int64             `json:"end_time" yaml:"end_time"`
+	Delayed        bool              `json:"delayed" yaml:"delayed"`
+	VestingPeriods authtypes.Periods `json:"vesting_periods" yaml:"vesting_periods"`
+}
+
+var _ sdk.Msg = MsgCreateVestingAccount{}
+
+// NewMsgCreateVestingAccount - construct a msg creating a continuous or delayed
+// vesting account.
+func NewMsgCreateVestingAccount(fromAddr, toAddr sdk.AccAddress, amount sdk.Coins,
+	startTime, endTime int64, delayed bool) MsgCreateVestingAccount {
+
+	return MsgCreateVestingAccount{
+		FromAddress: fromAddr,
+		ToAddress:   toAddr,
+		Amount:      amount,
+		StartTime:   startTime,
+		EndTime:     endTime,
+		Delayed:     delayed,
+	}
+}
+
+// NewMsgCreatePeriodicVestingAccount - construct a msg creating a periodic
+// vesting account. The vested amount is the total amount of the periods.
+func NewMsgCreatePeriodicVestingAccount(fromAddr, toAddr sdk.AccAddress,
+	startTime int64, periods authtypes.Periods) MsgCreateVestingAccount {
+
+	return MsgCreateVestingAccount{
+		FromAddress:    fromAddr,
+		ToAddress:      toAddr,
+		Amount:         periods.TotalAmount(),
+		StartTime:      startTime,
+		VestingPeriods: periods,
+	}
+}
+
+// Route Implements Msg.
+func (msg MsgCreateVestingAccount) Route() string { return RouterKey }
+
+// Type Implements Msg.
+func (msg MsgCreateVestingAccount) Type() string { return "create_vesting_account" }
+
+// ValidateBasic Implements Msg.
+func (msg MsgCreateVestingAccount) ValidateBasic() sdk.Error {
+	if msg.FromAddress.Empty() {
+		return sdk.ErrInvalidAddress("missing sender address")
+	}
+	if msg.ToAddress.Empty() {
+		return sdk.ErrInvalidAddress("missing recipient address")
+	}
+	if !msg.Amount.IsValid() {
+		return sdk.ErrInvalidCoins("vesting amount is invalid: " + msg.Amount.String())
+	}
+	if !msg.Amount.IsAllPositive() {
+		return sdk.ErrInsufficientCoins("vesting amount must be positive")
+	}
+
+	switch {
+	case len(msg.VestingPeriods) > 0:
+		if msg.Delayed {
+			return ErrInvalidVestingSchedule(DefaultCodespace, "a periodic vesting account cannot be delayed")
+		}
+		if msg.EndTime != 0 {
+			return ErrInvalidVestingSchedule(DefaultCodespace, "the end time of a periodic vesting account is set by its periods")
+		}
+		if err := msg.VestingPeriods.Validate(); err != nil {
+			return ErrInvalidVestingSchedule(DefaultCodespace, err.Error())
+		}
+		if !msg.VestingPeriods.TotalAmount().IsEqual(msg.Amount) {
+			return ErrInvalidVestingSchedule(DefaultCodespace, "vesting amount must be equal to the total amount of the vesting periods")
+		}
+		if msg.StartTime <= 0 {
+			return ErrInvalidVestingSchedule(DefaultCodespace, "start time must be positive")
+		}
+
+	case msg.Delayed:
+		if msg.EndTime <= 0 {
+			return ErrInvalidVestingSchedule(DefaultCodespace, "end time must be positive")
+		}
+
+	default:
+		if msg.StartTime <= 0 {
+			return ErrInvalidVestingSchedule(DefaultCodespace, "start time must be positive")
+		}
+		if msg.StartTime >= msg.EndTime {
+			return ErrInvalidVestingSchedule(DefaultCodespace, "start time must be before end time")
+		}
+	}
+
+	return nil
+}
+
+// GetSignBytes Implements Msg.
+func (msg MsgCreateVestingAccount) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners Implements Msg.
+func (msg MsgCreateVestingAccount) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.FromAddress}
+}
+
+// VestingAccount returns the vesting account that the msg creates. Its account
+// number must be set once the msg is executed.
+func (msg MsgCreateVestingAccount) VestingAccount() exported.VestingAccount {
+	bacc := authtypes.NewBaseAccountWithAddress(msg.ToAddress)
+	bva := authtypes.NewBaseVestingAccount(&bacc, msg.Amount, nil, nil, msg.EndTime)
+
+	switch {
+	case len(msg.VestingPeriods) > 0:
+		bva.EndTime = msg.StartTime + msg.VestingPeriods.TotalLength()
+		return authtypes.NewPeriodicVestingAccountRaw(bva, msg.StartTime, msg.VestingPeriods)
+	case msg.Delayed:
+		return authtypes.NewDelayedVestingAccountRaw(bva)
+	default:
+		return authtypes.NewContinuousVestingAccountRaw(bva, msg.StartTime)
+	}
+}
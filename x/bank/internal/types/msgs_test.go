@@ -7,6 +7,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 )
 
 func TestMsgSendRoute(t *testing.T) {
@@ -260,3 +261,90 @@ func TestMsgSendSigners(t *testing.T) {
 	require.Equal(t, signers, tx.Signers())
 }
 */
+
+func TestMsgCreateVestingAccountRoute(t *testing.T) {
+	addr1 := sdk.AccAddress([]byte("from"))
+	addr2 := sdk.AccAddress([]byte("to"))
+	coins := sdk.NewCoins(sdk.NewInt64Coin("atom", 10))
+	var msg = NewMsgCreateVestingAccount(addr1, addr2, coins, 1, 2, false)
+
+	require.Equal(t, msg.Route(), RouterKey)
+	require.Equal(t, msg.Type(), "create_vesting_account")
+	require.Equal(t, []sdk.AccAddress{addr1}, msg.GetSigners())
+}
+
+func TestMsgCreateVestingAccountValidation(t *testing.T) {
+	addr1 := sdk.AccAddress([]byte("from"))
+	addr2 := sdk.AccAddress([]byte("to"))
+	atom100 := sdk.NewCoins(sdk.NewInt64Coin("atom", 100))
+	atom50 := sdk.NewCoins(sdk.NewInt64Coin("atom", 50))
+	atom0 := sdk.NewCoins(sdk.NewInt64Coin("atom", 0))
+	periods := authtypes.Periods{
+		authtypes.NewPeriod(10, atom50),
+		authtypes.NewPeriod(20, atom50),
+	}
+
+	var emptyAddr sdk.AccAddress
+
+	delayedPeriodic := NewMsgCreatePeriodicVestingAccount(addr1, addr2, 1000, periods)
+	delayedPeriodic.Delayed = true
+	endTimePeriodic := NewMsgCreatePeriodicVestingAccount(addr1, addr2, 1000, periods)
+	endTimePeriodic.EndTime = 2000
+	amountPeriodic := NewMsgCreatePeriodicVestingAccount(addr1, addr2, 1000, periods)
+	amountPeriodic.Amount = atom50
+
+	cases := []struct {
+		valid bool
+		tx    MsgCreateVestingAccount
+	}{
+		{true, NewMsgCreateVestingAccount(addr1, addr2, atom100, 1000, 2000, false)},         // valid continuous
+		{true, NewMsgCreateVestingAccount(addr1, addr2, atom100, 0, 2000, true)},             // valid delayed
+		{true, NewMsgCreatePeriodicVestingAccount(addr1, addr2, 1000, periods)},              // valid periodic
+		{false, NewMsgCreateVestingAccount(emptyAddr, addr2, atom100, 1000, 2000, false)},    // empty from addr
+		{false, NewMsgCreateVestingAccount(addr1, emptyAddr, atom100, 1000, 2000, false)},    // empty to addr
+		{false, NewMsgCreateVestingAccount(addr1, addr2, atom0, 1000, 2000, false)},          // non positive coin
+		{false, NewMsgCreateVestingAccount(addr1, addr2, atom100, 2000, 1000, false)},        // start after end
+		{false, NewMsgCreateVestingAccount(addr1, addr2, atom100, 0, 1000, false)},           // no start time
+		{false, NewMsgCreateVestingAccount(addr1, addr2, atom100, 0, 0, true)},               // no end time
+		{false, NewMsgCreatePeriodicVestingAccount(addr1, addr2, 0, periods)},                // no start time
+		{false, NewMsgCreatePeriodicVestingAccount(addr1, addr2, 1000, authtypes.Periods{})}, // no periods
+		{false, delayedPeriodic}, // delayed periodic
+		{false, endTimePeriodic}, // end time set on periodic
+		{false, amountPeriodic},  // amount differs from periods
+	}
+
+	for i, tc := range cases {
+		err := tc.tx.ValidateBasic()
+		if tc.valid {
+			require.Nil(t, err, "case %d", i)
+		} else {
+			require.NotNil(t, err, "case %d", i)
+		}
+	}
+}
+
+func TestMsgCreateVestingAccountVestingAccount(t *testing.T) {
+	addr1 := sdk.AccAddress([]byte("from"))
+	addr2 := sdk.AccAddress([]byte("to"))
+	atom100 := sdk.NewCoins(sdk.NewInt64Coin("atom", 100))
+	atom50 := sdk.NewCoins(sdk.NewInt64Coin("atom", 50))
+
+	cva, ok := NewMsgCreateVestingAccount(addr1, addr2, atom100, 1000, 2000, false).VestingAccount().(*authtypes.ContinuousVestingAccount)
+	require.True(t, ok)
+	require.Equal(t, addr2, cva.GetAddress())
+	require.Equal(t, atom100, cva.GetOriginalVesting())
+	require.Equal(t, int64(1000), cva.GetStartTime())
+	require.Equal(t, int64(2000), cva.GetEndTime())
+
+	dva, ok := NewMsgCreateVestingAccount(addr1, addr2, atom100, 0, 2000, true).VestingAccount().(*authtypes.DelayedVestingAccount)
+	require.True(t, ok)
+	require.Equal(t, int64(2000), dva.GetEndTime())
+
+	periods := authtypes.Periods{authtypes.NewPeriod(10, atom50), authtypes.NewPeriod(20, atom50)}
+	pva, ok := NewMsgCreatePeriodicVestingAccount(addr1, addr2, 1000, periods).VestingAccount().(*authtypes.PeriodicVestingAccount)
+	require.True(t, ok)
+	require.Equal(t, atom100, pva.GetOriginalVesting())
+	require.Equal(t, int64(1000), pva.GetStartTime())
+	require.Equal(t, int64(1030), pva.GetEndTime())
+	require.Equal(t, periods, pva.GetVestingPeriods())
+}
@@ -0,0 +1,67 @@
+// nolint
+// autogenerated code using github.com/rigelrozanski/multitool
+// aliases generated for the following subdirectories:
+// ALIASGEN: github.com/cosmos/cosmos-sdk/x/circuit/internal/keeper
+// ALIASGEN: github.com/cosmos/cosmos-sdk/x/circuit/internal/types
+package circuit
+
+import (
+	"github.com/cosmos/cosmos-sdk/x/circuit/internal/keeper"
+	"github.com/cosmos/cosmos-sdk/x/circuit/internal/types"
+)
+
+const (
+	ModuleName                      = types.ModuleName
+	StoreKey                        = types.StoreKey
+	RouterKey                       = types.RouterKey
+	QuerierRoute                    = types.QuerierRoute
+	QueryTrippedBreakers            = types.QueryTrippedBreakers
+	DefaultParamspace               = types.DefaultParamspace
+	DefaultCodespace                = types.DefaultCodespace
+	CodeInvalidMsgName              = types.CodeInvalidMsgName
+	CodeNotTripped                  = types.CodeNotTripped
+	CodeUnauthorized                = types.CodeUnauthorized
+	EventTypeTripCircuitBreaker     = types.EventTypeTripCircuitBreaker
+	EventTypeResetCircuitBreaker    = types.EventTypeResetCircuitBreaker
+	AttributeKeyMsgName             = types.AttributeKeyMsgName
+	AttributeValueCategory          = types.AttributeValueCategory
+	ProposalTypeTripCircuitBreaker  = types.ProposalTypeTripCircuitBreaker
+	ProposalTypeResetCircuitBreaker = types.ProposalTypeResetCircuitBreaker
+)
+
+var (
+	// functions aliases
+	NewKeeper                      = keeper.NewKeeper
+	NewQuerier                     = keeper.NewQuerier
+	RegisterCodec                  = types.RegisterCodec
+	ErrInvalidMsgName              = types.ErrInvalidMsgName
+	ErrNotTripped                  = types.ErrNotTripped
+	ErrUnauthorized                = types.ErrUnauthorized
+	NewGenesisState                = types.NewGenesisState
+	DefaultGenesisState            = types.DefaultGenesisState
+	ValidateGenesis                = types.ValidateGenesis
+	TrippedBreakerKey              = types.TrippedBreakerKey
+	MsgName                        = types.MsgName
+	ValidateMsgName                = types.ValidateMsgName
+	ValidateMsgNames               = types.ValidateMsgNames
+	NewMsgTripCircuitBreaker       = types.NewMsgTripCircuitBreaker
+	NewMsgResetCircuitBreaker      = types.NewMsgResetCircuitBreaker
+	ParamKeyTable                  = types.ParamKeyTable
+	NewTripCircuitBreakerProposal  = types.NewTripCircuitBreakerProposal
+	NewResetCircuitBreakerProposal = types.NewResetCircuitBreakerProposal
+
+	// variable aliases
+	ModuleCdc                       = types.ModuleCdc
+	TrippedBreakerKeyPrefix         = types.TrippedBreakerKeyPrefix
+	ParamStoreKeyAuthorizedAccounts = types.ParamStoreKeyAuthorizedAccounts
+)
+
+type (
+	Keeper                      = keeper.Keeper
+	GenesisState                = types.GenesisState
+	MsgTripCircuitBreaker       = types.MsgTripCircuitBreaker
+	MsgResetCircuitBreaker      = types.MsgResetCircuitBreaker
+	TripCircuitBreakerProposal  = types.TripCircuitBreakerProposal
+	ResetCircuitBreakerProposal = types.ResetCircuitBreakerProposal
+	TrippedBreakers             = types.TrippedBreakers
+)
@@ -0,0 +1,193 @@
+package circuit_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/crypto/secp256k1"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	"github.com/cosmos/cosmos-sdk/x/bank"
+	"github.com/cosmos/cosmos-sdk/x/circuit"
+	"github.com/cosmos/cosmos-sdk/x/genaccounts"
+	"github.com/cosmos/cosmos-sdk/x/gov"
+)
+
+var (
+	priv1 = secp256k1.GenPrivKey()
+	addr1 = sdk.AccAddress(priv1.PubKey().Address())
+	addr2 = sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+
+	coins = sdk.NewCoins(sdk.NewInt64Coin("foocoin", 10))
+
+	send      = bank.MsgSend{FromAddress: addr1, ToAddress: addr2, Amount: coins}
+	multiSend = bank.MsgMultiSend{Inputs: []bank.Input{bank.NewInput(addr1, coins)}, Outputs: []bank.Output{bank.NewOutput(addr2, coins)}}
+)
+
+func TestTripAndResetCircuitBreakers(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, abci.Header{})
+	handler := circuit.NewHandler(app.CircuitKeeper)
+
+	app.CircuitKeeper.SetAuthorizedAccounts(ctx, []sdk.AccAddress{addr1})
+	require.True(t, app.CircuitKeeper.IsAllowed(ctx, send))
+
+	// only authorized accounts can trip circuit breakers
+	res := handler(ctx, circuit.NewMsgTripCircuitBreaker(addr2, []string{"bank/send"}))
+	require.Equal(t, circuit.CodeUnauthorized, res.Code)
+
+	res = handler(ctx, circuit.NewMsgTripCircuitBreaker(addr1, []string{"bank/send"}))
+	require.True(t, res.IsOK(), res.Log)
+	require.False(t, app.CircuitKeeper.IsAllowed(ctx, send))
+	require.True(t, app.CircuitKeeper.IsAllowed(ctx, multiSend))
+
+	// tripping the circuit breaker of a route disables all its messages
+	res = handler(ctx, circuit.NewMsgTripCircuitBreaker(addr1, []string{"bank"}))
+	require.True(t, res.IsOK(), res.Log)
+	require.False(t, app.CircuitKeeper.IsAllowed(ctx, multiSend))
+	require.Equal(t, []string{"bank", "bank/send"}, app.CircuitKeeper.GetTrippedBreakers(ctx))
+
+	res = handler(ctx, circuit.NewMsgResetCircuitBreaker(addr1, []string{"bank"}))
+	require.True(t, res.IsOK(), res.Log)
+	require.True(t, app.CircuitKeeper.IsAllowed(ctx, multiSend))
+	require.False(t, app.CircuitKeeper.IsAllowed(ctx, send))
+
+	res = handler(ctx, circuit.NewMsgResetCircuitBreaker(addr1, []string{"bank"}))
+	require.Equal(t, circuit.CodeNotTripped, res.Code)
+
+	// the tripped circuit breakers are queried and exported
+	querier := circuit.NewQuerier(app.CircuitKeeper)
+	bz, err := querier(ctx, []string{circuit.QueryTrippedBreakers}, abci.RequestQuery{})
+	require.NoError(t, err)
+
+	var tripped circuit.TrippedBreakers
+	require.NoError(t, app.Codec().UnmarshalJSON(bz, &tripped))
+	require.Equal(t, circuit.TrippedBreakers{"bank/send"}, tripped)
+
+	require.Equal(t,
+		circuit.NewGenesisState([]sdk.AccAddress{addr1}, []string{"bank/send"}),
+		circuit.ExportGenesis(ctx, app.CircuitKeeper),
+	)
+}
+
+func TestCircuitBreakerProposals(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, abci.Header{})
+	handler := circuit.NewCircuitBreakerProposalHandler(app.CircuitKeeper)
+
+	trip := circuit.NewTripCircuitBreakerProposal("title", "description", []string{"bank/send"})
+	require.NoError(t, trip.ValidateBasic())
+	require.NoError(t, handler(ctx, trip))
+	require.False(t, app.CircuitKeeper.IsAllowed(ctx, send))
+
+	reset := circuit.NewResetCircuitBreakerProposal("title", "description", []string{"bank/send"})
+	require.NoError(t, handler(ctx, reset))
+	require.True(t, app.CircuitKeeper.IsAllowed(ctx, send))
+	require.Error(t, handler(ctx, reset))
+
+	// the circuit and gov modules cannot be disabled
+	trip = circuit.NewTripCircuitBreakerProposal("title", "description", []string{circuit.RouterKey})
+	require.Error(t, trip.ValidateBasic())
+	trip = circuit.NewTripCircuitBreakerProposal("title", "description", []string{"gov/vote"})
+	require.NoError(t, trip.ValidateBasic())
+	require.Error(t, handler(ctx, trip))
+
+	// the proposals are stored by the governance module
+	require.NoError(t, handler(ctx, circuit.NewTripCircuitBreakerProposal("title", "description", []string{"bank/send"})))
+
+	trip = circuit.NewTripCircuitBreakerProposal("title", "description", []string{"bank/multisend"})
+	for _, content := range []gov.Content{trip, reset} {
+		proposal, err := app.GovKeeper.SubmitProposal(ctx, content)
+		require.NoError(t, err)
+
+		stored, found := app.GovKeeper.GetProposal(ctx, proposal.ProposalID)
+		require.True(t, found)
+		require.Equal(t, content, stored.Content)
+
+		// and are encoded in the transactions submitting them
+		var msg sdk.Msg = gov.NewMsgSubmitProposal(content, sdk.NewCoins(sdk.NewInt64Coin("foocoin", 10)), addr1)
+		bz := app.Codec().MustMarshalBinaryBare(msg)
+
+		var decoded sdk.Msg
+		require.NoError(t, app.Codec().UnmarshalBinaryBare(bz, &decoded))
+		require.Equal(t, msg, decoded)
+	}
+}
+
+func TestTrippedMessagesAreRejected(t *testing.T) {
+	acc := &auth.BaseAccount{Address: addr1, Coins: sdk.NewCoins(sdk.NewInt64Coin("foocoin", 100))}
+	app := simapp.SetupWithGenesisAccounts([]genaccounts.GenesisAccount{genaccounts.NewGenesisAccount(acc)})
+
+	ctx := app.BaseApp.NewContext(false, abci.Header{})
+	app.CircuitKeeper.SetAuthorizedAccounts(ctx, []sdk.AccAddress{addr1})
+	app.EndBlock(abci.RequestEndBlock{})
+	app.Commit()
+
+	trip := circuit.NewMsgTripCircuitBreaker(addr1, []string{"bank/send"})
+	header := abci.Header{Height: app.LastBlockHeight() + 1}
+	simapp.SignCheckDeliver(t, app.Codec(), app.BaseApp, header, []sdk.Msg{trip}, []uint64{0}, []uint64{0}, true, true, priv1)
+
+	header = abci.Header{Height: app.LastBlockHeight() + 1}
+	res := simapp.SignCheckDeliver(t, app.Codec(), app.BaseApp, header, []sdk.Msg{send}, []uint64{0}, []uint64{1}, false, false, priv1)
+	require.Equal(t, sdk.CodeMsgDisabled, res.Code, res.Log)
+	simapp.CheckBalance(t, app, addr1, sdk.NewCoins(sdk.NewInt64Coin("foocoin", 100)))
+
+	// the messages of a tripped circuit breaker are rejected by CheckTx
+	tx := simapp.GenTx([]sdk.Msg{send}, []uint64{0}, []uint64{2}, priv1)
+	checkRes := app.Check(tx)
+	require.Equal(t, sdk.CodeMsgDisabled, checkRes.Code, checkRes.Log)
+}
+
+func TestTrippedMessagesAreRejectedThroughRouter(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, abci.Header{})
+	require.NoError(t, app.CircuitKeeper.TripCircuitBreaker(ctx, "bank/send"))
+
+	// messages executed by authz
+	res := authz.NewHandler(app.AuthzKeeper)(ctx, authz.NewMsgExec(addr1, []sdk.Msg{send}))
+	require.Equal(t, sdk.CodeMsgDisabled, res.Code, res.Log)
+
+	// messages executed by a passed proposal
+	govAddr := app.SupplyKeeper.GetModuleAddress(gov.ModuleName)
+	govSend := bank.MsgSend{FromAddress: govAddr, ToAddress: addr2, Amount: coins}
+	_, err := app.GovKeeper.ExecuteProposal(ctx, gov.NewExecutableProposal("title", "description", []sdk.Msg{govSend}))
+	require.Error(t, err)
+	require.Equal(t, gov.CodeExecutionFailed, err.Code())
+	require.Contains(t, err.Error(), "bank/send")
+}
+
+func TestExemptRoutesAreAlwaysAllowed(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, abci.Header{})
+	app.CircuitKeeper.SetAuthorizedAccounts(ctx, []sdk.AccAddress{addr1})
+	handler := circuit.NewHandler(app.CircuitKeeper)
+
+	// the circuit module is always exempt, the gov module is exempted by simapp
+	require.Error(t, circuit.NewMsgTripCircuitBreaker(addr1, []string{circuit.RouterKey}).ValidateBasic())
+	require.True(t, app.CircuitKeeper.IsExemptRoute(circuit.RouterKey))
+	require.True(t, app.CircuitKeeper.IsExemptRoute(gov.RouterKey))
+	require.False(t, app.CircuitKeeper.IsExemptRoute(bank.RouterKey))
+
+	for _, msgName := range []string{circuit.RouterKey, gov.RouterKey, "gov/submit_proposal"} {
+		res := handler(ctx, circuit.NewMsgTripCircuitBreaker(addr1, []string{msgName}))
+		require.Equal(t, circuit.CodeInvalidMsgName, res.Code, msgName)
+		require.Error(t, app.CircuitKeeper.TripCircuitBreaker(ctx, msgName), msgName)
+	}
+	require.Empty(t, app.CircuitKeeper.GetTrippedBreakers(ctx))
+	require.True(t, app.CircuitKeeper.IsAllowed(ctx, circuit.NewMsgResetCircuitBreaker(addr1, []string{"bank"})))
+	require.True(t, app.CircuitKeeper.IsAllowed(ctx, gov.NewMsgVote(addr1, 1, gov.OptionYes)))
+
+	res := handler(ctx, circuit.NewMsgTripCircuitBreaker(addr1, []string{"bank"}))
+	require.True(t, res.IsOK(), res.Log)
+	require.False(t, app.CircuitKeeper.IsAllowed(ctx, send))
+
+	// the exempt routes are set on the keeper
+	keeper := circuit.NewKeeper(app.Codec(), app.GetKey(circuit.StoreKey), app.ParamsKeeper.Subspace("othercircuit"))
+	require.False(t, keeper.IsExemptRoute(gov.RouterKey))
+	require.NoError(t, keeper.TripCircuitBreaker(ctx, gov.RouterKey))
+	require.False(t, keeper.IsAllowed(ctx, gov.NewMsgVote(addr1, 1, gov.OptionYes)))
+}
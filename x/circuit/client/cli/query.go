@@ -0,0 +1,57 @@
+package cli
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/x/circuit/internal/types"
+)
+
+// GetQueryCmd returns the cli query commands for the circuit module.
+func GetQueryCmd(cdc *codec.Codec) *cobra.Command {
+	circuitQueryCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Querying commands for the circuit module",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	circuitQueryCmd.AddCommand(
+		client.GetCommands(
+			GetCmdQueryTrippedBreakers(cdc),
+		)...,
+	)
+
+	return circuitQueryCmd
+}
+
+// GetCmdQueryTrippedBreakers implements the command to query the tripped
+// circuit breakers.
+func GetCmdQueryTrippedBreakers(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "tripped",
+		Short: "Query the messages disabled by tripped circuit breakers",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			route := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryTrippedBreakers)
+			res, _, err := cliCtx.QueryWithData(route, nil)
+			if err != nil {
+				return err
+			}
+
+			var msgNames types.TrippedBreakers
+			if err := cdc.UnmarshalJSON(res, &msgNames); err != nil {
+				return err
+			}
+
+			return cliCtx.PrintOutput(msgNames)
+		},
+	}
+}
@@ -0,0 +1,179 @@
+package cli
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/cosmos/cosmos-sdk/x/auth/client/utils"
+	"github.com/cosmos/cosmos-sdk/x/circuit/internal/types"
+	govcli "github.com/cosmos/cosmos-sdk/x/gov/client/cli"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+// GetTxCmd returns the transaction commands for this module
+func GetTxCmd(cdc *codec.Codec) *cobra.Command {
+	circuitTxCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Circuit breaker transaction subcommands",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	circuitTxCmd.AddCommand(client.PostCommands(
+		GetCmdTripCircuitBreaker(cdc),
+		GetCmdResetCircuitBreaker(cdc),
+	)...)
+
+	return circuitTxCmd
+}
+
+// GetCmdTripCircuitBreaker implements the command to trip circuit breakers.
+func GetCmdTripCircuitBreaker(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "trip [msg-name]...",
+		Short: "Disable messages by tripping their circuit breakers",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Disable the messages of the given routes or types, formatted as <route>/<type>.
+The signer must be an authorized account of the circuit module.
+
+Example:
+$ %s tx circuit trip bank/send staking --from mykey
+`,
+				version.ClientName,
+			),
+		),
+		Args: cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			txBldr := auth.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			msg := types.NewMsgTripCircuitBreaker(cliCtx.GetFromAddress(), args)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
+
+// GetCmdResetCircuitBreaker implements the command to reset circuit breakers.
+func GetCmdResetCircuitBreaker(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "reset [msg-name]...",
+		Short: "Enable messages again by resetting their tripped circuit breakers",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Enable again the messages of the given routes or types, formatted as <route>/<type>.
+The signer must be an authorized account of the circuit module.
+
+Example:
+$ %s tx circuit reset bank/send staking --from mykey
+`,
+				version.ClientName,
+			),
+		),
+		Args: cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			txBldr := auth.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			msg := types.NewMsgResetCircuitBreaker(cliCtx.GetFromAddress(), args)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
+
+// GetCmdSubmitTripProposal implements a command handler for submitting a trip
+// circuit breaker proposal transaction.
+func GetCmdSubmitTripProposal(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "trip-circuit-breaker [msg-name]...",
+		Args:  cobra.MinimumNArgs(1),
+		Short: "Submit a proposal to disable messages by tripping their circuit breakers",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Submit a proposal to disable the messages of the given routes or types, formatted
+as <route>/<type>, along with an initial deposit.
+
+Example:
+$ %s tx gov submit-proposal trip-circuit-breaker bank/send --title="Disable sends" --description="Some description" --deposit="10000stake" --from=<key_or_address>
+`,
+				version.ClientName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			content := types.NewTripCircuitBreakerProposal(
+				viper.GetString(govcli.FlagTitle), viper.GetString(govcli.FlagDescription), args,
+			)
+			return submitProposal(cdc, content)
+		},
+	}
+
+	cmd.Flags().String(govcli.FlagTitle, "", "title of proposal")
+	cmd.Flags().String(govcli.FlagDescription, "", "description of proposal")
+	cmd.Flags().String(govcli.FlagDeposit, "", "deposit of proposal")
+
+	return cmd
+}
+
+// GetCmdSubmitResetProposal implements a command handler for submitting a
+// reset circuit breaker proposal transaction.
+func GetCmdSubmitResetProposal(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "reset-circuit-breaker [msg-name]...",
+		Args:  cobra.MinimumNArgs(1),
+		Short: "Submit a proposal to enable messages again by resetting their circuit breakers",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Submit a proposal to enable again the messages of the given routes or types,
+formatted as <route>/<type>, along with an initial deposit.
+
+Example:
+$ %s tx gov submit-proposal reset-circuit-breaker bank/send --title="Enable sends" --description="Some description" --deposit="10000stake" --from=<key_or_address>
+`,
+				version.ClientName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			content := types.NewResetCircuitBreakerProposal(
+				viper.GetString(govcli.FlagTitle), viper.GetString(govcli.FlagDescription), args,
+			)
+			return submitProposal(cdc, content)
+		},
+	}
+
+	cmd.Flags().String(govcli.FlagTitle, "", "title of proposal")
+	cmd.Flags().String(govcli.FlagDescription, "", "description of proposal")
+	cmd.Flags().String(govcli.FlagDeposit, "", "deposit of proposal")
+
+	return cmd
+}
+
+func submitProposal(cdc *codec.Codec, content govtypes.Content) error {
+	txBldr := auth.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+	cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+	deposit, err := sdk.ParseCoins(viper.GetString(govcli.FlagDeposit))
+	if err != nil {
+		return err
+	}
+
+	msg := govtypes.NewMsgSubmitProposal(content, deposit, cliCtx.GetFromAddress())
+	if err := msg.ValidateBasic(); err != nil {
+		return err
+	}
+
+	return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+}
@@ -0,0 +1,13 @@
+package client
+
+import (
+	"github.com/cosmos/cosmos-sdk/x/circuit/client/cli"
+	"github.com/cosmos/cosmos-sdk/x/circuit/client/rest"
+	govclient "github.com/cosmos/cosmos-sdk/x/gov/client"
+)
+
+// circuit breaker proposal handlers
+var (
+	TripProposalHandler  = govclient.NewProposalHandler(cli.GetCmdSubmitTripProposal, rest.TripProposalRESTHandler)
+	ResetProposalHandler = govclient.NewProposalHandler(cli.GetCmdSubmitResetProposal, rest.ResetProposalRESTHandler)
+)
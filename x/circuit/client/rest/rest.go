@@ -0,0 +1,102 @@
+package rest
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/gorilla/mux"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/rest"
+	"github.com/cosmos/cosmos-sdk/x/auth/client/utils"
+	"github.com/cosmos/cosmos-sdk/x/circuit/internal/types"
+	govrest "github.com/cosmos/cosmos-sdk/x/gov/client/rest"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+// CircuitBreakerProposalReq defines a trip or reset circuit breaker proposal
+// request body.
+type CircuitBreakerProposalReq struct {
+	BaseReq     rest.BaseReq   `json:"base_req" yaml:"base_req"`
+	Title       string         `json:"title" yaml:"title"`
+	Description string         `json:"description" yaml:"description"`
+	Deposit     sdk.Coins      `json:"deposit" yaml:"deposit"`
+	Proposer    sdk.AccAddress `json:"proposer" yaml:"proposer"`
+	MsgNames    []string       `json:"msg_names" yaml:"msg_names"`
+}
+
+// RegisterRoutes registers circuit module REST handlers on the provided router.
+func RegisterRoutes(cliCtx context.CLIContext, r *mux.Router) {
+	r.HandleFunc(
+		"/circuit/tripped",
+		queryTrippedBreakersHandlerFn(cliCtx),
+	).Methods("GET")
+}
+
+// TripProposalRESTHandler returns a ProposalRESTHandler that exposes the trip
+// circuit breaker REST handler with a given sub-route.
+func TripProposalRESTHandler(cliCtx context.CLIContext) govrest.ProposalRESTHandler {
+	return govrest.ProposalRESTHandler{
+		SubRoute: "circuit_trip",
+		Handler: postProposalHandlerFn(cliCtx, func(req CircuitBreakerProposalReq) govtypes.Content {
+			return types.NewTripCircuitBreakerProposal(req.Title, req.Description, req.MsgNames)
+		}),
+	}
+}
+
+// ResetProposalRESTHandler returns a ProposalRESTHandler that exposes the
+// reset circuit breaker REST handler with a given sub-route.
+func ResetProposalRESTHandler(cliCtx context.CLIContext) govrest.ProposalRESTHandler {
+	return govrest.ProposalRESTHandler{
+		SubRoute: "circuit_reset",
+		Handler: postProposalHandlerFn(cliCtx, func(req CircuitBreakerProposalReq) govtypes.Content {
+			return types.NewResetCircuitBreakerProposal(req.Title, req.Description, req.MsgNames)
+		}),
+	}
+}
+
+// HTTP request handler to query the tripped circuit breakers.
+func queryTrippedBreakersHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+		if !ok {
+			return
+		}
+
+		route := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryTrippedBreakers)
+		res, height, err := cliCtx.QueryWithData(route, nil)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		cliCtx = cliCtx.WithHeight(height)
+		rest.PostProcessResponse(w, cliCtx, res)
+	}
+}
+
+func postProposalHandlerFn(
+	cliCtx context.CLIContext, newContent func(req CircuitBreakerProposalReq) govtypes.Content,
+) http.HandlerFunc {
+
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req CircuitBreakerProposalReq
+		if !rest.ReadRESTReq(w, r, cliCtx.Codec, &req) {
+			return
+		}
+
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+
+		msg := govtypes.NewMsgSubmitProposal(newContent(req), req.Deposit, req.Proposer)
+		if err := msg.ValidateBasic(); err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		utils.WriteGenerateStdTxResponse(w, cliCtx, req.BaseReq, []sdk.Msg{msg})
+	}
+}
@@ -0,0 +1,27 @@
+/*
+Package circuit provides circuit breakers that disable messages at runtime, so
+that a faulty module can be switched off without halting the chain.
+
+A circuit breaker is named after either a message route, eg. "bank", which
+disables all the messages of the route, or a single message type formatted as
+<route>/<type>, eg. "bank/send". The keeper implements sdk.CircuitBreaker and
+is registered with baseapp.SetCircuitBreaker, so that the messages of a tripped
+circuit breaker fail with sdk.CodeMsgDisabled before their handler runs, in
+CheckTx as well as in DeliverTx:
+
+	app.SetCircuitBreaker(app.CircuitKeeper)
+
+Circuit breakers are tripped and reset either by the accounts listed in the
+AuthorizedAccounts parameter, through MsgTripCircuitBreaker and
+MsgResetCircuitBreaker, or by governance, through TripCircuitBreakerProposal
+and ResetCircuitBreakerProposal. The messages of the circuit module itself
+cannot be disabled, nor can the messages of the exempt routes given to the
+keeper, eg. the gov route when circuit breakers are reset by governance:
+
+	app.CircuitKeeper = circuit.NewKeeper(cdc, key, paramSpace, gov.RouterKey)
+
+Only the messages of a transaction are checked. A message executed by another
+module on behalf of a signer, eg. within an authz MsgExec, is disabled by
+tripping the circuit breaker of the executing message.
+*/
+package circuit
@@ -0,0 +1,23 @@
+package circuit
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// InitGenesis stores the authorized accounts and the tripped circuit breakers
+// of a *previously validated* GenesisState
+func InitGenesis(ctx sdk.Context, k Keeper, data GenesisState) {
+	k.SetAuthorizedAccounts(ctx, data.AuthorizedAccounts)
+
+	for _, msgName := range data.TrippedBreakers {
+		if err := k.TripCircuitBreaker(ctx, msgName); err != nil {
+			panic(err)
+		}
+	}
+}
+
+// ExportGenesis returns a GenesisState with the authorized accounts and the
+// tripped circuit breakers.
+func ExportGenesis(ctx sdk.Context, k Keeper) GenesisState {
+	return NewGenesisState(k.GetAuthorizedAccounts(ctx), k.GetTrippedBreakers(ctx))
+}
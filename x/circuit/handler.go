@@ -0,0 +1,108 @@
+package circuit
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+// NewHandler returns a handler for circuit messages
+func NewHandler(k Keeper) sdk.Handler {
+	return func(ctx sdk.Context, msg sdk.Msg) sdk.Result {
+		ctx = ctx.WithEventManager(sdk.NewEventManager())
+
+		switch msg := msg.(type) {
+		case MsgTripCircuitBreaker:
+			return handleMsgTripCircuitBreaker(ctx, k, msg)
+
+		case MsgResetCircuitBreaker:
+			return handleMsgResetCircuitBreaker(ctx, k, msg)
+
+		default:
+			errMsg := fmt.Sprintf("unrecognized circuit message type: %T", msg)
+			return sdk.ErrUnknownRequest(errMsg).Result()
+		}
+	}
+}
+
+func handleMsgTripCircuitBreaker(ctx sdk.Context, k Keeper, msg MsgTripCircuitBreaker) sdk.Result {
+	if !k.IsAuthorized(ctx, msg.Authority) {
+		return ErrUnauthorized(DefaultCodespace, msg.Authority).Result()
+	}
+
+	for _, msgName := range msg.MsgNames {
+		if err := k.TripCircuitBreaker(ctx, msgName); err != nil {
+			return err.Result()
+		}
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Authority.String()),
+		),
+	)
+
+	return sdk.Result{Events: ctx.EventManager().Events()}
+}
+
+func handleMsgResetCircuitBreaker(ctx sdk.Context, k Keeper, msg MsgResetCircuitBreaker) sdk.Result {
+	if !k.IsAuthorized(ctx, msg.Authority) {
+		return ErrUnauthorized(DefaultCodespace, msg.Authority).Result()
+	}
+
+	for _, msgName := range msg.MsgNames {
+		if err := k.ResetCircuitBreaker(ctx, msgName); err != nil {
+			return err.Result()
+		}
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Authority.String()),
+		),
+	)
+
+	return sdk.Result{Events: ctx.EventManager().Events()}
+}
+
+// NewCircuitBreakerProposalHandler creates a governance handler for the
+// circuit proposal types. TripCircuitBreakerProposal disables messages and
+// ResetCircuitBreakerProposal enables them again.
+func NewCircuitBreakerProposalHandler(k Keeper) govtypes.Handler {
+	return func(ctx sdk.Context, content govtypes.Content) sdk.Error {
+		switch c := content.(type) {
+		case TripCircuitBreakerProposal:
+			return handleTripCircuitBreakerProposal(ctx, k, c)
+
+		case ResetCircuitBreakerProposal:
+			return handleResetCircuitBreakerProposal(ctx, k, c)
+
+		default:
+			errMsg := fmt.Sprintf("unrecognized circuit proposal content type: %T", c)
+			return sdk.ErrUnknownRequest(errMsg)
+		}
+	}
+}
+
+func handleTripCircuitBreakerProposal(ctx sdk.Context, k Keeper, p TripCircuitBreakerProposal) sdk.Error {
+	for _, msgName := range p.MsgNames {
+		if err := k.TripCircuitBreaker(ctx, msgName); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func handleResetCircuitBreakerProposal(ctx sdk.Context, k Keeper, p ResetCircuitBreakerProposal) sdk.Error {
+	for _, msgName := range p.MsgNames {
+		if err := k.ResetCircuitBreaker(ctx, msgName); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,155 @@
+package keeper
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/circuit/internal/types"
+	"github.com/cosmos/cosmos-sdk/x/params"
+)
+
+// Keeper of the circuit store
+type Keeper struct {
+	storeKey     sdk.StoreKey
+	cdc          *codec.Codec
+	paramSpace   params.Subspace
+	exemptRoutes map[string]bool
+}
+
+var _ sdk.CircuitBreaker = Keeper{}
+
+// NewKeeper constructs a circuit Keeper. The messages of the given routes, eg.
+// the route of the module passing the proposals which reset circuit breakers,
+// can never be disabled, nor can the messages of the circuit module itself.
+func NewKeeper(cdc *codec.Codec, storeKey sdk.StoreKey, paramSpace params.Subspace, exemptRoutes ...string) Keeper {
+	exempt := map[string]bool{types.RouterKey: true}
+	for _, route := range exemptRoutes {
+		exempt[route] = true
+	}
+
+	return Keeper{
+		storeKey:     storeKey,
+		cdc:          cdc,
+		paramSpace:   paramSpace.WithKeyTable(types.ParamKeyTable()),
+		exemptRoutes: exempt,
+	}
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
+}
+
+// GetAuthorizedAccounts returns the accounts authorized to trip and reset
+// circuit breakers.
+func (k Keeper) GetAuthorizedAccounts(ctx sdk.Context) (accounts []sdk.AccAddress) {
+	k.paramSpace.Get(ctx, types.ParamStoreKeyAuthorizedAccounts, &accounts)
+	return accounts
+}
+
+// SetAuthorizedAccounts sets the accounts authorized to trip and reset circuit
+// breakers.
+func (k Keeper) SetAuthorizedAccounts(ctx sdk.Context, accounts []sdk.AccAddress) {
+	k.paramSpace.Set(ctx, types.ParamStoreKeyAuthorizedAccounts, &accounts)
+}
+
+// IsAuthorized returns true if the account is authorized to trip and reset
+// circuit breakers.
+func (k Keeper) IsAuthorized(ctx sdk.Context, addr sdk.AccAddress) bool {
+	for _, authorized := range k.GetAuthorizedAccounts(ctx) {
+		if authorized.Equals(addr) {
+			return true
+		}
+	}
+	return false
+}
+
+// IsExemptRoute returns true if the messages of the given route can never be
+// disabled.
+func (k Keeper) IsExemptRoute(route string) bool {
+	return k.exemptRoutes[route]
+}
+
+// TripCircuitBreaker disables the messages of the given name, either a message
+// route or a message type formatted as <route>/<type>. It returns an error if
+// the messages are of an exempt route. Tripping a tripped circuit breaker is a
+// no-op.
+func (k Keeper) TripCircuitBreaker(ctx sdk.Context, msgName string) sdk.Error {
+	if route := strings.Split(msgName, "/")[0]; k.IsExemptRoute(route) {
+		return types.ErrInvalidMsgName(types.DefaultCodespace,
+			fmt.Sprintf("%s, the %s module cannot be disabled", msgName, route))
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.TrippedBreakerKey(msgName), []byte{})
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeTripCircuitBreaker,
+			sdk.NewAttribute(types.AttributeKeyMsgName, msgName),
+		),
+	)
+
+	return nil
+}
+
+// ResetCircuitBreaker enables again the messages of the given name. It returns
+// an error if the circuit breaker is not tripped.
+func (k Keeper) ResetCircuitBreaker(ctx sdk.Context, msgName string) sdk.Error {
+	store := ctx.KVStore(k.storeKey)
+	key := types.TrippedBreakerKey(msgName)
+
+	if !store.Has(key) {
+		return types.ErrNotTripped(types.DefaultCodespace, msgName)
+	}
+
+	store.Delete(key)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeResetCircuitBreaker,
+			sdk.NewAttribute(types.AttributeKeyMsgName, msgName),
+		),
+	)
+
+	return nil
+}
+
+// IsTripped returns true if the circuit breaker of the given name is tripped.
+func (k Keeper) IsTripped(ctx sdk.Context, msgName string) bool {
+	return ctx.KVStore(k.storeKey).Has(types.TrippedBreakerKey(msgName))
+}
+
+// IsAllowed implements sdk.CircuitBreaker. A message is allowed unless the
+// circuit breaker of its route or of its type is tripped. The messages of the
+// exempt routes are always allowed. As every message is checked, the lookups
+// are not charged any gas.
+func (k Keeper) IsAllowed(ctx sdk.Context, msg sdk.Msg) bool {
+	if k.IsExemptRoute(msg.Route()) {
+		return true
+	}
+
+	store := ctx.MultiStore().GetKVStore(k.storeKey)
+
+	return !store.Has(types.TrippedBreakerKey(msg.Route())) &&
+		!store.Has(types.TrippedBreakerKey(types.MsgName(msg)))
+}
+
+// GetTrippedBreakers returns the names of all the tripped circuit breakers.
+func (k Keeper) GetTrippedBreakers(ctx sdk.Context) []string {
+	store := ctx.KVStore(k.storeKey)
+
+	iter := sdk.KVStorePrefixIterator(store, types.TrippedBreakerKeyPrefix)
+	defer iter.Close()
+
+	msgNames := []string{}
+	for ; iter.Valid(); iter.Next() {
+		msgNames = append(msgNames, string(iter.Key()[len(types.TrippedBreakerKeyPrefix):]))
+	}
+
+	return msgNames
+}
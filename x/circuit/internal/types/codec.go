@@ -0,0 +1,23 @@
+package types
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+)
+
+// RegisterCodec registers the circuit types
+func RegisterCodec(cdc *codec.Codec) {
+	cdc.RegisterConcrete(MsgTripCircuitBreaker{}, "cosmos-sdk/MsgTripCircuitBreaker", nil)
+	cdc.RegisterConcrete(MsgResetCircuitBreaker{}, "cosmos-sdk/MsgResetCircuitBreaker", nil)
+	cdc.RegisterConcrete(TripCircuitBreakerProposal{}, "cosmos-sdk/TripCircuitBreakerProposal", nil)
+	cdc.RegisterConcrete(ResetCircuitBreakerProposal{}, "cosmos-sdk/ResetCircuitBreakerProposal", nil)
+}
+
+// ModuleCdc generic sealed codec to be used throughout module
+var ModuleCdc *codec.Codec
+
+func init() {
+	ModuleCdc = codec.New()
+	RegisterCodec(ModuleCdc)
+	codec.RegisterCrypto(ModuleCdc)
+	ModuleCdc.Seal()
+}
@@ -0,0 +1,34 @@
+package types
+
+// DONTCOVER
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Codes for circuit errors
+const (
+	DefaultCodespace sdk.CodespaceType = ModuleName
+
+	CodeInvalidMsgName sdk.CodeType = 1
+	CodeNotTripped     sdk.CodeType = 2
+	CodeUnauthorized   sdk.CodeType = 3
+)
+
+// ErrInvalidMsgName error for an invalid circuit breaker message name
+func ErrInvalidMsgName(codespace sdk.CodespaceType, msgName string) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidMsgName, fmt.Sprintf("invalid message name: %s", msgName))
+}
+
+// ErrNotTripped error if the circuit breaker of a message name isn't tripped
+func ErrNotTripped(codespace sdk.CodespaceType, msgName string) sdk.Error {
+	return sdk.NewError(codespace, CodeNotTripped, fmt.Sprintf("circuit breaker %s is not tripped", msgName))
+}
+
+// ErrUnauthorized error if an account isn't authorized to trip or reset
+// circuit breakers
+func ErrUnauthorized(codespace sdk.CodespaceType, addr sdk.AccAddress) sdk.Error {
+	return sdk.NewError(codespace, CodeUnauthorized, fmt.Sprintf("%s is not authorized to operate circuit breakers", addr))
+}
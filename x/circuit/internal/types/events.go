@@ -0,0 +1,11 @@
+package types
+
+// circuit module event types
+const (
+	EventTypeTripCircuitBreaker  = "trip_circuit_breaker"
+	EventTypeResetCircuitBreaker = "reset_circuit_breaker"
+
+	AttributeKeyMsgName = "msg_name"
+
+	AttributeValueCategory = ModuleName
+)
@@ -0,0 +1,46 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// GenesisState contains the accounts authorized to operate the circuit
+// breakers and the tripped circuit breakers
+type GenesisState struct {
+	AuthorizedAccounts []sdk.AccAddress `json:"authorized_accounts" yaml:"authorized_accounts"`
+	TrippedBreakers    []string         `json:"tripped_breakers" yaml:"tripped_breakers"`
+}
+
+// NewGenesisState creates a new GenesisState object
+func NewGenesisState(authorizedAccounts []sdk.AccAddress, trippedBreakers []string) GenesisState {
+	return GenesisState{
+		AuthorizedAccounts: authorizedAccounts,
+		TrippedBreakers:    trippedBreakers,
+	}
+}
+
+// DefaultGenesisState returns a genesis state without authorized accounts nor
+// tripped circuit breakers
+func DefaultGenesisState() GenesisState {
+	return NewGenesisState([]sdk.AccAddress{}, []string{})
+}
+
+// ValidateGenesis ensures the authorized accounts and the tripped circuit
+// breakers of the genesis state are valid
+func ValidateGenesis(data GenesisState) error {
+	for _, addr := range data.AuthorizedAccounts {
+		if addr.Empty() {
+			return fmt.Errorf("authorized account cannot be empty")
+		}
+	}
+
+	for _, msgName := range data.TrippedBreakers {
+		if err := ValidateMsgName(msgName); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,64 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// ModuleName is the module name constant used in many places
+	ModuleName = "circuit"
+
+	// StoreKey is the store key string for the circuit module
+	StoreKey = ModuleName
+
+	// RouterKey is the message route for the circuit module
+	RouterKey = ModuleName
+
+	// QuerierRoute is the querier route for the circuit module
+	QuerierRoute = ModuleName
+)
+
+var (
+	// TrippedBreakerKeyPrefix is the prefix of the kvstore for tripped circuit
+	// breakers
+	TrippedBreakerKeyPrefix = []byte{0x01}
+)
+
+// TrippedBreakerKey is the key under which a tripped circuit breaker of the
+// given message name is stored.
+func TrippedBreakerKey(msgName string) []byte {
+	return append(TrippedBreakerKeyPrefix, []byte(msgName)...)
+}
+
+// MsgName returns the name of the circuit breaker of a message type, formatted
+// as <route>/<type>.
+func MsgName(msg sdk.Msg) string {
+	return fmt.Sprintf("%s/%s", msg.Route(), msg.Type())
+}
+
+// ValidateMsgName validates the name of a circuit breaker, which is either a
+// message route, which disables all the messages of the route, or a message
+// type formatted as <route>/<type>. The messages of the circuit module itself,
+// which reset the circuit breakers, cannot be disabled. The other exempt routes
+// are set on the keeper.
+func ValidateMsgName(msgName string) sdk.Error {
+	parts := strings.Split(msgName, "/")
+	if len(parts) > 2 {
+		return ErrInvalidMsgName(DefaultCodespace, msgName)
+	}
+
+	for _, part := range parts {
+		if strings.TrimSpace(part) == "" {
+			return ErrInvalidMsgName(DefaultCodespace, msgName)
+		}
+	}
+
+	if parts[0] == RouterKey {
+		return ErrInvalidMsgName(DefaultCodespace, fmt.Sprintf("%s, the %s module cannot be disabled", msgName, parts[0]))
+	}
+
+	return nil
+}
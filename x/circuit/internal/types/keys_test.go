@@ -0,0 +1,29 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateMsgName(t *testing.T) {
+	tests := []struct {
+		msgName string
+		expErr  bool
+	}{
+		{"bank", false},
+		{"bank/send", false},
+		{"", true},
+		{"bank/", true},
+		{"/send", true},
+		{"bank/send/extra", true},
+		{" /send", true},
+		{RouterKey, true},
+		{RouterKey + "/trip_circuit_breaker", true},
+	}
+
+	for _, tc := range tests {
+		err := ValidateMsgName(tc.msgName)
+		require.Equal(t, tc.expErr, err != nil, tc.msgName)
+	}
+}
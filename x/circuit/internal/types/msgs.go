@@ -0,0 +1,100 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// ensure Msg interface compliance at compile time
+var (
+	_ sdk.Msg = MsgTripCircuitBreaker{}
+	_ sdk.Msg = MsgResetCircuitBreaker{}
+)
+
+// MsgTripCircuitBreaker disables the messages of the given names. It must be
+// signed by an authorized account.
+type MsgTripCircuitBreaker struct {
+	Authority sdk.AccAddress `json:"authority" yaml:"authority"`
+	MsgNames  []string       `json:"msg_names" yaml:"msg_names"`
+}
+
+// NewMsgTripCircuitBreaker creates a new MsgTripCircuitBreaker object.
+func NewMsgTripCircuitBreaker(authority sdk.AccAddress, msgNames []string) MsgTripCircuitBreaker {
+	return MsgTripCircuitBreaker{Authority: authority, MsgNames: msgNames}
+}
+
+// Route implements the sdk.Msg interface
+func (msg MsgTripCircuitBreaker) Route() string { return RouterKey }
+
+// Type implements the sdk.Msg interface
+func (msg MsgTripCircuitBreaker) Type() string { return "trip_circuit_breaker" }
+
+// ValidateBasic implements the sdk.Msg interface
+func (msg MsgTripCircuitBreaker) ValidateBasic() sdk.Error {
+	return validateMsgNames(msg.Authority, msg.MsgNames)
+}
+
+// GetSignBytes implements the sdk.Msg interface
+func (msg MsgTripCircuitBreaker) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners implements the sdk.Msg interface
+func (msg MsgTripCircuitBreaker) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Authority}
+}
+
+// MsgResetCircuitBreaker enables again the messages of the given names. It
+// must be signed by an authorized account.
+type MsgResetCircuitBreaker struct {
+	Authority sdk.AccAddress `json:"authority" yaml:"authority"`
+	MsgNames  []string       `json:"msg_names" yaml:"msg_names"`
+}
+
+// NewMsgResetCircuitBreaker creates a new MsgResetCircuitBreaker object.
+func NewMsgResetCircuitBreaker(authority sdk.AccAddress, msgNames []string) MsgResetCircuitBreaker {
+	return MsgResetCircuitBreaker{Authority: authority, MsgNames: msgNames}
+}
+
+// Route implements the sdk.Msg interface
+func (msg MsgResetCircuitBreaker) Route() string { return RouterKey }
+
+// Type implements the sdk.Msg interface
+func (msg MsgResetCircuitBreaker) Type() string { return "reset_circuit_breaker" }
+
+// ValidateBasic implements the sdk.Msg interface
+func (msg MsgResetCircuitBreaker) ValidateBasic() sdk.Error {
+	return validateMsgNames(msg.Authority, msg.MsgNames)
+}
+
+// GetSignBytes implements the sdk.Msg interface
+func (msg MsgResetCircuitBreaker) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners implements the sdk.Msg interface
+func (msg MsgResetCircuitBreaker) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Authority}
+}
+
+func validateMsgNames(authority sdk.AccAddress, msgNames []string) sdk.Error {
+	if authority.Empty() {
+		return sdk.ErrInvalidAddress("missing authority address")
+	}
+
+	return ValidateMsgNames(msgNames)
+}
+
+// ValidateMsgNames validates a non-empty list of circuit breaker names.
+func ValidateMsgNames(msgNames []string) sdk.Error {
+	if len(msgNames) == 0 {
+		return ErrInvalidMsgName(DefaultCodespace, "no message names given")
+	}
+
+	for _, msgName := range msgNames {
+		if err := ValidateMsgName(msgName); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,23 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/params"
+)
+
+// Default parameter namespace
+const (
+	DefaultParamspace = ModuleName
+)
+
+var (
+	// key for the accounts authorized to trip and reset circuit breakers
+	ParamStoreKeyAuthorizedAccounts = []byte("AuthorizedAccounts")
+)
+
+// type declaration for parameters
+func ParamKeyTable() params.KeyTable {
+	return params.NewKeyTable(
+		ParamStoreKeyAuthorizedAccounts, []sdk.AccAddress{},
+	)
+}
@@ -0,0 +1,113 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+const (
+	// ProposalTypeTripCircuitBreaker defines the type for a TripCircuitBreakerProposal
+	ProposalTypeTripCircuitBreaker string = "TripCircuitBreaker"
+	// ProposalTypeResetCircuitBreaker defines the type for a ResetCircuitBreakerProposal
+	ProposalTypeResetCircuitBreaker string = "ResetCircuitBreaker"
+)
+
+// Assert the circuit proposals implement govtypes.Content at compile-time
+var (
+	_ govtypes.Content = TripCircuitBreakerProposal{}
+	_ govtypes.Content = ResetCircuitBreakerProposal{}
+)
+
+func init() {
+	govtypes.RegisterProposalType(ProposalTypeTripCircuitBreaker)
+	govtypes.RegisterProposalTypeCodec(TripCircuitBreakerProposal{}, "cosmos-sdk/TripCircuitBreakerProposal")
+	govtypes.RegisterProposalType(ProposalTypeResetCircuitBreaker)
+	govtypes.RegisterProposalTypeCodec(ResetCircuitBreakerProposal{}, "cosmos-sdk/ResetCircuitBreakerProposal")
+}
+
+// TripCircuitBreakerProposal is a gov Content type for disabling the messages
+// of the given names
+type TripCircuitBreakerProposal struct {
+	Title       string   `json:"title" yaml:"title"`
+	Description string   `json:"description" yaml:"description"`
+	MsgNames    []string `json:"msg_names" yaml:"msg_names"`
+}
+
+// NewTripCircuitBreakerProposal creates a new trip circuit breaker proposal
+func NewTripCircuitBreakerProposal(title, description string, msgNames []string) TripCircuitBreakerProposal {
+	return TripCircuitBreakerProposal{title, description, msgNames}
+}
+
+// GetTitle returns the proposal title
+func (tp TripCircuitBreakerProposal) GetTitle() string { return tp.Title }
+
+// GetDescription returns the proposal description
+func (tp TripCircuitBreakerProposal) GetDescription() string { return tp.Description }
+
+// ProposalRoute returns the proposal router key
+func (tp TripCircuitBreakerProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType is "TripCircuitBreaker"
+func (tp TripCircuitBreakerProposal) ProposalType() string { return ProposalTypeTripCircuitBreaker }
+
+// ValidateBasic validates the content's title, description and message names
+func (tp TripCircuitBreakerProposal) ValidateBasic() sdk.Error {
+	if err := ValidateMsgNames(tp.MsgNames); err != nil {
+		return err
+	}
+	return govtypes.ValidateAbstract(DefaultCodespace, tp)
+}
+
+// String implements the Stringer interface
+func (tp TripCircuitBreakerProposal) String() string {
+	return fmt.Sprintf(`Trip Circuit Breaker Proposal:
+  Title:       %s
+  Description: %s
+  Msg Names:   %s
+`, tp.Title, tp.Description, strings.Join(tp.MsgNames, ", "))
+}
+
+// ResetCircuitBreakerProposal is a gov Content type for enabling again the
+// messages of the given names
+type ResetCircuitBreakerProposal struct {
+	Title       string   `json:"title" yaml:"title"`
+	Description string   `json:"description" yaml:"description"`
+	MsgNames    []string `json:"msg_names" yaml:"msg_names"`
+}
+
+// NewResetCircuitBreakerProposal creates a new reset circuit breaker proposal
+func NewResetCircuitBreakerProposal(title, description string, msgNames []string) ResetCircuitBreakerProposal {
+	return ResetCircuitBreakerProposal{title, description, msgNames}
+}
+
+// GetTitle returns the proposal title
+func (rp ResetCircuitBreakerProposal) GetTitle() string { return rp.Title }
+
+// GetDescription returns the proposal description
+func (rp ResetCircuitBreakerProposal) GetDescription() string { return rp.Description }
+
+// ProposalRoute returns the proposal router key
+func (rp ResetCircuitBreakerProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType is "ResetCircuitBreaker"
+func (rp ResetCircuitBreakerProposal) ProposalType() string { return ProposalTypeResetCircuitBreaker }
+
+// ValidateBasic validates the content's title, description and message names
+func (rp ResetCircuitBreakerProposal) ValidateBasic() sdk.Error {
+	if err := ValidateMsgNames(rp.MsgNames); err != nil {
+		return err
+	}
+	return govtypes.ValidateAbstract(DefaultCodespace, rp)
+}
+
+// String implements the Stringer interface
+func (rp ResetCircuitBreakerProposal) String() string {
+	return fmt.Sprintf(`Reset Circuit Breaker Proposal:
+  Title:       %s
+  Description: %s
+  Msg Names:   %s
+`, rp.Title, rp.Description, strings.Join(rp.MsgNames, ", "))
+}
@@ -0,0 +1,18 @@
+package types
+
+import (
+	"strings"
+)
+
+// query endpoints supported by the circuit Querier
+const (
+	QueryTrippedBreakers = "tripped"
+)
+
+// TrippedBreakers defines the names of the tripped circuit breakers returned
+// by the tripped query
+type TrippedBreakers []string
+
+func (tb TrippedBreakers) String() string {
+	return strings.Join(tb, "\n")
+}
@@ -80,6 +80,7 @@ var (
 	ErrNoValidatorDistInfo                     = types.ErrNoValidatorDistInfo
 	ErrNoValidatorCommission                   = types.ErrNoValidatorCommission
 	ErrSetWithdrawAddrDisabled                 = types.ErrSetWithdrawAddrDisabled
+	ErrNoTokenizeShareRecords                  = types.ErrNoTokenizeShareRecords
 	ErrBadDistribution                         = types.ErrBadDistribution
 	ErrInvalidProposalAmount                   = types.ErrInvalidProposalAmount
 	ErrEmptyProposalRecipient                  = types.ErrEmptyProposalRecipient
@@ -90,6 +91,7 @@ var (
 	NewMsgSetWithdrawAddress                   = types.NewMsgSetWithdrawAddress
 	NewMsgWithdrawDelegatorReward              = types.NewMsgWithdrawDelegatorReward
 	NewMsgWithdrawValidatorCommission          = types.NewMsgWithdrawValidatorCommission
+	NewMsgWithdrawTokenizeShareRecordReward    = types.NewMsgWithdrawTokenizeShareRecordReward
 	NewCommunityPoolSpendProposal              = types.NewCommunityPoolSpendProposal
 	NewQueryValidatorOutstandingRewardsParams  = types.NewQueryValidatorOutstandingRewardsParams
 	NewQueryValidatorCommissionParams          = types.NewQueryValidatorCommissionParams
@@ -149,6 +151,7 @@ type (
 	MsgSetWithdrawAddress                  = types.MsgSetWithdrawAddress
 	MsgWithdrawDelegatorReward             = types.MsgWithdrawDelegatorReward
 	MsgWithdrawValidatorCommission         = types.MsgWithdrawValidatorCommission
+	MsgWithdrawTokenizeShareRecordReward   = types.MsgWithdrawTokenizeShareRecordReward
 	CommunityPoolSpendProposal             = types.CommunityPoolSpendProposal
 	QueryValidatorOutstandingRewardsParams = types.QueryValidatorOutstandingRewardsParams
 	QueryValidatorCommissionParams         = types.QueryValidatorCommissionParams
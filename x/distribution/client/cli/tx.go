@@ -46,6 +46,7 @@ func GetTxCmd(storeKey string, cdc *codec.Codec) *cobra.Command {
 		GetCmdWithdrawRewards(cdc),
 		GetCmdSetWithdrawAddr(cdc),
 		GetCmdWithdrawAllRewards(cdc, storeKey),
+		GetCmdWithdrawTokenizeShareRecordReward(cdc),
 	)...)
 
 	return distTxCmd
@@ -195,6 +196,32 @@ $ %s tx set-withdraw-addr cosmos1gghjut3ccd8ay0zduzj64hwre2fxs9ld75ru9p --from m
 	}
 }
 
+// command to withdraw the rewards of the tokenize share records of an owner
+func GetCmdWithdrawTokenizeShareRecordReward(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "withdraw-tokenize-share-rewards",
+		Short: "withdraw the rewards of all the tokenize share records owned by an address",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Withdraw the rewards of the tokenized delegations of all the tokenize share records
+owned by an address.
+
+Example:
+$ %s tx distr withdraw-tokenize-share-rewards --from mykey
+`,
+				version.ClientName,
+			),
+		),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			txBldr := auth.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			msg := types.NewMsgWithdrawTokenizeShareRecordReward(cliCtx.GetFromAddress())
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
+
 // GetCmdSubmitProposal implements the command to submit a community-pool-spend proposal
 func GetCmdSubmitProposal(cdc *codec.Codec) *cobra.Command {
 	cmd := &cobra.Command{
@@ -39,6 +39,12 @@ func registerTxRoutes(cliCtx context.CLIContext, r *mux.Router, queryRoute strin
 		withdrawValidatorRewardsHandlerFn(cliCtx),
 	).Methods("POST")
 
+	// Withdraw the rewards of the tokenize share records of an owner
+	r.HandleFunc(
+		"/distribution/delegators/{delegatorAddr}/tokenize_share_rewards",
+		withdrawTokenizeShareRecordRewardHandlerFn(cliCtx),
+	).Methods("POST")
+
 }
 
 type (
@@ -177,6 +183,36 @@ func withdrawValidatorRewardsHandlerFn(cliCtx context.CLIContext) http.HandlerFu
 	}
 }
 
+// Withdraw the rewards of the tokenize share records of an owner
+func withdrawTokenizeShareRecordRewardHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req withdrawRewardsReq
+
+		if !rest.ReadRESTReq(w, r, cliCtx.Codec, &req) {
+			return
+		}
+
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+
+		// read and validate URL's variables
+		ownerAddr, ok := checkDelegatorAddressVar(w, r)
+		if !ok {
+			return
+		}
+
+		msg := types.NewMsgWithdrawTokenizeShareRecordReward(ownerAddr)
+		if err := msg.ValidateBasic(); err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		utils.WriteGenerateStdTxResponse(w, cliCtx, req.BaseReq, []sdk.Msg{msg})
+	}
+}
+
 // Auxiliary
 
 func checkDelegatorAddressVar(w http.ResponseWriter, r *http.Request) (sdk.AccAddress, bool) {
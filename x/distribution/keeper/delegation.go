@@ -162,7 +162,7 @@ func (k Keeper) withdrawDelegationRewards(ctx sdk.Context, val exported.Validato
 
 	// add coins to user account
 	if !coins.IsZero() {
-		withdrawAddr := k.GetDelegatorWithdrawAddr(ctx, del.GetDelegatorAddr())
+		withdrawAddr := k.getDelegationRewardsRecipient(ctx, del.GetDelegatorAddr())
 		err := k.supplyKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, withdrawAddr, coins)
 		if err != nil {
 			return nil, err
@@ -186,3 +186,12 @@ func (k Keeper) withdrawDelegationRewards(ctx sdk.Context, val exported.Validato
 
 	return coins, nil
 }
+
+// get the address receiving the rewards of a delegator, the rewards of a
+// tokenized delegation go to the owner of its tokenize share record
+func (k Keeper) getDelegationRewardsRecipient(ctx sdk.Context, delAddr sdk.AccAddress) sdk.AccAddress {
+	if record, found := k.stakingKeeper.GetTokenizeShareRecordByDelegator(ctx, delAddr); found {
+		return k.GetDelegatorWithdrawAddr(ctx, record.Owner)
+	}
+	return k.GetDelegatorWithdrawAddr(ctx, delAddr)
+}
@@ -613,3 +613,83 @@ func TestCalculateRewardsMultiDelegatorMultWithdraw(t *testing.T) {
 	// commission should be zero
 	require.True(t, k.GetValidatorAccumulatedCommission(ctx, valOpAddr1).IsZero())
 }
+
+func TestWithdrawTokenizeShareRecordReward(t *testing.T) {
+	balancePower := int64(1000)
+	balanceTokens := sdk.TokensFromConsensusPower(balancePower)
+	ctx, ak, k, sk, _ := CreateTestInputDefault(t, false, balancePower)
+	sh := staking.NewHandler(sk)
+
+	// set module account coins
+	distrAcc := k.GetDistributionAccount(ctx)
+	distrAcc.SetCoins(sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, balanceTokens)))
+	k.supplyKeeper.SetModuleAccount(ctx, distrAcc)
+
+	// create validator with no commission
+	valTokens := sdk.TokensFromConsensusPower(100)
+	commission := staking.NewCommissionRates(sdk.ZeroDec(), sdk.ZeroDec(), sdk.ZeroDec())
+	msg := staking.NewMsgCreateValidator(
+		valOpAddr1, valConsPk1,
+		sdk.NewCoin(sdk.DefaultBondDenom, valTokens),
+		staking.Description{}, commission, sdk.OneInt(),
+	)
+	require.True(t, sh(ctx, msg).IsOK())
+
+	// end block to bond validator
+	staking.EndBlocker(ctx, sk)
+
+	// next block
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+
+	// delegate and tokenize the whole delegation
+	delTokens := sdk.TokensFromConsensusPower(100)
+	msgDelegate := staking.NewMsgDelegate(delAddr1, valOpAddr1, sdk.NewCoin(sdk.DefaultBondDenom, delTokens))
+	require.True(t, sh(ctx, msgDelegate).IsOK())
+	msgTokenize := staking.NewMsgTokenizeShares(delAddr1, valOpAddr1, sdk.NewCoin(sdk.DefaultBondDenom, delTokens))
+	got := sh(ctx, msgTokenize)
+	require.True(t, got.IsOK(), "%v", got)
+
+	// transfer the record, the share tokens stay with the delegator
+	require.True(t, sh(ctx, staking.NewMsgTransferTokenizeShareRecord(1, delAddr1, delAddr2)).IsOK())
+
+	// next block
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+
+	// allocate some rewards
+	val := sk.Validator(ctx, valOpAddr1)
+	initial := sdk.TokensFromConsensusPower(20)
+	k.AllocateTokensToValidator(ctx, val, sdk.DecCoins{sdk.NewDecCoin(sdk.DefaultBondDenom, initial)})
+
+	// the rewards accrued before a transfer go to the previous owner
+	require.True(t, sh(ctx, staking.NewMsgTransferTokenizeShareRecord(1, delAddr2, delAddr3)).IsOK())
+	require.Equal(t,
+		balanceTokens.Add(initial.QuoRaw(2)),
+		ak.GetAccount(ctx, delAddr2).GetCoins().AmountOf(sdk.DefaultBondDenom),
+	)
+
+	// next block
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+	k.AllocateTokensToValidator(ctx, val, sdk.DecCoins{sdk.NewDecCoin(sdk.DefaultBondDenom, initial)})
+
+	// only the record owner can withdraw the rewards
+	_, err := k.WithdrawTokenizeShareRecordReward(ctx, delAddr2)
+	require.NotNil(t, err)
+
+	rewards, err := k.WithdrawTokenizeShareRecordReward(ctx, delAddr3)
+	require.Nil(t, err)
+	require.Equal(t, sdk.Coins{sdk.NewCoin(sdk.DefaultBondDenom, initial.QuoRaw(2))}, rewards)
+
+	// assert correct balances
+	require.Equal(t,
+		balanceTokens.Add(initial.QuoRaw(2)),
+		ak.GetAccount(ctx, delAddr3).GetCoins().AmountOf(sdk.DefaultBondDenom),
+	)
+	require.Equal(t,
+		balanceTokens.Add(initial.QuoRaw(2)),
+		ak.GetAccount(ctx, delAddr2).GetCoins().AmountOf(sdk.DefaultBondDenom),
+	)
+	require.Equal(t,
+		balanceTokens.Sub(delTokens),
+		ak.GetAccount(ctx, delAddr1).GetCoins().AmountOf(sdk.DefaultBondDenom),
+	)
+}
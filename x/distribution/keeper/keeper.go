@@ -105,6 +105,33 @@ func (k Keeper) WithdrawDelegationRewards(ctx sdk.Context, delAddr sdk.AccAddres
 	return rewards, nil
 }
 
+// withdraw the rewards of all the tokenized delegations whose tokenize share
+// record is owned by an account
+func (k Keeper) WithdrawTokenizeShareRecordReward(ctx sdk.Context, ownerAddr sdk.AccAddress) (sdk.Coins, sdk.Error) {
+	records := k.stakingKeeper.GetTokenizeShareRecordsByOwner(ctx, ownerAddr)
+	if len(records) == 0 {
+		return nil, types.ErrNoTokenizeShareRecords(k.codespace)
+	}
+
+	totalRewards := sdk.Coins{}
+	for _, record := range records {
+		recordAddr := record.GetDelegatorAddress()
+
+		// the delegation of a record can be fully redeemed in the same block
+		if k.stakingKeeper.Delegation(ctx, recordAddr, record.Validator) == nil {
+			continue
+		}
+
+		rewards, err := k.WithdrawDelegationRewards(ctx, recordAddr, record.Validator)
+		if err != nil {
+			return nil, err
+		}
+		totalRewards = totalRewards.Add(rewards)
+	}
+
+	return totalRewards, nil
+}
+
 // withdraw validator commission
 func (k Keeper) WithdrawValidatorCommission(ctx sdk.Context, valAddr sdk.ValAddress) (sdk.Coins, sdk.Error) {
 	// fetch validator accumulated commission
@@ -45,3 +45,6 @@ func ErrInvalidProposalAmount(codespace sdk.CodespaceType) sdk.Error {
 func ErrEmptyProposalRecipient(codespace sdk.CodespaceType) sdk.Error {
 	return sdk.NewError(codespace, CodeInvalidInput, "invalid community pool spend proposal recipient")
 }
+func ErrNoTokenizeShareRecords(codespace sdk.CodespaceType) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidInput, "no tokenize share records owned by this address")
+}
@@ -43,6 +43,9 @@ type StakingKeeper interface {
 	GetLastValidatorPower(ctx sdk.Context, valAddr sdk.ValAddress) int64
 
 	GetAllSDKDelegations(ctx sdk.Context) []staking.Delegation
+
+	GetTokenizeShareRecordByDelegator(ctx sdk.Context, delAddr sdk.AccAddress) (staking.TokenizeShareRecord, bool)
+	GetTokenizeShareRecordsByOwner(ctx sdk.Context, owner sdk.AccAddress) staking.TokenizeShareRecords
 }
 
 // StakingHooks event hooks for staking validator object (noalias)
@@ -6,7 +6,8 @@ import (
 )
 
 // Verify interface at compile time
-var _, _, _ sdk.Msg = &MsgSetWithdrawAddress{}, &MsgWithdrawDelegatorReward{}, &MsgWithdrawValidatorCommission{}
+var _, _, _, _ sdk.Msg = &MsgSetWithdrawAddress{}, &MsgWithdrawDelegatorReward{}, &MsgWithdrawValidatorCommission{},
+	&MsgWithdrawTokenizeShareRecordReward{}
 
 // msg struct for changing the withdraw address for a delegator (or validator self-delegation)
 type MsgSetWithdrawAddress struct {
@@ -116,3 +117,39 @@ func (msg MsgWithdrawValidatorCommission) ValidateBasic() sdk.Error {
 	}
 	return nil
 }
+
+// msg struct for withdrawing the rewards of all the tokenize share records
+// owned by an account
+type MsgWithdrawTokenizeShareRecordReward struct {
+	OwnerAddress sdk.AccAddress `json:"owner_address" yaml:"owner_address"`
+}
+
+func NewMsgWithdrawTokenizeShareRecordReward(ownerAddr sdk.AccAddress) MsgWithdrawTokenizeShareRecordReward {
+	return MsgWithdrawTokenizeShareRecordReward{
+		OwnerAddress: ownerAddr,
+	}
+}
+
+func (msg MsgWithdrawTokenizeShareRecordReward) Route() string { return ModuleName }
+func (msg MsgWithdrawTokenizeShareRecordReward) Type() string {
+	return "withdraw_tokenize_share_record_reward"
+}
+
+// Return address that must sign over msg.GetSignBytes()
+func (msg MsgWithdrawTokenizeShareRecordReward) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.OwnerAddress}
+}
+
+// get the bytes for the message signer to sign on
+func (msg MsgWithdrawTokenizeShareRecordReward) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// quick validity check
+func (msg MsgWithdrawTokenizeShareRecordReward) ValidateBasic() sdk.Error {
+	if msg.OwnerAddress.Empty() {
+		return ErrNilDelegatorAddr(DefaultCodespace)
+	}
+	return nil
+}
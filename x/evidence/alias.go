@@ -0,0 +1,70 @@
+// nolint
+// autogenerated code using github.com/rigelrozanski/multitool
+// aliases generated for the following subdirectories:
+// ALIASGEN: github.com/cosmos/cosmos-sdk/x/evidence/internal/keeper
+// ALIASGEN: github.com/cosmos/cosmos-sdk/x/evidence/internal/types
+package evidence
+
+import (
+	"github.com/cosmos/cosmos-sdk/x/evidence/internal/keeper"
+	"github.com/cosmos/cosmos-sdk/x/evidence/internal/types"
+)
+
+const (
+	ModuleName                  = types.ModuleName
+	StoreKey                    = types.StoreKey
+	RouterKey                   = types.RouterKey
+	QuerierRoute                = types.QuerierRoute
+	DefaultCodespace            = types.DefaultCodespace
+	CodeNoEvidenceHandlerExists = types.CodeNoEvidenceHandlerExists
+	CodeInvalidEvidence         = types.CodeInvalidEvidence
+	CodeNoEvidenceExists        = types.CodeNoEvidenceExists
+	CodeEvidenceExists          = types.CodeEvidenceExists
+	EventTypeSubmitEvidence     = types.EventTypeSubmitEvidence
+	AttributeKeyEvidenceHash    = types.AttributeKeyEvidenceHash
+	AttributeValueCategory      = types.AttributeValueCategory
+	RouteEquivocation           = types.RouteEquivocation
+	TypeEquivocation            = types.TypeEquivocation
+	TypeMsgSubmitEvidence       = types.TypeMsgSubmitEvidence
+	QueryEvidence               = types.QueryEvidence
+	QueryAllEvidence            = types.QueryAllEvidence
+)
+
+var (
+	// functions aliases
+	NewKeeper                    = keeper.NewKeeper
+	NewQuerier                   = keeper.NewQuerier
+	RegisterCodec                = types.RegisterCodec
+	RegisterEvidenceTypeCodec    = types.RegisterEvidenceTypeCodec
+	ErrNoEvidenceHandlerExists   = types.ErrNoEvidenceHandlerExists
+	ErrInvalidEvidence           = types.ErrInvalidEvidence
+	ErrNoEvidenceExists          = types.ErrNoEvidenceExists
+	ErrEvidenceExists            = types.ErrEvidenceExists
+	NewEquivocation              = types.NewEquivocation
+	ConvertDuplicateVoteEvidence = types.ConvertDuplicateVoteEvidence
+	NewGenesisState              = types.NewGenesisState
+	DefaultGenesisState          = types.DefaultGenesisState
+	ValidateGenesis              = types.ValidateGenesis
+	EvidenceKey                  = types.EvidenceKey
+	NewMsgSubmitEvidence         = types.NewMsgSubmitEvidence
+	NewQueryEvidenceParams       = types.NewQueryEvidenceParams
+	NewRouter                    = types.NewRouter
+
+	// variable aliases
+	ModuleCdc             = types.ModuleCdc
+	KeyPrefixEvidence     = types.KeyPrefixEvidence
+	DoubleSignJailEndTime = types.DoubleSignJailEndTime
+)
+
+type (
+	Keeper              = keeper.Keeper
+	Equivocation        = types.Equivocation
+	EvidenceList        = types.EvidenceList
+	GenesisState        = types.GenesisState
+	Handler             = types.Handler
+	MsgSubmitEvidence   = types.MsgSubmitEvidence
+	QueryEvidenceParams = types.QueryEvidenceParams
+	Router              = types.Router
+	SlashingKeeper      = types.SlashingKeeper
+	StakingKeeper       = types.StakingKeeper
+)
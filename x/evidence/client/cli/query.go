@@ -0,0 +1,100 @@
+package cli
+
+import (
+	"encoding/hex"
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/x/evidence/exported"
+	"github.com/cosmos/cosmos-sdk/x/evidence/internal/types"
+)
+
+// GetQueryCmd returns the cli query commands for the evidence module.
+func GetQueryCmd(cdc *codec.Codec) *cobra.Command {
+	evidenceQueryCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Querying commands for the evidence module",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	evidenceQueryCmd.AddCommand(
+		client.GetCommands(
+			GetCmdQueryEvidence(cdc),
+			GetCmdQueryAllEvidence(cdc),
+		)...,
+	)
+
+	return evidenceQueryCmd
+}
+
+// GetCmdQueryEvidence implements the command to query evidence by hash.
+func GetCmdQueryEvidence(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "evidence [hash]",
+		Short: "Query submitted evidence by its hash",
+		Long: `Query the evidence stored under the given hex encoded hash.
+
+Example:
+$ <appcli> query evidence evidence DF0C23E8634E480F84B9D5674A7CDC9816466DEC28A3358F73260F68D28D7660
+`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			hash, err := hex.DecodeString(args[0])
+			if err != nil {
+				return fmt.Errorf("invalid evidence hash: %s", err)
+			}
+
+			bz, err := cdc.MarshalJSON(types.NewQueryEvidenceParams(hash))
+			if err != nil {
+				return err
+			}
+
+			route := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryEvidence)
+			res, _, err := cliCtx.QueryWithData(route, bz)
+			if err != nil {
+				return err
+			}
+
+			var evidence exported.Evidence
+			if err := cdc.UnmarshalJSON(res, &evidence); err != nil {
+				return err
+			}
+
+			return cliCtx.PrintOutput(evidence)
+		},
+	}
+}
+
+// GetCmdQueryAllEvidence implements the command to query all the stored
+// evidence.
+func GetCmdQueryAllEvidence(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "all",
+		Short: "Query all the submitted evidence",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			route := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryAllEvidence)
+			res, _, err := cliCtx.QueryWithData(route, nil)
+			if err != nil {
+				return err
+			}
+
+			var evidence types.EvidenceList
+			if err := cdc.UnmarshalJSON(res, &evidence); err != nil {
+				return err
+			}
+
+			return cliCtx.PrintOutput(evidence)
+		},
+	}
+}
@@ -0,0 +1,79 @@
+package cli
+
+import (
+	"io/ioutil"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/cosmos/cosmos-sdk/x/auth/client/utils"
+	"github.com/cosmos/cosmos-sdk/x/evidence/exported"
+	"github.com/cosmos/cosmos-sdk/x/evidence/internal/types"
+)
+
+// GetTxCmd returns the transaction commands for this module
+func GetTxCmd(cdc *codec.Codec) *cobra.Command {
+	evidenceTxCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Evidence transaction subcommands",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	evidenceTxCmd.AddCommand(client.PostCommands(
+		GetCmdSubmitEvidence(cdc),
+	)...)
+
+	return evidenceTxCmd
+}
+
+// GetCmdSubmitEvidence implements the command to submit evidence of
+// misbehaviour.
+func GetCmdSubmitEvidence(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "submit [evidence-file]",
+		Short: "Submit evidence of misbehaviour",
+		Long: `Submit evidence of misbehaviour read from a JSON file. The evidence must be one of
+the evidence types registered by the application, encoded as Amino JSON.
+
+Example:
+$ <appcli> tx evidence submit path/to/evidence.json --from mykey
+
+Where evidence.json contains:
+
+{
+  "type": "<evidence type name>",
+  "value": {
+    ...
+  }
+}
+`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			txBldr := auth.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			bz, err := ioutil.ReadFile(args[0])
+			if err != nil {
+				return err
+			}
+
+			var evidence exported.Evidence
+			if err := cdc.UnmarshalJSON(bz, &evidence); err != nil {
+				return err
+			}
+
+			msg := types.NewMsgSubmitEvidence(evidence, cliCtx.GetFromAddress())
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
@@ -0,0 +1,29 @@
+/*
+Package evidence handles the submission and storage of evidence of
+misbehaviour, such as a validator signing two conflicting blocks at the same
+height.
+
+Concrete evidence types implement the Evidence interface and are handled by a
+Handler registered on the evidence Router under the evidence's route. The
+router is given to the keeper at construction time and sealed, so all the
+evidence types of an application must be known up front:
+
+	evidenceRouter := evidence.NewRouter().
+		AddRoute(mytypes.RouteMyEvidence, mytypes.NewMyEvidenceHandler(...))
+
+Evidence types defined outside of this module must be registered on the
+evidence module codec with RegisterEvidenceTypeCodec so that
+MsgSubmitEvidence can be correctly encoded and decoded.
+
+Anyone, eg. a light client or a watcher, may submit evidence with a
+MsgSubmitEvidence. The evidence is routed to its handler which verifies it
+and punishes the offender; once handled, the evidence is stored by hash and
+can be queried and is exported in genesis. The same evidence cannot be
+submitted twice.
+
+Duplicate vote evidence reported by Tendermint in BeginBlock is converted to
+an Equivocation and handled by the keeper directly: the validator is slashed,
+jailed and tombstoned through the slashing module, unless the evidence is
+older than the slashing module's MaxEvidenceAge parameter.
+*/
+package evidence
@@ -0,0 +1,213 @@
+package evidence_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/crypto"
+	"github.com/tendermint/tendermint/crypto/ed25519"
+	tmtypes "github.com/tendermint/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/evidence"
+	"github.com/cosmos/cosmos-sdk/x/evidence/exported"
+	"github.com/cosmos/cosmos-sdk/x/staking"
+	"github.com/cosmos/cosmos-sdk/x/supply"
+)
+
+var (
+	valPubKey   = ed25519.GenPrivKey().PubKey()
+	operator    = sdk.ValAddress(valPubKey.Address())
+	submitter   = sdk.AccAddress("submitter___________")
+	initTokens  = sdk.TokensFromConsensusPower(200)
+	bondedPower = int64(100)
+)
+
+func createTestApp(t *testing.T) (*simapp.SimApp, sdk.Context) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, abci.Header{Height: 1, Time: time.Unix(0, 0)})
+
+	acc := app.AccountKeeper.NewAccountWithAddress(ctx, sdk.AccAddress(operator))
+	require.NoError(t, acc.SetCoins(sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, initTokens))))
+	app.AccountKeeper.SetAccount(ctx, acc)
+	app.SupplyKeeper.SetSupply(ctx, supply.NewSupply(acc.GetCoins()))
+
+	return app, ctx
+}
+
+// createValidator bonds a validator so that the slashing module tracks its
+// signing info.
+func createValidator(t *testing.T, app *simapp.SimApp, ctx sdk.Context, pubKey crypto.PubKey) {
+	commission := staking.NewCommissionRates(sdk.ZeroDec(), sdk.ZeroDec(), sdk.ZeroDec())
+	msg := staking.NewMsgCreateValidator(
+		sdk.ValAddress(pubKey.Address()), pubKey,
+		sdk.NewCoin(sdk.DefaultBondDenom, sdk.TokensFromConsensusPower(bondedPower)),
+		staking.Description{}, commission, sdk.OneInt(),
+	)
+
+	res := staking.NewHandler(app.StakingKeeper)(ctx, msg)
+	require.True(t, res.IsOK(), res.Log)
+
+	staking.EndBlocker(ctx, app.StakingKeeper)
+}
+
+func duplicateVoteRequest(pubKey crypto.PubKey, t time.Time) abci.RequestBeginBlock {
+	return abci.RequestBeginBlock{
+		ByzantineValidators: []abci.Evidence{
+			{
+				Type:      tmtypes.ABCIEvidenceTypeDuplicateVote,
+				Validator: abci.Validator{Address: pubKey.Address(), Power: bondedPower},
+				Height:    1,
+				Time:      t,
+			},
+		},
+	}
+}
+
+func TestHandleDoubleSign(t *testing.T) {
+	app, ctx := createTestApp(t)
+	createValidator(t, app, ctx, valPubKey)
+	consAddr := sdk.ConsAddress(valPubKey.Address())
+
+	oldTokens := app.StakingKeeper.Validator(ctx, operator).GetTokens()
+
+	// double sign less than max age
+	evidence.BeginBlocker(ctx, duplicateVoteRequest(valPubKey, time.Unix(0, 0)), app.EvidenceKeeper)
+
+	// should be jailed and tombstoned
+	require.True(t, app.StakingKeeper.Validator(ctx, operator).IsJailed())
+	require.True(t, app.SlashingKeeper.IsTombstoned(ctx, consAddr))
+
+	// tokens should be decreased
+	newTokens := app.StakingKeeper.Validator(ctx, operator).GetTokens()
+	require.True(t, newTokens.LT(oldTokens))
+
+	// the evidence is stored
+	equivocation := evidence.NewEquivocation(1, time.Unix(0, 0).UTC(), bondedPower, consAddr)
+	stored, ok := app.EvidenceKeeper.GetEvidence(ctx, equivocation.Hash())
+	require.True(t, ok)
+	require.Equal(t, equivocation, stored)
+
+	// new evidence is ignored: tokens should be the same (capped slash)
+	evidence.BeginBlocker(ctx, duplicateVoteRequest(valPubKey, time.Unix(0, 1)), app.EvidenceKeeper)
+	require.True(t, app.StakingKeeper.Validator(ctx, operator).GetTokens().Equal(newTokens))
+	require.Len(t, app.EvidenceKeeper.GetAllEvidence(ctx), 1)
+
+	// jump to past the unbonding period: still shouldn't be able to unjail
+	ctx = ctx.WithBlockHeader(abci.Header{Time: time.Unix(1, 0).Add(app.StakingKeeper.GetParams(ctx).UnbondingTime)})
+	require.Error(t, app.SlashingKeeper.Unjail(ctx, operator))
+}
+
+func TestHandleDoubleSignPastMaxEvidenceAge(t *testing.T) {
+	app, ctx := createTestApp(t)
+	createValidator(t, app, ctx, valPubKey)
+
+	ctx = ctx.WithBlockHeader(abci.Header{Time: time.Unix(1, 0).Add(app.SlashingKeeper.MaxEvidenceAge(ctx))})
+	oldPower := app.StakingKeeper.Validator(ctx, operator).GetConsensusPower()
+
+	// double sign past max age
+	evidence.BeginBlocker(ctx, duplicateVoteRequest(valPubKey, time.Unix(0, 0)), app.EvidenceKeeper)
+
+	// should still be bonded with the same power
+	require.True(t, app.StakingKeeper.Validator(ctx, operator).IsBonded())
+	require.Equal(t, oldPower, app.StakingKeeper.Validator(ctx, operator).GetConsensusPower())
+	require.Empty(t, app.EvidenceKeeper.GetAllEvidence(ctx))
+}
+
+func TestSubmitEvidence(t *testing.T) {
+	app, ctx := createTestApp(t)
+
+	equivocation := evidence.NewEquivocation(1, time.Unix(0, 0).UTC(), bondedPower, sdk.ConsAddress(valPubKey.Address()))
+	msg := evidence.NewMsgSubmitEvidence(equivocation, submitter)
+	require.NoError(t, msg.ValidateBasic())
+
+	// the simulation app doesn't register a handler for equivocations
+	res := evidence.NewHandler(app.EvidenceKeeper)(ctx, msg)
+	require.Equal(t, evidence.CodeNoEvidenceHandlerExists, res.Code)
+
+	router := evidence.NewRouter().AddRoute(evidence.RouteEquivocation, func(ctx sdk.Context, e exported.Evidence) sdk.Error {
+		if e.(evidence.Equivocation).Power > bondedPower {
+			return evidence.ErrInvalidEvidence(evidence.DefaultCodespace, "power too high")
+		}
+		return nil
+	})
+	keeper := evidence.NewKeeper(
+		app.Codec(), app.GetKey(evidence.StoreKey), app.StakingKeeper, app.SlashingKeeper,
+		evidence.DefaultCodespace, router,
+	)
+	handler := evidence.NewHandler(keeper)
+
+	// the handler rejects the evidence
+	invalid := evidence.NewEquivocation(1, time.Unix(0, 0).UTC(), bondedPower+1, sdk.ConsAddress(valPubKey.Address()))
+	res = handler(ctx, evidence.NewMsgSubmitEvidence(invalid, submitter))
+	require.Equal(t, evidence.CodeInvalidEvidence, res.Code)
+	_, ok := keeper.GetEvidence(ctx, invalid.Hash())
+	require.False(t, ok)
+
+	res = handler(ctx, msg)
+	require.True(t, res.IsOK(), res.Log)
+	require.Equal(t, []byte(equivocation.Hash()), res.Data)
+
+	stored, ok := keeper.GetEvidence(ctx, equivocation.Hash())
+	require.True(t, ok)
+	require.Equal(t, equivocation, stored)
+
+	// the same evidence can't be submitted twice
+	res = handler(ctx, msg)
+	require.Equal(t, evidence.CodeEvidenceExists, res.Code)
+}
+
+func TestQuerier(t *testing.T) {
+	app, ctx := createTestApp(t)
+	querier := evidence.NewQuerier(app.EvidenceKeeper)
+
+	equivocation := evidence.NewEquivocation(1, time.Unix(0, 0).UTC(), bondedPower, sdk.ConsAddress(valPubKey.Address()))
+	params := app.Codec().MustMarshalJSON(evidence.NewQueryEvidenceParams(equivocation.Hash()))
+
+	_, err := querier(ctx, []string{evidence.QueryEvidence}, abci.RequestQuery{Data: params})
+	require.Error(t, err)
+	require.Equal(t, evidence.CodeNoEvidenceExists, err.Code())
+
+	app.EvidenceKeeper.SetEvidence(ctx, equivocation)
+
+	bz, err := querier(ctx, []string{evidence.QueryEvidence}, abci.RequestQuery{Data: params})
+	require.NoError(t, err)
+	var stored exported.Evidence
+	app.Codec().MustUnmarshalJSON(bz, &stored)
+	require.Equal(t, equivocation, stored)
+
+	bz, err = querier(ctx, []string{evidence.QueryAllEvidence}, abci.RequestQuery{})
+	require.NoError(t, err)
+	var all evidence.EvidenceList
+	app.Codec().MustUnmarshalJSON(bz, &all)
+	require.Equal(t, evidence.EvidenceList{equivocation}, all)
+}
+
+func TestExportGenesis(t *testing.T) {
+	app, ctx := createTestApp(t)
+
+	equivocations := []exported.Evidence{
+		evidence.NewEquivocation(1, time.Unix(0, 0).UTC(), bondedPower, sdk.ConsAddress(valPubKey.Address())),
+		evidence.NewEquivocation(2, time.Unix(0, 0).UTC(), bondedPower, sdk.ConsAddress(valPubKey.Address())),
+	}
+	for _, e := range equivocations {
+		app.EvidenceKeeper.SetEvidence(ctx, e)
+	}
+
+	genesis := evidence.ExportGenesis(ctx, app.EvidenceKeeper)
+	require.NoError(t, evidence.ValidateGenesis(genesis))
+	require.ElementsMatch(t, equivocations, genesis.Evidence)
+
+	// the genesis state survives a JSON round trip
+	var imported evidence.GenesisState
+	evidence.ModuleCdc.MustUnmarshalJSON(evidence.ModuleCdc.MustMarshalJSON(genesis), &imported)
+
+	app2, ctx2 := createTestApp(t)
+	evidence.InitGenesis(ctx2, app2.EvidenceKeeper, imported)
+	require.ElementsMatch(t, equivocations, app2.EvidenceKeeper.GetAllEvidence(ctx2))
+
+	require.Panics(t, func() { evidence.InitGenesis(ctx2, app2.EvidenceKeeper, imported) })
+}
@@ -0,0 +1,24 @@
+package evidence
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// InitGenesis stores the evidence of a *previously validated* GenesisState.
+// It panics if the same evidence is stored twice.
+func InitGenesis(ctx sdk.Context, k Keeper, data GenesisState) {
+	for _, e := range data.Evidence {
+		if _, ok := k.GetEvidence(ctx, e.Hash()); ok {
+			panic(fmt.Sprintf("evidence with hash %s already exists", e.Hash()))
+		}
+
+		k.SetEvidence(ctx, e)
+	}
+}
+
+// ExportGenesis returns a GenesisState with all the stored evidence.
+func ExportGenesis(ctx sdk.Context, k Keeper) GenesisState {
+	return NewGenesisState(k.GetAllEvidence(ctx))
+}
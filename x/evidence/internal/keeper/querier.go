@@ -0,0 +1,58 @@
+package keeper
+
+import (
+	"fmt"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/evidence/internal/types"
+)
+
+// NewQuerier creates a new querier for the evidence module
+func NewQuerier(k Keeper) sdk.Querier {
+	return func(ctx sdk.Context, path []string, req abci.RequestQuery) ([]byte, sdk.Error) {
+		switch path[0] {
+		case types.QueryEvidence:
+			return queryEvidence(ctx, req, k)
+
+		case types.QueryAllEvidence:
+			return queryAllEvidence(ctx, k)
+
+		default:
+			return nil, sdk.ErrUnknownRequest(fmt.Sprintf("unknown evidence query endpoint: %s", path[0]))
+		}
+	}
+}
+
+// queryEvidence returns the evidence stored under the hash given in the
+// query parameters
+func queryEvidence(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, sdk.Error) {
+	var params types.QueryEvidenceParams
+	if err := k.cdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdk.ErrUnknownRequest(sdk.AppendMsgToErr("incorrectly formatted request data", err.Error()))
+	}
+
+	evidence, ok := k.GetEvidence(ctx, params.EvidenceHash)
+	if !ok {
+		return nil, types.ErrNoEvidenceExists(k.codespace, params.EvidenceHash)
+	}
+
+	bz, err := codec.MarshalJSONIndent(k.cdc, evidence)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("failed to marshal JSON", err.Error()))
+	}
+
+	return bz, nil
+}
+
+// queryAllEvidence returns all the stored evidence
+func queryAllEvidence(ctx sdk.Context, k Keeper) ([]byte, sdk.Error) {
+	bz, err := codec.MarshalJSONIndent(k.cdc, k.GetAllEvidence(ctx))
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("failed to marshal JSON", err.Error()))
+	}
+
+	return bz, nil
+}
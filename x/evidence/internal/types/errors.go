@@ -0,0 +1,42 @@
+package types
+
+// DONTCOVER
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	cmn "github.com/tendermint/tendermint/libs/common"
+)
+
+// Codes for evidence errors
+const (
+	DefaultCodespace sdk.CodespaceType = ModuleName
+
+	CodeNoEvidenceHandlerExists sdk.CodeType = 1
+	CodeInvalidEvidence         sdk.CodeType = 2
+	CodeNoEvidenceExists        sdk.CodeType = 3
+	CodeEvidenceExists          sdk.CodeType = 4
+)
+
+// ErrNoEvidenceHandlerExists error if no handler is registered for the route
+// of the evidence
+func ErrNoEvidenceHandlerExists(codespace sdk.CodespaceType, route string) sdk.Error {
+	return sdk.NewError(codespace, CodeNoEvidenceHandlerExists, fmt.Sprintf("route '%s' does not have a registered evidence handler", route))
+}
+
+// ErrInvalidEvidence error for evidence that failed validation
+func ErrInvalidEvidence(codespace sdk.CodespaceType, msg string) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidEvidence, fmt.Sprintf("invalid evidence: %s", msg))
+}
+
+// ErrNoEvidenceExists error if no evidence is stored under the given hash
+func ErrNoEvidenceExists(codespace sdk.CodespaceType, hash cmn.HexBytes) sdk.Error {
+	return sdk.NewError(codespace, CodeNoEvidenceExists, fmt.Sprintf("evidence with hash %s does not exist", hash))
+}
+
+// ErrEvidenceExists error if the evidence has already been submitted
+func ErrEvidenceExists(codespace sdk.CodespaceType, hash cmn.HexBytes) sdk.Error {
+	return sdk.NewError(codespace, CodeEvidenceExists, fmt.Sprintf("evidence with hash %s already exists", hash))
+}
@@ -0,0 +1,10 @@
+package types
+
+// evidence module event types
+const (
+	EventTypeSubmitEvidence = "submit_evidence"
+
+	AttributeKeyEvidenceHash = "evidence_hash"
+
+	AttributeValueCategory = ModuleName
+)
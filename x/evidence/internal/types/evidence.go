@@ -0,0 +1,131 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"gopkg.in/yaml.v2"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/crypto/tmhash"
+	cmn "github.com/tendermint/tendermint/libs/common"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/evidence/exported"
+)
+
+// Evidence type constants
+const (
+	RouteEquivocation = "equivocation"
+	TypeEquivocation  = "equivocation"
+)
+
+// DoubleSignJailEndTime is the time a validator that double signed is jailed
+// until, ie. forever.
+var DoubleSignJailEndTime = time.Unix(253402300799, 0)
+
+var _ exported.ValidatorEvidence = Equivocation{}
+
+// Equivocation implements the Evidence interface and defines evidence of a
+// validator signing two conflicting votes at the same height (double signing).
+type Equivocation struct {
+	Height           int64           `json:"height" yaml:"height"`
+	Time             time.Time       `json:"time" yaml:"time"`
+	Power            int64           `json:"power" yaml:"power"`
+	ConsensusAddress sdk.ConsAddress `json:"consensus_address" yaml:"consensus_address"`
+}
+
+// NewEquivocation creates a new Equivocation instance
+func NewEquivocation(height int64, t time.Time, power int64, consAddr sdk.ConsAddress) Equivocation {
+	return Equivocation{
+		Height:           height,
+		Time:             t,
+		Power:            power,
+		ConsensusAddress: consAddr,
+	}
+}
+
+// ConvertDuplicateVoteEvidence converts a Tendermint duplicate vote ABCI
+// evidence into an Equivocation.
+func ConvertDuplicateVoteEvidence(evidence abci.Evidence) Equivocation {
+	return NewEquivocation(
+		evidence.Height, evidence.Time, evidence.Validator.Power,
+		sdk.ConsAddress(evidence.Validator.Address),
+	)
+}
+
+// Route returns the Evidence Handler route for an Equivocation type.
+func (e Equivocation) Route() string { return RouteEquivocation }
+
+// Type returns the Evidence Handler type for an Equivocation type.
+func (e Equivocation) Type() string { return TypeEquivocation }
+
+func (e Equivocation) String() string {
+	bz, _ := yaml.Marshal(e)
+	return string(bz)
+}
+
+// Hash returns the hash of an Equivocation object.
+func (e Equivocation) Hash() cmn.HexBytes {
+	return tmhash.Sum(ModuleCdc.MustMarshalBinaryBare(e))
+}
+
+// ValidateBasic performs basic stateless validation checks on an Equivocation object.
+func (e Equivocation) ValidateBasic() sdk.Error {
+	if e.Time.IsZero() {
+		return ErrInvalidEvidence(DefaultCodespace, "invalid equivocation time")
+	}
+	if e.Height < 1 {
+		return ErrInvalidEvidence(DefaultCodespace, fmt.Sprintf("invalid equivocation height: %d", e.Height))
+	}
+	if e.Power < 1 {
+		return ErrInvalidEvidence(DefaultCodespace, fmt.Sprintf("invalid equivocation validator power: %d", e.Power))
+	}
+	if e.ConsensusAddress.Empty() {
+		return ErrInvalidEvidence(DefaultCodespace, "invalid equivocation validator consensus address")
+	}
+
+	return nil
+}
+
+// GetHeight returns the height at time of the Equivocation infraction.
+func (e Equivocation) GetHeight() int64 {
+	return e.Height
+}
+
+// GetTime returns the time at time of the Equivocation infraction.
+func (e Equivocation) GetTime() time.Time {
+	return e.Time
+}
+
+// GetConsensusAddress returns the validator's consensus address at time of the
+// Equivocation infraction.
+func (e Equivocation) GetConsensusAddress() sdk.ConsAddress {
+	return e.ConsensusAddress
+}
+
+// GetValidatorPower returns the validator's power at time of the Equivocation
+// infraction.
+func (e Equivocation) GetValidatorPower() int64 {
+	return e.Power
+}
+
+// GetTotalPower is a no-op for the Equivocation type.
+func (e Equivocation) GetTotalPower() int64 { return 0 }
+
+// EvidenceList defines a list of Evidence objects.
+type EvidenceList []exported.Evidence
+
+func (el EvidenceList) String() string {
+	if len(el) == 0 {
+		return "[]"
+	}
+
+	out := make([]string, len(el))
+	for i, e := range el {
+		out[i] = e.String()
+	}
+
+	return strings.TrimSpace(strings.Join(out, "\n"))
+}
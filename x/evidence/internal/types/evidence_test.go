@@ -0,0 +1,75 @@
+package types
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/evidence/exported"
+)
+
+var consAddr = sdk.ConsAddress("consensus_address___")
+
+func TestEquivocationValidateBasic(t *testing.T) {
+	now := time.Now().UTC()
+
+	cases := map[string]struct {
+		evidence Equivocation
+		valid    bool
+	}{
+		"valid":         {NewEquivocation(10, now, 100, consAddr), true},
+		"zero time":     {NewEquivocation(10, time.Time{}, 100, consAddr), false},
+		"zero height":   {NewEquivocation(0, now, 100, consAddr), false},
+		"zero power":    {NewEquivocation(10, now, 0, consAddr), false},
+		"empty address": {NewEquivocation(10, now, 100, nil), false},
+	}
+
+	for name, tc := range cases {
+		err := tc.evidence.ValidateBasic()
+		if tc.valid {
+			require.NoError(t, err, name)
+		} else {
+			require.Error(t, err, name)
+			require.Equal(t, CodeInvalidEvidence, err.Code(), name)
+		}
+	}
+}
+
+func TestEquivocationHash(t *testing.T) {
+	now := time.Now().UTC()
+	e := NewEquivocation(10, now, 100, consAddr)
+
+	require.Equal(t, e.Hash(), NewEquivocation(10, now, 100, consAddr).Hash())
+	require.NotEqual(t, e.Hash(), NewEquivocation(11, now, 100, consAddr).Hash())
+}
+
+func TestMsgSubmitEvidence(t *testing.T) {
+	submitter := sdk.AccAddress("submitter___________")
+	e := NewEquivocation(10, time.Now().UTC(), 100, consAddr)
+
+	require.NoError(t, NewMsgSubmitEvidence(e, submitter).ValidateBasic())
+	require.Error(t, NewMsgSubmitEvidence(nil, submitter).ValidateBasic())
+	require.Error(t, NewMsgSubmitEvidence(NewEquivocation(0, time.Now(), 100, consAddr), submitter).ValidateBasic())
+	require.Error(t, NewMsgSubmitEvidence(e, nil).ValidateBasic())
+
+	msg := NewMsgSubmitEvidence(e, submitter)
+	require.Equal(t, []sdk.AccAddress{submitter}, msg.GetSigners())
+	require.NotPanics(t, func() { msg.GetSignBytes() })
+}
+
+func TestRouter(t *testing.T) {
+	handler := func(sdk.Context, exported.Evidence) sdk.Error { return nil }
+
+	rtr := NewRouter().AddRoute(RouteEquivocation, handler)
+	require.True(t, rtr.HasRoute(RouteEquivocation))
+	require.False(t, rtr.HasRoute("other"))
+	require.Panics(t, func() { rtr.GetRoute("other") })
+	require.Panics(t, func() { rtr.AddRoute(RouteEquivocation, handler) })
+	require.Panics(t, func() { rtr.AddRoute("not/alphanumeric", handler) })
+
+	rtr.Seal()
+	require.Panics(t, func() { rtr.AddRoute("other", handler) })
+	require.Panics(t, func() { rtr.Seal() })
+}
@@ -0,0 +1,29 @@
+package types
+
+import (
+	cmn "github.com/tendermint/tendermint/libs/common"
+)
+
+const (
+	// ModuleName is the module name constant used in many places
+	ModuleName = "evidence"
+
+	// StoreKey is the store key string for the evidence module
+	StoreKey = ModuleName
+
+	// RouterKey is the message route for the evidence module
+	RouterKey = ModuleName
+
+	// QuerierRoute is the querier route for the evidence module
+	QuerierRoute = ModuleName
+)
+
+var (
+	// KeyPrefixEvidence is the prefix of the kvstore for submitted evidence
+	KeyPrefixEvidence = []byte{0x01}
+)
+
+// EvidenceKey is the key to store evidence under its hash
+func EvidenceKey(hash cmn.HexBytes) []byte {
+	return append(KeyPrefixEvidence, hash...)
+}
@@ -0,0 +1,57 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/evidence/exported"
+)
+
+// Message types for the evidence module
+const (
+	TypeMsgSubmitEvidence = "submit_evidence"
+)
+
+var _ sdk.Msg = MsgSubmitEvidence{}
+
+// MsgSubmitEvidence defines an sdk.Msg type that supports submitting arbitrary
+// Evidence, eg. misbehaviour observed by a light client or a watcher.
+type MsgSubmitEvidence struct {
+	Evidence  exported.Evidence `json:"evidence" yaml:"evidence"`
+	Submitter sdk.AccAddress    `json:"submitter" yaml:"submitter"`
+}
+
+// NewMsgSubmitEvidence returns a new MsgSubmitEvidence.
+func NewMsgSubmitEvidence(evidence exported.Evidence, submitter sdk.AccAddress) MsgSubmitEvidence {
+	return MsgSubmitEvidence{Evidence: evidence, Submitter: submitter}
+}
+
+// Route returns the MsgSubmitEvidence's route.
+func (m MsgSubmitEvidence) Route() string { return RouterKey }
+
+// Type returns the MsgSubmitEvidence's type.
+func (m MsgSubmitEvidence) Type() string { return TypeMsgSubmitEvidence }
+
+// ValidateBasic performs basic (non-state-dependant) validation on a MsgSubmitEvidence.
+func (m MsgSubmitEvidence) ValidateBasic() sdk.Error {
+	if m.Evidence == nil {
+		return ErrInvalidEvidence(DefaultCodespace, "missing evidence")
+	}
+	if err := m.Evidence.ValidateBasic(); err != nil {
+		return err
+	}
+	if m.Submitter.Empty() {
+		return sdk.ErrInvalidAddress(m.Submitter.String())
+	}
+
+	return nil
+}
+
+// GetSignBytes returns the raw bytes a MsgSubmitEvidence message must be
+// signed over.
+func (m MsgSubmitEvidence) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(m))
+}
+
+// GetSigners returns the single expected signer for a MsgSubmitEvidence.
+func (m MsgSubmitEvidence) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{m.Submitter}
+}
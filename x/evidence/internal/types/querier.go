@@ -0,0 +1,22 @@
+package types
+
+import (
+	cmn "github.com/tendermint/tendermint/libs/common"
+)
+
+// querier keys
+const (
+	QueryEvidence    = "evidence"
+	QueryAllEvidence = "all_evidence"
+)
+
+// QueryEvidenceParams defines the parameters necessary for querying evidence
+// by its hash.
+type QueryEvidenceParams struct {
+	EvidenceHash cmn.HexBytes `json:"evidence_hash" yaml:"evidence_hash"`
+}
+
+// NewQueryEvidenceParams creates a new QueryEvidenceParams instance
+func NewQueryEvidenceParams(hash cmn.HexBytes) QueryEvidenceParams {
+	return QueryEvidenceParams{EvidenceHash: hash}
+}
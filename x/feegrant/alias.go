@@ -0,0 +1,73 @@
+// nolint
+// autogenerated code using github.com/rigelrozanski/multitool
+// aliases generated for the following subdirectories:
+// ALIASGEN: github.com/cosmos/cosmos-sdk/x/feegrant/internal/keeper
+// ALIASGEN: github.com/cosmos/cosmos-sdk/x/feegrant/internal/types
+package feegrant
+
+import (
+	"github.com/cosmos/cosmos-sdk/x/feegrant/internal/keeper"
+	"github.com/cosmos/cosmos-sdk/x/feegrant/internal/types"
+)
+
+const (
+	DefaultCodespace        = types.DefaultCodespace
+	CodeFeeLimitExceeded    = types.CodeFeeLimitExceeded
+	CodeFeeLimitExpired     = types.CodeFeeLimitExpired
+	CodeInvalidDuration     = types.CodeInvalidDuration
+	CodeNoAllowance         = types.CodeNoAllowance
+	CodeInvalidAllowance    = types.CodeInvalidAllowance
+	EventTypeUseFeeGrant    = types.EventTypeUseFeeGrant
+	EventTypeRevokeFeeGrant = types.EventTypeRevokeFeeGrant
+	EventTypeSetFeeGrant    = types.EventTypeSetFeeGrant
+	AttributeKeyGranter     = types.AttributeKeyGranter
+	AttributeKeyGrantee     = types.AttributeKeyGrantee
+	AttributeValueCategory  = types.AttributeValueCategory
+	ModuleName              = types.ModuleName
+	StoreKey                = types.StoreKey
+	RouterKey               = types.RouterKey
+	QuerierRoute            = types.QuerierRoute
+	QueryGetFeeAllowances   = types.QueryGetFeeAllowances
+)
+
+var (
+	// functions aliases
+	NewKeeper                   = keeper.NewKeeper
+	NewQuerier                  = keeper.NewQuerier
+	RegisterCodec               = types.RegisterCodec
+	ErrFeeLimitExceeded         = types.ErrFeeLimitExceeded
+	ErrFeeLimitExpired          = types.ErrFeeLimitExpired
+	ErrInvalidDuration          = types.ErrInvalidDuration
+	ErrNoAllowance              = types.ErrNoAllowance
+	ErrInvalidAllowance         = types.ErrInvalidAllowance
+	ExpiresAtTime               = types.ExpiresAtTime
+	ExpiresAtHeight             = types.ExpiresAtHeight
+	ClockDuration               = types.ClockDuration
+	BlockDuration               = types.BlockDuration
+	NewGenesisState             = types.NewGenesisState
+	DefaultGenesisState         = types.DefaultGenesisState
+	ValidateGenesis             = types.ValidateGenesis
+	NewFeeAllowanceGrant        = types.NewFeeAllowanceGrant
+	FeeAllowanceKey             = types.FeeAllowanceKey
+	FeeAllowancePrefixByGrantee = types.FeeAllowancePrefixByGrantee
+	NewMsgGrantFeeAllowance     = types.NewMsgGrantFeeAllowance
+	NewMsgRevokeFeeAllowance    = types.NewMsgRevokeFeeAllowance
+
+	// variable aliases
+	ModuleCdc             = types.ModuleCdc
+	FeeAllowanceKeyPrefix = types.FeeAllowanceKeyPrefix
+)
+
+type (
+	Keeper                = keeper.Keeper
+	BasicFeeAllowance     = types.BasicFeeAllowance
+	ExpiresAt             = types.ExpiresAt
+	Duration              = types.Duration
+	FeeAllowance          = types.FeeAllowance
+	GenesisState          = types.GenesisState
+	FeeAllowanceGrant     = types.FeeAllowanceGrant
+	FeeAllowanceGrants    = types.FeeAllowanceGrants
+	MsgGrantFeeAllowance  = types.MsgGrantFeeAllowance
+	MsgRevokeFeeAllowance = types.MsgRevokeFeeAllowance
+	PeriodicFeeAllowance  = types.PeriodicFeeAllowance
+)
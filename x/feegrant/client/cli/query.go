@@ -0,0 +1,63 @@
+package cli
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/feegrant/internal/types"
+)
+
+// GetQueryCmd returns the cli query commands for the fee grant module.
+func GetQueryCmd(cdc *codec.Codec) *cobra.Command {
+	feegrantQueryCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Querying commands for the fee grant module",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	feegrantQueryCmd.AddCommand(
+		client.GetCommands(
+			GetCmdQueryFeeGrants(cdc),
+		)...,
+	)
+
+	return feegrantQueryCmd
+}
+
+// GetCmdQueryFeeGrants implements the command to query all the fee grants
+// given to an address.
+func GetCmdQueryFeeGrants(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "grants [grantee]",
+		Short: "Query all fee allowances granted to an address",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			grantee, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			route := fmt.Sprintf("custom/%s/%s/%s", types.QuerierRoute, types.QueryGetFeeAllowances, grantee)
+			res, _, err := cliCtx.QueryWithData(route, nil)
+			if err != nil {
+				return err
+			}
+
+			var grants types.FeeAllowanceGrants
+			if err := cdc.UnmarshalJSON(res, &grants); err != nil {
+				return err
+			}
+
+			return cliCtx.PrintOutput(grants)
+		},
+	}
+}
@@ -0,0 +1,163 @@
+package cli
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/cosmos/cosmos-sdk/x/auth/client/utils"
+	"github.com/cosmos/cosmos-sdk/x/feegrant/internal/types"
+)
+
+// flags for the fee grant commands
+const (
+	FlagExpiration       = "expiration"
+	FlagExpirationHeight = "expiration-height"
+	FlagPeriod           = "period"
+	FlagPeriodBlocks     = "period-blocks"
+	FlagPeriodLimit      = "period-limit"
+)
+
+// GetTxCmd returns the transaction commands for this module
+func GetTxCmd(cdc *codec.Codec) *cobra.Command {
+	feegrantTxCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Fee grant transaction subcommands",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	feegrantTxCmd.AddCommand(client.PostCommands(
+		GetCmdGrantFeeAllowance(cdc),
+		GetCmdRevokeFeeAllowance(cdc),
+	)...)
+
+	return feegrantTxCmd
+}
+
+// GetCmdGrantFeeAllowance implements the command to grant a fee allowance.
+func GetCmdGrantFeeAllowance(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "grant [granter_key_or_address] [grantee] [spend_limit]",
+		Short: "Grant a fee allowance to an address",
+		Long: `Grant an allowance to pay the fees of the grantee's transactions from the granter's account.
+An empty spend limit ("") grants an unlimited allowance. The allowance may expire at a block time
+(--expiration) or block height (--expiration-height). If --period-limit is given, the allowance is
+periodic: at most the period limit may be spent per period (--period or --period-blocks).
+
+Example:
+$ <appcli> tx feegrant grant mykey cosmos1... 1000stake --expiration 2021-01-01T00:00:00Z
+$ <appcli> tx feegrant grant mykey cosmos1... 1000stake --period 24h --period-limit 10stake
+`,
+		Args: cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			txBldr := auth.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContextWithFrom(args[0]).WithCodec(cdc)
+
+			grantee, err := sdk.AccAddressFromBech32(args[1])
+			if err != nil {
+				return err
+			}
+
+			allowance, err := buildAllowance(args[2])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgGrantFeeAllowance(cliCtx.GetFromAddress(), grantee, allowance)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+
+	cmd.Flags().String(FlagExpiration, "", "Block time (RFC3339) at which the allowance expires")
+	cmd.Flags().Int64(FlagExpirationHeight, 0, "Block height at which the allowance expires")
+	cmd.Flags().Duration(FlagPeriod, 0, "Duration of a spending period, e.g. 24h")
+	cmd.Flags().Int64(FlagPeriodBlocks, 0, "Number of blocks in a spending period")
+	cmd.Flags().String(FlagPeriodLimit, "", "Maximum amount spendable per period; makes the allowance periodic")
+
+	return cmd
+}
+
+// GetCmdRevokeFeeAllowance implements the command to revoke a fee allowance.
+func GetCmdRevokeFeeAllowance(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "revoke [granter_key_or_address] [grantee]",
+		Short: "Revoke a fee allowance granted to an address",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			txBldr := auth.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContextWithFrom(args[0]).WithCodec(cdc)
+
+			grantee, err := sdk.AccAddressFromBech32(args[1])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgRevokeFeeAllowance(cliCtx.GetFromAddress(), grantee)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
+
+// buildAllowance builds a basic or periodic fee allowance from the given spend
+// limit and the command flags.
+func buildAllowance(spendLimit string) (types.FeeAllowance, error) {
+	limit, err := sdk.ParseCoins(spendLimit)
+	if err != nil {
+		return nil, err
+	}
+
+	var expiration types.ExpiresAt
+	if s := viper.GetString(FlagExpiration); s != "" {
+		t, err := time.Parse(time.RFC3339, s)
+		if err != nil {
+			return nil, fmt.Errorf("invalid expiration time %q: %s", s, err)
+		}
+		expiration = types.ExpiresAtTime(t)
+	}
+	if h := viper.GetInt64(FlagExpirationHeight); h != 0 {
+		if !expiration.IsZero() {
+			return nil, fmt.Errorf("only one of --%s and --%s may be set", FlagExpiration, FlagExpirationHeight)
+		}
+		expiration = types.ExpiresAtHeight(h)
+	}
+
+	basic := types.BasicFeeAllowance{SpendLimit: limit, Expiration: expiration}
+
+	periodLimit := viper.GetString(FlagPeriodLimit)
+	if periodLimit == "" {
+		return &basic, nil
+	}
+
+	periodSpendLimit, err := sdk.ParseCoins(periodLimit)
+	if err != nil {
+		return nil, err
+	}
+
+	period := types.Duration{
+		Clock: viper.GetDuration(FlagPeriod),
+		Block: viper.GetInt64(FlagPeriodBlocks),
+	}
+
+	return &types.PeriodicFeeAllowance{
+		Basic:            basic,
+		Period:           period,
+		PeriodSpendLimit: periodSpendLimit,
+	}, nil
+}
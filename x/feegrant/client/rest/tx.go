@@ -0,0 +1,119 @@
+package rest
+
+import (
+	"net/http"
+
+	"github.com/gorilla/mux"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/rest"
+	"github.com/cosmos/cosmos-sdk/x/auth/client/utils"
+	"github.com/cosmos/cosmos-sdk/x/feegrant/internal/types"
+)
+
+func registerTxRoutes(cliCtx context.CLIContext, r *mux.Router) {
+	r.HandleFunc(
+		"/feegrant/grants/{grantee}",
+		grantFeeAllowanceHandlerFn(cliCtx),
+	).Methods("POST")
+
+	r.HandleFunc(
+		"/feegrant/grants/{grantee}/revoke",
+		revokeFeeAllowanceHandlerFn(cliCtx),
+	).Methods("POST")
+}
+
+// GrantReq defines the properties of a fee grant request's body. If
+// PeriodSpendLimit is set, a periodic allowance is granted.
+type GrantReq struct {
+	BaseReq          rest.BaseReq    `json:"base_req" yaml:"base_req"`
+	SpendLimit       sdk.Coins       `json:"spend_limit" yaml:"spend_limit"`
+	Expiration       types.ExpiresAt `json:"expiration" yaml:"expiration"`
+	Period           types.Duration  `json:"period" yaml:"period"`
+	PeriodSpendLimit sdk.Coins       `json:"period_spend_limit" yaml:"period_spend_limit"`
+}
+
+// RevokeReq defines the properties of a fee grant revocation request's body.
+type RevokeReq struct {
+	BaseReq rest.BaseReq `json:"base_req" yaml:"base_req"`
+}
+
+func grantFeeAllowanceHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		grantee, err := sdk.AccAddressFromBech32(mux.Vars(r)["grantee"])
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		var req GrantReq
+		if !rest.ReadRESTReq(w, r, cliCtx.Codec, &req) {
+			return
+		}
+
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+
+		granter, err := sdk.AccAddressFromBech32(req.BaseReq.From)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		basic := types.BasicFeeAllowance{SpendLimit: req.SpendLimit, Expiration: req.Expiration}
+
+		var allowance types.FeeAllowance = &basic
+		if !req.PeriodSpendLimit.Empty() {
+			allowance = &types.PeriodicFeeAllowance{
+				Basic:            basic,
+				Period:           req.Period,
+				PeriodSpendLimit: req.PeriodSpendLimit,
+			}
+		}
+
+		msg := types.NewMsgGrantFeeAllowance(granter, grantee, allowance)
+		if err := msg.ValidateBasic(); err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		utils.WriteGenerateStdTxResponse(w, cliCtx, req.BaseReq, []sdk.Msg{msg})
+	}
+}
+
+func revokeFeeAllowanceHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		grantee, err := sdk.AccAddressFromBech32(mux.Vars(r)["grantee"])
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		var req RevokeReq
+		if !rest.ReadRESTReq(w, r, cliCtx.Codec, &req) {
+			return
+		}
+
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+
+		granter, err := sdk.AccAddressFromBech32(req.BaseReq.From)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		msg := types.NewMsgRevokeFeeAllowance(granter, grantee)
+		if err := msg.ValidateBasic(); err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		utils.WriteGenerateStdTxResponse(w, cliCtx, req.BaseReq, []sdk.Msg{msg})
+	}
+}
@@ -0,0 +1,22 @@
+/*
+Package feegrant provides functionality for granting fee allowances, so that
+one account (the granter) can pay the transaction fees of another account (the
+grantee).
+
+A granter issues a MsgGrantFeeAllowance carrying a FeeAllowance for a grantee.
+Two allowances are provided:
+
+	- BasicFeeAllowance: a total spend limit with an optional expiration,
+	  given as either a block time or a block height.
+	- PeriodicFeeAllowance: a BasicFeeAllowance that additionally limits how
+	  much may be spent per period, topping up every period.
+
+A grant is revoked with MsgRevokeFeeAllowance, and it is removed automatically
+once it is used up or has expired.
+
+To use a grant, the grantee sets the granter on the transaction's StdFee. The
+auth ante handler created with auth.NewFeeGrantAnteHandler then asks the keeper
+to consume the allowance via UseGrantedFees and, if it is accepted, deducts the
+fees from the granter's account instead of the grantee's.
+*/
+package feegrant
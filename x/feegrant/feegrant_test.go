@@ -0,0 +1,157 @@
+package feegrant_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/crypto"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/feegrant"
+)
+
+func createTestApp() (*simapp.SimApp, sdk.Context) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, abci.Header{Height: 10, Time: time.Now()})
+	app.AccountKeeper.SetParams(ctx, authtypes.DefaultParams())
+
+	return app, ctx
+}
+
+func createAccount(app *simapp.SimApp, ctx sdk.Context, coins sdk.Coins) (crypto.PrivKey, sdk.AccAddress) {
+	priv, _, addr := authtypes.KeyTestPubAddr()
+	acc := app.AccountKeeper.NewAccountWithAddress(ctx, addr)
+	if err := acc.SetCoins(coins); err != nil {
+		panic(err)
+	}
+	app.AccountKeeper.SetAccount(ctx, acc)
+
+	return priv, addr
+}
+
+func TestGrantAndRevokeFeeAllowance(t *testing.T) {
+	app, ctx := createTestApp()
+	handler := feegrant.NewHandler(app.FeeGrantKeeper)
+
+	_, granter := createAccount(app, ctx, nil)
+	_, grantee := createAccount(app, ctx, nil)
+
+	allowance := &feegrant.BasicFeeAllowance{
+		SpendLimit: sdk.NewCoins(sdk.NewInt64Coin("atom", 500)),
+		Expiration: feegrant.ExpiresAtHeight(100),
+	}
+
+	res := handler(ctx, feegrant.NewMsgGrantFeeAllowance(granter, grantee, allowance))
+	require.True(t, res.IsOK(), res.Log)
+	require.Equal(t, allowance, app.FeeGrantKeeper.GetFeeAllowance(ctx, granter, grantee))
+	require.Nil(t, app.FeeGrantKeeper.GetFeeAllowance(ctx, grantee, granter))
+
+	// grants are exported relative to the current height
+	exported := feegrant.ExportGenesis(ctx, app.FeeGrantKeeper)
+	require.Len(t, exported.FeeAllowances, 1)
+	require.Equal(t, feegrant.ExpiresAtHeight(90), exported.FeeAllowances[0].Allowance.(*feegrant.BasicFeeAllowance).Expiration)
+	require.NoError(t, feegrant.ValidateGenesis(exported))
+
+	res = handler(ctx, feegrant.NewMsgRevokeFeeAllowance(granter, grantee))
+	require.True(t, res.IsOK(), res.Log)
+	require.Nil(t, app.FeeGrantKeeper.GetFeeAllowance(ctx, granter, grantee))
+
+	res = handler(ctx, feegrant.NewMsgRevokeFeeAllowance(granter, grantee))
+	require.False(t, res.IsOK())
+	require.Equal(t, feegrant.CodeNoAllowance, res.Code)
+}
+
+func TestUseGrantedFees(t *testing.T) {
+	app, ctx := createTestApp()
+
+	_, granter := createAccount(app, ctx, nil)
+	_, grantee := createAccount(app, ctx, nil)
+
+	fee := sdk.NewCoins(sdk.NewInt64Coin("atom", 150))
+	limit := sdk.NewCoins(sdk.NewInt64Coin("atom", 200))
+
+	// no grant
+	err := app.FeeGrantKeeper.UseGrantedFees(ctx, granter, grantee, fee)
+	require.Error(t, err)
+	require.Equal(t, feegrant.CodeNoAllowance, err.Code())
+
+	app.FeeGrantKeeper.GrantFeeAllowance(ctx, feegrant.NewFeeAllowanceGrant(
+		granter, grantee, &feegrant.BasicFeeAllowance{SpendLimit: limit},
+	))
+
+	require.NoError(t, app.FeeGrantKeeper.UseGrantedFees(ctx, granter, grantee, fee))
+	require.Equal(t,
+		sdk.NewCoins(sdk.NewInt64Coin("atom", 50)),
+		app.FeeGrantKeeper.GetFeeAllowance(ctx, granter, grantee).(*feegrant.BasicFeeAllowance).SpendLimit,
+	)
+
+	// the remainder doesn't cover the fee
+	err = app.FeeGrantKeeper.UseGrantedFees(ctx, granter, grantee, fee)
+	require.Error(t, err)
+	require.Equal(t, feegrant.CodeFeeLimitExceeded, err.Code())
+
+	// using up the allowance removes the grant
+	require.NoError(t, app.FeeGrantKeeper.UseGrantedFees(ctx, granter, grantee, sdk.NewCoins(sdk.NewInt64Coin("atom", 50))))
+	require.Nil(t, app.FeeGrantKeeper.GetFeeAllowance(ctx, granter, grantee))
+}
+
+func TestAnteHandlerDeductsFeesFromGranter(t *testing.T) {
+	app, ctx := createTestApp()
+	anteHandler := auth.NewFeeGrantAnteHandler(
+		app.AccountKeeper, app.SupplyKeeper, app.FeeGrantKeeper, auth.DefaultSigVerificationGasConsumer,
+	)
+
+	funds := sdk.NewCoins(sdk.NewInt64Coin("atom", 1000))
+	_, granter := createAccount(app, ctx, funds)
+	priv, grantee := createAccount(app, ctx, nil)
+	_, stranger := createAccount(app, ctx, funds)
+
+	app.FeeGrantKeeper.GrantFeeAllowance(ctx, feegrant.NewFeeAllowanceGrant(
+		granter, grantee, &feegrant.BasicFeeAllowance{SpendLimit: sdk.NewCoins(sdk.NewInt64Coin("atom", 200))},
+	))
+
+	msgs := []sdk.Msg{authtypes.NewTestMsg(grantee)}
+	acc := app.AccountKeeper.GetAccount(ctx, grantee)
+
+	// the grantee can't pay the fees itself
+	fee := authtypes.NewTestStdFee()
+	tx := authtypes.NewTestTx(ctx, msgs, []crypto.PrivKey{priv}, []uint64{acc.GetAccountNumber()}, []uint64{0}, fee)
+	_, res, abort := anteHandler(ctx, tx, false)
+	require.True(t, abort)
+	require.Equal(t, sdk.CodeInsufficientFunds, res.Code)
+
+	// an account that granted nothing can't pay the fees
+	tx = authtypes.NewTestTx(ctx, msgs, []crypto.PrivKey{priv}, []uint64{acc.GetAccountNumber()}, []uint64{0}, fee.WithGranter(stranger))
+	_, res, abort = anteHandler(ctx, tx, false)
+	require.True(t, abort)
+	require.Equal(t, feegrant.CodeNoAllowance, res.Code)
+
+	// the granter pays the fees from the allowance
+	tx = authtypes.NewTestTx(ctx, msgs, []crypto.PrivKey{priv}, []uint64{acc.GetAccountNumber()}, []uint64{0}, fee.WithGranter(granter))
+	_, res, abort = anteHandler(ctx, tx, false)
+	require.False(t, abort, res.Log)
+
+	require.Equal(t, funds.Sub(fee.Amount), app.AccountKeeper.GetAccount(ctx, granter).GetCoins())
+	require.True(t, app.AccountKeeper.GetAccount(ctx, grantee).GetCoins().IsZero())
+	require.Equal(t,
+		sdk.NewCoins(sdk.NewInt64Coin("atom", 50)),
+		app.FeeGrantKeeper.GetFeeAllowance(ctx, granter, grantee).(*feegrant.BasicFeeAllowance).SpendLimit,
+	)
+
+	// the remaining allowance doesn't cover another fee
+	tx = authtypes.NewTestTx(ctx, msgs, []crypto.PrivKey{priv}, []uint64{acc.GetAccountNumber()}, []uint64{1}, fee.WithGranter(granter))
+	_, res, abort = anteHandler(ctx, tx, false)
+	require.True(t, abort)
+	require.Equal(t, feegrant.CodeFeeLimitExceeded, res.Code)
+
+	// fee grants are rejected when the ante handler has no fee grant keeper
+	anteHandler = auth.NewAnteHandler(app.AccountKeeper, app.SupplyKeeper, auth.DefaultSigVerificationGasConsumer)
+	_, res, abort = anteHandler(ctx, tx, false)
+	require.True(t, abort)
+	require.Equal(t, sdk.CodeUnauthorized, res.Code)
+}
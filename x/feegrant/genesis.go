@@ -0,0 +1,35 @@
+package feegrant
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// InitGenesis will initialize the keeper from a *previously validated* GenesisState
+func InitGenesis(ctx sdk.Context, k Keeper, data GenesisState) {
+	for _, f := range data.FeeAllowances {
+		k.GrantFeeAllowance(ctx, f)
+	}
+}
+
+// ExportGenesis will dump the contents of the keeper into a serializable GenesisState
+//
+// All expiration heights will be thrown off if we dump state and start at a new
+// chain at height 0. Thus, we allow the Allowances to "prepare themselves"
+// for export, like if they have expiry at 5000 and current is 4000, they export with
+// expiry of 1000. Every FeeAllowance has a method `PrepareForExport` that allows
+// them to perform any changes needed prior to export. Expired grants are not
+// exported.
+func ExportGenesis(ctx sdk.Context, k Keeper) GenesisState {
+	time, height := ctx.BlockTime(), ctx.BlockHeight()
+
+	grants := []FeeAllowanceGrant{}
+	k.IterateAllFeeAllowances(ctx, func(grant FeeAllowanceGrant) bool {
+		grant = grant.PrepareForExport(time, height)
+		if grant.Allowance != nil {
+			grants = append(grants, grant)
+		}
+		return false
+	})
+
+	return NewGenesisState(grants)
+}
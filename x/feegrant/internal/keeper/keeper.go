@@ -0,0 +1,172 @@
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/feegrant/internal/types"
+)
+
+// Keeper manages state of all fee grants, as well as calculating approval.
+// It must have a codec with all available allowances registered.
+type Keeper struct {
+	cdc      *codec.Codec
+	storeKey sdk.StoreKey
+}
+
+// NewKeeper creates a fee grant Keeper
+func NewKeeper(cdc *codec.Codec, storeKey sdk.StoreKey) Keeper {
+	return Keeper{
+		cdc:      cdc,
+		storeKey: storeKey,
+	}
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
+}
+
+// GrantFeeAllowance creates a new grant, overwriting any existing grant
+// from the same granter to the same grantee.
+func (k Keeper) GrantFeeAllowance(ctx sdk.Context, grant types.FeeAllowanceGrant) {
+	store := ctx.KVStore(k.storeKey)
+	key := types.FeeAllowanceKey(grant.Granter, grant.Grantee)
+
+	bz := k.cdc.MustMarshalBinaryBare(grant)
+	store.Set(key, bz)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeSetFeeGrant,
+			sdk.NewAttribute(types.AttributeKeyGranter, grant.Granter.String()),
+			sdk.NewAttribute(types.AttributeKeyGrantee, grant.Grantee.String()),
+		),
+	)
+}
+
+// RevokeFeeAllowance removes an existing grant. It returns an error if no
+// grant exists from granter to grantee.
+func (k Keeper) RevokeFeeAllowance(ctx sdk.Context, granter, grantee sdk.AccAddress) sdk.Error {
+	store := ctx.KVStore(k.storeKey)
+	key := types.FeeAllowanceKey(granter, grantee)
+
+	if !store.Has(key) {
+		return types.ErrNoAllowance(types.DefaultCodespace)
+	}
+
+	store.Delete(key)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeRevokeFeeGrant,
+			sdk.NewAttribute(types.AttributeKeyGranter, granter.String()),
+			sdk.NewAttribute(types.AttributeKeyGrantee, grantee.String()),
+		),
+	)
+
+	return nil
+}
+
+// GetFeeAllowance returns the allowance between the granter and grantee.
+// If there is none, it returns nil.
+func (k Keeper) GetFeeAllowance(ctx sdk.Context, granter, grantee sdk.AccAddress) types.FeeAllowance {
+	grant, found := k.GetFeeGrant(ctx, granter, grantee)
+	if !found {
+		return nil
+	}
+
+	return grant.Allowance
+}
+
+// GetFeeGrant returns entire grant between both accounts
+func (k Keeper) GetFeeGrant(ctx sdk.Context, granter sdk.AccAddress, grantee sdk.AccAddress) (types.FeeAllowanceGrant, bool) {
+	store := ctx.KVStore(k.storeKey)
+	key := types.FeeAllowanceKey(granter, grantee)
+
+	bz := store.Get(key)
+	if len(bz) == 0 {
+		return types.FeeAllowanceGrant{}, false
+	}
+
+	var grant types.FeeAllowanceGrant
+	k.cdc.MustUnmarshalBinaryBare(bz, &grant)
+
+	return grant, true
+}
+
+// IterateAllGranteeFeeAllowances iterates over all the grants from anyone to the given grantee.
+// Callback to get all data, returns true to stop, false to keep reading
+func (k Keeper) IterateAllGranteeFeeAllowances(ctx sdk.Context, grantee sdk.AccAddress, cb func(types.FeeAllowanceGrant) bool) {
+	store := ctx.KVStore(k.storeKey)
+	prefix := types.FeeAllowancePrefixByGrantee(grantee)
+
+	iter := sdk.KVStorePrefixIterator(store, prefix)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		var grant types.FeeAllowanceGrant
+		k.cdc.MustUnmarshalBinaryBare(iter.Value(), &grant)
+
+		if cb(grant) {
+			break
+		}
+	}
+}
+
+// IterateAllFeeAllowances iterates over all the grants in the store.
+// Callback to get all data, returns true to stop, false to keep reading
+// Calling this without pagination is very expensive and only designed for export genesis
+func (k Keeper) IterateAllFeeAllowances(ctx sdk.Context, cb func(types.FeeAllowanceGrant) bool) {
+	store := ctx.KVStore(k.storeKey)
+
+	iter := sdk.KVStorePrefixIterator(store, types.FeeAllowanceKeyPrefix)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		var grant types.FeeAllowanceGrant
+		k.cdc.MustUnmarshalBinaryBare(iter.Value(), &grant)
+
+		if cb(grant) {
+			break
+		}
+	}
+}
+
+// UseGrantedFees will try to pay the given fee from the granter's account as
+// requested by the grantee. It returns an error if there is no grant or the
+// grant does not cover the fee. Grants that are used up or expired are removed.
+func (k Keeper) UseGrantedFees(ctx sdk.Context, granter, grantee sdk.AccAddress, fee sdk.Coins) sdk.Error {
+	grant, found := k.GetFeeGrant(ctx, granter, grantee)
+	if !found || grant.Allowance == nil {
+		return types.ErrNoAllowance(types.DefaultCodespace)
+	}
+
+	remove, err := grant.Allowance.Accept(fee, ctx.BlockTime(), ctx.BlockHeight())
+	if err == nil {
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeUseFeeGrant,
+				sdk.NewAttribute(types.AttributeKeyGranter, granter.String()),
+				sdk.NewAttribute(types.AttributeKeyGrantee, grantee.String()),
+			),
+		)
+	}
+
+	if remove {
+		// ignore the error, we know the grant exists
+		_ = k.RevokeFeeAllowance(ctx, granter, grantee)
+		return err
+	}
+
+	if err != nil {
+		return err
+	}
+
+	// if we accepted, store the updated state of the allowance
+	k.GrantFeeAllowance(ctx, grant)
+	return nil
+}
@@ -0,0 +1,48 @@
+package keeper
+
+import (
+	"fmt"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/feegrant/internal/types"
+)
+
+// NewQuerier creates a new querier for the fee grant module
+func NewQuerier(keeper Keeper) sdk.Querier {
+	return func(ctx sdk.Context, path []string, req abci.RequestQuery) ([]byte, sdk.Error) {
+		switch path[0] {
+		case types.QueryGetFeeAllowances:
+			return queryGetFeeAllowances(ctx, path[1:], keeper)
+
+		default:
+			return nil, sdk.ErrUnknownRequest(fmt.Sprintf("unknown feegrant query endpoint: %s", path[0]))
+		}
+	}
+}
+
+func queryGetFeeAllowances(ctx sdk.Context, args []string, keeper Keeper) ([]byte, sdk.Error) {
+	if len(args) == 0 {
+		return nil, sdk.ErrUnknownRequest("missing grantee address")
+	}
+
+	grantee, err := sdk.AccAddressFromBech32(args[0])
+	if err != nil {
+		return nil, sdk.ErrInvalidAddress(fmt.Sprintf("invalid address %s", args[0]))
+	}
+
+	grants := []types.FeeAllowanceGrant{}
+	keeper.IterateAllGranteeFeeAllowances(ctx, grantee, func(grant types.FeeAllowanceGrant) bool {
+		grants = append(grants, grant)
+		return false
+	})
+
+	bz, err := codec.MarshalJSONIndent(keeper.cdc, grants)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("failed to marshal JSON", err.Error()))
+	}
+
+	return bz, nil
+}
@@ -0,0 +1,73 @@
+package types
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// BasicFeeAllowance implements FeeAllowance with a one-time grant of tokens
+// that optionally expires. The delegatee can use up to SpendLimit to cover fees.
+type BasicFeeAllowance struct {
+	// SpendLimit specifies the maximum amount of tokens that can be spent
+	// by this allowance and will be updated as tokens are spent. If it is
+	// empty, there is no spend limit and any amount of coins can be spent.
+	SpendLimit sdk.Coins `json:"spend_limit" yaml:"spend_limit"`
+
+	// Expiration specifies an optional time or height when this allowance expires.
+	// If Expiration is empty, there is no expiration.
+	Expiration ExpiresAt `json:"expiration" yaml:"expiration"`
+}
+
+var _ FeeAllowance = (*BasicFeeAllowance)(nil)
+
+// Accept can use fee payment requested as well as timestamp/height of the current block
+// to determine whether or not to process this. This is checked in
+// Keeper.UseGrantedFees and the return values should match how it is handled there.
+//
+// If it returns an error, the fee payment is rejected, otherwise it is accepted.
+// The FeeAllowance implementation is expected to update it's internal state
+// and will be saved again after an acceptance.
+//
+// If remove is true (regardless of the error), the FeeAllowance will be deleted from storage
+// (eg. when it is used up). (See call to RevokeFeeAllowance in Keeper.UseGrantedFees)
+func (a *BasicFeeAllowance) Accept(fee sdk.Coins, blockTime time.Time, blockHeight int64) (bool, sdk.Error) {
+	if a.Expiration.IsExpired(blockTime, blockHeight) {
+		return true, ErrFeeLimitExpired(DefaultCodespace)
+	}
+
+	if a.SpendLimit.Empty() {
+		return false, nil
+	}
+
+	left, invalid := a.SpendLimit.SafeSub(fee)
+	if invalid {
+		return false, ErrFeeLimitExceeded(DefaultCodespace)
+	}
+
+	a.SpendLimit = left
+	return left.IsZero(), nil
+}
+
+// PrepareForExport will adjust the expiration based on export time. In particular,
+// it will subtract the dumpHeight from any height-based expiration to ensure that
+// the elapsed number of blocks this allowance is valid for is fixed. It returns
+// nil if the allowance has already expired.
+func (a *BasicFeeAllowance) PrepareForExport(dumpTime time.Time, dumpHeight int64) FeeAllowance {
+	if a.Expiration.IsExpired(dumpTime, dumpHeight) {
+		return nil
+	}
+
+	return &BasicFeeAllowance{
+		SpendLimit: a.SpendLimit,
+		Expiration: a.Expiration.PrepareForExport(dumpTime, dumpHeight),
+	}
+}
+
+// ValidateBasic implements FeeAllowance and enforces basic sanity checks
+func (a BasicFeeAllowance) ValidateBasic() sdk.Error {
+	if !a.SpendLimit.IsValid() {
+		return ErrInvalidAllowance(DefaultCodespace, "send amount is invalid: "+a.SpendLimit.String())
+	}
+	return a.Expiration.ValidateBasic()
+}
@@ -0,0 +1,45 @@
+package types
+
+// DONTCOVER
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Codes for fee grant errors
+const (
+	DefaultCodespace sdk.CodespaceType = ModuleName
+
+	CodeFeeLimitExceeded sdk.CodeType = 1
+	CodeFeeLimitExpired  sdk.CodeType = 2
+	CodeInvalidDuration  sdk.CodeType = 3
+	CodeNoAllowance      sdk.CodeType = 4
+	CodeInvalidAllowance sdk.CodeType = 5
+)
+
+// ErrFeeLimitExceeded error if there are not enough allowance to cover the fees
+func ErrFeeLimitExceeded(codespace sdk.CodespaceType) sdk.Error {
+	return sdk.NewError(codespace, CodeFeeLimitExceeded, "fee limit exceeded")
+}
+
+// ErrFeeLimitExpired error if the allowance has expired
+func ErrFeeLimitExpired(codespace sdk.CodespaceType) sdk.Error {
+	return sdk.NewError(codespace, CodeFeeLimitExpired, "fee limit expired")
+}
+
+// ErrInvalidDuration error if the Duration is invalid or doesn't match the expiration
+func ErrInvalidDuration(codespace sdk.CodespaceType, msg string) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidDuration, fmt.Sprintf("invalid duration: %s", msg))
+}
+
+// ErrNoAllowance error if there is no allowance for that pair
+func ErrNoAllowance(codespace sdk.CodespaceType) sdk.Error {
+	return sdk.NewError(codespace, CodeNoAllowance, "no fee allowance")
+}
+
+// ErrInvalidAllowance error if the allowance fails validation
+func ErrInvalidAllowance(codespace sdk.CodespaceType, msg string) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidAllowance, fmt.Sprintf("invalid fee allowance: %s", msg))
+}
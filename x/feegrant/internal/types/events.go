@@ -0,0 +1,13 @@
+package types
+
+// fee grant module event types
+const (
+	EventTypeUseFeeGrant    = "use_feegrant"
+	EventTypeRevokeFeeGrant = "revoke_feegrant"
+	EventTypeSetFeeGrant    = "set_feegrant"
+
+	AttributeKeyGranter = "granter"
+	AttributeKeyGrantee = "grantee"
+
+	AttributeValueCategory = ModuleName
+)
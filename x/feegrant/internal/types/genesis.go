@@ -0,0 +1,26 @@
+package types
+
+// GenesisState contains a set of fee allowances, persisted from the store
+type GenesisState struct {
+	FeeAllowances []FeeAllowanceGrant `json:"fee_allowances" yaml:"fee_allowances"`
+}
+
+// NewGenesisState creates a new GenesisState object
+func NewGenesisState(feeAllowances []FeeAllowanceGrant) GenesisState {
+	return GenesisState{FeeAllowances: feeAllowances}
+}
+
+// DefaultGenesisState returns an empty genesis state
+func DefaultGenesisState() GenesisState {
+	return GenesisState{FeeAllowances: []FeeAllowanceGrant{}}
+}
+
+// ValidateGenesis ensures all grants in the genesis state are valid
+func ValidateGenesis(data GenesisState) error {
+	for _, f := range data.FeeAllowances {
+		if err := f.ValidateBasic(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
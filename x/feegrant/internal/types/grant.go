@@ -0,0 +1,71 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// FeeAllowanceGrant is stored in the KVStore to record a grant with full context
+type FeeAllowanceGrant struct {
+	Granter   sdk.AccAddress `json:"granter" yaml:"granter"`
+	Grantee   sdk.AccAddress `json:"grantee" yaml:"grantee"`
+	Allowance FeeAllowance   `json:"allowance" yaml:"allowance"`
+}
+
+// NewFeeAllowanceGrant creates a new FeeAllowanceGrant.
+func NewFeeAllowanceGrant(granter, grantee sdk.AccAddress, allowance FeeAllowance) FeeAllowanceGrant {
+	return FeeAllowanceGrant{
+		Granter:   granter,
+		Grantee:   grantee,
+		Allowance: allowance,
+	}
+}
+
+// ValidateBasic performs basic validation on
+// FeeAllowanceGrant
+func (a FeeAllowanceGrant) ValidateBasic() sdk.Error {
+	if a.Granter.Empty() {
+		return sdk.ErrInvalidAddress("missing granter address")
+	}
+	if a.Grantee.Empty() {
+		return sdk.ErrInvalidAddress("missing grantee address")
+	}
+	if a.Grantee.Equals(a.Granter) {
+		return sdk.ErrInvalidAddress("cannot self-grant fee authorization")
+	}
+	if a.Allowance == nil {
+		return ErrInvalidAllowance(DefaultCodespace, "missing allowance")
+	}
+
+	return a.Allowance.ValidateBasic()
+}
+
+// PrepareForExport will make all needed changes to the allowance to prepare to be
+// re-imported at height 0, and return a copy of this grant. The allowance of
+// the returned grant is nil if it has expired.
+func (a FeeAllowanceGrant) PrepareForExport(dumpTime time.Time, dumpHeight int64) FeeAllowanceGrant {
+	a.Allowance = a.Allowance.PrepareForExport(dumpTime, dumpHeight)
+	return a
+}
+
+// String implements the fmt.Stringer interface
+func (a FeeAllowanceGrant) String() string {
+	return fmt.Sprintf(`Fee Allowance Grant:
+  Granter:   %s
+  Grantee:   %s
+  Allowance: %+v`, a.Granter, a.Grantee, a.Allowance)
+}
+
+// FeeAllowanceGrants is a collection of fee allowance grants
+type FeeAllowanceGrants []FeeAllowanceGrant
+
+// String implements the fmt.Stringer interface
+func (g FeeAllowanceGrants) String() (out string) {
+	for _, grant := range g {
+		out += grant.String() + "\n"
+	}
+	return strings.TrimSpace(out)
+}
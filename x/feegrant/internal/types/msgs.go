@@ -0,0 +1,84 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// ensure Msg interface compliance at compile time
+var (
+	_ sdk.Msg = MsgGrantFeeAllowance{}
+	_ sdk.Msg = MsgRevokeFeeAllowance{}
+)
+
+// MsgGrantFeeAllowance adds permission for Grantee to spend up to Allowance
+// of fees from the account of Granter.
+// If there was already an existing grant, this overwrites it.
+type MsgGrantFeeAllowance struct {
+	Granter   sdk.AccAddress `json:"granter" yaml:"granter"`
+	Grantee   sdk.AccAddress `json:"grantee" yaml:"grantee"`
+	Allowance FeeAllowance   `json:"allowance" yaml:"allowance"`
+}
+
+// NewMsgGrantFeeAllowance creates a new MsgGrantFeeAllowance instance
+func NewMsgGrantFeeAllowance(granter, grantee sdk.AccAddress, allowance FeeAllowance) MsgGrantFeeAllowance {
+	return MsgGrantFeeAllowance{Granter: granter, Grantee: grantee, Allowance: allowance}
+}
+
+// Route implements the sdk.Msg interface
+func (msg MsgGrantFeeAllowance) Route() string { return RouterKey }
+
+// Type implements the sdk.Msg interface
+func (msg MsgGrantFeeAllowance) Type() string { return "grant_fee_allowance" }
+
+// ValidateBasic implements the sdk.Msg interface
+func (msg MsgGrantFeeAllowance) ValidateBasic() sdk.Error {
+	return NewFeeAllowanceGrant(msg.Granter, msg.Grantee, msg.Allowance).ValidateBasic()
+}
+
+// GetSignBytes implements the sdk.Msg interface
+func (msg MsgGrantFeeAllowance) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners implements the sdk.Msg interface
+func (msg MsgGrantFeeAllowance) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Granter}
+}
+
+// MsgRevokeFeeAllowance removes any existing FeeAllowance from Granter to Grantee.
+type MsgRevokeFeeAllowance struct {
+	Granter sdk.AccAddress `json:"granter" yaml:"granter"`
+	Grantee sdk.AccAddress `json:"grantee" yaml:"grantee"`
+}
+
+// NewMsgRevokeFeeAllowance creates a new MsgRevokeFeeAllowance instance
+func NewMsgRevokeFeeAllowance(granter, grantee sdk.AccAddress) MsgRevokeFeeAllowance {
+	return MsgRevokeFeeAllowance{Granter: granter, Grantee: grantee}
+}
+
+// Route implements the sdk.Msg interface
+func (msg MsgRevokeFeeAllowance) Route() string { return RouterKey }
+
+// Type implements the sdk.Msg interface
+func (msg MsgRevokeFeeAllowance) Type() string { return "revoke_fee_allowance" }
+
+// ValidateBasic implements the sdk.Msg interface
+func (msg MsgRevokeFeeAllowance) ValidateBasic() sdk.Error {
+	if msg.Granter.Empty() {
+		return sdk.ErrInvalidAddress("missing granter address")
+	}
+	if msg.Grantee.Empty() {
+		return sdk.ErrInvalidAddress("missing grantee address")
+	}
+	return nil
+}
+
+// GetSignBytes implements the sdk.Msg interface
+func (msg MsgRevokeFeeAllowance) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners implements the sdk.Msg interface
+func (msg MsgRevokeFeeAllowance) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Granter}
+}
@@ -0,0 +1,6 @@
+package types
+
+// query endpoints supported by the fee grant Querier
+const (
+	QueryGetFeeAllowances = "fees"
+)
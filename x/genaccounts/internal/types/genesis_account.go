@@ -26,6 +26,9 @@ type GenesisAccount struct {
 	StartTime        int64     `json:"start_time" yaml:"start_time"`               // vesting start time (UNIX Epoch time)
 	EndTime          int64     `json:"end_time" yaml:"end_time"`                   // vesting end time (UNIX Epoch time)
 
+	// periodic vesting account fields
+	VestingPeriods authtypes.Periods `json:"vesting_periods,omitempty" yaml:"vesting_periods,omitempty"` // vesting schedule of a periodic vesting account
+
 	// module account fields
 	ModuleName        string   `json:"module_name" yaml:"module_name"`               // name of the module account
 	ModulePermissions []string `json:"module_permissions" yaml:"module_permissions"` // permissions of module account
@@ -42,6 +45,18 @@ func (ga GenesisAccount) Validate() error {
 		}
 	}
 
+	if len(ga.VestingPeriods) > 0 {
+		if err := ga.VestingPeriods.Validate(); err != nil {
+			return err
+		}
+		if !ga.VestingPeriods.TotalAmount().IsEqual(ga.OriginalVesting) {
+			return errors.New("vesting amount must be equal to the total amount of the vesting periods")
+		}
+		if ga.StartTime+ga.VestingPeriods.TotalLength() != ga.EndTime {
+			return errors.New("vesting end-time must be equal to the end of the last vesting period")
+		}
+	}
+
 	// don't allow blank (i.e just whitespaces) on the module name
 	if ga.ModuleName != "" && strings.TrimSpace(ga.ModuleName) == "" {
 		return errors.New("module account name cannot be blank")
@@ -100,6 +115,10 @@ func NewGenesisAccountI(acc authexported.Account) (GenesisAccount, error) {
 		gacc.DelegatedVesting = acc.GetDelegatedVesting()
 		gacc.StartTime = acc.GetStartTime()
 		gacc.EndTime = acc.GetEndTime()
+
+		if pva, ok := acc.(*authtypes.PeriodicVestingAccount); ok {
+			gacc.VestingPeriods = pva.GetVestingPeriods()
+		}
 	case supplyexported.ModuleAccountI:
 		gacc.ModuleName = acc.GetName()
 		gacc.ModulePermissions = acc.GetPermissions()
@@ -120,6 +139,8 @@ func (ga *GenesisAccount) ToAccount() authexported.Account {
 		)
 
 		switch {
+		case len(ga.VestingPeriods) > 0:
+			return authtypes.NewPeriodicVestingAccountRaw(baseVestingAcc, ga.StartTime, ga.VestingPeriods)
 		case ga.StartTime != 0 && ga.EndTime != 0:
 			return authtypes.NewContinuousVestingAccountRaw(baseVestingAcc, ga.StartTime)
 		case ga.EndTime != 0:
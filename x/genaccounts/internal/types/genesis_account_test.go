@@ -51,6 +51,49 @@ func TestGenesisAccountValidate(t *testing.T) {
 				sdk.NewCoins(sdk.NewInt64Coin("stake", 50)), 1654668078, 1554668078, "", ""),
 			errors.New("vesting start-time cannot be before end-time"),
 		},
+		{
+			"valid periodic vesting account",
+			GenesisAccount{
+				Address:         addr,
+				Coins:           sdk.NewCoins(sdk.NewInt64Coin("stake", 50)),
+				OriginalVesting: sdk.NewCoins(sdk.NewInt64Coin("stake", 50)),
+				StartTime:       1554668078,
+				EndTime:         1554668078 + 200,
+				VestingPeriods: authtypes.Periods{
+					authtypes.NewPeriod(100, sdk.NewCoins(sdk.NewInt64Coin("stake", 20))),
+					authtypes.NewPeriod(100, sdk.NewCoins(sdk.NewInt64Coin("stake", 30))),
+				},
+			},
+			nil,
+		},
+		{
+			"invalid periodic vesting amount",
+			GenesisAccount{
+				Address:         addr,
+				Coins:           sdk.NewCoins(sdk.NewInt64Coin("stake", 50)),
+				OriginalVesting: sdk.NewCoins(sdk.NewInt64Coin("stake", 50)),
+				StartTime:       1554668078,
+				EndTime:         1554668078 + 100,
+				VestingPeriods: authtypes.Periods{
+					authtypes.NewPeriod(100, sdk.NewCoins(sdk.NewInt64Coin("stake", 20))),
+				},
+			},
+			errors.New("vesting amount must be equal to the total amount of the vesting periods"),
+		},
+		{
+			"invalid periodic vesting end time",
+			GenesisAccount{
+				Address:         addr,
+				Coins:           sdk.NewCoins(sdk.NewInt64Coin("stake", 50)),
+				OriginalVesting: sdk.NewCoins(sdk.NewInt64Coin("stake", 50)),
+				StartTime:       1554668078,
+				EndTime:         1554668078 + 300,
+				VestingPeriods: authtypes.Periods{
+					authtypes.NewPeriod(100, sdk.NewCoins(sdk.NewInt64Coin("stake", 50))),
+				},
+			},
+			errors.New("vesting end-time must be equal to the end of the last vesting period"),
+		},
 		{
 			"invalid module account name",
 			NewGenesisAccountRaw(addr, sdk.NewCoins(), sdk.NewCoins(), 0, 0, " ", ""),
@@ -87,6 +130,19 @@ func TestToAccount(t *testing.T) {
 	require.IsType(t, &authtypes.ContinuousVestingAccount{}, acc)
 	require.Equal(t, vacc, acc.(*authtypes.ContinuousVestingAccount))
 
+	// periodic vesting account
+	periods := authtypes.Periods{
+		authtypes.NewPeriod(60*60*12, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 100))),
+		authtypes.NewPeriod(60*60*12, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 50))),
+	}
+	pvacc := authtypes.NewPeriodicVestingAccount(&authAcc, time.Now().Unix(), periods)
+	genAcc, err = NewGenesisAccountI(pvacc)
+	require.NoError(t, err)
+	require.NoError(t, genAcc.Validate())
+	acc = genAcc.ToAccount()
+	require.IsType(t, &authtypes.PeriodicVestingAccount{}, acc)
+	require.Equal(t, pvacc, acc.(*authtypes.PeriodicVestingAccount))
+
 	// module account
 	macc := supply.NewEmptyModuleAccount("mint", supply.Minter)
 	genAcc, err = NewGenesisAccountI(macc)
@@ -0,0 +1,26 @@
+package v038
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/x/genutil"
+	v038gov "github.com/cosmos/cosmos-sdk/x/gov/legacy/v0_38"
+)
+
+// Migrate migrates exported state from v0.36 or v0.37 to a v0.38 genesis state.
+func Migrate(appState genutil.AppMap) genutil.AppMap {
+	v036Codec := codec.New()
+	codec.RegisterCrypto(v036Codec)
+
+	v038Codec := codec.New()
+	codec.RegisterCrypto(v038Codec)
+
+	// migrate gov state
+	if appState[v038gov.ModuleName] != nil {
+		var govGenState v038gov.GenesisStateV036
+		v036Codec.MustUnmarshalJSON(appState[v038gov.ModuleName], &govGenState)
+
+		appState[v038gov.ModuleName] = v038Codec.MustMarshalJSON(v038gov.Migrate(govGenState))
+	}
+
+	return appState
+}
@@ -0,0 +1,141 @@
+package v038
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/genutil"
+	"github.com/cosmos/cosmos-sdk/x/gov"
+)
+
+var basic037Gov = []byte(`
+    {
+      "starting_proposal_id": "2",
+      "deposits": [
+        {
+          "proposal_id": "1",
+          "depositor": "cosmos1grgelyng2v6v3t8z87wu3sxgt9m5s03xvslewd",
+          "amount": [
+            {
+              "denom": "uatom",
+              "amount": "512000000"
+            }
+          ]
+        }
+      ],
+      "votes": [
+        {
+          "proposal_id": "1",
+          "voter": "cosmos1lktjhnzkpkz3ehrg8psvmwhafg56kfss5597tg",
+          "option": "Yes"
+        }
+      ],
+      "proposals": [
+        {
+          "content": {
+            "type": "cosmos-sdk/TextProposal",
+            "value": {
+              "title": "test",
+              "description": "test"
+            }
+          },
+          "id": "1",
+          "proposal_status": "Passed",
+          "final_tally_result": {
+            "yes": "1",
+            "abstain": "0",
+            "no": "0",
+            "no_with_veto": "0"
+          },
+          "submit_time": "2019-05-03T21:08:25.443199036Z",
+          "deposit_end_time": "2019-05-17T21:08:25.443199036Z",
+          "total_deposit": [
+            {
+              "denom": "uatom",
+              "amount": "512000000"
+            }
+          ],
+          "voting_start_time": "2019-05-04T16:02:33.24680295Z",
+          "voting_end_time": "2019-05-18T16:02:33.24680295Z"
+        }
+      ],
+      "deposit_params": {
+        "min_deposit": [
+          {
+            "denom": "uatom",
+            "amount": "512000000"
+          }
+        ],
+        "max_deposit_period": "1209600000000000"
+      },
+      "voting_params": {
+        "voting_period": "1209600000000000"
+      },
+      "tally_params": {
+        "quorum": "0.400000000000000000",
+        "threshold": "0.500000000000000000",
+        "veto": "0.334000000000000000"
+      }
+    }
+`)
+
+func TestDummyGenesis(t *testing.T) {
+	genesisDummy := genutil.AppMap{
+		"foo": {},
+		"bar": []byte(`{"custom": "module"}`),
+	}
+	migratedDummy := Migrate(genesisDummy)
+
+	// We should not touch custom modules in the map
+	require.Equal(t, genesisDummy["foo"], migratedDummy["foo"])
+	require.Equal(t, genesisDummy["bar"], migratedDummy["bar"])
+}
+
+func TestGovGenesis(t *testing.T) {
+	genesis := genutil.AppMap{
+		"gov": basic037Gov,
+	}
+
+	var migrated genutil.AppMap
+	require.NotPanics(t, func() { migrated = Migrate(genesis) })
+
+	var govGenState gov.GenesisState
+	gov.ModuleCdc.MustUnmarshalJSON(migrated["gov"], &govGenState)
+	require.NoError(t, gov.ValidateGenesis(govGenState))
+
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("uatom", 2560000000)), govGenState.DepositParams.ExpeditedMinDeposit)
+	require.Equal(t, gov.DefaultExpeditedPeriod, govGenState.VotingParams.ExpeditedVotingPeriod)
+	require.Equal(t, sdk.NewDecWithPrec(667, 3), govGenState.TallyParams.ExpeditedThreshold)
+
+	require.Len(t, govGenState.Proposals, 1)
+	require.Equal(t, "test", govGenState.Proposals[0].GetTitle())
+	require.Equal(t, gov.StatusPassed, govGenState.Proposals[0].Status)
+	require.Len(t, govGenState.Deposits, 1)
+	require.Len(t, govGenState.Votes, 1)
+}
+
+func TestGovGenesisShortVotingPeriod(t *testing.T) {
+	genesis := genutil.AppMap{
+		"gov": []byte(`{
+      "starting_proposal_id": "1",
+      "deposits": null,
+      "votes": null,
+      "proposals": null,
+      "deposit_params": {"min_deposit": [{"denom": "stake", "amount": "10"}], "max_deposit_period": "3600000000000"},
+      "voting_params": {"voting_period": "3600000000000"},
+      "tally_params": {"quorum": "0.334000000000000000", "threshold": "0.700000000000000000", "veto": "0.334000000000000000"}
+    }`),
+	}
+
+	migrated := Migrate(genesis)
+
+	var govGenState gov.GenesisState
+	gov.ModuleCdc.MustUnmarshalJSON(migrated["gov"], &govGenState)
+	require.NoError(t, gov.ValidateGenesis(govGenState))
+
+	require.Equal(t, 30*time.Minute, govGenState.VotingParams.ExpeditedVotingPeriod)
+	require.Equal(t, sdk.NewDecWithPrec(85, 2), govGenState.TallyParams.ExpeditedThreshold)
+}
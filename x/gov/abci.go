@@ -16,6 +16,9 @@ func EndBlocker(ctx sdk.Context, keeper Keeper) {
 		keeper.DeleteProposal(ctx, proposal.ProposalID)
 		keeper.DeleteDeposits(ctx, proposal.ProposalID)
 
+		// called when proposal become inactive
+		keeper.AfterProposalFailedMinDeposit(ctx, proposal.ProposalID)
+
 		ctx.EventManager().EmitEvent(
 			sdk.NewEvent(
 				types.EventTypeInactiveProposal,
@@ -39,7 +42,41 @@ func EndBlocker(ctx sdk.Context, keeper Keeper) {
 	keeper.IterateActiveProposalsQueue(ctx, ctx.BlockHeader().Time, func(proposal Proposal) bool {
 		var tagValue, logMsg string
 
-		passes, burnDeposits, tallyResults := keeper.Tally(ctx, proposal)
+		// Tallying deletes the votes, so it runs on a cache-wrapped context
+		// which is only written if the proposal isn't converted below.
+		tallyCtx, writeTally := ctx.CacheContext()
+		passes, burnDeposits, tallyResults := keeper.Tally(tallyCtx, proposal)
+
+		keeper.RemoveFromActiveProposalQueue(ctx, proposal.ProposalID, proposal.VotingEndTime)
+
+		// An expedited proposal which doesn't pass is converted to a regular
+		// proposal: it keeps its votes and deposits, and its voting period is
+		// extended to the regular one.
+		if proposal.Expedited && !passes {
+			proposal.Expedited = false
+			proposal.VotingEndTime = proposal.VotingStartTime.Add(keeper.GetVotingParams(ctx).VotingPeriod)
+
+			keeper.SetProposal(ctx, proposal)
+			keeper.InsertActiveProposalQueue(ctx, proposal.ProposalID, proposal.VotingEndTime)
+
+			logger.Info(
+				fmt.Sprintf(
+					"expedited proposal %d (%s) tallied; result: converted to a regular proposal ending at %s",
+					proposal.ProposalID, proposal.GetTitle(), proposal.VotingEndTime,
+				),
+			)
+
+			ctx.EventManager().EmitEvent(
+				sdk.NewEvent(
+					types.EventTypeActiveProposal,
+					sdk.NewAttribute(types.AttributeKeyProposalID, fmt.Sprintf("%d", proposal.ProposalID)),
+					sdk.NewAttribute(types.AttributeKeyProposalResult, types.AttributeValueExpeditedProposalRejected),
+				),
+			)
+			return false
+		}
+
+		writeTally()
 
 		if burnDeposits {
 			keeper.DeleteDeposits(ctx, proposal.ProposalID)
@@ -48,22 +85,26 @@ func EndBlocker(ctx sdk.Context, keeper Keeper) {
 		}
 
 		if passes {
-			handler := keeper.Router().GetRoute(proposal.ProposalRoute())
 			cacheCtx, writeCache := ctx.CacheContext()
 
-			// The proposal handler may execute state mutating logic depending
-			// on the proposal content. If the handler fails, no state mutation
-			// is written and the error message is logged.
-			err := handler(cacheCtx, proposal.Content)
+			// The proposal execution may run state mutating logic depending
+			// on the proposal content. If the execution fails, no state
+			// mutation is written and the error message is logged and recorded
+			// on the proposal.
+			execLog, err := keeper.ExecuteProposal(cacheCtx, proposal.Content)
 			if err == nil {
 				proposal.Status = StatusPassed
+				proposal.ExecutionLog = execLog
 				tagValue = types.AttributeValueProposalPassed
 				logMsg = "passed"
 
-				// write state to the underlying multi-store
+				// write state to the underlying multi-store, along with the
+				// events of the execution
 				writeCache()
+				ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
 			} else {
 				proposal.Status = StatusFailed
+				proposal.ExecutionLog = err.ABCILog()
 				tagValue = types.AttributeValueProposalFailed
 				logMsg = fmt.Sprintf("passed, but failed on execution: %s", err.ABCILog())
 			}
@@ -76,7 +117,9 @@ func EndBlocker(ctx sdk.Context, keeper Keeper) {
 		proposal.FinalTallyResult = tallyResults
 
 		keeper.SetProposal(ctx, proposal)
-		keeper.RemoveFromActiveProposalQueue(ctx, proposal.ProposalID, proposal.VotingEndTime)
+
+		// called when proposal voting period ends
+		keeper.AfterProposalVotingPeriodEnded(ctx, proposal.ProposalID)
 
 		logger.Info(
 			fmt.Sprintf(
@@ -10,6 +10,7 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	keep "github.com/cosmos/cosmos-sdk/x/gov/keeper"
+	"github.com/cosmos/cosmos-sdk/x/gov/types"
 	"github.com/cosmos/cosmos-sdk/x/staking"
 )
 
@@ -325,3 +326,158 @@ func TestEndBlockerProposalHandlerFailed(t *testing.T) {
 	// validate that the proposal fails/has been rejected
 	EndBlocker(ctx, input.keeper)
 }
+
+func TestExecutableProposalEndBlocker(t *testing.T) {
+	input := getMockApp(t, 1, GenesisState{}, nil, ProposalHandler)
+	SortAddresses(input.addrs)
+
+	handler := NewHandler(input.keeper)
+	stakingHandler := staking.NewHandler(input.sk)
+
+	header := abci.Header{Height: input.mApp.LastBlockHeight() + 1}
+	input.mApp.BeginBlock(abci.RequestBeginBlock{Header: header})
+	ctx := input.mApp.BaseApp.NewContext(false, abci.Header{})
+
+	valAddr := sdk.ValAddress(input.addrs[0])
+
+	createValidators(t, stakingHandler, ctx, []sdk.ValAddress{valAddr}, []int64{10})
+	staking.EndBlocker(ctx, input.sk)
+
+	proposalCoins := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.TokensFromConsensusPower(10)))
+	activate := func(ctx sdk.Context, content Content) uint64 {
+		proposal, err := input.keeper.SubmitProposal(ctx, content)
+		require.NoError(t, err)
+
+		res := handler(ctx, NewMsgDeposit(input.addrs[0], proposal.ProposalID, proposalCoins))
+		require.True(t, res.IsOK())
+
+		return proposal.ProposalID
+	}
+
+	// the governance account votes on a text proposal ending after the
+	// executable proposals, the second executable proposal fails on its second
+	// vote on an unknown proposal
+	govAddr := input.keeper.GetGovernanceAccount(ctx).GetAddress()
+	nextID, err := input.keeper.GetProposalID(ctx)
+	require.NoError(t, err)
+	textID := nextID + 2
+
+	passingID := activate(ctx, NewExecutableProposal("Test", "description", []sdk.Msg{
+		NewMsgVote(govAddr, textID, OptionNo),
+	}))
+	failingID := activate(ctx, NewExecutableProposal("Test", "description", []sdk.Msg{
+		NewMsgVote(govAddr, textID, OptionNoWithVeto),
+		NewMsgVote(govAddr, textID+1, OptionYes),
+	}))
+
+	textHeader := ctx.BlockHeader()
+	textHeader.Time = ctx.BlockHeader().Time.Add(time.Second)
+	require.Equal(t, textID, activate(ctx.WithBlockHeader(textHeader), keep.TestProposal))
+
+	for _, id := range []uint64{passingID, failingID} {
+		require.NoError(t, input.keeper.AddVote(ctx, id, input.addrs[0], OptionYes))
+	}
+
+	newHeader := ctx.BlockHeader()
+	newHeader.Time = ctx.BlockHeader().Time.Add(input.keeper.GetVotingParams(ctx).VotingPeriod)
+	ctx = ctx.WithBlockHeader(newHeader).WithEventManager(sdk.NewEventManager())
+
+	EndBlocker(ctx, input.keeper)
+
+	passing, ok := input.keeper.GetProposal(ctx, passingID)
+	require.True(t, ok)
+	require.Equal(t, StatusPassed, passing.Status)
+
+	logs, parseErr := sdk.ParseABCILogs(passing.ExecutionLog)
+	require.NoError(t, parseErr)
+	require.Len(t, logs, 1)
+
+	failing, ok := input.keeper.GetProposal(ctx, failingID)
+	require.True(t, ok)
+	require.Equal(t, StatusFailed, failing.Status)
+	require.Contains(t, failing.ExecutionLog, "message 1 failed on execution")
+
+	// only the messages of the passing proposal are applied, along with their
+	// events
+	vote, ok := input.keeper.GetVote(ctx, textID, govAddr)
+	require.True(t, ok)
+	require.Equal(t, OptionNo, vote.Option)
+
+	var voteEvents int
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type == types.EventTypeProposalVote {
+			voteEvents++
+		}
+	}
+	require.Equal(t, 1, voteEvents)
+}
+
+func TestExpeditedProposalEndBlocker(t *testing.T) {
+	input := getMockApp(t, 2, GenesisState{}, nil, ProposalHandler)
+	SortAddresses(input.addrs)
+
+	handler := NewHandler(input.keeper)
+	stakingHandler := staking.NewHandler(input.sk)
+
+	header := abci.Header{Height: input.mApp.LastBlockHeight() + 1}
+	input.mApp.BeginBlock(abci.RequestBeginBlock{Header: header})
+	ctx := input.mApp.BaseApp.NewContext(false, abci.Header{})
+
+	valAddrs := []sdk.ValAddress{sdk.ValAddress(input.addrs[0]), sdk.ValAddress(input.addrs[1])}
+	createValidators(t, stakingHandler, ctx, valAddrs, []int64{5, 6})
+	staking.EndBlocker(ctx, input.sk)
+
+	depositParams := input.keeper.GetDepositParams(ctx)
+	depositParams.ExpeditedMinDeposit = sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.TokensFromConsensusPower(20)))
+	input.keeper.SetDepositParams(ctx, depositParams)
+	votingParams := input.keeper.GetVotingParams(ctx)
+
+	submitExpedited := func(proposer sdk.AccAddress) uint64 {
+		msg := NewMsgSubmitExpeditedProposal(keep.TestProposal, depositParams.ExpeditedMinDeposit, proposer)
+		res := handler(ctx, msg)
+		require.True(t, res.IsOK())
+		return GetProposalIDFromBytes(res.Data)
+	}
+
+	// an expedited proposal passing the expedited threshold
+	passingID := submitExpedited(input.addrs[0])
+	require.NoError(t, input.keeper.AddVote(ctx, passingID, input.addrs[0], OptionYes))
+	require.NoError(t, input.keeper.AddVote(ctx, passingID, input.addrs[1], OptionYes))
+
+	// an expedited proposal only passing the regular threshold
+	convertedID := submitExpedited(input.addrs[1])
+	require.NoError(t, input.keeper.AddVote(ctx, convertedID, input.addrs[0], OptionNo))
+	require.NoError(t, input.keeper.AddVote(ctx, convertedID, input.addrs[1], OptionYes))
+
+	startTime := ctx.BlockHeader().Time
+	ctx = ctx.WithBlockTime(startTime.Add(votingParams.ExpeditedVotingPeriod))
+	EndBlocker(ctx, input.keeper)
+
+	proposal, ok := input.keeper.GetProposal(ctx, passingID)
+	require.True(t, ok)
+	require.Equal(t, StatusPassed, proposal.Status)
+
+	proposal, ok = input.keeper.GetProposal(ctx, convertedID)
+	require.True(t, ok)
+	require.Equal(t, StatusVotingPeriod, proposal.Status)
+	require.False(t, proposal.Expedited)
+	require.True(t, proposal.VotingEndTime.Equal(startTime.Add(votingParams.VotingPeriod)))
+	require.True(t, proposal.TotalDeposit.IsEqual(depositParams.ExpeditedMinDeposit))
+
+	require.Len(t, input.keeper.GetDeposits(ctx, convertedID), 1)
+	require.Len(t, input.keeper.GetVotes(ctx, convertedID), 2)
+
+	activeQueue := input.keeper.ActiveProposalQueueIterator(ctx, ctx.BlockHeader().Time)
+	require.False(t, activeQueue.Valid())
+	activeQueue.Close()
+
+	// the converted proposal is tallied with the regular threshold at the end
+	// of the regular voting period
+	ctx = ctx.WithBlockTime(startTime.Add(votingParams.VotingPeriod))
+	EndBlocker(ctx, input.keeper)
+
+	proposal, ok = input.keeper.GetProposal(ctx, convertedID)
+	require.True(t, ok)
+	require.Equal(t, StatusPassed, proposal.Status)
+	require.Empty(t, input.keeper.GetDeposits(ctx, convertedID))
+}
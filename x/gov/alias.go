@@ -25,7 +25,10 @@ const (
 	CodeInvalidGenesis           = types.CodeInvalidGenesis
 	CodeInvalidProposalStatus    = types.CodeInvalidProposalStatus
 	CodeProposalHandlerNotExists = types.CodeProposalHandlerNotExists
+	CodeExecutionFailed          = types.CodeExecutionFailed
+	CodeExpeditedParamsNotSet    = types.CodeExpeditedParamsNotSet
 	DefaultPeriod                = types.DefaultPeriod
+	DefaultExpeditedPeriod       = types.DefaultExpeditedPeriod
 	ModuleName                   = types.ModuleName
 	StoreKey                     = types.StoreKey
 	RouterKey                    = types.RouterKey
@@ -33,6 +36,7 @@ const (
 	DefaultParamspace            = types.DefaultParamspace
 	TypeMsgDeposit               = types.TypeMsgDeposit
 	TypeMsgVote                  = types.TypeMsgVote
+	TypeMsgVoteWeighted          = types.TypeMsgVoteWeighted
 	TypeMsgSubmitProposal        = types.TypeMsgSubmitProposal
 	StatusNil                    = types.StatusNil
 	StatusDepositPeriod          = types.StatusDepositPeriod
@@ -41,7 +45,7 @@ const (
 	StatusRejected               = types.StatusRejected
 	StatusFailed                 = types.StatusFailed
 	ProposalTypeText             = types.ProposalTypeText
-	ProposalTypeSoftwareUpgrade  = types.ProposalTypeSoftwareUpgrade
+	ProposalTypeExecutable       = types.ProposalTypeExecutable
 	QueryParams                  = types.QueryParams
 	QueryProposals               = types.QueryProposals
 	QueryProposal                = types.QueryProposal
@@ -68,6 +72,7 @@ var (
 	NewKeeper                     = keeper.NewKeeper
 	NewQuerier                    = keeper.NewQuerier
 	RegisterCodec                 = types.RegisterCodec
+	NewAminoCodec                 = types.NewAminoCodec
 	RegisterProposalTypeCodec     = types.RegisterProposalTypeCodec
 	ValidateAbstract              = types.ValidateAbstract
 	NewDeposit                    = types.NewDeposit
@@ -79,6 +84,8 @@ var (
 	ErrInvalidVote                = types.ErrInvalidVote
 	ErrInvalidGenesis             = types.ErrInvalidGenesis
 	ErrNoProposalHandlerExists    = types.ErrNoProposalHandlerExists
+	ErrExecutionFailed            = types.ErrExecutionFailed
+	ErrExpeditedParamsNotSet      = types.ErrExpeditedParamsNotSet
 	NewGenesisState               = types.NewGenesisState
 	DefaultGenesisState           = types.DefaultGenesisState
 	ValidateGenesis               = types.ValidateGenesis
@@ -99,8 +106,10 @@ var (
 	SplitKeyDeposit               = types.SplitKeyDeposit
 	SplitKeyVote                  = types.SplitKeyVote
 	NewMsgSubmitProposal          = types.NewMsgSubmitProposal
+	NewMsgSubmitExpeditedProposal = types.NewMsgSubmitExpeditedProposal
 	NewMsgDeposit                 = types.NewMsgDeposit
 	NewMsgVote                    = types.NewMsgVote
+	NewMsgVoteWeighted            = types.NewMsgVoteWeighted
 	ParamKeyTable                 = types.ParamKeyTable
 	NewDepositParams              = types.NewDepositParams
 	NewTallyParams                = types.NewTallyParams
@@ -111,7 +120,7 @@ var (
 	ProposalStatusFromString      = types.ProposalStatusFromString
 	ValidProposalStatus           = types.ValidProposalStatus
 	NewTextProposal               = types.NewTextProposal
-	NewSoftwareUpgradeProposal    = types.NewSoftwareUpgradeProposal
+	NewExecutableProposal         = types.NewExecutableProposal
 	RegisterProposalType          = types.RegisterProposalType
 	ContentFromProposalType       = types.ContentFromProposalType
 	IsValidProposalType           = types.IsValidProposalType
@@ -127,6 +136,13 @@ var (
 	NewVote                       = types.NewVote
 	VoteOptionFromString          = types.VoteOptionFromString
 	ValidVoteOption               = types.ValidVoteOption
+	NewWeightedVote               = types.NewWeightedVote
+	NewWeightedVoteOption         = types.NewWeightedVoteOption
+	NewNonSplitVoteOption         = types.NewNonSplitVoteOption
+	WeightedVoteOptionsFromString = types.WeightedVoteOptionsFromString
+	ValidWeightedVoteOptions      = types.ValidWeightedVoteOptions
+	ErrInvalidWeightedVote        = types.ErrInvalidWeightedVote
+	NewMultiGovHooks              = types.NewMultiGovHooks
 
 	// variable aliases
 	ModuleCdc                   = types.ModuleCdc
@@ -142,32 +158,39 @@ var (
 )
 
 type (
-	Keeper                  = keeper.Keeper
-	Content                 = types.Content
-	Handler                 = types.Handler
-	Deposit                 = types.Deposit
-	Deposits                = types.Deposits
-	GenesisState            = types.GenesisState
-	MsgSubmitProposal       = types.MsgSubmitProposal
-	MsgDeposit              = types.MsgDeposit
-	MsgVote                 = types.MsgVote
-	DepositParams           = types.DepositParams
-	TallyParams             = types.TallyParams
-	VotingParams            = types.VotingParams
-	Params                  = types.Params
-	Proposal                = types.Proposal
-	Proposals               = types.Proposals
-	ProposalQueue           = types.ProposalQueue
-	ProposalStatus          = types.ProposalStatus
-	TextProposal            = types.TextProposal
-	SoftwareUpgradeProposal = types.SoftwareUpgradeProposal
-	QueryProposalParams     = types.QueryProposalParams
-	QueryDepositParams      = types.QueryDepositParams
-	QueryVoteParams         = types.QueryVoteParams
-	QueryProposalsParams    = types.QueryProposalsParams
-	ValidatorGovInfo        = types.ValidatorGovInfo
-	TallyResult             = types.TallyResult
-	Vote                    = types.Vote
-	Votes                   = types.Votes
-	VoteOption              = types.VoteOption
+	Keeper               = keeper.Keeper
+	Content              = types.Content
+	Handler              = types.Handler
+	Deposit              = types.Deposit
+	Deposits             = types.Deposits
+	GenesisState         = types.GenesisState
+	MsgSubmitProposal    = types.MsgSubmitProposal
+	MsgDeposit           = types.MsgDeposit
+	MsgVote              = types.MsgVote
+	MsgVoteWeighted      = types.MsgVoteWeighted
+	DepositParams        = types.DepositParams
+	TallyParams          = types.TallyParams
+	VotingParams         = types.VotingParams
+	Params               = types.Params
+	Proposal             = types.Proposal
+	Proposals            = types.Proposals
+	ProposalQueue        = types.ProposalQueue
+	ProposalStatus       = types.ProposalStatus
+	TextProposal         = types.TextProposal
+	ExecutableProposal   = types.ExecutableProposal
+	QueryProposalParams  = types.QueryProposalParams
+	QueryDepositParams   = types.QueryDepositParams
+	QueryVoteParams      = types.QueryVoteParams
+	QueryProposalsParams = types.QueryProposalsParams
+	ValidatorGovInfo     = types.ValidatorGovInfo
+	TallyResult          = types.TallyResult
+	Vote                 = types.Vote
+	Votes                = types.Votes
+	VoteOption           = types.VoteOption
+	WeightedVoteOption   = types.WeightedVoteOption
+	WeightedVoteOptions  = types.WeightedVoteOptions
+	Codec                = types.Codec
+	AminoCodec           = types.AminoCodec
+	ProposalBase         = types.ProposalBase
+	MultiGovHooks        = types.MultiGovHooks
 )
@@ -7,9 +7,20 @@ import (
 
 	"github.com/spf13/viper"
 
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	govutils "github.com/cosmos/cosmos-sdk/x/gov/client/utils"
 )
 
+// ExecutableProposalJSON defines an ExecutableProposal with a deposit
+type ExecutableProposalJSON struct {
+	Title       string    `json:"title" yaml:"title"`
+	Description string    `json:"description" yaml:"description"`
+	Msgs        []sdk.Msg `json:"msgs" yaml:"msgs"`
+	Deposit     sdk.Coins `json:"deposit" yaml:"deposit"`
+	Expedited   bool      `json:"expedited" yaml:"expedited"`
+}
+
 func parseSubmitProposalFlags() (*proposal, error) {
 	proposal := &proposal{}
 	proposalFile := viper.GetString(FlagProposal)
@@ -19,6 +30,7 @@ func parseSubmitProposalFlags() (*proposal, error) {
 		proposal.Description = viper.GetString(FlagDescription)
 		proposal.Type = govutils.NormalizeProposalType(viper.GetString(flagProposalType))
 		proposal.Deposit = viper.GetString(FlagDeposit)
+		proposal.Expedited = viper.GetBool(FlagExpedited)
 		return proposal, nil
 	}
 
@@ -28,6 +40,10 @@ func parseSubmitProposalFlags() (*proposal, error) {
 		}
 	}
 
+	if viper.GetBool(FlagExpedited) {
+		return nil, fmt.Errorf("--%s flag provided alongside --proposal, which is a noop", FlagExpedited)
+	}
+
 	contents, err := ioutil.ReadFile(proposalFile)
 	if err != nil {
 		return nil, err
@@ -40,3 +56,20 @@ func parseSubmitProposalFlags() (*proposal, error) {
 
 	return proposal, nil
 }
+
+// ParseExecutableProposalJSON reads and parses an ExecutableProposalJSON from a
+// file. The codec must be aware of all the messages of the application.
+func ParseExecutableProposalJSON(cdc *codec.Codec, proposalFile string) (ExecutableProposalJSON, error) {
+	proposal := ExecutableProposalJSON{}
+
+	contents, err := ioutil.ReadFile(proposalFile)
+	if err != nil {
+		return proposal, err
+	}
+
+	if err := cdc.UnmarshalJSON(contents, &proposal); err != nil {
+		return proposal, err
+	}
+
+	return proposal, nil
+}
@@ -29,6 +29,7 @@ const (
 	flagStatus       = "status"
 	flagNumLimit     = "limit"
 	FlagProposal     = "proposal"
+	FlagExpedited    = "expedited"
 )
 
 type proposal struct {
@@ -36,6 +37,7 @@ type proposal struct {
 	Description string
 	Type        string
 	Deposit     string
+	Expedited   bool
 }
 
 // ProposalFlags defines the core required fields of a proposal. It is used to
@@ -63,6 +65,7 @@ func GetTxCmd(storeKey string, cdc *codec.Codec, pcmds []*cobra.Command) *cobra.
 	}
 
 	cmdSubmitProp := GetCmdSubmitProposal(cdc)
+	cmdSubmitProp.AddCommand(client.PostCommands(GetCmdSubmitExecutableProposal(cdc))[0])
 	for _, pcmd := range pcmds {
 		cmdSubmitProp.AddCommand(client.PostCommands(pcmd)[0])
 	}
@@ -70,6 +73,7 @@ func GetTxCmd(storeKey string, cdc *codec.Codec, pcmds []*cobra.Command) *cobra.
 	govTxCmd.AddCommand(client.PostCommands(
 		GetCmdDeposit(cdc),
 		GetCmdVote(cdc),
+		GetCmdWeightedVote(cdc),
 		cmdSubmitProp,
 	)...)
 
@@ -84,6 +88,8 @@ func GetCmdSubmitProposal(cdc *codec.Codec) *cobra.Command {
 		Long: strings.TrimSpace(
 			fmt.Sprintf(`Submit a proposal along with an initial deposit.
 Proposal title, description, type and deposit can be given directly or through a proposal JSON file.
+An expedited proposal requires a higher deposit and threshold, but has a shorter voting period. If it
+doesn't pass by the end of its voting period, it is converted to a regular proposal.
 
 Example:
 $ %s tx gov submit-proposal --proposal="path/to/proposal.json" --from mykey
@@ -94,12 +100,13 @@ Where proposal.json contains:
   "title": "Test Proposal",
   "description": "My awesome proposal",
   "type": "Text",
-  "deposit": "10test"
+  "deposit": "10test",
+  "expedited": false
 }
 
 Which is equivalent to:
 
-$ %s tx gov submit-proposal --title="Test Proposal" --description="My awesome proposal" --type="Text" --deposit="10test" --from mykey
+$ %s tx gov submit-proposal --title="Test Proposal" --description="My awesome proposal" --type="Text" --deposit="10test" --expedited=false --from mykey
 `,
 				version.ClientName, version.ClientName,
 			),
@@ -121,6 +128,7 @@ $ %s tx gov submit-proposal --title="Test Proposal" --description="My awesome pr
 			content := types.ContentFromProposalType(proposal.Title, proposal.Description, proposal.Type)
 
 			msg := types.NewMsgSubmitProposal(content, amount, cliCtx.GetFromAddress())
+			msg.Expedited = proposal.Expedited
 			if err := msg.ValidateBasic(); err != nil {
 				return err
 			}
@@ -131,13 +139,83 @@ $ %s tx gov submit-proposal --title="Test Proposal" --description="My awesome pr
 
 	cmd.Flags().String(FlagTitle, "", "title of proposal")
 	cmd.Flags().String(FlagDescription, "", "description of proposal")
-	cmd.Flags().String(flagProposalType, "", "proposalType of proposal, types: text/parameter_change/software_upgrade")
+	cmd.Flags().String(flagProposalType, "", "proposalType of proposal, types: text/parameter_change")
 	cmd.Flags().String(FlagDeposit, "", "deposit of proposal")
+	cmd.Flags().Bool(FlagExpedited, false, "whether the proposal is expedited")
 	cmd.Flags().String(FlagProposal, "", "proposal file path (if this path is given, other proposal flags are ignored)")
 
 	return cmd
 }
 
+// GetCmdSubmitExecutableProposal implements submitting an executable proposal
+// transaction command.
+func GetCmdSubmitExecutableProposal(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "executable [proposal-file]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Submit a proposal executing messages once passed",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Submit a proposal executing the given messages once passed, along with an
+initial deposit. The messages must be signed by the governance module account
+only, and are executed atomically. The proposal details must be supplied via a
+JSON file, which may set "expedited" to true to submit an expedited proposal.
+
+Example:
+$ %s tx gov submit-proposal executable <path/to/proposal.json> --from=<key_or_address>
+
+Where proposal.json contains:
+
+{
+  "title": "Treasury Transfer",
+  "description": "Send some Atoms from the governance account",
+  "msgs": [
+    {
+      "type": "cosmos-sdk/MsgSend",
+      "value": {
+        "from_address": "cosmos10d07y265gmmuvt4z0w9aw880jnsr700j6zn9kn",
+        "to_address": "cosmos1s5afhd6gxevu37mkqcvvsj8qeylhn0rz46zdlq",
+        "amount": [
+          {
+            "denom": "stake",
+            "amount": "10000"
+          }
+        ]
+      }
+    }
+  ],
+  "deposit": [
+    {
+      "denom": "stake",
+      "amount": "10000"
+    }
+  ]
+}
+`,
+				version.ClientName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			txBldr := auth.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			proposal, err := ParseExecutableProposalJSON(cdc, args[0])
+			if err != nil {
+				return err
+			}
+
+			content := types.NewExecutableProposal(proposal.Title, proposal.Description, proposal.Msgs)
+
+			msg := types.NewMsgSubmitProposal(content, proposal.Deposit, cliCtx.GetFromAddress())
+			msg.Expedited = proposal.Expedited
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
+
 // GetCmdDeposit implements depositing tokens for an active proposal.
 func GetCmdDeposit(cdc *codec.Codec) *cobra.Command {
 	return &cobra.Command{
@@ -232,4 +310,53 @@ $ %s tx gov vote 1 yes --from mykey
 	}
 }
 
+// GetCmdWeightedVote implements creating a new weighted vote command.
+func GetCmdWeightedVote(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "weighted-vote [proposal-id] [weighted-options]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Vote for an active proposal, splitting the voting power between options: yes/no/no_with_veto/abstain",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Submit a vote for an active proposal, splitting the voting power
+between several options. The weights of the options must sum to 1. You can
+find the proposal-id by running "%s query gov proposals".
+
+
+Example:
+$ %s tx gov weighted-vote 1 yes=0.6,no=0.3,abstain=0.1 --from mykey
+`,
+				version.ClientName, version.ClientName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			txBldr := auth.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			// Get voting address
+			from := cliCtx.GetFromAddress()
+
+			// validate that the proposal id is a uint
+			proposalID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("proposal-id %s not a valid int, please input a valid proposal-id", args[0])
+			}
+
+			// Find out which vote options user chose
+			options, err := types.WeightedVoteOptionsFromString(govutils.NormalizeWeightedVoteOptions(args[1]))
+			if err != nil {
+				return err
+			}
+
+			// Build vote message and run basic validation
+			msg := types.NewMsgVoteWeighted(from, proposalID, options)
+			err = msg.ValidateBasic()
+			if err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
+
 // DONTCOVER
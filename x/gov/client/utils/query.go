@@ -80,33 +80,14 @@ func QueryDepositsByTxQuery(cliCtx context.CLIContext, params types.QueryProposa
 // support configurable pagination.
 func QueryVotesByTxQuery(cliCtx context.CLIContext, params types.QueryProposalParams) ([]byte, error) {
 	events := []string{
-		fmt.Sprintf("%s.%s='%s'", sdk.EventTypeMessage, sdk.AttributeKeyAction, types.TypeMsgVote),
 		fmt.Sprintf("%s.%s='%s'", types.EventTypeProposalVote, types.AttributeKeyProposalID, []byte(fmt.Sprintf("%d", params.ProposalID))),
 	}
 
-	// NOTE: SearchTxs is used to facilitate the txs query which does not currently
-	// support configurable pagination.
-	searchResult, err := utils.QueryTxsByEvents(cliCtx, events, defaultPage, defaultLimit)
+	votes, err := queryVotesByEvents(cliCtx, events, params.ProposalID)
 	if err != nil {
 		return nil, err
 	}
 
-	var votes []types.Vote
-
-	for _, info := range searchResult.Txs {
-		for _, msg := range info.Tx.GetMsgs() {
-			if msg.Type() == types.TypeMsgVote {
-				voteMsg := msg.(types.MsgVote)
-
-				votes = append(votes, types.Vote{
-					Voter:      voteMsg.Voter,
-					ProposalID: params.ProposalID,
-					Option:     voteMsg.Option,
-				})
-			}
-		}
-	}
-
 	if cliCtx.Indent {
 		return cliCtx.Codec.MarshalJSONIndent(votes, "", "  ")
 	}
@@ -117,40 +98,66 @@ func QueryVotesByTxQuery(cliCtx context.CLIContext, params types.QueryProposalPa
 // QueryVoteByTxQuery will query for a single vote via a direct txs tags query.
 func QueryVoteByTxQuery(cliCtx context.CLIContext, params types.QueryVoteParams) ([]byte, error) {
 	events := []string{
-		fmt.Sprintf("%s.%s='%s'", sdk.EventTypeMessage, sdk.AttributeKeyAction, types.TypeMsgVote),
 		fmt.Sprintf("%s.%s='%s'", types.EventTypeProposalVote, types.AttributeKeyProposalID, []byte(fmt.Sprintf("%d", params.ProposalID))),
 		fmt.Sprintf("%s.%s='%s'", sdk.EventTypeMessage, sdk.AttributeKeySender, []byte(params.Voter.String())),
 	}
 
-	// NOTE: SearchTxs is used to facilitate the txs query which does not currently
-	// support configurable pagination.
-	searchResult, err := utils.QueryTxsByEvents(cliCtx, events, defaultPage, defaultLimit)
+	votes, err := queryVotesByEvents(cliCtx, events, params.ProposalID)
 	if err != nil {
 		return nil, err
 	}
 
-	for _, info := range searchResult.Txs {
-		for _, msg := range info.Tx.GetMsgs() {
-			// there should only be a single vote under the given conditions
-			if msg.Type() == types.TypeMsgVote {
-				voteMsg := msg.(types.MsgVote)
+	// there should only be a single vote under the given conditions
+	for _, vote := range votes {
+		if !vote.Voter.Equals(params.Voter) {
+			continue
+		}
 
-				vote := types.Vote{
-					Voter:      voteMsg.Voter,
-					ProposalID: params.ProposalID,
-					Option:     voteMsg.Option,
-				}
+		if cliCtx.Indent {
+			return cliCtx.Codec.MarshalJSONIndent(vote, "", "  ")
+		}
 
-				if cliCtx.Indent {
-					return cliCtx.Codec.MarshalJSONIndent(vote, "", "  ")
-				}
+		return cliCtx.Codec.MarshalJSON(vote)
+	}
+
+	return nil, fmt.Errorf("address '%s' did not vote on proposalID %d", params.Voter, params.ProposalID)
+}
+
+// queryVotesByEvents builds the votes of the MsgVote and MsgVoteWeighted
+// messages of the txs matching the given events.
+func queryVotesByEvents(cliCtx context.CLIContext, events []string, proposalID uint64) ([]types.Vote, error) {
+	var votes []types.Vote
+
+	for _, msgType := range []string{types.TypeMsgVote, types.TypeMsgVoteWeighted} {
+		msgEvents := append([]string{
+			fmt.Sprintf("%s.%s='%s'", sdk.EventTypeMessage, sdk.AttributeKeyAction, msgType),
+		}, events...)
 
-				return cliCtx.Codec.MarshalJSON(vote)
+		// NOTE: SearchTxs is used to facilitate the txs query which does not currently
+		// support configurable pagination.
+		searchResult, err := utils.QueryTxsByEvents(cliCtx, msgEvents, defaultPage, defaultLimit)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, info := range searchResult.Txs {
+			for _, msg := range info.Tx.GetMsgs() {
+				switch msg := msg.(type) {
+				case types.MsgVote:
+					if msg.ProposalID == proposalID {
+						votes = append(votes, types.NewVote(proposalID, msg.Voter, msg.Option))
+					}
+
+				case types.MsgVoteWeighted:
+					if msg.ProposalID == proposalID {
+						votes = append(votes, types.NewWeightedVote(proposalID, msg.Voter, msg.Options))
+					}
+				}
 			}
 		}
 	}
 
-	return nil, fmt.Errorf("address '%s' did not vote on proposalID %d", params.Voter, params.ProposalID)
+	return votes, nil
 }
 
 // QueryDepositByTxQuery will query for a single deposit via a direct txs tags
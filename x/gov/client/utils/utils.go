@@ -1,6 +1,10 @@
 package utils
 
-import "github.com/cosmos/cosmos-sdk/x/gov/types"
+import (
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/x/gov/types"
+)
 
 // NormalizeVoteOption - normalize user specified vote option
 func NormalizeVoteOption(option string) string {
@@ -22,21 +26,31 @@ func NormalizeVoteOption(option string) string {
 	}
 }
 
-//NormalizeProposalType - normalize user specified proposal type
+// NormalizeWeightedVoteOptions - normalize user specified weighted vote options,
+// e.g. yes=0.6,no=0.4
+func NormalizeWeightedVoteOptions(options string) string {
+	newOptions := strings.Split(options, ",")
+	for i, option := range newOptions {
+		fields := strings.Split(strings.TrimSpace(option), "=")
+		fields[0] = NormalizeVoteOption(fields[0])
+		newOptions[i] = strings.Join(fields, "=")
+	}
+
+	return strings.Join(newOptions, ",")
+}
+
+// NormalizeProposalType - normalize user specified proposal type
 func NormalizeProposalType(proposalType string) string {
 	switch proposalType {
 	case "Text", "text":
 		return types.ProposalTypeText
 
-	case "SoftwareUpgrade", "software_upgrade":
-		return types.ProposalTypeSoftwareUpgrade
-
 	default:
 		return ""
 	}
 }
 
-//NormalizeProposalStatus - normalize user specified proposal status
+// NormalizeProposalStatus - normalize user specified proposal status
 func NormalizeProposalStatus(status string) string {
 	switch status {
 	case "DepositPeriod", "deposit_period":
@@ -3,6 +3,7 @@ package gov
 import (
 	"testing"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	keep "github.com/cosmos/cosmos-sdk/x/gov/keeper"
 	"github.com/stretchr/testify/require"
 
@@ -33,6 +34,13 @@ func TestImportExportQueues(t *testing.T) {
 	require.NoError(t, err)
 	require.True(t, votingStarted)
 
+	// Split the vote of the depositor on the second proposal
+	options := WeightedVoteOptions{
+		NewWeightedVoteOption(OptionYes, sdk.NewDecWithPrec(7, 1)),
+		NewWeightedVoteOption(OptionNo, sdk.NewDecWithPrec(3, 1)),
+	}
+	require.NoError(t, input.keeper.AddWeightedVote(ctx, proposalID2, input.addrs[0], options))
+
 	proposal1, ok := input.keeper.GetProposal(ctx, proposalID1)
 	require.True(t, ok)
 	proposal2, ok = input.keeper.GetProposal(ctx, proposalID2)
@@ -64,6 +72,10 @@ func TestImportExportQueues(t *testing.T) {
 
 	require.Equal(t, input2.keeper.GetDepositParams(ctx2).MinDeposit, input2.keeper.GetGovernanceAccount(ctx2).GetCoins())
 
+	vote, found := input2.keeper.GetVote(ctx2, proposalID2, input.addrs[0])
+	require.True(t, found)
+	require.True(t, options.Equals(vote.Options))
+
 	// Run the endblocker. Check to make sure that proposal1 is removed from state, and proposal2 is finished VotingPeriod.
 	EndBlocker(ctx2, input2.keeper)
 
@@ -0,0 +1,119 @@
+package gov
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	keep "github.com/cosmos/cosmos-sdk/x/gov/keeper"
+	"github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/cosmos/cosmos-sdk/x/staking"
+)
+
+var _ types.GovHooks = &MockGovHooksReceiver{}
+
+// MockGovHooksReceiver records which of the governance hooks were called
+type MockGovHooksReceiver struct {
+	AfterProposalSubmissionValid        bool
+	AfterProposalDepositValid           bool
+	AfterProposalVoteValid              bool
+	AfterProposalFailedMinDepositValid  bool
+	AfterProposalVotingPeriodEndedValid bool
+}
+
+func (h *MockGovHooksReceiver) AfterProposalSubmission(ctx sdk.Context, proposalID uint64) {
+	h.AfterProposalSubmissionValid = true
+}
+
+func (h *MockGovHooksReceiver) AfterProposalDeposit(ctx sdk.Context, proposalID uint64, depositorAddr sdk.AccAddress) {
+	h.AfterProposalDepositValid = true
+}
+
+func (h *MockGovHooksReceiver) AfterProposalVote(ctx sdk.Context, proposalID uint64, voterAddr sdk.AccAddress) {
+	h.AfterProposalVoteValid = true
+}
+
+func (h *MockGovHooksReceiver) AfterProposalFailedMinDeposit(ctx sdk.Context, proposalID uint64) {
+	h.AfterProposalFailedMinDepositValid = true
+}
+
+func (h *MockGovHooksReceiver) AfterProposalVotingPeriodEnded(ctx sdk.Context, proposalID uint64) {
+	h.AfterProposalVotingPeriodEndedValid = true
+}
+
+func TestHooks(t *testing.T) {
+	input := getMockApp(t, 1, GenesisState{}, nil, ProposalHandler)
+	SortAddresses(input.addrs)
+
+	govHooksReceiver := MockGovHooksReceiver{}
+	otherHooksReceiver := MockGovHooksReceiver{}
+
+	keeper := input.keeper
+	keeper.SetHooks(NewMultiGovHooks(&govHooksReceiver, &otherHooksReceiver))
+
+	require.Panics(t, func() { keeper.SetHooks(NewMultiGovHooks()) })
+
+	stakingHandler := staking.NewHandler(input.sk)
+
+	header := abci.Header{Height: input.mApp.LastBlockHeight() + 1}
+	input.mApp.BeginBlock(abci.RequestBeginBlock{Header: header})
+	ctx := input.mApp.BaseApp.NewContext(false, abci.Header{})
+
+	valAddr := sdk.ValAddress(input.addrs[0])
+	createValidators(t, stakingHandler, ctx, []sdk.ValAddress{valAddr}, []int64{10})
+	staking.EndBlocker(ctx, input.sk)
+
+	minDeposit := keeper.GetDepositParams(ctx).MinDeposit
+
+	// a proposal failing to reach the minimum deposit
+	require.False(t, govHooksReceiver.AfterProposalSubmissionValid)
+	proposal, err := keeper.SubmitProposal(ctx, keep.TestProposal)
+	require.NoError(t, err)
+	require.True(t, govHooksReceiver.AfterProposalSubmissionValid)
+	require.True(t, otherHooksReceiver.AfterProposalSubmissionValid)
+
+	newHeader := ctx.BlockHeader()
+	newHeader.Time = ctx.BlockHeader().Time.Add(keeper.GetDepositParams(ctx).MaxDepositPeriod)
+	ctx = ctx.WithBlockHeader(newHeader)
+
+	require.False(t, govHooksReceiver.AfterProposalFailedMinDepositValid)
+	EndBlocker(ctx, keeper)
+	require.True(t, govHooksReceiver.AfterProposalFailedMinDepositValid)
+	require.True(t, otherHooksReceiver.AfterProposalFailedMinDepositValid)
+
+	_, found := keeper.GetProposal(ctx, proposal.ProposalID)
+	require.False(t, found)
+
+	// a proposal entering and ending its voting period
+	proposal, err = keeper.SubmitProposal(ctx, keep.TestProposal)
+	require.NoError(t, err)
+
+	require.False(t, govHooksReceiver.AfterProposalDepositValid)
+	err, votingStarted := keeper.AddDeposit(ctx, proposal.ProposalID, input.addrs[0], minDeposit)
+	require.NoError(t, err)
+	require.True(t, votingStarted)
+	require.True(t, govHooksReceiver.AfterProposalDepositValid)
+	require.True(t, otherHooksReceiver.AfterProposalDepositValid)
+
+	require.False(t, govHooksReceiver.AfterProposalVoteValid)
+	err = keeper.AddVote(ctx, proposal.ProposalID, input.addrs[0], OptionYes)
+	require.NoError(t, err)
+	require.True(t, govHooksReceiver.AfterProposalVoteValid)
+	require.True(t, otherHooksReceiver.AfterProposalVoteValid)
+
+	newHeader = ctx.BlockHeader()
+	newHeader.Time = ctx.BlockHeader().Time.Add(keeper.GetVotingParams(ctx).VotingPeriod)
+	ctx = ctx.WithBlockHeader(newHeader)
+
+	require.False(t, govHooksReceiver.AfterProposalVotingPeriodEndedValid)
+	EndBlocker(ctx, keeper)
+	require.True(t, govHooksReceiver.AfterProposalVotingPeriodEndedValid)
+	require.True(t, otherHooksReceiver.AfterProposalVotingPeriodEndedValid)
+
+	proposal, found = keeper.GetProposal(ctx, proposal.ProposalID)
+	require.True(t, found)
+	require.Equal(t, StatusPassed, proposal.Status)
+}
@@ -7,6 +7,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/gov/types"
 )
 
 func TestDeposits(t *testing.T) {
@@ -96,3 +97,30 @@ func TestDeposits(t *testing.T) {
 	require.Equal(t, addr0Initial, ak.GetAccount(ctx, TestAddrs[0]).GetCoins())
 	require.Equal(t, addr1Initial, ak.GetAccount(ctx, TestAddrs[1]).GetCoins())
 }
+
+func TestExpeditedDeposits(t *testing.T) {
+	ctx, _, keeper, _, _ := createTestInput(t, false, 100)
+
+	proposal, err := keeper.SubmitExpeditedProposal(ctx, TestProposal)
+	require.NoError(t, err)
+	proposalID := proposal.ProposalID
+
+	depositParams := keeper.GetDepositParams(ctx)
+	votingParams := keeper.GetVotingParams(ctx)
+
+	// the regular min deposit doesn't activate the voting period
+	err, votingStarted := keeper.AddDeposit(ctx, proposalID, TestAddrs[0], depositParams.MinDeposit)
+	require.NoError(t, err)
+	require.False(t, votingStarted)
+
+	// the expedited min deposit does
+	err, votingStarted = keeper.AddDeposit(ctx, proposalID, TestAddrs[1], depositParams.ExpeditedMinDeposit)
+	require.NoError(t, err)
+	require.True(t, votingStarted)
+
+	proposal, ok := keeper.GetProposal(ctx, proposalID)
+	require.True(t, ok)
+	require.True(t, proposal.Expedited)
+	require.Equal(t, types.StatusVotingPeriod, proposal.Status)
+	require.True(t, proposal.VotingEndTime.Equal(ctx.BlockHeader().Time.Add(votingParams.ExpeditedVotingPeriod)))
+}
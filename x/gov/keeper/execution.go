@@ -0,0 +1,80 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+// ExecuteProposal executes the content of a passed proposal. The messages of an
+// ExecutableProposal are executed in order through the message router, any
+// other content is executed by the handler of its proposal route. It returns
+// the log of the execution, the events of the messages are emitted on the
+// context.
+//
+// CONTRACT: the context must be cache-wrapped, as the state changes of the
+// messages executed before a failed one are not reverted.
+func (keeper Keeper) ExecuteProposal(ctx sdk.Context, content types.Content) (string, sdk.Error) {
+	executable, ok := content.(types.ExecutableProposal)
+	if !ok {
+		handler := keeper.router.GetRoute(content.ProposalRoute())
+		return "", handler(ctx, content)
+	}
+
+	if err := keeper.validateExecutableMsgs(executable.Msgs); err != nil {
+		return "", err
+	}
+
+	logs := make(sdk.ABCIMessageLogs, len(executable.Msgs))
+	for i, msg := range executable.Msgs {
+		res, err := keeper.executeMsg(ctx, i, msg)
+		if err != nil {
+			return "", err
+		}
+
+		ctx.EventManager().EmitEvents(res.Events)
+		logs[i] = sdk.ABCIMessageLog{MsgIndex: uint16(i), Success: true, Log: res.Log}
+	}
+
+	return logs.String(), nil
+}
+
+// executeMsg handles a message of an executable proposal. The proposals are
+// executed by the EndBlocker, so a panicking handler fails the message instead
+// of halting the chain.
+func (keeper Keeper) executeMsg(ctx sdk.Context, msgIndex int, msg sdk.Msg) (res sdk.Result, err sdk.Error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = types.ErrExecutionFailed(keeper.codespace, msgIndex, fmt.Sprintf("panic: %v", r))
+		}
+	}()
+
+	res = keeper.msgRouter.Route(msg.Route())(ctx, msg)
+	if !res.IsOK() {
+		return res, types.ErrExecutionFailed(keeper.codespace, msgIndex, res.Log)
+	}
+
+	return res, nil
+}
+
+// validateExecutableMsgs ensures the messages of an executable proposal are
+// routed by the message router and signed by the governance account only.
+func (keeper Keeper) validateExecutableMsgs(msgs []sdk.Msg) sdk.Error {
+	govAddr := keeper.supplyKeeper.GetModuleAddress(types.ModuleName)
+
+	for i, msg := range msgs {
+		if keeper.msgRouter.Route(msg.Route()) == nil {
+			return sdk.ErrUnknownRequest(fmt.Sprintf("unrecognized route of message %d: %s", i, msg.Route()))
+		}
+
+		signers := msg.GetSigners()
+		if len(signers) != 1 || !signers[0].Equals(govAddr) {
+			return types.ErrInvalidProposalContent(
+				keeper.codespace, fmt.Sprintf("message %d must only be signed by the governance account %s", i, govAddr),
+			)
+		}
+	}
+
+	return nil
+}
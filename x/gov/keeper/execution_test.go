@@ -0,0 +1,95 @@
+package keeper
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/bank"
+	"github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+func TestExecutableProposal(t *testing.T) {
+	ctx, ak, keeper, _, _ := createTestInput(t, false, 100)
+
+	govAddr := keeper.GetGovernanceAccount(ctx).GetAddress()
+	coins := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 100))
+	send := bank.MsgSend{FromAddress: govAddr, ToAddress: TestAddrs[0], Amount: coins}
+
+	// the messages must be routed and signed by the governance account only
+	invalidMsgs := [][]sdk.Msg{
+		{send, bank.MsgSend{FromAddress: TestAddrs[1], ToAddress: TestAddrs[0], Amount: coins}},
+		{bank.MsgMultiSend{
+			Inputs:  []bank.Input{bank.NewInput(govAddr, coins), bank.NewInput(TestAddrs[1], coins)},
+			Outputs: []bank.Output{bank.NewOutput(TestAddrs[0], coins.Add(coins))},
+		}},
+		{types.NewMsgVote(govAddr, 1, types.OptionYes)},
+	}
+	for i, msgs := range invalidMsgs {
+		_, err := keeper.SubmitProposal(ctx, types.NewExecutableProposal("title", "description", msgs))
+		require.Error(t, err, "test: %v", i)
+	}
+
+	// the messages are executed against the state at the end of the voting
+	// period, so they can fail at submission
+	proposal, err := keeper.SubmitProposal(ctx, types.NewExecutableProposal("title", "description", []sdk.Msg{send, send}))
+	require.NoError(t, err)
+
+	fund := func(amount sdk.Coins) {
+		acc := ak.GetAccount(ctx, govAddr)
+		require.NoError(t, acc.SetCoins(amount))
+		ak.SetAccount(ctx, acc)
+	}
+
+	fund(coins)
+	_, err = keeper.ExecuteProposal(ctx, proposal.Content)
+	require.Equal(t, types.ErrExecutionFailed(types.DefaultCodespace, 1, "").Code(), err.Code())
+	require.Contains(t, err.Error(), "message 1 failed on execution")
+
+	fund(coins.Add(coins))
+	balance := ak.GetAccount(ctx, TestAddrs[0]).GetCoins()
+
+	execLog, err := keeper.ExecuteProposal(ctx, proposal.Content)
+	require.NoError(t, err)
+	require.True(t, ak.GetAccount(ctx, govAddr).GetCoins().Empty())
+	require.Equal(t, balance.Add(coins).Add(coins), ak.GetAccount(ctx, TestAddrs[0]).GetCoins())
+
+	logs, parseErr := sdk.ParseABCILogs(execLog)
+	require.NoError(t, parseErr)
+	require.Len(t, logs, 2)
+	require.True(t, logs[1].Success)
+}
+
+// panicMsg is a message whose handler panics
+type panicMsg struct {
+	Signer sdk.AccAddress
+}
+
+func (msg panicMsg) Route() string                { return "panic" }
+func (msg panicMsg) Type() string                 { return "panic" }
+func (msg panicMsg) ValidateBasic() sdk.Error     { return nil }
+func (msg panicMsg) GetSignBytes() []byte         { return nil }
+func (msg panicMsg) GetSigners() []sdk.AccAddress { return []sdk.AccAddress{msg.Signer} }
+
+func TestExecutableProposalPanic(t *testing.T) {
+	ctx, ak, keeper, _, _ := createTestInput(t, false, 100)
+	keeper.msgRouter.AddRoute("panic", func(ctx sdk.Context, msg sdk.Msg) sdk.Result {
+		panic("handler panic")
+	})
+
+	govAddr := keeper.GetGovernanceAccount(ctx).GetAddress()
+	coins := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 100))
+	acc := ak.GetAccount(ctx, govAddr)
+	require.NoError(t, acc.SetCoins(coins))
+	ak.SetAccount(ctx, acc)
+
+	send := bank.MsgSend{FromAddress: govAddr, ToAddress: TestAddrs[0], Amount: coins}
+	content := types.NewExecutableProposal("title", "description", []sdk.Msg{send, panicMsg{govAddr}})
+
+	// the panic fails the execution instead of halting the chain
+	var execErr sdk.Error
+	require.NotPanics(t, func() { _, execErr = keeper.ExecuteProposal(ctx, content) })
+	require.Equal(t, types.CodeExecutionFailed, execErr.Code())
+	require.Contains(t, execErr.Error(), "message 1 failed on execution: panic: handler panic")
+}
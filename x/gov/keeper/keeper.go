@@ -4,7 +4,6 @@ import (
 	"fmt"
 	"time"
 
-	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/gov/types"
 	"github.com/cosmos/cosmos-sdk/x/supply/exported"
@@ -26,26 +25,35 @@ type Keeper struct {
 	// The (unexposed) keys used to access the stores from the Context.
 	storeKey sdk.StoreKey
 
-	// The codec codec for binary encoding/decoding.
-	cdc *codec.Codec
+	// The codec for binary encoding/decoding.
+	cdc types.Codec
 
 	// Reserved codespace
 	codespace sdk.CodespaceType
 
 	// Proposal router
 	router types.Router
+
+	// Message router executing the messages of the executable proposals
+	msgRouter sdk.Router
+
+	// Governance hooks
+	hooks types.GovHooks
 }
 
 // NewKeeper returns a governance keeper. It handles:
 // - submitting governance proposals
 // - depositing funds into proposals, and activating upon sufficient funds being deposited
 // - users voting on proposals, with weight proportional to stake in the system
-// - and tallying the result of the vote.
+// - tallying the result of the vote
+// - and executing the passed proposals, routing the messages of the executable
+// proposals through the msgRouter, typically the app's baseapp.Router.
 //
 // CONTRACT: the parameter Subspace must have the param key table already initialized
 func NewKeeper(
-	cdc *codec.Codec, key sdk.StoreKey, paramSpace types.ParamSubspace,
+	cdc types.Codec, key sdk.StoreKey, paramSpace types.ParamSubspace,
 	supplyKeeper types.SupplyKeeper, sk types.StakingKeeper, codespace sdk.CodespaceType, rtr types.Router,
+	msgRouter sdk.Router,
 ) Keeper {
 
 	// ensure governance module account is set
@@ -66,7 +74,24 @@ func NewKeeper(
 		cdc:          cdc,
 		codespace:    codespace,
 		router:       rtr,
+		msgRouter:    msgRouter,
+	}
+}
+
+// SetHooks sets the hooks for governance
+func (keeper *Keeper) SetHooks(gh types.GovHooks) *Keeper {
+	if keeper.hooks != nil {
+		panic("cannot set governance hooks twice")
 	}
+
+	keeper.hooks = gh
+
+	return keeper
+}
+
+// GetCodec returns the codec used to encode and decode the gov state.
+func (keeper Keeper) GetCodec() types.Codec {
+	return keeper.cdc
 }
 
 // Logger returns a module-specific logger.
@@ -44,7 +44,7 @@ func TestProposalQueues(t *testing.T) {
 
 	activeIterator := keeper.ActiveProposalQueueIterator(ctx, proposal.VotingEndTime)
 	require.True(t, activeIterator.Valid())
-	keeper.cdc.UnmarshalBinaryLengthPrefixed(activeIterator.Value(), &proposalID)
+	proposalID = types.GetProposalIDFromBytes(activeIterator.Value())
 	require.Equal(t, proposalID, proposal.ProposalID)
 	activeIterator.Close()
 }
@@ -9,17 +9,43 @@ import (
 
 // SubmitProposal create new proposal given a content
 func (keeper Keeper) SubmitProposal(ctx sdk.Context, content types.Content) (types.Proposal, sdk.Error) {
-	if !keeper.router.HasRoute(content.ProposalRoute()) {
-		return types.Proposal{}, types.ErrNoProposalHandlerExists(keeper.codespace, content)
+	return keeper.submitProposal(ctx, content, false)
+}
+
+// SubmitExpeditedProposal create new expedited proposal given a content. It
+// requires the expedited min deposit to enter its voting period, which lasts
+// the expedited voting period, and passes with the expedited threshold.
+func (keeper Keeper) SubmitExpeditedProposal(ctx sdk.Context, content types.Content) (types.Proposal, sdk.Error) {
+	return keeper.submitProposal(ctx, content, true)
+}
+
+func (keeper Keeper) submitProposal(ctx sdk.Context, content types.Content, expedited bool) (types.Proposal, sdk.Error) {
+	if expedited {
+		if err := keeper.validateExpeditedParams(ctx); err != nil {
+			return types.Proposal{}, types.ErrExpeditedParamsNotSet(keeper.codespace, err.Error())
+		}
 	}
 
-	// Execute the proposal content in a cache-wrapped context to validate the
-	// actual parameter changes before the proposal proceeds through the
-	// governance process. State is not persisted.
-	cacheCtx, _ := ctx.CacheContext()
-	handler := keeper.router.GetRoute(content.ProposalRoute())
-	if err := handler(cacheCtx, content); err != nil {
-		return types.Proposal{}, types.ErrInvalidProposalContent(keeper.codespace, err.Result().Log)
+	if executable, ok := content.(types.ExecutableProposal); ok {
+		// The messages are executed against the state at the end of the voting
+		// period, so they are only checked to be routed and signed by the
+		// governance account.
+		if err := keeper.validateExecutableMsgs(executable.Msgs); err != nil {
+			return types.Proposal{}, err
+		}
+	} else {
+		if !keeper.router.HasRoute(content.ProposalRoute()) {
+			return types.Proposal{}, types.ErrNoProposalHandlerExists(keeper.codespace, content)
+		}
+
+		// Execute the proposal content in a cache-wrapped context to validate the
+		// actual parameter changes before the proposal proceeds through the
+		// governance process. State is not persisted.
+		cacheCtx, _ := ctx.CacheContext()
+		handler := keeper.router.GetRoute(content.ProposalRoute())
+		if err := handler(cacheCtx, content); err != nil {
+			return types.Proposal{}, types.ErrInvalidProposalContent(keeper.codespace, err.Result().Log)
+		}
 	}
 
 	proposalID, err := keeper.GetProposalID(ctx)
@@ -31,11 +57,15 @@ func (keeper Keeper) SubmitProposal(ctx sdk.Context, content types.Content) (typ
 	depositPeriod := keeper.GetDepositParams(ctx).MaxDepositPeriod
 
 	proposal := types.NewProposal(content, proposalID, submitTime, submitTime.Add(depositPeriod))
+	proposal.Expedited = expedited
 
 	keeper.SetProposal(ctx, proposal)
 	keeper.InsertInactiveProposalQueue(ctx, proposalID, proposal.DepositEndTime)
 	keeper.SetProposalID(ctx, proposalID+1)
 
+	// called right after a proposal is submitted
+	keeper.AfterProposalSubmission(ctx, proposalID)
+
 	ctx.EventManager().EmitEvent(
 		sdk.NewEvent(
 			types.EventTypeSubmitProposal,
@@ -53,14 +83,20 @@ func (keeper Keeper) GetProposal(ctx sdk.Context, proposalID uint64) (proposal t
 	if bz == nil {
 		return
 	}
-	keeper.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &proposal)
+	proposal, err := keeper.cdc.UnmarshalProposal(bz)
+	if err != nil {
+		panic(err)
+	}
 	return proposal, true
 }
 
 // SetProposal set a proposal to store
 func (keeper Keeper) SetProposal(ctx sdk.Context, proposal types.Proposal) {
 	store := ctx.KVStore(keeper.storeKey)
-	bz := keeper.cdc.MustMarshalBinaryLengthPrefixed(proposal)
+	bz, err := keeper.cdc.MarshalProposal(proposal)
+	if err != nil {
+		panic(err)
+	}
 	store.Set(types.ProposalKey(proposal.ProposalID), bz)
 }
 
@@ -83,8 +119,10 @@ func (keeper Keeper) IterateProposals(ctx sdk.Context, cb func(proposal types.Pr
 
 	defer iterator.Close()
 	for ; iterator.Valid(); iterator.Next() {
-		var proposal types.Proposal
-		keeper.cdc.MustUnmarshalBinaryLengthPrefixed(iterator.Value(), &proposal)
+		proposal, err := keeper.cdc.UnmarshalProposal(iterator.Value())
+		if err != nil {
+			panic(err)
+		}
 
 		if cb(proposal) {
 			break
@@ -167,7 +205,7 @@ func (keeper Keeper) SetProposalID(ctx sdk.Context, proposalID uint64) {
 
 func (keeper Keeper) activateVotingPeriod(ctx sdk.Context, proposal types.Proposal) {
 	proposal.VotingStartTime = ctx.BlockHeader().Time
-	votingPeriod := keeper.GetVotingParams(ctx).VotingPeriod
+	votingPeriod := keeper.GetVotingParams(ctx).GetVotingPeriod(proposal.Expedited)
 	proposal.VotingEndTime = proposal.VotingStartTime.Add(votingPeriod)
 	proposal.Status = types.StatusVotingPeriod
 	keeper.SetProposal(ctx, proposal)
@@ -175,3 +213,15 @@ func (keeper Keeper) activateVotingPeriod(ctx sdk.Context, proposal types.Propos
 	keeper.RemoveFromInactiveProposalQueue(ctx, proposal.ProposalID, proposal.DepositEndTime)
 	keeper.InsertActiveProposalQueue(ctx, proposal.ProposalID, proposal.VotingEndTime)
 }
+
+// validateExpeditedParams checks the expedited params are set. They are unset
+// on chains whose params were stored before expedited proposals existed.
+func (keeper Keeper) validateExpeditedParams(ctx sdk.Context) error {
+	if err := keeper.GetDepositParams(ctx).Validate(); err != nil {
+		return err
+	}
+	if err := keeper.GetVotingParams(ctx).Validate(); err != nil {
+		return err
+	}
+	return keeper.GetTallyParams(ctx).Validate()
+}
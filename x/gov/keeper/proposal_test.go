@@ -25,6 +25,37 @@ func TestGetSetProposal(t *testing.T) {
 	require.True(t, ProposalEqual(proposal, gotProposal))
 }
 
+func TestSubmitExpeditedProposalParamsNotSet(t *testing.T) {
+	ctx, _, keeper, _, _ := createTestInput(t, false, 100)
+
+	// params stored before expedited proposals existed
+	depositParams := keeper.GetDepositParams(ctx)
+	keeper.SetDepositParams(ctx, types.DepositParams{
+		MinDeposit:       depositParams.MinDeposit,
+		MaxDepositPeriod: depositParams.MaxDepositPeriod,
+	})
+	keeper.SetVotingParams(ctx, types.VotingParams{VotingPeriod: types.DefaultPeriod})
+	keeper.SetTallyParams(ctx, types.TallyParams{
+		Quorum:    types.DefaultQuorum,
+		Threshold: types.DefaultThreshold,
+		Veto:      types.DefaultVeto,
+	})
+
+	_, err := keeper.SubmitExpeditedProposal(ctx, TestProposal)
+	require.Error(t, err)
+	require.Equal(t, types.CodeExpeditedParamsNotSet, err.Code())
+
+	_, err = keeper.SubmitProposal(ctx, TestProposal)
+	require.NoError(t, err)
+
+	keeper.SetDepositParams(ctx, types.DefaultDepositParams())
+	keeper.SetVotingParams(ctx, types.DefaultVotingParams())
+	keeper.SetTallyParams(ctx, types.DefaultTallyParams())
+
+	_, err = keeper.SubmitExpeditedProposal(ctx, TestProposal)
+	require.NoError(t, err)
+}
+
 func TestActivateVotingPeriod(t *testing.T) {
 	ctx, _, keeper, _, _ := createTestInput(t, false, 100)
 
@@ -97,7 +128,7 @@ func registerTestCodec(cdc *codec.Codec) {
 func TestSubmitProposal(t *testing.T) {
 	ctx, _, keeper, _, _ := createTestInput(t, false, 100)
 
-	registerTestCodec(keeper.cdc)
+	registerTestCodec(keeper.cdc.(*types.AminoCodec).Amino())
 
 	testCases := []struct {
 		content     types.Content
@@ -120,6 +120,38 @@ func TestTallyOnlyValidators51Yes(t *testing.T) {
 	require.False(t, tallyResults.Equals(types.EmptyTallyResult()))
 }
 
+func TestTallyOnlyValidatorsExpedited(t *testing.T) {
+	ctx, _, keeper, sk, _ := createTestInput(t, false, 100)
+	createValidators(ctx, sk, []int64{5, 6, 0})
+
+	tp := TestProposal
+	proposal, err := keeper.SubmitExpeditedProposal(ctx, tp)
+	require.NoError(t, err)
+	require.True(t, proposal.Expedited)
+	proposalID := proposal.ProposalID
+	proposal.Status = types.StatusVotingPeriod
+	keeper.SetProposal(ctx, proposal)
+
+	// 6/11 of the voting power is over the threshold, but not over the
+	// expedited threshold
+	require.NoError(t, keeper.AddVote(ctx, proposalID, valAccAddr1, types.OptionNo))
+	require.NoError(t, keeper.AddVote(ctx, proposalID, valAccAddr2, types.OptionYes))
+
+	proposal, ok := keeper.GetProposal(ctx, proposalID)
+	require.True(t, ok)
+	passes, burnDeposits, tallyResults := keeper.Tally(ctx, proposal)
+
+	require.False(t, passes)
+	require.False(t, burnDeposits)
+	require.False(t, tallyResults.Equals(types.EmptyTallyResult()))
+
+	require.NoError(t, keeper.AddVote(ctx, proposalID, valAccAddr1, types.OptionYes))
+
+	passes, burnDeposits, _ = keeper.Tally(ctx, proposal)
+	require.True(t, passes)
+	require.False(t, burnDeposits)
+}
+
 func TestTallyOnlyValidatorsVetoed(t *testing.T) {
 	ctx, _, keeper, sk, _ := createTestInput(t, false, 100)
 	createValidators(ctx, sk, []int64{6, 6, 7})
@@ -396,3 +428,55 @@ func TestTallyJailedValidator(t *testing.T) {
 	require.False(t, burnDeposits)
 	require.False(t, tallyResults.Equals(types.EmptyTallyResult()))
 }
+
+func TestTallyWeightedVotes(t *testing.T) {
+	ctx, _, keeper, sk, _ := createTestInput(t, false, 100)
+	createValidators(ctx, sk, []int64{6, 6, 10})
+
+	delTokens := sdk.TokensFromConsensusPower(30)
+	val3, found := sk.GetValidator(ctx, valOpAddr3)
+	require.True(t, found)
+
+	_, err := sk.Delegate(ctx, TestAddrs[0], delTokens, sdk.Unbonded, val3, true)
+	require.NoError(t, err)
+
+	_ = staking.EndBlocker(ctx, sk)
+
+	tp := TestProposal
+	proposal, err := keeper.SubmitProposal(ctx, tp)
+	require.NoError(t, err)
+	proposalID := proposal.ProposalID
+	proposal.Status = types.StatusVotingPeriod
+	keeper.SetProposal(ctx, proposal)
+
+	val1Options := types.WeightedVoteOptions{
+		types.NewWeightedVoteOption(types.OptionYes, sdk.NewDecWithPrec(75, 2)),
+		types.NewWeightedVoteOption(types.OptionNo, sdk.NewDecWithPrec(25, 2)),
+	}
+	delOptions := types.WeightedVoteOptions{
+		types.NewWeightedVoteOption(types.OptionYes, sdk.NewDecWithPrec(5, 1)),
+		types.NewWeightedVoteOption(types.OptionAbstain, sdk.NewDecWithPrec(5, 1)),
+	}
+
+	require.NoError(t, keeper.AddWeightedVote(ctx, proposalID, valAccAddr1, val1Options))
+	require.NoError(t, keeper.AddVote(ctx, proposalID, valAccAddr2, types.OptionYes))
+	require.NoError(t, keeper.AddVote(ctx, proposalID, valAccAddr3, types.OptionNo))
+	require.NoError(t, keeper.AddWeightedVote(ctx, proposalID, TestAddrs[0], delOptions))
+
+	proposal, ok := keeper.GetProposal(ctx, proposalID)
+	require.True(t, ok)
+	passes, burnDeposits, tallyResults := keeper.Tally(ctx, proposal)
+
+	// the delegator splits its 30 between yes and abstain, the validator 3 votes
+	// no with its own 10, and the validator 1 splits its 6 between yes and no
+	expected := types.NewTallyResult(
+		sdk.TokensFromConsensusPower(6).MulRaw(3).QuoRaw(4).Add(sdk.TokensFromConsensusPower(6+15)),
+		sdk.TokensFromConsensusPower(15),
+		sdk.TokensFromConsensusPower(6).QuoRaw(4).Add(sdk.TokensFromConsensusPower(10)),
+		sdk.ZeroInt(),
+	)
+
+	require.True(t, passes)
+	require.False(t, burnDeposits)
+	require.True(t, tallyResults.Equals(expected), tallyResults.String())
+}
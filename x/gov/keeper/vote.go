@@ -9,26 +9,42 @@ import (
 
 // AddVote adds a vote on a specific proposal
 func (keeper Keeper) AddVote(ctx sdk.Context, proposalID uint64, voterAddr sdk.AccAddress, option types.VoteOption) sdk.Error {
-	proposal, ok := keeper.GetProposal(ctx, proposalID)
-	if !ok {
-		return types.ErrUnknownProposal(keeper.codespace, proposalID)
+	if !types.ValidVoteOption(option) {
+		return types.ErrInvalidVote(keeper.codespace, option)
 	}
-	if proposal.Status != types.StatusVotingPeriod {
-		return types.ErrInactiveProposal(keeper.codespace, proposalID)
+
+	return keeper.addVote(ctx, types.NewVote(proposalID, voterAddr, option), option.String())
+}
+
+// AddWeightedVote adds a vote on a specific proposal, splitting the voting
+// power of the voter between the weighted options
+func (keeper Keeper) AddWeightedVote(ctx sdk.Context, proposalID uint64, voterAddr sdk.AccAddress, options types.WeightedVoteOptions) sdk.Error {
+	if !types.ValidWeightedVoteOptions(options) {
+		return types.ErrInvalidWeightedVote(keeper.codespace, options)
 	}
 
-	if !types.ValidVoteOption(option) {
-		return types.ErrInvalidVote(keeper.codespace, option)
+	return keeper.addVote(ctx, types.NewWeightedVote(proposalID, voterAddr, options), options.String())
+}
+
+func (keeper Keeper) addVote(ctx sdk.Context, vote types.Vote, option string) sdk.Error {
+	proposal, ok := keeper.GetProposal(ctx, vote.ProposalID)
+	if !ok {
+		return types.ErrUnknownProposal(keeper.codespace, vote.ProposalID)
+	}
+	if proposal.Status != types.StatusVotingPeriod {
+		return types.ErrInactiveProposal(keeper.codespace, vote.ProposalID)
 	}
 
-	vote := types.NewVote(proposalID, voterAddr, option)
 	keeper.SetVote(ctx, vote)
 
+	// called after a vote on a proposal is cast
+	keeper.AfterProposalVote(ctx, vote.ProposalID, vote.Voter)
+
 	ctx.EventManager().EmitEvent(
 		sdk.NewEvent(
 			types.EventTypeProposalVote,
-			sdk.NewAttribute(types.AttributeKeyOption, option.String()),
-			sdk.NewAttribute(types.AttributeKeyProposalID, fmt.Sprintf("%d", proposalID)),
+			sdk.NewAttribute(types.AttributeKeyOption, option),
+			sdk.NewAttribute(types.AttributeKeyProposalID, fmt.Sprintf("%d", vote.ProposalID)),
 		),
 	)
 
@@ -68,7 +84,7 @@ func (keeper Keeper) GetVote(ctx sdk.Context, proposalID uint64, voterAddr sdk.A
 // SetVote sets a Vote to the gov store
 func (keeper Keeper) SetVote(ctx sdk.Context, vote types.Vote) {
 	store := ctx.KVStore(keeper.storeKey)
-	bz := keeper.cdc.MustMarshalBinaryLengthPrefixed(vote)
+	bz := keeper.cdc.MustMarshalBinaryLengthPrefixed(&vote)
 	store.Set(types.VoteKey(vote.ProposalID, vote.Voter), bz)
 }
 
@@ -5,6 +5,7 @@ import (
 
 	"github.com/stretchr/testify/require"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/gov/types"
 )
 
@@ -63,3 +64,39 @@ func TestVotes(t *testing.T) {
 	require.Equal(t, proposalID, votes[1].ProposalID)
 	require.Equal(t, types.OptionNoWithVeto, votes[1].Option)
 }
+
+func TestWeightedVotes(t *testing.T) {
+	ctx, _, keeper, _, _ := createTestInput(t, false, 100)
+
+	tp := TestProposal
+	proposal, err := keeper.SubmitProposal(ctx, tp)
+	require.NoError(t, err)
+	proposalID := proposal.ProposalID
+	proposal.Status = types.StatusVotingPeriod
+	keeper.SetProposal(ctx, proposal)
+
+	options := types.WeightedVoteOptions{
+		types.NewWeightedVoteOption(types.OptionYes, sdk.NewDecWithPrec(6, 1)),
+		types.NewWeightedVoteOption(types.OptionNo, sdk.NewDecWithPrec(4, 1)),
+	}
+	invalidOptions := types.WeightedVoteOptions{
+		types.NewWeightedVoteOption(types.OptionYes, sdk.NewDecWithPrec(6, 1)),
+		types.NewWeightedVoteOption(types.OptionNo, sdk.NewDecWithPrec(6, 1)),
+	}
+
+	require.Error(t, keeper.AddWeightedVote(ctx, proposalID, TestAddrs[0], invalidOptions), "invalid weights")
+
+	// a split vote has no single option
+	require.NoError(t, keeper.AddWeightedVote(ctx, proposalID, TestAddrs[0], options))
+	vote, found := keeper.GetVote(ctx, proposalID, TestAddrs[0])
+	require.True(t, found)
+	require.Equal(t, types.OptionEmpty, vote.Option)
+	require.True(t, options.Equals(vote.Options))
+
+	// a weighted vote with a single option is not split
+	require.NoError(t, keeper.AddWeightedVote(ctx, proposalID, TestAddrs[1], types.NewNonSplitVoteOption(types.OptionNo)))
+	vote, found = keeper.GetVote(ctx, proposalID, TestAddrs[1])
+	require.True(t, found)
+	require.Equal(t, types.OptionNo, vote.Option)
+	require.True(t, vote.Equals(types.NewVote(proposalID, TestAddrs[1], types.OptionNo)))
+}
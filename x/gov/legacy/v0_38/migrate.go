@@ -0,0 +1,75 @@
+package v0_38
+
+import (
+	"encoding/json"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	v034gov "github.com/cosmos/cosmos-sdk/x/gov/legacy/v0_34"
+)
+
+// GenesisStateV036 is the exported v0.36 and v0.37 genesis state, keeping the
+// proposals as raw JSON.
+type GenesisStateV036 struct {
+	StartingProposalID uint64                `json:"starting_proposal_id"`
+	Deposits           v034gov.Deposits      `json:"deposits"`
+	Votes              v034gov.Votes         `json:"votes"`
+	Proposals          []json.RawMessage     `json:"proposals"`
+	DepositParams      v034gov.DepositParams `json:"deposit_params"`
+	VotingParams       v034gov.VotingParams  `json:"voting_params"`
+	TallyParams        v034gov.TallyParams   `json:"tally_params"`
+}
+
+// Migrate accepts exported genesis state from v0.36 or v0.37 and migrates it to
+// v0.38 genesis state. This migration fills the expedited params, which must be
+// stricter than the params of the regular proposals: the expedited min deposit
+// is a multiple of the min deposit, the expedited voting period is at most half
+// of the voting period and the expedited threshold is greater than the
+// threshold.
+func Migrate(oldGenState GenesisStateV036) GenesisState {
+	depositParams := DepositParams{
+		MinDeposit:          oldGenState.DepositParams.MinDeposit,
+		MaxDepositPeriod:    oldGenState.DepositParams.MaxDepositPeriod,
+		ExpeditedMinDeposit: migrateExpeditedMinDeposit(oldGenState.DepositParams.MinDeposit),
+	}
+
+	votingParams := VotingParams{
+		VotingPeriod:          oldGenState.VotingParams.VotingPeriod,
+		ExpeditedVotingPeriod: migrateExpeditedVotingPeriod(oldGenState.VotingParams.VotingPeriod),
+	}
+
+	tallyParams := TallyParams{
+		Quorum:             oldGenState.TallyParams.Quorum,
+		Threshold:          oldGenState.TallyParams.Threshold,
+		Veto:               oldGenState.TallyParams.Veto,
+		ExpeditedThreshold: migrateExpeditedThreshold(oldGenState.TallyParams.Threshold),
+	}
+
+	return NewGenesisState(
+		oldGenState.StartingProposalID, oldGenState.Deposits, oldGenState.Votes, oldGenState.Proposals,
+		depositParams, votingParams, tallyParams,
+	)
+}
+
+func migrateExpeditedMinDeposit(minDeposit sdk.Coins) sdk.Coins {
+	expeditedMinDeposit := make(sdk.Coins, len(minDeposit))
+	for i, coin := range minDeposit {
+		expeditedMinDeposit[i] = sdk.NewCoin(coin.Denom, coin.Amount.Mul(DefaultExpeditedMinDepositRatio))
+	}
+	return expeditedMinDeposit
+}
+
+func migrateExpeditedVotingPeriod(votingPeriod time.Duration) time.Duration {
+	if DefaultExpeditedPeriod <= votingPeriod/2 {
+		return DefaultExpeditedPeriod
+	}
+	return votingPeriod / 2
+}
+
+func migrateExpeditedThreshold(threshold sdk.Dec) sdk.Dec {
+	if DefaultExpeditedThreshold.GT(threshold) {
+		return DefaultExpeditedThreshold
+	}
+	// halfway between the threshold and one
+	return threshold.Add(sdk.OneDec().Sub(threshold).QuoInt64(2))
+}
@@ -0,0 +1,73 @@
+// DONTCOVER
+// nolint
+package v0_38
+
+import (
+	"encoding/json"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	v034gov "github.com/cosmos/cosmos-sdk/x/gov/legacy/v0_34"
+)
+
+const (
+	ModuleName = "gov"
+
+	DefaultExpeditedPeriod time.Duration = time.Hour * 24 // 1 day
+)
+
+var (
+	DefaultExpeditedThreshold = sdk.NewDecWithPrec(667, 3)
+
+	// DefaultExpeditedMinDepositRatio is the ratio of the default expedited
+	// min deposit to the default min deposit.
+	DefaultExpeditedMinDepositRatio = sdk.NewInt(5)
+)
+
+type (
+	DepositParams struct {
+		MinDeposit          sdk.Coins     `json:"min_deposit,omitempty"`
+		MaxDepositPeriod    time.Duration `json:"max_deposit_period,omitempty"`
+		ExpeditedMinDeposit sdk.Coins     `json:"expedited_min_deposit,omitempty"`
+	}
+
+	TallyParams struct {
+		Quorum             sdk.Dec `json:"quorum,omitempty"`
+		Threshold          sdk.Dec `json:"threshold,omitempty"`
+		Veto               sdk.Dec `json:"veto,omitempty"`
+		ExpeditedThreshold sdk.Dec `json:"expedited_threshold,omitempty"`
+	}
+
+	VotingParams struct {
+		VotingPeriod          time.Duration `json:"voting_period,omitempty"`
+		ExpeditedVotingPeriod time.Duration `json:"expedited_voting_period,omitempty"`
+	}
+
+	// GenesisState keeps the proposals as raw JSON, as they are not migrated
+	// and their content types are registered by the app.
+	GenesisState struct {
+		StartingProposalID uint64            `json:"starting_proposal_id"`
+		Deposits           v034gov.Deposits  `json:"deposits"`
+		Votes              v034gov.Votes     `json:"votes"`
+		Proposals          []json.RawMessage `json:"proposals"`
+		DepositParams      DepositParams     `json:"deposit_params"`
+		VotingParams       VotingParams      `json:"voting_params"`
+		TallyParams        TallyParams       `json:"tally_params"`
+	}
+)
+
+func NewGenesisState(
+	startingProposalID uint64, deposits v034gov.Deposits, votes v034gov.Votes, proposals []json.RawMessage,
+	depositParams DepositParams, votingParams VotingParams, tallyParams TallyParams,
+) GenesisState {
+
+	return GenesisState{
+		StartingProposalID: startingProposalID,
+		Deposits:           deposits,
+		Votes:              votes,
+		Proposals:          proposals,
+		DepositParams:      depositParams,
+		VotingParams:       votingParams,
+		TallyParams:        tallyParams,
+	}
+}
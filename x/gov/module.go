@@ -94,11 +94,13 @@ func (AppModuleBasic) GetQueryCmd(cdc *codec.Codec) *cobra.Command {
 //____________________________________________________________________________
 
 // AppModuleSimulation defines the module simulation functions used by the gov module.
-type AppModuleSimulation struct{}
+type AppModuleSimulation struct {
+	cdc types.Codec
+}
 
-// RegisterStoreDecoder performs a no-op.
-func (AppModuleSimulation) RegisterStoreDecoder(sdr sdk.StoreDecoderRegistry) {
-	sdr[StoreKey] = simulation.DecodeStore
+// RegisterStoreDecoder registers a decoder for gov module's types
+func (ams AppModuleSimulation) RegisterStoreDecoder(sdr sdk.StoreDecoderRegistry) {
+	sdr[StoreKey] = simulation.NewDecodeStore(ams.cdc)
 }
 
 //____________________________________________________________________________
@@ -116,7 +118,7 @@ type AppModule struct {
 func NewAppModule(keeper Keeper, supplyKeeper types.SupplyKeeper) AppModule {
 	return AppModule{
 		AppModuleBasic:      AppModuleBasic{},
-		AppModuleSimulation: AppModuleSimulation{},
+		AppModuleSimulation: AppModuleSimulation{cdc: keeper.GetCodec()},
 		keeper:              keeper,
 		supplyKeeper:        supplyKeeper,
 	}
@@ -153,10 +155,11 @@ func (am AppModule) NewQuerierHandler() sdk.Querier {
 }
 
 // InitGenesis performs genesis initialization for the gov module. It returns
-// no validator updates.
+// no validator updates. The genesis state is decoded with the keeper codec, as
+// the messages of the executable proposals are not registered on ModuleCdc.
 func (am AppModule) InitGenesis(ctx sdk.Context, data json.RawMessage) []abci.ValidatorUpdate {
 	var genesisState GenesisState
-	ModuleCdc.MustUnmarshalJSON(data, &genesisState)
+	am.keeper.GetCodec().MustUnmarshalJSON(data, &genesisState)
 	InitGenesis(ctx, am.keeper, am.supplyKeeper, genesisState)
 	return []abci.ValidatorUpdate{}
 }
@@ -165,7 +168,7 @@ func (am AppModule) InitGenesis(ctx sdk.Context, data json.RawMessage) []abci.Va
 // module.
 func (am AppModule) ExportGenesis(ctx sdk.Context) json.RawMessage {
 	gs := ExportGenesis(ctx, am.keeper)
-	return ModuleCdc.MustMarshalJSON(gs)
+	return am.keeper.GetCodec().MustMarshalJSON(gs)
 }
 
 // BeginBlock performs a no-op.
@@ -11,13 +11,25 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/gov/types"
 )
 
-// DecodeStore unmarshals the KVPair's Value to the corresponding gov type
-func DecodeStore(cdc *codec.Codec, kvA, kvB cmn.KVPair) string {
+// NewDecodeStore returns a function that unmarshals the KVPair's Value to the
+// corresponding gov type using the given x/gov codec.
+func NewDecodeStore(cdc types.Codec) func(*codec.Codec, cmn.KVPair, cmn.KVPair) string {
+	return func(_ *codec.Codec, kvA, kvB cmn.KVPair) string {
+		return decodeStore(cdc, kvA, kvB)
+	}
+}
+
+func decodeStore(cdc types.Codec, kvA, kvB cmn.KVPair) string {
 	switch {
 	case bytes.Equal(kvA.Key[:1], types.ProposalsKeyPrefix):
-		var proposalA, proposalB types.Proposal
-		cdc.MustUnmarshalBinaryLengthPrefixed(kvA.Value, &proposalA)
-		cdc.MustUnmarshalBinaryLengthPrefixed(kvB.Value, &proposalB)
+		proposalA, err := cdc.UnmarshalProposal(kvA.Value)
+		if err != nil {
+			panic(err)
+		}
+		proposalB, err := cdc.UnmarshalProposal(kvB.Value)
+		if err != nil {
+			panic(err)
+		}
 		return fmt.Sprintf("%v\n%v", proposalA, proposalB)
 
 	case bytes.Equal(kvA.Key[:1], types.ActiveProposalQueuePrefix),
@@ -81,12 +81,21 @@ func SimulateSubmittingVotingAndSlashingForProposal(k gov.Keeper, contentSim Con
 
 		// didntVote := whoVotes[numVotes:]
 		whoVotes = whoVotes[:numVotes]
+
+		// the votes are spread over the regular voting period, which an
+		// expedited proposal reaches once converted to a regular proposal
 		votingPeriod := k.GetVotingParams(ctx).VotingPeriod
 
 		fops := make([]simulation.FutureOperation, numVotes+1)
 		for i := 0; i < numVotes; i++ {
 			whenVote := ctx.BlockHeader().Time.Add(time.Duration(r.Int63n(int64(votingPeriod.Seconds()))) * time.Second)
-			fops[i] = simulation.FutureOperation{BlockTime: whenVote, Op: operationSimulateMsgVote(k, accs[whoVotes[i]], proposalID)}
+
+			// half of the voters split their vote
+			voteOp := operationSimulateMsgVote(k, accs[whoVotes[i]], proposalID)
+			if r.Intn(2) == 0 {
+				voteOp = operationSimulateMsgVoteWeighted(k, accs[whoVotes[i]], proposalID)
+			}
+			fops[i] = simulation.FutureOperation{BlockTime: whenVote, Op: voteOp}
 		}
 
 		// 3) Make an operation to ensure slashes were done correctly. (Really should be a future invariant)
@@ -116,6 +125,9 @@ func SimulateTextProposalContent(r *rand.Rand, _ *baseapp.BaseApp, _ sdk.Context
 
 func simulationCreateMsgSubmitProposal(r *rand.Rand, c gov.Content, s simulation.Account) (msg gov.MsgSubmitProposal, err error) {
 	msg = gov.NewMsgSubmitProposal(c, randomDeposit(r), s.Address)
+
+	// a quarter of the proposals are expedited
+	msg.Expedited = r.Intn(4) == 0
 	if msg.ValidateBasic() != nil {
 		err = fmt.Errorf("expected msg to pass ValidateBasic: %s", msg.GetSignBytes())
 	}
@@ -187,6 +199,45 @@ func operationSimulateMsgVote(k gov.Keeper, acc simulation.Account, proposalID u
 	}
 }
 
+// SimulateMsgVoteWeighted generates a MsgVoteWeighted with random values.
+func SimulateMsgVoteWeighted(k gov.Keeper) simulation.Operation {
+	return operationSimulateMsgVoteWeighted(k, simulation.Account{}, 0)
+}
+
+// nolint: unparam
+func operationSimulateMsgVoteWeighted(k gov.Keeper, acc simulation.Account, proposalID uint64) simulation.Operation {
+	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simulation.Account) (
+		opMsg simulation.OperationMsg, fOps []simulation.FutureOperation, err error) {
+
+		if acc.Equals(simulation.Account{}) {
+			acc = simulation.RandomAcc(r, accs)
+		}
+
+		if proposalID < uint64(0) {
+			var ok bool
+			proposalID, ok = randomProposalID(r, k, ctx)
+			if !ok {
+				return simulation.NoOpMsg(gov.ModuleName), nil, nil
+			}
+		}
+		options := randomWeightedVotingOptions(r)
+
+		msg := gov.NewMsgVoteWeighted(acc.Address, proposalID, options)
+		if msg.ValidateBasic() != nil {
+			return simulation.NoOpMsg(gov.ModuleName), nil, fmt.Errorf("expected msg to pass ValidateBasic: %s", msg.GetSignBytes())
+		}
+
+		ctx, write := ctx.CacheContext()
+		ok := gov.NewHandler(k)(ctx, msg).IsOK()
+		if ok {
+			write()
+		}
+
+		opMsg = simulation.NewOperationMsg(msg, ok, "")
+		return opMsg, nil, nil
+	}
+}
+
 // Pick a random deposit
 func randomDeposit(r *rand.Rand) sdk.Coins {
 	// TODO Choose based on account balance and min deposit
@@ -220,3 +271,24 @@ func randomVotingOption(r *rand.Rand) gov.VoteOption {
 	}
 	panic("should not happen")
 }
+
+// Pick random weighted voting options, with weights in hundredths summing to 1
+func randomWeightedVotingOptions(r *rand.Rand) gov.WeightedVoteOptions {
+	options := []gov.VoteOption{gov.OptionYes, gov.OptionAbstain, gov.OptionNo, gov.OptionNoWithVeto}
+
+	var weightedOptions gov.WeightedVoteOptions
+	remaining := 100
+	for i, option := range options {
+		weight := remaining
+		if i < len(options)-1 {
+			weight = r.Intn(remaining + 1)
+		}
+		remaining -= weight
+
+		if weight > 0 {
+			weightedOptions = append(weightedOptions, gov.NewWeightedVoteOption(option, sdk.NewDecWithPrec(int64(weight), 2)))
+		}
+	}
+
+	return weightedOptions
+}
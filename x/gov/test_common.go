@@ -15,6 +15,7 @@ import (
 	abci "github.com/tendermint/tendermint/abci/types"
 	"github.com/tendermint/tendermint/crypto"
 
+	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/auth"
 	"github.com/cosmos/cosmos-sdk/x/bank"
@@ -48,6 +49,7 @@ func getMockApp(t *testing.T, numGenAccs int, genState types.GenesisState, genAc
 	mApp := mock.NewApp()
 
 	staking.RegisterCodec(mApp.Cdc)
+	bank.RegisterCodec(mApp.Cdc)
 	types.RegisterCodec(mApp.Cdc)
 	supply.RegisterCodec(mApp.Cdc)
 
@@ -79,13 +81,15 @@ func getMockApp(t *testing.T, numGenAccs int, genState types.GenesisState, genAc
 	}
 	supplyKeeper := supply.NewKeeper(mApp.Cdc, keySupply, mApp.AccountKeeper, bk, maccPerms)
 	sk := staking.NewKeeper(
-		mApp.Cdc, keyStaking, tKeyStaking, supplyKeeper, pk.Subspace(staking.DefaultParamspace), staking.DefaultCodespace,
+		codec.NewHybridCodec(mApp.Cdc), keyStaking, tKeyStaking, mApp.AccountKeeper, supplyKeeper, pk.Subspace(staking.DefaultParamspace), staking.DefaultCodespace,
 	)
 
 	keeper := keep.NewKeeper(
-		mApp.Cdc, keyGov, pk.Subspace(DefaultParamspace).WithKeyTable(ParamKeyTable()), supplyKeeper, sk, types.DefaultCodespace, rtr,
+		types.NewAminoCodec(mApp.Cdc), keyGov, pk.Subspace(DefaultParamspace).WithKeyTable(ParamKeyTable()), supplyKeeper, sk, types.DefaultCodespace, rtr,
+		mApp.Router(),
 	)
 
+	mApp.Router().AddRoute(bank.RouterKey, bank.NewHandler(bk))
 	mApp.Router().AddRoute(types.RouterKey, NewHandler(keeper))
 	mApp.QueryRouter().AddRoute(types.QuerierRoute, keep.NewQuerier(keeper))
 
@@ -196,7 +200,7 @@ const contextKeyBadProposal = "contextKeyBadProposal"
 // for the key contextKeyBadProposal or if the value is false.
 func badProposalHandler(ctx sdk.Context, c types.Content) sdk.Error {
 	switch c.ProposalType() {
-	case types.ProposalTypeText, types.ProposalTypeSoftwareUpgrade:
+	case types.ProposalTypeText:
 		v := ctx.Value(contextKeyBadProposal)
 
 		if v == nil || !v.(bool) {
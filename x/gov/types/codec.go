@@ -4,6 +4,45 @@ import (
 	"github.com/cosmos/cosmos-sdk/codec"
 )
 
+// Codec defines the interface required to serialize x/gov state. It must be
+// aware of all the concrete proposal content types an application uses, so it
+// is implemented by the application.
+type Codec interface {
+	codec.Marshaler
+
+	MarshalProposal(p Proposal) ([]byte, error)
+	UnmarshalProposal(bz []byte) (Proposal, error)
+}
+
+// AminoCodec defines an x/gov Codec that encodes proposals with amino. The
+// proposal content is decoded into any concrete type registered on the
+// underlying amino codec.
+type AminoCodec struct {
+	*codec.AminoCodec
+}
+
+var _ Codec = (*AminoCodec)(nil)
+
+// NewAminoCodec returns a new x/gov AminoCodec using the given amino codec.
+func NewAminoCodec(amino *codec.Codec) *AminoCodec {
+	return &AminoCodec{AminoCodec: codec.NewAminoCodec(amino)}
+}
+
+// MarshalProposal marshals a Proposal with amino.
+func (ac *AminoCodec) MarshalProposal(p Proposal) ([]byte, error) {
+	return ac.Amino().MarshalBinaryLengthPrefixed(p)
+}
+
+// UnmarshalProposal unmarshals a Proposal with amino.
+func (ac *AminoCodec) UnmarshalProposal(bz []byte) (Proposal, error) {
+	var p Proposal
+	if err := ac.Amino().UnmarshalBinaryLengthPrefixed(bz, &p); err != nil {
+		return Proposal{}, err
+	}
+
+	return p, nil
+}
+
 // module codec
 var ModuleCdc = codec.New()
 
@@ -15,9 +54,10 @@ func RegisterCodec(cdc *codec.Codec) {
 	cdc.RegisterConcrete(MsgSubmitProposal{}, "cosmos-sdk/MsgSubmitProposal", nil)
 	cdc.RegisterConcrete(MsgDeposit{}, "cosmos-sdk/MsgDeposit", nil)
 	cdc.RegisterConcrete(MsgVote{}, "cosmos-sdk/MsgVote", nil)
+	cdc.RegisterConcrete(MsgVoteWeighted{}, "cosmos-sdk/MsgVoteWeighted", nil)
 
 	cdc.RegisterConcrete(TextProposal{}, "cosmos-sdk/TextProposal", nil)
-	cdc.RegisterConcrete(SoftwareUpgradeProposal{}, "cosmos-sdk/SoftwareUpgradeProposal", nil)
+	cdc.RegisterConcrete(ExecutableProposal{}, "cosmos-sdk/ExecutableProposal", nil)
 }
 
 // RegisterProposalTypeCodec registers an external proposal content type defined
@@ -23,6 +23,8 @@ const (
 	CodeInvalidGenesis           sdk.CodeType = 9
 	CodeInvalidProposalStatus    sdk.CodeType = 10
 	CodeProposalHandlerNotExists sdk.CodeType = 11
+	CodeExecutionFailed          sdk.CodeType = 12
+	CodeExpeditedParamsNotSet    sdk.CodeType = 13
 )
 
 // ErrUnknownProposal error for unknown proposals
@@ -55,6 +57,11 @@ func ErrInvalidVote(codespace sdk.CodespaceType, voteOption VoteOption) sdk.Erro
 	return sdk.NewError(codespace, CodeInvalidVote, fmt.Sprintf("'%v' is not a valid voting option", voteOption.String()))
 }
 
+// ErrInvalidWeightedVote error for invalid weighted vote options
+func ErrInvalidWeightedVote(codespace sdk.CodespaceType, options WeightedVoteOptions) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidVote, fmt.Sprintf("'%s' are not valid weighted voting options: the options must be distinct with positive weights summing to 1", options))
+}
+
 // ErrInvalidGenesis error for an invalid governance GenesisState
 func ErrInvalidGenesis(codespace sdk.CodespaceType, msg string) sdk.Error {
 	return sdk.NewError(codespace, CodeInvalidVote, msg)
@@ -64,3 +71,14 @@ func ErrInvalidGenesis(codespace sdk.CodespaceType, msg string) sdk.Error {
 func ErrNoProposalHandlerExists(codespace sdk.CodespaceType, content interface{}) sdk.Error {
 	return sdk.NewError(codespace, CodeProposalHandlerNotExists, fmt.Sprintf("'%T' does not have a corresponding handler", content))
 }
+
+// ErrExecutionFailed error when a message of an executable proposal fails
+func ErrExecutionFailed(codespace sdk.CodespaceType, msgIndex int, log string) sdk.Error {
+	return sdk.NewError(codespace, CodeExecutionFailed, fmt.Sprintf("message %d failed on execution: %s", msgIndex, log))
+}
+
+// ErrExpeditedParamsNotSet error when an expedited proposal is submitted before
+// the expedited params are set
+func ErrExpeditedParamsNotSet(codespace sdk.CodespaceType, log string) sdk.Error {
+	return sdk.NewError(codespace, CodeExpeditedParamsNotSet, fmt.Sprintf("expedited proposals are disabled until the expedited params are set: %s", log))
+}
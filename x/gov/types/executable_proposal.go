@@ -0,0 +1,105 @@
+package types
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// ProposalTypeExecutable defines the type for an ExecutableProposal
+const ProposalTypeExecutable string = "Executable"
+
+// Implements Content Interface
+var _ Content = ExecutableProposal{}
+
+// ExecutableProposal defines a proposal which executes arbitrary messages once
+// passed. The messages must be signed by the governance module account only.
+// They are executed in order through the application router, and atomically:
+// if any of them fails, none of them is applied.
+type ExecutableProposal struct {
+	Title       string    `json:"title" yaml:"title"`
+	Description string    `json:"description" yaml:"description"`
+	Msgs        []sdk.Msg `json:"msgs" yaml:"msgs"`
+}
+
+// NewExecutableProposal creates an executable proposal Content
+func NewExecutableProposal(title, description string, msgs []sdk.Msg) ExecutableProposal {
+	return ExecutableProposal{title, description, msgs}
+}
+
+// GetTitle returns the proposal title
+func (ep ExecutableProposal) GetTitle() string { return ep.Title }
+
+// GetDescription returns the proposal description
+func (ep ExecutableProposal) GetDescription() string { return ep.Description }
+
+// ProposalRoute returns the proposal router key
+func (ep ExecutableProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType is "Executable"
+func (ep ExecutableProposal) ProposalType() string { return ProposalTypeExecutable }
+
+// ValidateBasic validates the title and description of the proposal along with
+// each of its messages.
+func (ep ExecutableProposal) ValidateBasic() sdk.Error {
+	if err := ValidateAbstract(DefaultCodespace, ep); err != nil {
+		return err
+	}
+
+	if len(ep.Msgs) == 0 {
+		return ErrInvalidProposalContent(DefaultCodespace, "executable proposal must contain at least one message")
+	}
+
+	for _, msg := range ep.Msgs {
+		if err := msg.ValidateBasic(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// String implements Stringer interface
+func (ep ExecutableProposal) String() string {
+	var b strings.Builder
+	b.WriteString(fmt.Sprintf(`Executable Proposal:
+  Title:       %s
+  Description: %s
+  Messages:
+`, ep.Title, ep.Description))
+
+	for i, msg := range ep.Msgs {
+		b.WriteString(fmt.Sprintf("    %d: %s/%s\n", i, msg.Route(), msg.Type()))
+	}
+
+	return b.String()
+}
+
+// aminoJSON defines the amino JSON encoding of a registered concrete type.
+type aminoJSON struct {
+	Type  string      `json:"type"`
+	Value interface{} `json:"value"`
+}
+
+// signBytes returns the amino JSON encoding of the proposal as a Content. The
+// messages are included through their own sign bytes, so they don't need to be
+// registered on the module codec.
+func (ep ExecutableProposal) signBytes() json.RawMessage {
+	msgs := make([]json.RawMessage, len(ep.Msgs))
+	for i, msg := range ep.Msgs {
+		msgs[i] = json.RawMessage(msg.GetSignBytes())
+	}
+
+	bz, err := json.Marshal(aminoJSON{"cosmos-sdk/ExecutableProposal", struct {
+		Title       string            `json:"title"`
+		Description string            `json:"description"`
+		Msgs        []json.RawMessage `json:"msgs"`
+	}{ep.Title, ep.Description, msgs}})
+	if err != nil {
+		panic(err)
+	}
+
+	return bz
+}
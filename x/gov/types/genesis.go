@@ -3,8 +3,6 @@ package types
 import (
 	"bytes"
 	"fmt"
-
-	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 // GenesisState - all staking state that must be provided at genesis
@@ -52,21 +50,23 @@ func (data GenesisState) IsEmpty() bool {
 
 // ValidateGenesis checks if parameters are within valid ranges
 func ValidateGenesis(data GenesisState) error {
-	threshold := data.TallyParams.Threshold
-	if threshold.IsNegative() || threshold.GT(sdk.OneDec()) {
-		return fmt.Errorf("governance vote threshold should be positive and less or equal to one, is %s",
-			threshold.String())
+	if err := data.TallyParams.Validate(); err != nil {
+		return err
+	}
+
+	if err := data.VotingParams.Validate(); err != nil {
+		return err
 	}
 
-	veto := data.TallyParams.Veto
-	if veto.IsNegative() || veto.GT(sdk.OneDec()) {
-		return fmt.Errorf("governance vote veto threshold should be positive and less or equal to one, is %s",
-			veto.String())
+	if err := data.DepositParams.Validate(); err != nil {
+		return err
 	}
 
-	if !data.DepositParams.MinDeposit.IsValid() {
-		return fmt.Errorf("governance deposit amount must be a valid sdk.Coins amount, is %s",
-			data.DepositParams.MinDeposit.String())
+	for _, vote := range data.Votes {
+		if !ValidWeightedVoteOptions(vote.GetOptions()) {
+			return fmt.Errorf("invalid options %s of the vote of %s on proposal %d",
+				vote.GetOptions(), vote.Voter, vote.ProposalID)
+		}
 	}
 
 	return nil
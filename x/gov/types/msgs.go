@@ -1,6 +1,7 @@
 package types
 
 import (
+	"encoding/json"
 	"fmt"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -10,10 +11,11 @@ import (
 const (
 	TypeMsgDeposit        = "deposit"
 	TypeMsgVote           = "vote"
+	TypeMsgVoteWeighted   = "weighted_vote"
 	TypeMsgSubmitProposal = "submit_proposal"
 )
 
-var _, _, _ sdk.Msg = MsgSubmitProposal{}, MsgDeposit{}, MsgVote{}
+var _, _, _, _ sdk.Msg = MsgSubmitProposal{}, MsgDeposit{}, MsgVote{}, MsgVoteWeighted{}
 
 // MsgSubmitProposal defines a message to create a governance proposal with a
 // given content and initial deposit
@@ -21,11 +23,18 @@ type MsgSubmitProposal struct {
 	Content        Content        `json:"content" yaml:"content"`
 	InitialDeposit sdk.Coins      `json:"initial_deposit" yaml:"initial_deposit"` //  Initial deposit paid by sender. Must be strictly positive
 	Proposer       sdk.AccAddress `json:"proposer" yaml:"proposer"`               //  Address of the proposer
+	Expedited      bool           `json:"expedited,omitempty" yaml:"expedited"`   //  Whether the proposal is expedited
 }
 
 // NewMsgSubmitProposal creates a new MsgSubmitProposal instance
 func NewMsgSubmitProposal(content Content, initialDeposit sdk.Coins, proposer sdk.AccAddress) MsgSubmitProposal {
-	return MsgSubmitProposal{content, initialDeposit, proposer}
+	return MsgSubmitProposal{content, initialDeposit, proposer, false}
+}
+
+// NewMsgSubmitExpeditedProposal creates a new MsgSubmitProposal instance of an
+// expedited proposal
+func NewMsgSubmitExpeditedProposal(content Content, initialDeposit sdk.Coins, proposer sdk.AccAddress) MsgSubmitProposal {
+	return MsgSubmitProposal{content, initialDeposit, proposer, true}
 }
 
 // Route implements Msg
@@ -39,12 +48,6 @@ func (msg MsgSubmitProposal) ValidateBasic() sdk.Error {
 	if msg.Content == nil {
 		return ErrInvalidProposalContent(DefaultCodespace, "missing content")
 	}
-	if msg.Content.ProposalType() == ProposalTypeSoftwareUpgrade {
-		// Disable software upgrade proposals as they are currently equivalent
-		// to text proposals. Re-enable once a valid software upgrade proposal
-		// handler is implemented.
-		return ErrInvalidProposalType(DefaultCodespace, msg.Content.ProposalType())
-	}
 	if msg.Proposer.Empty() {
 		return sdk.ErrInvalidAddress(msg.Proposer.String())
 	}
@@ -66,12 +69,30 @@ func (msg MsgSubmitProposal) String() string {
 	return fmt.Sprintf(`Submit Proposal Message:
   Content:         %s
   Initial Deposit: %s
-`, msg.Content.String(), msg.InitialDeposit)
+  Expedited:       %t
+`, msg.Content.String(), msg.InitialDeposit, msg.Expedited)
 }
 
-// GetSignBytes implements Msg
+// GetSignBytes implements Msg. The messages of an ExecutableProposal can be
+// any of the messages of the application, so it is encoded through its own
+// sign bytes.
 func (msg MsgSubmitProposal) GetSignBytes() []byte {
-	bz := ModuleCdc.MustMarshalJSON(msg)
+	content, ok := msg.Content.(ExecutableProposal)
+	if !ok {
+		bz := ModuleCdc.MustMarshalJSON(msg)
+		return sdk.MustSortJSON(bz)
+	}
+
+	bz, err := json.Marshal(aminoJSON{"cosmos-sdk/MsgSubmitProposal", struct {
+		Content        json.RawMessage `json:"content"`
+		InitialDeposit sdk.Coins       `json:"initial_deposit"`
+		Proposer       sdk.AccAddress  `json:"proposer"`
+		Expedited      bool            `json:"expedited,omitempty"`
+	}{content.signBytes(), msg.InitialDeposit, msg.Proposer, msg.Expedited}})
+	if err != nil {
+		panic(err)
+	}
+
 	return sdk.MustSortJSON(bz)
 }
 
@@ -181,3 +202,54 @@ func (msg MsgVote) GetSignBytes() []byte {
 func (msg MsgVote) GetSigners() []sdk.AccAddress {
 	return []sdk.AccAddress{msg.Voter}
 }
+
+// MsgVoteWeighted defines a message to cast a vote splitting the voting power
+// of the voter between several options
+type MsgVoteWeighted struct {
+	ProposalID uint64              `json:"proposal_id" yaml:"proposal_id"` // ID of the proposal
+	Voter      sdk.AccAddress      `json:"voter" yaml:"voter"`             //  address of the voter
+	Options    WeightedVoteOptions `json:"options" yaml:"options"`         //  weighted options chosen by the voter, summing to 1
+}
+
+// NewMsgVoteWeighted creates a message to cast a weighted vote on an active
+// proposal
+func NewMsgVoteWeighted(voter sdk.AccAddress, proposalID uint64, options WeightedVoteOptions) MsgVoteWeighted {
+	return MsgVoteWeighted{proposalID, voter, options}
+}
+
+// Route implements Msg
+func (msg MsgVoteWeighted) Route() string { return RouterKey }
+
+// Type implements Msg
+func (msg MsgVoteWeighted) Type() string { return TypeMsgVoteWeighted }
+
+// ValidateBasic implements Msg
+func (msg MsgVoteWeighted) ValidateBasic() sdk.Error {
+	if msg.Voter.Empty() {
+		return sdk.ErrInvalidAddress(msg.Voter.String())
+	}
+	if !ValidWeightedVoteOptions(msg.Options) {
+		return ErrInvalidWeightedVote(DefaultCodespace, msg.Options)
+	}
+
+	return nil
+}
+
+// String implements the Stringer interface
+func (msg MsgVoteWeighted) String() string {
+	return fmt.Sprintf(`Weighted Vote Message:
+  Proposal ID: %d
+  Options:     %s
+`, msg.ProposalID, msg.Options)
+}
+
+// GetSignBytes implements Msg
+func (msg MsgVoteWeighted) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners implements Msg
+func (msg MsgVoteWeighted) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Voter}
+}
@@ -6,6 +6,7 @@ import (
 
 	"github.com/stretchr/testify/require"
 
+	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
@@ -35,7 +36,6 @@ func TestMsgSubmitProposal(t *testing.T) {
 		{"Test Proposal", "the purpose of this proposal is to test", ProposalTypeText, addrs[0], coinsPos, true},
 		{"", "the purpose of this proposal is to test", ProposalTypeText, addrs[0], coinsPos, false},
 		{"Test Proposal", "", ProposalTypeText, addrs[0], coinsPos, false},
-		{"Test Proposal", "the purpose of this proposal is to test", ProposalTypeSoftwareUpgrade, addrs[0], coinsPos, false},
 		{"Test Proposal", "the purpose of this proposal is to test", ProposalTypeText, sdk.AccAddress{}, coinsPos, false},
 		{"Test Proposal", "the purpose of this proposal is to test", ProposalTypeText, addrs[0], coinsZero, true},
 		{"Test Proposal", "the purpose of this proposal is to test", ProposalTypeText, addrs[0], coinsMulti, true},
@@ -58,6 +58,38 @@ func TestMsgSubmitProposal(t *testing.T) {
 	}
 }
 
+func TestMsgSubmitExecutableProposal(t *testing.T) {
+	cdc := codec.New()
+	sdk.RegisterCodec(cdc)
+	RegisterCodec(cdc)
+
+	vote := NewMsgVote(addrs[0], 1, OptionYes)
+
+	tests := []struct {
+		msgs       []sdk.Msg
+		expectPass bool
+	}{
+		{[]sdk.Msg{vote}, true},
+		{[]sdk.Msg{vote, NewMsgDeposit(addrs[0], 1, coinsPos)}, true},
+		{nil, false},
+		{[]sdk.Msg{vote, NewMsgVote(addrs[0], 1, OptionEmpty)}, false},
+	}
+
+	for i, tc := range tests {
+		content := NewExecutableProposal("Test Proposal", "the purpose of this proposal is to test", tc.msgs)
+		msg := NewMsgSubmitProposal(content, coinsPos, addrs[1])
+
+		if tc.expectPass {
+			require.NoError(t, msg.ValidateBasic(), "test: %v", i)
+
+			// the messages are encoded through their sign bytes as amino would
+			require.Equal(t, string(sdk.MustSortJSON(cdc.MustMarshalJSON(msg))), string(msg.GetSignBytes()), "test: %v", i)
+		} else {
+			require.Error(t, msg.ValidateBasic(), "test: %v", i)
+		}
+	}
+}
+
 func TestMsgDepositGetSignBytes(t *testing.T) {
 	addr := sdk.AccAddress("addr1")
 	msg := NewMsgDeposit(addr, 0, coinsPos)
@@ -116,3 +148,50 @@ func TestMsgVote(t *testing.T) {
 		}
 	}
 }
+
+func TestMsgVoteWeighted(t *testing.T) {
+	yes := NewWeightedVoteOption(OptionYes, sdk.NewDecWithPrec(6, 1))
+	no := NewWeightedVoteOption(OptionNo, sdk.NewDecWithPrec(4, 1))
+
+	tests := []struct {
+		voterAddr  sdk.AccAddress
+		options    WeightedVoteOptions
+		expectPass bool
+	}{
+		{addrs[0], WeightedVoteOptions{yes, no}, true},
+		{addrs[0], NewNonSplitVoteOption(OptionAbstain), true},
+		{sdk.AccAddress{}, WeightedVoteOptions{yes, no}, false},
+		{addrs[0], WeightedVoteOptions{}, false},
+		{addrs[0], WeightedVoteOptions{yes}, false},
+		{addrs[0], WeightedVoteOptions{yes, yes}, false},
+		{addrs[0], WeightedVoteOptions{yes, NewWeightedVoteOption(OptionYes, sdk.NewDecWithPrec(4, 1))}, false},
+		{addrs[0], WeightedVoteOptions{yes, no, NewWeightedVoteOption(OptionAbstain, sdk.ZeroDec())}, false},
+		{addrs[0], WeightedVoteOptions{NewWeightedVoteOption(OptionYes, sdk.NewDec(2)), NewWeightedVoteOption(OptionNo, sdk.NewDec(-1))}, false},
+		{addrs[0], WeightedVoteOptions{NewWeightedVoteOption(VoteOption(0x13), sdk.OneDec())}, false},
+	}
+
+	for i, tc := range tests {
+		msg := NewMsgVoteWeighted(tc.voterAddr, 0, tc.options)
+		if tc.expectPass {
+			require.Nil(t, msg.ValidateBasic(), "test: %v", i)
+		} else {
+			require.NotNil(t, msg.ValidateBasic(), "test: %v", i)
+		}
+	}
+}
+
+func TestWeightedVoteOptionsFromString(t *testing.T) {
+	options, err := WeightedVoteOptionsFromString("Yes=0.6, NoWithVeto=0.4")
+	require.NoError(t, err)
+	require.True(t, options.Equals(WeightedVoteOptions{
+		NewWeightedVoteOption(OptionYes, sdk.NewDecWithPrec(6, 1)),
+		NewWeightedVoteOption(OptionNoWithVeto, sdk.NewDecWithPrec(4, 1)),
+	}))
+
+	_, err = WeightedVoteOptionsFromString("Yes")
+	require.Error(t, err)
+	_, err = WeightedVoteOptionsFromString("Maybe=1")
+	require.Error(t, err)
+	_, err = WeightedVoteOptionsFromString("Yes=one")
+	require.Error(t, err)
+}
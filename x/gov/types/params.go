@@ -10,15 +10,26 @@ import (
 
 // Default period for deposits & voting
 const (
-	DefaultPeriod time.Duration = time.Hour * 24 * 2 // 2 days
+	DefaultPeriod          time.Duration = time.Hour * 24 * 2 // 2 days
+	DefaultExpeditedPeriod time.Duration = time.Hour * 24     // 1 day
 )
 
 // Default governance params
 var (
-	DefaultMinDepositTokens = sdk.TokensFromConsensusPower(10)
-	DefaultQuorum           = sdk.NewDecWithPrec(334, 3)
-	DefaultThreshold        = sdk.NewDecWithPrec(5, 1)
-	DefaultVeto             = sdk.NewDecWithPrec(334, 3)
+	DefaultMinDepositTokens          = sdk.TokensFromConsensusPower(10)
+	DefaultExpeditedMinDepositTokens = sdk.TokensFromConsensusPower(50)
+	DefaultQuorum                    = sdk.NewDecWithPrec(334, 3)
+	DefaultThreshold                 = sdk.NewDecWithPrec(5, 1)
+	DefaultExpeditedThreshold        = sdk.NewDecWithPrec(667, 3)
+	DefaultVeto                      = sdk.NewDecWithPrec(334, 3)
+)
+
+// The params are validated on every update, so that param change proposals
+// breaking the invariants of the expedited params are rejected.
+var (
+	_ params.ParamValidator = DepositParams{}
+	_ params.ParamValidator = TallyParams{}
+	_ params.ParamValidator = VotingParams{}
 )
 
 // Parameter store key
@@ -39,15 +50,17 @@ func ParamKeyTable() params.KeyTable {
 
 // DepositParams defines the params around deposits for governance
 type DepositParams struct {
-	MinDeposit       sdk.Coins     `json:"min_deposit,omitempty" yaml:"min_deposit,omitempty"`               //  Minimum deposit for a proposal to enter voting period.
-	MaxDepositPeriod time.Duration `json:"max_deposit_period,omitempty" yaml:"max_deposit_period,omitempty"` //  Maximum period for Atom holders to deposit on a proposal. Initial value: 2 months
+	MinDeposit          sdk.Coins     `json:"min_deposit,omitempty" yaml:"min_deposit,omitempty"`                     //  Minimum deposit for a proposal to enter voting period.
+	MaxDepositPeriod    time.Duration `json:"max_deposit_period,omitempty" yaml:"max_deposit_period,omitempty"`       //  Maximum period for Atom holders to deposit on a proposal. Initial value: 2 months
+	ExpeditedMinDeposit sdk.Coins     `json:"expedited_min_deposit,omitempty" yaml:"expedited_min_deposit,omitempty"` //  Minimum deposit for an expedited proposal to enter voting period.
 }
 
 // NewDepositParams creates a new DepositParams object
-func NewDepositParams(minDeposit sdk.Coins, maxDepositPeriod time.Duration) DepositParams {
+func NewDepositParams(minDeposit sdk.Coins, maxDepositPeriod time.Duration, expeditedMinDeposit sdk.Coins) DepositParams {
 	return DepositParams{
-		MinDeposit:       minDeposit,
-		MaxDepositPeriod: maxDepositPeriod,
+		MinDeposit:          minDeposit,
+		MaxDepositPeriod:    maxDepositPeriod,
+		ExpeditedMinDeposit: expeditedMinDeposit,
 	}
 }
 
@@ -56,72 +69,164 @@ func DefaultDepositParams() DepositParams {
 	return NewDepositParams(
 		sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, DefaultMinDepositTokens)),
 		DefaultPeriod,
+		sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, DefaultExpeditedMinDepositTokens)),
 	)
 }
 
+// GetMinDeposit returns the minimum deposit of a regular or an expedited
+// proposal.
+func (dp DepositParams) GetMinDeposit(expedited bool) sdk.Coins {
+	if expedited {
+		return dp.ExpeditedMinDeposit
+	}
+	return dp.MinDeposit
+}
+
 // String implements stringer insterface
 func (dp DepositParams) String() string {
 	return fmt.Sprintf(`Deposit Params:
-  Min Deposit:        %s
-  Max Deposit Period: %s`, dp.MinDeposit, dp.MaxDepositPeriod)
+  Min Deposit:           %s
+  Max Deposit Period:    %s
+  Expedited Min Deposit: %s`, dp.MinDeposit, dp.MaxDepositPeriod, dp.ExpeditedMinDeposit)
 }
 
 // Equal checks equality of DepositParams
 func (dp DepositParams) Equal(dp2 DepositParams) bool {
-	return dp.MinDeposit.IsEqual(dp2.MinDeposit) && dp.MaxDepositPeriod == dp2.MaxDepositPeriod
+	return dp.MinDeposit.IsEqual(dp2.MinDeposit) && dp.MaxDepositPeriod == dp2.MaxDepositPeriod &&
+		dp.ExpeditedMinDeposit.IsEqual(dp2.ExpeditedMinDeposit)
+}
+
+// Validate checks the deposit params are valid. The minimum deposit of the
+// expedited proposals must be greater than the one of the regular proposals.
+func (dp DepositParams) Validate() error {
+	if !dp.MinDeposit.IsValid() {
+		return fmt.Errorf("governance deposit amount must be a valid sdk.Coins amount, is %s",
+			dp.MinDeposit.String())
+	}
+
+	if !dp.ExpeditedMinDeposit.IsValid() {
+		return fmt.Errorf("governance expedited deposit amount must be a valid sdk.Coins amount, is %s",
+			dp.ExpeditedMinDeposit.String())
+	}
+
+	if !dp.ExpeditedMinDeposit.IsAllGT(dp.MinDeposit) {
+		return fmt.Errorf("governance expedited deposit amount must be greater than the deposit amount %s, is %s",
+			dp.MinDeposit.String(), dp.ExpeditedMinDeposit.String())
+	}
+
+	return nil
 }
 
 // TallyParams defines the params around Tallying votes in governance
 type TallyParams struct {
-	Quorum    sdk.Dec `json:"quorum,omitempty" yaml:"quorum,omitempty"`       //  Minimum percentage of total stake needed to vote for a result to be considered valid
-	Threshold sdk.Dec `json:"threshold,omitempty" yaml:"threshold,omitempty"` //  Minimum proportion of Yes votes for proposal to pass. Initial value: 0.5
-	Veto      sdk.Dec `json:"veto,omitempty" yaml:"veto,omitempty"`           //  Minimum value of Veto votes to Total votes ratio for proposal to be vetoed. Initial value: 1/3
+	Quorum             sdk.Dec `json:"quorum,omitempty" yaml:"quorum,omitempty"`                           //  Minimum percentage of total stake needed to vote for a result to be considered valid
+	Threshold          sdk.Dec `json:"threshold,omitempty" yaml:"threshold,omitempty"`                     //  Minimum proportion of Yes votes for proposal to pass. Initial value: 0.5
+	Veto               sdk.Dec `json:"veto,omitempty" yaml:"veto,omitempty"`                               //  Minimum value of Veto votes to Total votes ratio for proposal to be vetoed. Initial value: 1/3
+	ExpeditedThreshold sdk.Dec `json:"expedited_threshold,omitempty" yaml:"expedited_threshold,omitempty"` //  Minimum proportion of Yes votes for an expedited proposal to pass. Initial value: 0.667
 }
 
 // NewTallyParams creates a new TallyParams object
-func NewTallyParams(quorum, threshold, veto sdk.Dec) TallyParams {
+func NewTallyParams(quorum, threshold, veto, expeditedThreshold sdk.Dec) TallyParams {
 	return TallyParams{
-		Quorum:    quorum,
-		Threshold: threshold,
-		Veto:      veto,
+		Quorum:             quorum,
+		Threshold:          threshold,
+		Veto:               veto,
+		ExpeditedThreshold: expeditedThreshold,
 	}
 }
 
 // DefaultTallyParams default parameters for tallying
 func DefaultTallyParams() TallyParams {
-	return NewTallyParams(DefaultQuorum, DefaultThreshold, DefaultVeto)
+	return NewTallyParams(DefaultQuorum, DefaultThreshold, DefaultVeto, DefaultExpeditedThreshold)
+}
+
+// GetThreshold returns the threshold of a regular or an expedited proposal.
+func (tp TallyParams) GetThreshold(expedited bool) sdk.Dec {
+	if expedited {
+		return tp.ExpeditedThreshold
+	}
+	return tp.Threshold
 }
 
 // String implements stringer insterface
 func (tp TallyParams) String() string {
 	return fmt.Sprintf(`Tally Params:
-  Quorum:             %s
-  Threshold:          %s
-  Veto:               %s`,
-		tp.Quorum, tp.Threshold, tp.Veto)
+  Quorum:              %s
+  Threshold:           %s
+  Veto:                %s
+  Expedited Threshold: %s`,
+		tp.Quorum, tp.Threshold, tp.Veto, tp.ExpeditedThreshold)
+}
+
+// Validate checks the tally params are valid. The threshold of the expedited
+// proposals must be greater than the one of the regular proposals.
+func (tp TallyParams) Validate() error {
+	if tp.Threshold.IsNil() || tp.Threshold.IsNegative() || tp.Threshold.GT(sdk.OneDec()) {
+		return fmt.Errorf("governance vote threshold should be positive and less or equal to one, is %s",
+			tp.Threshold.String())
+	}
+
+	if tp.Veto.IsNil() || tp.Veto.IsNegative() || tp.Veto.GT(sdk.OneDec()) {
+		return fmt.Errorf("governance vote veto threshold should be positive and less or equal to one, is %s",
+			tp.Veto.String())
+	}
+
+	if tp.ExpeditedThreshold.IsNil() || tp.ExpeditedThreshold.LTE(tp.Threshold) || tp.ExpeditedThreshold.GT(sdk.OneDec()) {
+		return fmt.Errorf("governance expedited vote threshold should be greater than the vote threshold %s and less or equal to one, is %s",
+			tp.Threshold.String(), tp.ExpeditedThreshold.String())
+	}
+
+	return nil
 }
 
 // VotingParams defines the params around Voting in governance
 type VotingParams struct {
-	VotingPeriod time.Duration `json:"voting_period,omitempty" yaml:"voting_period,omitempty"` //  Length of the voting period.
+	VotingPeriod          time.Duration `json:"voting_period,omitempty" yaml:"voting_period,omitempty"`                     //  Length of the voting period.
+	ExpeditedVotingPeriod time.Duration `json:"expedited_voting_period,omitempty" yaml:"expedited_voting_period,omitempty"` //  Length of the voting period of the expedited proposals.
 }
 
 // NewVotingParams creates a new VotingParams object
-func NewVotingParams(votingPeriod time.Duration) VotingParams {
+func NewVotingParams(votingPeriod, expeditedVotingPeriod time.Duration) VotingParams {
 	return VotingParams{
-		VotingPeriod: votingPeriod,
+		VotingPeriod:          votingPeriod,
+		ExpeditedVotingPeriod: expeditedVotingPeriod,
 	}
 }
 
 // DefaultVotingParams default parameters for voting
 func DefaultVotingParams() VotingParams {
-	return NewVotingParams(DefaultPeriod)
+	return NewVotingParams(DefaultPeriod, DefaultExpeditedPeriod)
+}
+
+// GetVotingPeriod returns the voting period of a regular or an expedited
+// proposal.
+func (vp VotingParams) GetVotingPeriod(expedited bool) time.Duration {
+	if expedited {
+		return vp.ExpeditedVotingPeriod
+	}
+	return vp.VotingPeriod
 }
 
 // String implements stringer interface
 func (vp VotingParams) String() string {
 	return fmt.Sprintf(`Voting Params:
-  Voting Period:      %s`, vp.VotingPeriod)
+  Voting Period:           %s
+  Expedited Voting Period: %s`, vp.VotingPeriod, vp.ExpeditedVotingPeriod)
+}
+
+// Validate checks the voting params are valid. The voting period of the
+// expedited proposals must be shorter than the one of the regular proposals.
+func (vp VotingParams) Validate() error {
+	if vp.VotingPeriod <= 0 {
+		return fmt.Errorf("governance voting period must be positive, is %s", vp.VotingPeriod)
+	}
+
+	if vp.ExpeditedVotingPeriod <= 0 || vp.ExpeditedVotingPeriod >= vp.VotingPeriod {
+		return fmt.Errorf("governance expedited voting period must be positive and shorter than the voting period %s, is %s",
+			vp.VotingPeriod, vp.ExpeditedVotingPeriod)
+	}
+
+	return nil
 }
 
 // Params returns all of the governance params
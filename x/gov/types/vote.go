@@ -3,24 +3,48 @@ package types
 import (
 	"encoding/json"
 	"fmt"
+	"strings"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 // Vote
 type Vote struct {
-	ProposalID uint64         `json:"proposal_id" yaml:"proposal_id"` //  proposalID of the proposal
-	Voter      sdk.AccAddress `json:"voter" yaml:"voter"`             //  address of the voter
-	Option     VoteOption     `json:"option" yaml:"option"`           //  option from OptionSet chosen by the voter
+	ProposalID uint64              `json:"proposal_id" yaml:"proposal_id"` //  proposalID of the proposal
+	Voter      sdk.AccAddress      `json:"voter" yaml:"voter"`             //  address of the voter
+	Option     VoteOption          `json:"option" yaml:"option"`           //  option from OptionSet chosen by the voter, empty if the vote is split
+	Options    WeightedVoteOptions `json:"options" yaml:"options"`         //  weighted options the voting power of the voter is split between
 }
 
-// NewVote creates a new Vote instance
+// NewVote creates a new Vote instance casting all the voting power of the
+// voter with a single option
 func NewVote(proposalID uint64, voter sdk.AccAddress, option VoteOption) Vote {
-	return Vote{proposalID, voter, option}
+	return Vote{proposalID, voter, option, NewNonSplitVoteOption(option)}
+}
+
+// NewWeightedVote creates a new Vote instance splitting the voting power of
+// the voter between the weighted options
+func NewWeightedVote(proposalID uint64, voter sdk.AccAddress, options WeightedVoteOptions) Vote {
+	option := OptionEmpty
+	if len(options) == 1 && options[0].Weight.Equal(sdk.OneDec()) {
+		option = options[0].Option
+	}
+
+	return Vote{proposalID, voter, option, options}
+}
+
+// GetOptions returns the weighted options of the vote. The votes cast before
+// votes could be split only have an option, which gets all the voting power.
+func (v Vote) GetOptions() WeightedVoteOptions {
+	if len(v.Options) == 0 && v.Option != OptionEmpty {
+		return NewNonSplitVoteOption(v.Option)
+	}
+
+	return v.Options
 }
 
 func (v Vote) String() string {
-	return fmt.Sprintf("voter %s voted with option %s on proposal %d", v.Voter, v.Option, v.ProposalID)
+	return fmt.Sprintf("voter %s voted with options %s on proposal %d", v.Voter, v.GetOptions(), v.ProposalID)
 }
 
 // Votes is a collection of Vote objects
@@ -32,7 +56,7 @@ func (v Votes) String() string {
 	}
 	out := fmt.Sprintf("Votes for Proposal %d:", v[0].ProposalID)
 	for _, vot := range v {
-		out += fmt.Sprintf("\n  %s: %s", vot.Voter, vot.Option)
+		out += fmt.Sprintf("\n  %s: %s", vot.Voter, vot.GetOptions())
 	}
 	return out
 }
@@ -41,7 +65,8 @@ func (v Votes) String() string {
 func (v Vote) Equals(comp Vote) bool {
 	return v.Voter.Equals(comp.Voter) &&
 		v.ProposalID == comp.ProposalID &&
-		v.Option == comp.Option
+		v.Option == comp.Option &&
+		v.Options.Equals(comp.Options)
 }
 
 // Empty returns whether a vote is empty.
@@ -49,6 +74,111 @@ func (v Vote) Empty() bool {
 	return v.Equals(Vote{})
 }
 
+// WeightedVoteOption defines a vote option and the fraction of the voting
+// power cast with it
+type WeightedVoteOption struct {
+	Option VoteOption `json:"option" yaml:"option"`
+	Weight sdk.Dec    `json:"weight" yaml:"weight"`
+}
+
+// NewWeightedVoteOption creates a new WeightedVoteOption instance
+func NewWeightedVoteOption(option VoteOption, weight sdk.Dec) WeightedVoteOption {
+	return WeightedVoteOption{option, weight}
+}
+
+// String implements the Stringer interface, e.g. Yes=0.6.
+func (wvo WeightedVoteOption) String() string {
+	return fmt.Sprintf("%s=%s", wvo.Option, wvo.Weight)
+}
+
+// WeightedVoteOptions defines the weighted options of a vote
+type WeightedVoteOptions []WeightedVoteOption
+
+// NewNonSplitVoteOption returns the weighted options of a vote casting all the
+// voting power with a single option.
+func NewNonSplitVoteOption(option VoteOption) WeightedVoteOptions {
+	return WeightedVoteOptions{NewWeightedVoteOption(option, sdk.OneDec())}
+}
+
+// WeightedVoteOptionsFromString returns the WeightedVoteOptions of a comma
+// separated list of weighted options, e.g. Yes=0.6,No=0.4. It returns an error
+// if the string is invalid.
+func WeightedVoteOptionsFromString(str string) (WeightedVoteOptions, error) {
+	var options WeightedVoteOptions
+
+	for _, s := range strings.Split(str, ",") {
+		fields := strings.Split(strings.TrimSpace(s), "=")
+		if len(fields) != 2 {
+			return nil, fmt.Errorf("'%s' is not a valid weighted vote option", s)
+		}
+
+		option, err := VoteOptionFromString(fields[0])
+		if err != nil {
+			return nil, err
+		}
+
+		weight, err := sdk.NewDecFromStr(fields[1])
+		if err != nil {
+			return nil, fmt.Errorf("'%s' is not a valid vote weight: %s", fields[1], err)
+		}
+
+		options = append(options, NewWeightedVoteOption(option, weight))
+	}
+
+	return options, nil
+}
+
+// ValidWeightedVoteOptions returns true if the weighted options are valid vote
+// options with positive weights summing to 1, each option being given at most
+// once, and false otherwise.
+func ValidWeightedVoteOptions(options WeightedVoteOptions) bool {
+	if len(options) == 0 {
+		return false
+	}
+
+	seen := make(map[VoteOption]bool, len(options))
+	total := sdk.ZeroDec()
+
+	for _, option := range options {
+		if !ValidVoteOption(option.Option) || seen[option.Option] {
+			return false
+		}
+		if option.Weight.IsNil() || !option.Weight.IsPositive() || option.Weight.GT(sdk.OneDec()) {
+			return false
+		}
+
+		seen[option.Option] = true
+		total = total.Add(option.Weight)
+	}
+
+	return total.Equal(sdk.OneDec())
+}
+
+// Equals returns whether two weighted options are equal.
+func (wvo WeightedVoteOptions) Equals(comp WeightedVoteOptions) bool {
+	if len(wvo) != len(comp) {
+		return false
+	}
+
+	for i := range wvo {
+		if wvo[i].Option != comp[i].Option || !wvo[i].Weight.Equal(comp[i].Weight) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// String implements the Stringer interface, e.g. Yes=0.6,No=0.4.
+func (wvo WeightedVoteOptions) String() string {
+	strs := make([]string, len(wvo))
+	for i, option := range wvo {
+		strs[i] = option.String()
+	}
+
+	return strings.Join(strs, ",")
+}
+
 // VoteOption defines a vote option
 type VoteOption byte
 
@@ -67,7 +67,7 @@ func NewApp() *App {
 	app.ParamsKeeper = params.NewKeeper(app.Cdc, app.KeyParams, app.TKeyParams, params.DefaultCodespace)
 
 	app.AccountKeeper = auth.NewAccountKeeper(
-		app.Cdc,
+		auth.NewAminoCodec(app.Cdc),
 		app.KeyAccount,
 		app.ParamsKeeper.Subspace(auth.DefaultParamspace),
 		auth.ProtoBaseAccount,
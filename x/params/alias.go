@@ -49,6 +49,7 @@ type (
 	ParamSetPair            = subspace.ParamSetPair
 	ParamSetPairs           = subspace.ParamSetPairs
 	ParamSet                = subspace.ParamSet
+	ParamValidator          = subspace.ParamValidator
 	Subspace                = subspace.Subspace
 	ReadOnlySubspace        = subspace.ReadOnlySubspace
 	KeyTable                = subspace.KeyTable
@@ -0,0 +1,15 @@
+package params
+
+import (
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// BaseAppParamKeyTable returns the key table of the parameters of the
+// BaseApp, to be used by its parameter store.
+func BaseAppParamKeyTable() KeyTable {
+	return NewKeyTable(
+		baseapp.ParamStoreKeyKVGasConfig, sdk.GasConfig{},
+		baseapp.ParamStoreKeyTransientGasConfig, sdk.GasConfig{},
+	)
+}
@@ -0,0 +1,81 @@
+package params
+
+import (
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// GenesisState contains the parameters of the BaseApp, which no module owns.
+// A parameter left unset keeps the default value stored by InitChain.
+type GenesisState struct {
+	KVGasConfig        *sdk.GasConfig `json:"kv_gas_config,omitempty" yaml:"kv_gas_config"`
+	TransientGasConfig *sdk.GasConfig `json:"transient_gas_config,omitempty" yaml:"transient_gas_config"`
+}
+
+// NewGenesisState creates a new GenesisState object
+func NewGenesisState(kvGasConfig, transientGasConfig sdk.GasConfig) GenesisState {
+	return GenesisState{
+		KVGasConfig:        &kvGasConfig,
+		TransientGasConfig: &transientGasConfig,
+	}
+}
+
+// DefaultGenesisState returns a genesis state with the default gas costs of
+// the KVStores
+func DefaultGenesisState() GenesisState {
+	return NewGenesisState(sdk.KVGasConfig(), sdk.TransientGasConfig())
+}
+
+// ValidateGenesis ensures the gas configs of the genesis state are valid
+func ValidateGenesis(data GenesisState) error {
+	if data.KVGasConfig != nil {
+		if err := data.KVGasConfig.Validate(); err != nil {
+			return err
+		}
+	}
+
+	if data.TransientGasConfig != nil {
+		if err := data.TransientGasConfig.Validate(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// InitGenesis stores the BaseApp parameters of a *previously validated*
+// GenesisState, if the BaseApp parameter subspace is used
+func InitGenesis(ctx sdk.Context, k Keeper, data GenesisState) {
+	subspace, found := k.GetSubspace(baseapp.Paramspace)
+	if !found {
+		return
+	}
+
+	if data.KVGasConfig != nil {
+		subspace.Set(ctx, baseapp.ParamStoreKeyKVGasConfig, *data.KVGasConfig)
+	}
+
+	if data.TransientGasConfig != nil {
+		subspace.Set(ctx, baseapp.ParamStoreKeyTransientGasConfig, *data.TransientGasConfig)
+	}
+}
+
+// ExportGenesis returns a GenesisState with the stored BaseApp parameters
+func ExportGenesis(ctx sdk.Context, k Keeper) (data GenesisState) {
+	subspace, found := k.GetSubspace(baseapp.Paramspace)
+	if !found {
+		return data
+	}
+
+	if subspace.Has(ctx, baseapp.ParamStoreKeyKVGasConfig) {
+		data.KVGasConfig = &sdk.GasConfig{}
+		subspace.Get(ctx, baseapp.ParamStoreKeyKVGasConfig, data.KVGasConfig)
+	}
+
+	if subspace.Has(ctx, baseapp.ParamStoreKeyTransientGasConfig) {
+		data.TransientGasConfig = &sdk.GasConfig{}
+		subspace.Get(ctx, baseapp.ParamStoreKeyTransientGasConfig, data.TransientGasConfig)
+	}
+
+	return data
+}
@@ -0,0 +1,38 @@
+package params_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/params"
+)
+
+func TestGenesisBaseAppGasConfig(t *testing.T) {
+	input := newTestInput(t)
+
+	// nothing is exported without the BaseApp parameter subspace
+	require.Equal(t, params.GenesisState{}, params.ExportGenesis(input.ctx, input.keeper))
+
+	ss := input.keeper.Subspace(baseapp.Paramspace).WithKeyTable(params.BaseAppParamKeyTable())
+	ss.Set(input.ctx, baseapp.ParamStoreKeyKVGasConfig, sdk.KVGasConfig())
+	ss.Set(input.ctx, baseapp.ParamStoreKeyTransientGasConfig, sdk.TransientGasConfig())
+
+	kvGasConfig := sdk.KVGasConfig()
+	kvGasConfig.WriteCostFlat = 500
+
+	// unset parameters keep their stored value
+	params.InitGenesis(input.ctx, input.keeper, params.GenesisState{KVGasConfig: &kvGasConfig})
+	require.Equal(t,
+		params.NewGenesisState(kvGasConfig, sdk.TransientGasConfig()),
+		params.ExportGenesis(input.ctx, input.keeper),
+	)
+
+	require.NoError(t, params.ValidateGenesis(params.DefaultGenesisState()))
+	require.NoError(t, params.ValidateGenesis(params.GenesisState{}))
+
+	kvGasConfig.ReadCostFlat = 0
+	require.Error(t, params.ValidateGenesis(params.GenesisState{KVGasConfig: &kvGasConfig}))
+}
@@ -2,6 +2,7 @@ package params_test
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 	abci "github.com/tendermint/tendermint/abci/types"
@@ -9,9 +10,11 @@ import (
 
 	dbm "github.com/tendermint/tm-db"
 
+	"github.com/cosmos/cosmos-sdk/baseapp"
 	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/cosmos/cosmos-sdk/store"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 	"github.com/cosmos/cosmos-sdk/x/params"
 	"github.com/cosmos/cosmos-sdk/x/params/subspace"
 	"github.com/cosmos/cosmos-sdk/x/params/types"
@@ -127,3 +130,70 @@ func TestProposalHandlerUpdateOmitempty(t *testing.T) {
 	ss.Get(input.ctx, []byte(keySlashingRate), &param)
 	require.Equal(t, testParamsSlashingRate{10, 7}, param)
 }
+
+func TestProposalHandlerBaseAppGasConfig(t *testing.T) {
+	input := newTestInput(t)
+	ss := input.keeper.Subspace(baseapp.Paramspace).WithKeyTable(params.BaseAppParamKeyTable())
+	ss.Set(input.ctx, baseapp.ParamStoreKeyKVGasConfig, sdk.KVGasConfig())
+
+	hdlr := params.NewParamChangeProposalHandler(input.keeper)
+	key := string(baseapp.ParamStoreKeyKVGasConfig)
+
+	tp := testProposal(params.NewParamChange(baseapp.Paramspace, key, `{"read_cost_flat": "500"}`))
+	require.NoError(t, hdlr(input.ctx, tp))
+
+	expected := sdk.KVGasConfig()
+	expected.ReadCostFlat = 500
+
+	var gasConfig sdk.GasConfig
+	ss.Get(input.ctx, baseapp.ParamStoreKeyKVGasConfig, &gasConfig)
+	require.Equal(t, expected, gasConfig)
+
+	// invalid gas configs are rejected
+	tp = testProposal(params.NewParamChange(baseapp.Paramspace, key, `{"write_cost_flat": "0"}`))
+	require.Error(t, hdlr(input.ctx, tp))
+
+	ss.Get(input.ctx, baseapp.ParamStoreKeyKVGasConfig, &gasConfig)
+	require.Equal(t, expected, gasConfig)
+}
+
+func TestProposalHandlerGovParams(t *testing.T) {
+	input := newTestInput(t)
+	ss := input.keeper.Subspace(govtypes.DefaultParamspace).WithKeyTable(govtypes.ParamKeyTable())
+	ss.Set(input.ctx, govtypes.ParamStoreKeyVotingParams, govtypes.DefaultVotingParams())
+	ss.Set(input.ctx, govtypes.ParamStoreKeyTallyParams, govtypes.DefaultTallyParams())
+	ss.Set(input.ctx, govtypes.ParamStoreKeyDepositParams, govtypes.DefaultDepositParams())
+
+	hdlr := params.NewParamChangeProposalHandler(input.keeper)
+	votingKey := string(govtypes.ParamStoreKeyVotingParams)
+
+	tp := testProposal(params.NewParamChange(govtypes.DefaultParamspace, votingKey, `{"expedited_voting_period": "3600000000000"}`))
+	require.NoError(t, hdlr(input.ctx, tp))
+
+	var votingParams govtypes.VotingParams
+	ss.Get(input.ctx, govtypes.ParamStoreKeyVotingParams, &votingParams)
+	require.Equal(t, govtypes.NewVotingParams(govtypes.DefaultPeriod, time.Hour), votingParams)
+
+	// changes breaking the expedited params invariants are rejected
+	invalid := []params.ParamChange{
+		params.NewParamChange(govtypes.DefaultParamspace, votingKey, `{"expedited_voting_period": "172800000000000"}`),
+		params.NewParamChange(govtypes.DefaultParamspace, votingKey, `{"voting_period": "1800000000000"}`),
+		params.NewParamChange(govtypes.DefaultParamspace, string(govtypes.ParamStoreKeyTallyParams), `{"expedited_threshold": "0.4"}`),
+		params.NewParamChange(govtypes.DefaultParamspace, string(govtypes.ParamStoreKeyDepositParams),
+			`{"expedited_min_deposit": [{"denom": "stake", "amount": "1"}]}`),
+	}
+	for _, change := range invalid {
+		require.Error(t, hdlr(input.ctx, testProposal(change)), change.String())
+	}
+
+	ss.Get(input.ctx, govtypes.ParamStoreKeyVotingParams, &votingParams)
+	require.Equal(t, govtypes.NewVotingParams(govtypes.DefaultPeriod, time.Hour), votingParams)
+
+	var tallyParams govtypes.TallyParams
+	ss.Get(input.ctx, govtypes.ParamStoreKeyTallyParams, &tallyParams)
+	require.Equal(t, govtypes.DefaultTallyParams(), tallyParams)
+
+	var depositParams govtypes.DepositParams
+	ss.Get(input.ctx, govtypes.ParamStoreKeyDepositParams, &depositParams)
+	require.True(t, govtypes.DefaultDepositParams().Equal(depositParams))
+}
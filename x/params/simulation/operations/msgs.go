@@ -7,6 +7,7 @@ import (
 	"time"
 
 	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 	"github.com/cosmos/cosmos-sdk/x/params"
@@ -108,6 +109,7 @@ var paramChangePool = []simParamChange{
 				{"quorum", simulation.ModuleParamSimulator[simulation.TallyParamsQuorum](r).(sdk.Dec)},
 				{"threshold", simulation.ModuleParamSimulator[simulation.TallyParamsThreshold](r).(sdk.Dec)},
 				{"veto", simulation.ModuleParamSimulator[simulation.TallyParamsVeto](r).(sdk.Dec)},
+				{"expedited_threshold", simulation.ModuleParamSimulator[simulation.TallyParamsExpeditedThreshold](r).(sdk.Dec)},
 			}
 
 			pc := make(map[string]string)
@@ -153,6 +155,43 @@ var paramChangePool = []simParamChange{
 			return fmt.Sprintf("\"%d\"", simulation.ModuleParamSimulator[simulation.TxSizeCostPerByte](r).(uint64))
 		},
 	},
+	// baseapp parameters
+	{
+		"baseapp",
+		"KVGasConfig",
+		"",
+		func(r *rand.Rand) string {
+			return randomGasConfig(r, sdk.KVGasConfig())
+		},
+	},
+	{
+		"baseapp",
+		"TransientGasConfig",
+		"",
+		func(r *rand.Rand) string {
+			return randomGasConfig(r, sdk.TransientGasConfig())
+		},
+	},
+}
+
+// randomGasConfig returns a random gas config with costs between half the
+// given ones and the given ones, so that simulated txs keep within their gas
+// limit.
+func randomGasConfig(r *rand.Rand, gasConfig sdk.GasConfig) string {
+	randomCost := func(cost sdk.Gas) sdk.Gas {
+		return sdk.Gas(simulation.RandIntBetween(r, int(cost/2)+1, int(cost)+1))
+	}
+
+	bz := codec.Cdc.MustMarshalJSON(sdk.GasConfig{
+		HasCost:          randomCost(gasConfig.HasCost),
+		DeleteCost:       randomCost(gasConfig.DeleteCost),
+		ReadCostFlat:     randomCost(gasConfig.ReadCostFlat),
+		ReadCostPerByte:  randomCost(gasConfig.ReadCostPerByte),
+		WriteCostFlat:    randomCost(gasConfig.WriteCostFlat),
+		WriteCostPerByte: randomCost(gasConfig.WriteCostPerByte),
+		IterNextCostFlat: randomCost(gasConfig.IterNextCostFlat),
+	})
+	return string(bz)
 }
 
 // SimulateParamChangeProposalContent returns random parameter change content.
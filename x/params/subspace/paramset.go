@@ -18,3 +18,10 @@ type ParamSetPairs []ParamSetPair
 type ParamSet interface {
 	ParamSetPairs() ParamSetPairs
 }
+
+// ParamValidator defines an interface for parameters validating their value.
+// Updates resulting in an invalid parameter, e.g. by a parameter change
+// proposal, are rejected.
+type ParamValidator interface {
+	Validate() error
+}
@@ -194,6 +194,10 @@ func (s Subspace) Update(ctx sdk.Context, key []byte, param []byte) error {
 		return err
 	}
 
+	if err := validate(dest); err != nil {
+		return err
+	}
+
 	s.Set(ctx, key, dest)
 	tStore := s.transientStore(ctx)
 	tStore.Set(key, []byte{})
@@ -238,6 +242,10 @@ func (s Subspace) UpdateWithSubkey(ctx sdk.Context, key []byte, subkey []byte, p
 		return err
 	}
 
+	if err := validate(dest); err != nil {
+		return err
+	}
+
 	s.SetWithSubkey(ctx, key, subkey, dest)
 	tStore := s.transientStore(ctx)
 	tStore.Set(concatkey, []byte{})
@@ -245,6 +253,16 @@ func (s Subspace) UpdateWithSubkey(ctx sdk.Context, key []byte, subkey []byte, p
 	return nil
 }
 
+// validate validates an updated parameter if its type implements
+// ParamValidator, so that invalid parameter changes are rejected.
+func validate(param interface{}) error {
+	if v, ok := param.(ParamValidator); ok {
+		return v.Validate()
+	}
+
+	return nil
+}
+
 // Get to ParamSet
 func (s Subspace) GetParamSet(ctx sdk.Context, ps ParamSet) {
 	for _, pair := range ps.ParamSetPairs() {
@@ -18,32 +18,35 @@ const (
 	maxTimePerBlock int64 = 10000
 
 	// Simulation parameter constants
-	SendEnabled              = "send_enabled"
-	MaxMemoChars             = "max_memo_characters"
-	TxSigLimit               = "tx_sig_limit"
-	TxSizeCostPerByte        = "tx_size_cost_per_byte"
-	SigVerifyCostED25519     = "sig_verify_cost_ed25519"
-	SigVerifyCostSECP256K1   = "sig_verify_cost_secp256k1"
-	DepositParamsMinDeposit  = "deposit_params_min_deposit"
-	VotingParamsVotingPeriod = "voting_params_voting_period"
-	TallyParamsQuorum        = "tally_params_quorum"
-	TallyParamsThreshold     = "tally_params_threshold"
-	TallyParamsVeto          = "tally_params_veto"
-	UnbondingTime            = "unbonding_time"
-	MaxValidators            = "max_validators"
-	SignedBlocksWindow       = "signed_blocks_window"
-	MinSignedPerWindow       = "min_signed_per_window"
-	DowntimeJailDuration     = "downtime_jail_duration"
-	SlashFractionDoubleSign  = "slash_fraction_double_sign"
-	SlashFractionDowntime    = "slash_fraction_downtime"
-	InflationRateChange      = "inflation_rate_change"
-	Inflation                = "inflation"
-	InflationMax             = "inflation_max"
-	InflationMin             = "inflation_min"
-	GoalBonded               = "goal_bonded"
-	CommunityTax             = "community_tax"
-	BaseProposerReward       = "base_proposer_reward"
-	BonusProposerReward      = "bonus_proposer_reward"
+	SendEnabled                       = "send_enabled"
+	MaxMemoChars                      = "max_memo_characters"
+	TxSigLimit                        = "tx_sig_limit"
+	TxSizeCostPerByte                 = "tx_size_cost_per_byte"
+	SigVerifyCostED25519              = "sig_verify_cost_ed25519"
+	SigVerifyCostSECP256K1            = "sig_verify_cost_secp256k1"
+	DepositParamsMinDeposit           = "deposit_params_min_deposit"
+	DepositParamsExpeditedMinDeposit  = "deposit_params_expedited_min_deposit"
+	VotingParamsVotingPeriod          = "voting_params_voting_period"
+	VotingParamsExpeditedVotingPeriod = "voting_params_expedited_voting_period"
+	TallyParamsQuorum                 = "tally_params_quorum"
+	TallyParamsThreshold              = "tally_params_threshold"
+	TallyParamsExpeditedThreshold     = "tally_params_expedited_threshold"
+	TallyParamsVeto                   = "tally_params_veto"
+	UnbondingTime                     = "unbonding_time"
+	MaxValidators                     = "max_validators"
+	SignedBlocksWindow                = "signed_blocks_window"
+	MinSignedPerWindow                = "min_signed_per_window"
+	DowntimeJailDuration              = "downtime_jail_duration"
+	SlashFractionDoubleSign           = "slash_fraction_double_sign"
+	SlashFractionDowntime             = "slash_fraction_downtime"
+	InflationRateChange               = "inflation_rate_change"
+	Inflation                         = "inflation"
+	InflationMax                      = "inflation_max"
+	InflationMin                      = "inflation_min"
+	GoalBonded                        = "goal_bonded"
+	CommunityTax                      = "community_tax"
+	BaseProposerReward                = "base_proposer_reward"
+	BonusProposerReward               = "bonus_proposer_reward"
 )
 
 // TODO explain transitional matrix usage
@@ -89,8 +92,16 @@ var (
 		DepositParamsMinDeposit: func(r *rand.Rand) interface{} {
 			return sdk.Coins{sdk.NewInt64Coin(sdk.DefaultBondDenom, int64(RandIntBetween(r, 1, 1e3)))}
 		},
+		// always greater than the min deposit
+		DepositParamsExpeditedMinDeposit: func(r *rand.Rand) interface{} {
+			return sdk.Coins{sdk.NewInt64Coin(sdk.DefaultBondDenom, int64(RandIntBetween(r, 1e3, 1e4)))}
+		},
 		VotingParamsVotingPeriod: func(r *rand.Rand) interface{} {
-			return time.Duration(RandIntBetween(r, 1, 2*60*60*24*2)) * time.Second
+			return time.Duration(RandIntBetween(r, 60*60, 2*60*60*24*2)) * time.Second
+		},
+		// always shorter than the voting period
+		VotingParamsExpeditedVotingPeriod: func(r *rand.Rand) interface{} {
+			return time.Duration(RandIntBetween(r, 1, 60*60)) * time.Second
 		},
 		TallyParamsQuorum: func(r *rand.Rand) interface{} {
 			return sdk.NewDecWithPrec(int64(RandIntBetween(r, 334, 500)), 3)
@@ -98,6 +109,10 @@ var (
 		TallyParamsThreshold: func(r *rand.Rand) interface{} {
 			return sdk.NewDecWithPrec(int64(RandIntBetween(r, 450, 550)), 3)
 		},
+		// always greater than the threshold
+		TallyParamsExpeditedThreshold: func(r *rand.Rand) interface{} {
+			return sdk.NewDecWithPrec(int64(RandIntBetween(r, 550, 800)), 3)
+		},
 		TallyParamsVeto: func(r *rand.Rand) interface{} {
 			return sdk.NewDecWithPrec(int64(RandIntBetween(r, 250, 334)), 3)
 		},
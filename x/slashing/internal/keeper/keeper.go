@@ -64,3 +64,34 @@ func (k Keeper) deleteAddrPubkeyRelation(ctx sdk.Context, addr crypto.Address) {
 	store := ctx.KVStore(k.storeKey)
 	store.Delete(types.GetAddrPubkeyRelationKey(addr))
 }
+
+// Slash attempts to slash a validator for a double sign infraction. The slash
+// is delegated to the staking module to make the necessary validator changes.
+//
+// NOTE: power is the int64 power of the validator as provided to/by
+// Tendermint, ie. validator.Tokens as sent to Tendermint via ABCI.
+func (k Keeper) Slash(ctx sdk.Context, consAddr sdk.ConsAddress, fraction sdk.Dec, power, distributionHeight int64) {
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeSlash,
+			sdk.NewAttribute(types.AttributeKeyAddress, consAddr.String()),
+			sdk.NewAttribute(types.AttributeKeyPower, fmt.Sprintf("%d", power)),
+			sdk.NewAttribute(types.AttributeKeyReason, types.AttributeValueDoubleSign),
+		),
+	)
+
+	k.sk.Slash(ctx, consAddr, distributionHeight, power, fraction)
+}
+
+// Jail attempts to jail a validator. The jail is delegated to the staking
+// module to make the necessary validator changes.
+func (k Keeper) Jail(ctx sdk.Context, consAddr sdk.ConsAddress) {
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeSlash,
+			sdk.NewAttribute(types.AttributeKeyJailed, consAddr.String()),
+		),
+	)
+
+	k.sk.Jail(ctx, consAddr)
+}
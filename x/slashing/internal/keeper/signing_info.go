@@ -1,6 +1,9 @@
 package keeper
 
 import (
+	"fmt"
+	"time"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/slashing/internal/types"
 )
@@ -94,3 +97,43 @@ func (k Keeper) clearValidatorMissedBlockBitArray(ctx sdk.Context, address sdk.C
 		store.Delete(iter.Key())
 	}
 }
+
+// JailUntil attempts to set a validator's JailedUntil attribute in its signing
+// info. It will panic if the signing info does not exist for the validator.
+func (k Keeper) JailUntil(ctx sdk.Context, consAddr sdk.ConsAddress, jailTime time.Time) {
+	signInfo, found := k.GetValidatorSigningInfo(ctx, consAddr)
+	if !found {
+		panic(fmt.Sprintf("cannot jail validator %s that does not have any signing information", consAddr))
+	}
+
+	signInfo.JailedUntil = jailTime
+	k.SetValidatorSigningInfo(ctx, consAddr, signInfo)
+}
+
+// Tombstone attempts to tombstone a validator. It will panic if the signing
+// info for the given validator does not exist or the validator is already
+// tombstoned.
+func (k Keeper) Tombstone(ctx sdk.Context, consAddr sdk.ConsAddress) {
+	signInfo, found := k.GetValidatorSigningInfo(ctx, consAddr)
+	if !found {
+		panic(fmt.Sprintf("cannot tombstone validator %s that does not have any signing information", consAddr))
+	}
+
+	if signInfo.Tombstoned {
+		panic(fmt.Sprintf("cannot tombstone validator %s that is already tombstoned", consAddr))
+	}
+
+	signInfo.Tombstoned = true
+	k.SetValidatorSigningInfo(ctx, consAddr, signInfo)
+}
+
+// IsTombstoned returns true if a validator's signing info exists and the
+// validator is tombstoned, false otherwise.
+func (k Keeper) IsTombstoned(ctx sdk.Context, consAddr sdk.ConsAddress) bool {
+	signInfo, found := k.GetValidatorSigningInfo(ctx, consAddr)
+	if !found {
+		return false
+	}
+
+	return signInfo.Tombstoned
+}
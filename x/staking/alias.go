@@ -52,6 +52,10 @@ const (
 	MaxWebsiteLength                   = types.MaxWebsiteLength
 	MaxDetailsLength                   = types.MaxDetailsLength
 	DoNotModifyDesc                    = types.DoNotModifyDesc
+	QueryTokenizeShareRecordsByOwner   = types.QueryTokenizeShareRecordsByOwner
+	QueryTokenizeShareRecordByDenom    = types.QueryTokenizeShareRecordByDenom
+	TokenizeShareRecordAccountPrefix   = types.TokenizeShareRecordAccountPrefix
+	ShareTokenDenomPrefix              = types.ShareTokenDenomPrefix
 )
 
 var (
@@ -180,6 +184,29 @@ var (
 	UnmarshalValidator                 = types.UnmarshalValidator
 	NewDescription                     = types.NewDescription
 
+	ErrTokenizeShareRecordNotExists           = types.ErrTokenizeShareRecordNotExists
+	ErrNotTokenizeShareRecordOwner            = types.ErrNotTokenizeShareRecordOwner
+	ErrTokenizeSelfDelegation                 = types.ErrTokenizeSelfDelegation
+	ErrTokenizeSharesRedelegationInProgress   = types.ErrTokenizeSharesRedelegationInProgress
+	ErrTokenizeSharesVestingAccount           = types.ErrTokenizeSharesVestingAccount
+	ErrValidatorLiquidStakingCapExceeded      = types.ErrValidatorLiquidStakingCapExceeded
+	ErrGlobalLiquidStakingCapExceeded         = types.ErrGlobalLiquidStakingCapExceeded
+	ErrTinyRedemptionAmount                   = types.ErrTinyRedemptionAmount
+	GetTokenizeShareRecordKey                 = types.GetTokenizeShareRecordKey
+	GetTokenizeShareRecordByOwnerIndexKey     = types.GetTokenizeShareRecordByOwnerIndexKey
+	GetTokenizeShareRecordsByOwnerIndexKey    = types.GetTokenizeShareRecordsByOwnerIndexKey
+	GetTokenizeShareRecordByDelegatorIndexKey = types.GetTokenizeShareRecordByDelegatorIndexKey
+	NewMsgTokenizeShares                      = types.NewMsgTokenizeShares
+	NewMsgRedeemTokensForShares               = types.NewMsgRedeemTokensForShares
+	NewMsgTransferTokenizeShareRecord         = types.NewMsgTransferTokenizeShareRecord
+	NewQueryTokenizeShareRecordsParams        = types.NewQueryTokenizeShareRecordsParams
+	NewQueryTokenizeShareRecordParams         = types.NewQueryTokenizeShareRecordParams
+	NewTokenizeShareRecord                    = types.NewTokenizeShareRecord
+	MustMarshalTokenizeShareRecord            = types.MustMarshalTokenizeShareRecord
+	MustUnmarshalTokenizeShareRecord          = types.MustUnmarshalTokenizeShareRecord
+	UnmarshalTokenizeShareRecord              = types.UnmarshalTokenizeShareRecord
+	GetValidatorLiquidSharesKey               = types.GetValidatorLiquidSharesKey
+
 	// variable aliases
 	ModuleCdc                        = types.ModuleCdc
 	LastValidatorPowerKey            = types.LastValidatorPowerKey
@@ -200,6 +227,17 @@ var (
 	KeyMaxValidators                 = types.KeyMaxValidators
 	KeyMaxEntries                    = types.KeyMaxEntries
 	KeyBondDenom                     = types.KeyBondDenom
+
+	TokenizeShareRecordKey                 = types.TokenizeShareRecordKey
+	TokenizeShareRecordByOwnerIndexKey     = types.TokenizeShareRecordByOwnerIndexKey
+	TokenizeShareRecordByDelegatorIndexKey = types.TokenizeShareRecordByDelegatorIndexKey
+	LastTokenizeShareRecordIDKey           = types.LastTokenizeShareRecordIDKey
+	TotalLiquidStakedTokensKey             = types.TotalLiquidStakedTokensKey
+	ValidatorLiquidSharesKey               = types.ValidatorLiquidSharesKey
+	KeyGlobalLiquidStakingCap              = types.KeyGlobalLiquidStakingCap
+	KeyValidatorLiquidStakingCap           = types.KeyValidatorLiquidStakingCap
+	DefaultGlobalLiquidStakingCap          = types.DefaultGlobalLiquidStakingCap
+	DefaultValidatorLiquidStakingCap       = types.DefaultValidatorLiquidStakingCap
 )
 
 type (
@@ -242,4 +280,12 @@ type (
 	Description               = types.Description
 	DelegationI               = exported.DelegationI
 	ValidatorI                = exported.ValidatorI
+
+	MsgTokenizeShares               = types.MsgTokenizeShares
+	MsgRedeemTokensForShares        = types.MsgRedeemTokensForShares
+	MsgTransferTokenizeShareRecord  = types.MsgTransferTokenizeShareRecord
+	QueryTokenizeShareRecordsParams = types.QueryTokenizeShareRecordsParams
+	QueryTokenizeShareRecordParams  = types.QueryTokenizeShareRecordParams
+	TokenizeShareRecord             = types.TokenizeShareRecord
+	TokenizeShareRecords            = types.TokenizeShareRecords
 )
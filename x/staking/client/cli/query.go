@@ -36,7 +36,9 @@ func GetQueryCmd(queryRoute string, cdc *codec.Codec) *cobra.Command {
 		GetCmdQueryValidatorUnbondingDelegations(queryRoute, cdc),
 		GetCmdQueryValidatorRedelegations(queryRoute, cdc),
 		GetCmdQueryParams(queryRoute, cdc),
-		GetCmdQueryPool(queryRoute, cdc))...)
+		GetCmdQueryPool(queryRoute, cdc),
+		GetCmdQueryTokenizeShareRecordsByOwner(queryRoute, cdc),
+		GetCmdQueryTokenizeShareRecordByDenom(queryRoute, cdc))...)
 
 	return stakingQueryCmd
 
@@ -74,7 +76,7 @@ $ %s query staking validator cosmosvaloper1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhff
 				return fmt.Errorf("no validator found with address %s", addr)
 			}
 
-			return cliCtx.PrintOutput(types.MustUnmarshalValidator(cdc, res))
+			return cliCtx.PrintOutput(types.MustUnmarshalValidator(codec.NewHybridCodec(cdc), res))
 		},
 	}
 }
@@ -103,8 +105,9 @@ $ %s query staking validators
 			}
 
 			var validators types.Validators
+			hybridCdc := codec.NewHybridCodec(cdc)
 			for _, kv := range resKVs {
-				validators = append(validators, types.MustUnmarshalValidator(cdc, kv.Value))
+				validators = append(validators, types.MustUnmarshalValidator(hybridCdc, kv.Value))
 			}
 
 			return cliCtx.PrintOutput(validators)
@@ -377,7 +380,12 @@ $ %s query staking unbonding-delegation cosmos1gghjut3ccd8ay0zduzj64hwre2fxs9ld7
 				return err
 			}
 
-			return cliCtx.PrintOutput(types.MustUnmarshalUBD(cdc, res))
+			var ubd types.UnbondingDelegation
+			if err := cdc.UnmarshalJSON(res, &ubd); err != nil {
+				return err
+			}
+
+			return cliCtx.PrintOutput(ubd)
 		},
 	}
 }
@@ -590,3 +598,88 @@ $ %s query staking params
 		},
 	}
 }
+
+// GetCmdQueryTokenizeShareRecordsByOwner implements the command to query all
+// the tokenize share records owned by an account.
+func GetCmdQueryTokenizeShareRecordsByOwner(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "tokenize-share-records [owner-addr]",
+		Short: "Query all tokenize share records owned by an account",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the tokenize share records owned by an account.
+
+Example:
+$ %s query staking tokenize-share-records cosmos1gghjut3ccd8ay0zduzj64hwre2fxs9ld75ru9p
+`,
+				version.ClientName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			owner, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			bz, err := cdc.MarshalJSON(types.NewQueryTokenizeShareRecordsParams(owner))
+			if err != nil {
+				return err
+			}
+
+			route := fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryTokenizeShareRecordsByOwner)
+			res, _, err := cliCtx.QueryWithData(route, bz)
+			if err != nil {
+				return err
+			}
+
+			var records types.TokenizeShareRecords
+			if err := cdc.UnmarshalJSON(res, &records); err != nil {
+				return err
+			}
+
+			return cliCtx.PrintOutput(records)
+		},
+	}
+}
+
+// GetCmdQueryTokenizeShareRecordByDenom implements the command to query the
+// tokenize share record of a share token denomination.
+func GetCmdQueryTokenizeShareRecordByDenom(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "tokenize-share-record [denom]",
+		Short: "Query the tokenize share record of a share token denomination",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the tokenize share record of a share token denomination.
+
+Example:
+$ %s query staking tokenize-share-record share1
+`,
+				version.ClientName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			bz, err := cdc.MarshalJSON(types.NewQueryTokenizeShareRecordParams(args[0]))
+			if err != nil {
+				return err
+			}
+
+			route := fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryTokenizeShareRecordByDenom)
+			res, _, err := cliCtx.QueryWithData(route, bz)
+			if err != nil {
+				return err
+			}
+
+			var record types.TokenizeShareRecord
+			if err := cdc.UnmarshalJSON(res, &record); err != nil {
+				return err
+			}
+
+			return cliCtx.PrintOutput(record)
+		},
+	}
+}
@@ -98,6 +98,18 @@ func registerQueryRoutes(cliCtx context.CLIContext, r *mux.Router) {
 		paramsHandlerFn(cliCtx),
 	).Methods("GET")
 
+	// Get all tokenize share records owned by an account
+	r.HandleFunc(
+		"/staking/delegators/{delegatorAddr}/tokenize_share_records",
+		delegatorTokenizeShareRecordsHandlerFn(cliCtx),
+	).Methods("GET")
+
+	// Get the tokenize share record of a share token denomination
+	r.HandleFunc(
+		"/staking/tokenize_share_records/{denom}",
+		tokenizeShareRecordHandlerFn(cliCtx),
+	).Methods("GET")
+
 }
 
 // HTTP request handler to query a delegator delegations
@@ -350,3 +362,64 @@ func paramsHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
 		rest.PostProcessResponse(w, cliCtx, res)
 	}
 }
+
+// HTTP request handler to query the tokenize share records owned by an account
+func delegatorTokenizeShareRecordsHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		owner, err := sdk.AccAddressFromBech32(mux.Vars(r)["delegatorAddr"])
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+		if !ok {
+			return
+		}
+
+		bz, err := cliCtx.Codec.MarshalJSON(types.NewQueryTokenizeShareRecordsParams(owner))
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		route := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryTokenizeShareRecordsByOwner)
+		res, height, err := cliCtx.QueryWithData(route, bz)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		cliCtx = cliCtx.WithHeight(height)
+		rest.PostProcessResponse(w, cliCtx, res)
+	}
+}
+
+// HTTP request handler to query the tokenize share record of a share token
+// denomination
+func tokenizeShareRecordHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		denom := mux.Vars(r)["denom"]
+
+		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+		if !ok {
+			return
+		}
+
+		bz, err := cliCtx.Codec.MarshalJSON(types.NewQueryTokenizeShareRecordParams(denom))
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		route := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryTokenizeShareRecordByDenom)
+		res, height, err := cliCtx.QueryWithData(route, bz)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		cliCtx = cliCtx.WithHeight(height)
+		rest.PostProcessResponse(w, cliCtx, res)
+	}
+}
@@ -26,6 +26,18 @@ func registerTxRoutes(cliCtx context.CLIContext, r *mux.Router) {
 		"/staking/delegators/{delegatorAddr}/redelegations",
 		postRedelegationsHandlerFn(cliCtx),
 	).Methods("POST")
+	r.HandleFunc(
+		"/staking/delegators/{delegatorAddr}/tokenize_shares",
+		postTokenizeSharesHandlerFn(cliCtx),
+	).Methods("POST")
+	r.HandleFunc(
+		"/staking/delegators/{delegatorAddr}/redeem_tokens",
+		postRedeemTokensForSharesHandlerFn(cliCtx),
+	).Methods("POST")
+	r.HandleFunc(
+		"/staking/tokenize_share_records/{recordID}/transfer",
+		postTransferTokenizeShareRecordHandlerFn(cliCtx),
+	).Methods("POST")
 }
 
 type (
@@ -53,6 +65,27 @@ type (
 		ValidatorAddress sdk.ValAddress `json:"validator_address" yaml:"validator_address"` // in bech32
 		Amount           sdk.Coin       `json:"amount" yaml:"amount"`
 	}
+
+	// TokenizeSharesRequest defines the properties of a tokenize shares request's body.
+	TokenizeSharesRequest struct {
+		BaseReq          rest.BaseReq   `json:"base_req" yaml:"base_req"`
+		DelegatorAddress sdk.AccAddress `json:"delegator_address" yaml:"delegator_address"` // in bech32
+		ValidatorAddress sdk.ValAddress `json:"validator_address" yaml:"validator_address"` // in bech32
+		Amount           sdk.Coin       `json:"amount" yaml:"amount"`
+	}
+
+	// RedeemTokensForSharesRequest defines the properties of a redeem share tokens request's body.
+	RedeemTokensForSharesRequest struct {
+		BaseReq          rest.BaseReq   `json:"base_req" yaml:"base_req"`
+		DelegatorAddress sdk.AccAddress `json:"delegator_address" yaml:"delegator_address"` // in bech32
+		Amount           sdk.Coin       `json:"amount" yaml:"amount"`
+	}
+
+	// TransferTokenizeShareRecordRequest defines the properties of a transfer tokenize share record request's body.
+	TransferTokenizeShareRecordRequest struct {
+		BaseReq  rest.BaseReq   `json:"base_req" yaml:"base_req"`
+		NewOwner sdk.AccAddress `json:"new_owner" yaml:"new_owner"` // in bech32
+	}
 )
 
 func postDelegationsHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
@@ -156,3 +189,105 @@ func postUnbondingDelegationsHandlerFn(cliCtx context.CLIContext) http.HandlerFu
 		utils.WriteGenerateStdTxResponse(w, cliCtx, req.BaseReq, []sdk.Msg{msg})
 	}
 }
+
+func postTokenizeSharesHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req TokenizeSharesRequest
+
+		if !rest.ReadRESTReq(w, r, cliCtx.Codec, &req) {
+			return
+		}
+
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+
+		msg := types.NewMsgTokenizeShares(req.DelegatorAddress, req.ValidatorAddress, req.Amount)
+		if err := msg.ValidateBasic(); err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		fromAddr, err := sdk.AccAddressFromBech32(req.BaseReq.From)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		if !bytes.Equal(fromAddr, req.DelegatorAddress) {
+			rest.WriteErrorResponse(w, http.StatusUnauthorized, "must use own delegator address")
+			return
+		}
+
+		utils.WriteGenerateStdTxResponse(w, cliCtx, req.BaseReq, []sdk.Msg{msg})
+	}
+}
+
+func postRedeemTokensForSharesHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req RedeemTokensForSharesRequest
+
+		if !rest.ReadRESTReq(w, r, cliCtx.Codec, &req) {
+			return
+		}
+
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+
+		msg := types.NewMsgRedeemTokensForShares(req.DelegatorAddress, req.Amount)
+		if err := msg.ValidateBasic(); err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		fromAddr, err := sdk.AccAddressFromBech32(req.BaseReq.From)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		if !bytes.Equal(fromAddr, req.DelegatorAddress) {
+			rest.WriteErrorResponse(w, http.StatusUnauthorized, "must use own delegator address")
+			return
+		}
+
+		utils.WriteGenerateStdTxResponse(w, cliCtx, req.BaseReq, []sdk.Msg{msg})
+	}
+}
+
+func postTransferTokenizeShareRecordHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req TransferTokenizeShareRecordRequest
+
+		recordID, ok := rest.ParseUint64OrReturnBadRequest(w, mux.Vars(r)["recordID"])
+		if !ok {
+			return
+		}
+
+		if !rest.ReadRESTReq(w, r, cliCtx.Codec, &req) {
+			return
+		}
+
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+
+		fromAddr, err := sdk.AccAddressFromBech32(req.BaseReq.From)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		msg := types.NewMsgTransferTokenizeShareRecord(recordID, fromAddr, req.NewOwner)
+		if err := msg.ValidateBasic(); err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		utils.WriteGenerateStdTxResponse(w, cliCtx, req.BaseReq, []sdk.Msg{msg})
+	}
+}
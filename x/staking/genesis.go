@@ -87,6 +87,22 @@ func InitGenesis(ctx sdk.Context, keeper Keeper, accountKeeper types.AccountKeep
 		}
 	}
 
+	// the liquid staking totals are rebuilt from the record delegations
+	for _, record := range data.TokenizeShareRecords {
+		keeper.SetTokenizeShareRecord(ctx, record)
+
+		delegation, found := keeper.GetDelegation(ctx, record.GetDelegatorAddress(), record.Validator)
+		validator, valFound := keeper.GetValidator(ctx, record.Validator)
+		if found && valFound {
+			liquidShares := keeper.GetValidatorLiquidShares(ctx, record.Validator).Add(delegation.Shares)
+			keeper.SetValidatorLiquidShares(ctx, record.Validator, liquidShares)
+
+			tokens := validator.TokensFromShares(delegation.Shares).TruncateInt()
+			keeper.SetTotalLiquidStakedTokens(ctx, keeper.GetTotalLiquidStakedTokens(ctx).Add(tokens))
+		}
+	}
+	keeper.SetLastTokenizeShareRecordID(ctx, data.LastTokenizeShareRecordID)
+
 	bondedCoins := sdk.NewCoins(sdk.NewCoin(data.Params.BondDenom, bondedTokens))
 	notBondedCoins := sdk.NewCoins(sdk.NewCoin(data.Params.BondDenom, notBondedTokens))
 
@@ -161,14 +177,16 @@ func ExportGenesis(ctx sdk.Context, keeper Keeper) types.GenesisState {
 	})
 
 	return types.GenesisState{
-		Params:               params,
-		LastTotalPower:       lastTotalPower,
-		LastValidatorPowers:  lastValidatorPowers,
-		Validators:           validators,
-		Delegations:          delegations,
-		UnbondingDelegations: unbondingDelegations,
-		Redelegations:        redelegations,
-		Exported:             true,
+		Params:                    params,
+		LastTotalPower:            lastTotalPower,
+		LastValidatorPowers:       lastValidatorPowers,
+		Validators:                validators,
+		Delegations:               delegations,
+		UnbondingDelegations:      unbondingDelegations,
+		Redelegations:             redelegations,
+		Exported:                  true,
+		TokenizeShareRecords:      keeper.GetAllTokenizeShareRecords(ctx),
+		LastTokenizeShareRecordID: keeper.GetLastTokenizeShareRecordID(ctx),
 	}
 }
 
@@ -198,10 +216,31 @@ func ValidateGenesis(data types.GenesisState) error {
 	if err != nil {
 		return err
 	}
+	err = validateGenesisStateTokenizeShareRecords(data.TokenizeShareRecords, data.LastTokenizeShareRecordID)
+	if err != nil {
+		return err
+	}
 
 	return nil
 }
 
+func validateGenesisStateTokenizeShareRecords(records []types.TokenizeShareRecord, lastID uint64) error {
+	ids := make(map[uint64]bool, len(records))
+	for _, record := range records {
+		if record.ID == 0 || record.ID > lastID {
+			return fmt.Errorf("tokenize share record %d is not between 1 and the last record ID %d", record.ID, lastID)
+		}
+		if ids[record.ID] {
+			return fmt.Errorf("duplicate tokenize share record in genesis state: %d", record.ID)
+		}
+		if record.Owner.Empty() || record.Validator.Empty() {
+			return fmt.Errorf("tokenize share record %d must have an owner and a validator", record.ID)
+		}
+		ids[record.ID] = true
+	}
+	return nil
+}
+
 func validateGenesisStateValidators(validators []types.Validator) (err error) {
 	addrMap := make(map[string]bool, len(validators))
 	for i := 0; i < len(validators); i++ {
@@ -13,6 +13,7 @@ import (
 	tmtypes "github.com/tendermint/tendermint/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
 	keep "github.com/cosmos/cosmos-sdk/x/staking/keeper"
 	"github.com/cosmos/cosmos-sdk/x/staking/types"
 )
@@ -1324,3 +1325,179 @@ func TestInvalidCoinDenom(t *testing.T) {
 	got = handleMsgBeginRedelegate(ctx, msgRedelegate, keeper)
 	require.True(t, got.IsOK())
 }
+
+func TestTokenizeSharesAndRedeemTokens(t *testing.T) {
+	ctx, ak, keeper, sk := keep.CreateTestInput(t, false, 1000)
+	valAddr, delAddr, newOwner := sdk.ValAddress(keep.Addrs[0]), keep.Addrs[1], keep.Addrs[2]
+	bondDenom := keeper.BondDenom(ctx)
+
+	// create a bonded validator and a delegation to it
+	valTokens := sdk.TokensFromConsensusPower(100)
+	got := handleMsgCreateValidator(ctx, NewTestMsgCreateValidator(valAddr, keep.PKs[0], valTokens), keeper)
+	require.True(t, got.IsOK(), "%v", got)
+	keeper.ApplyAndReturnValidatorSetUpdates(ctx)
+
+	delTokens := sdk.TokensFromConsensusPower(50)
+	got = handleMsgDelegate(ctx, NewTestMsgDelegate(delAddr, valAddr, delTokens), keeper)
+	require.True(t, got.IsOK(), "%v", got)
+
+	// the operator cannot tokenize its self-delegation
+	tokenizeTokens := sdk.TokensFromConsensusPower(20)
+	msgTokenize := NewMsgTokenizeShares(sdk.AccAddress(valAddr), valAddr, sdk.NewCoin(bondDenom, tokenizeTokens))
+	got = handleMsgTokenizeShares(ctx, msgTokenize, keeper)
+	require.False(t, got.IsOK())
+
+	// tokenize a part of the delegation
+	msgTokenize = NewMsgTokenizeShares(delAddr, valAddr, sdk.NewCoin(bondDenom, tokenizeTokens))
+	got = handleMsgTokenizeShares(ctx, msgTokenize, keeper)
+	require.True(t, got.IsOK(), "%v", got)
+
+	record, found := keeper.GetTokenizeShareRecord(ctx, 1)
+	require.True(t, found)
+	require.Equal(t, delAddr, record.Owner)
+	require.Equal(t, valAddr, record.Validator)
+	shareDenom := types.ShareTokenDenomPrefix + "1"
+	require.Equal(t, shareDenom, record.GetShareTokenDenom())
+	require.Equal(t, tokenizeTokens, ak.GetAccount(ctx, delAddr).GetCoins().AmountOf(shareDenom))
+
+	// the shares moved from the delegator to the record, the validator is unchanged
+	delegation, found := keeper.GetDelegation(ctx, delAddr, valAddr)
+	require.True(t, found)
+	require.Equal(t, sdk.NewDecFromInt(delTokens.Sub(tokenizeTokens)), delegation.Shares)
+	delegation, found = keeper.GetDelegation(ctx, record.GetDelegatorAddress(), valAddr)
+	require.True(t, found)
+	require.Equal(t, sdk.NewDecFromInt(tokenizeTokens), delegation.Shares)
+	validator, found := keeper.GetValidator(ctx, valAddr)
+	require.True(t, found)
+	require.Equal(t, valTokens.Add(delTokens), validator.Tokens)
+	require.Equal(t, valTokens.Add(delTokens), keeper.TotalBondedTokens(ctx))
+
+	// redeem a part of the share tokens
+	redeemTokens := sdk.TokensFromConsensusPower(5)
+	got = handleMsgRedeemTokensForShares(ctx, NewMsgRedeemTokensForShares(delAddr, sdk.NewCoin(shareDenom, redeemTokens)), keeper)
+	require.True(t, got.IsOK(), "%v", got)
+
+	delegation, found = keeper.GetDelegation(ctx, delAddr, valAddr)
+	require.True(t, found)
+	require.Equal(t, sdk.NewDecFromInt(delTokens.Sub(tokenizeTokens).Add(redeemTokens)), delegation.Shares)
+	require.Equal(t, tokenizeTokens.Sub(redeemTokens), ak.GetAccount(ctx, delAddr).GetCoins().AmountOf(shareDenom))
+
+	// share tokens can't be redeemed beyond the balance, nor for an unknown record
+	got = handleMsgRedeemTokensForShares(ctx, NewMsgRedeemTokensForShares(newOwner, sdk.NewCoin(shareDenom, redeemTokens)), keeper)
+	require.False(t, got.IsOK())
+	got = handleMsgRedeemTokensForShares(ctx, NewMsgRedeemTokensForShares(delAddr, sdk.NewCoin(types.ShareTokenDenomPrefix+"2", redeemTokens)), keeper)
+	require.False(t, got.IsOK())
+
+	// only the owner can transfer the record
+	got = handleMsgTransferTokenizeShareRecord(ctx, NewMsgTransferTokenizeShareRecord(1, newOwner, newOwner), keeper)
+	require.False(t, got.IsOK())
+	got = handleMsgTransferTokenizeShareRecord(ctx, NewMsgTransferTokenizeShareRecord(1, delAddr, newOwner), keeper)
+	require.True(t, got.IsOK(), "%v", got)
+	require.Empty(t, keeper.GetTokenizeShareRecordsByOwner(ctx, delAddr))
+	require.Len(t, keeper.GetTokenizeShareRecordsByOwner(ctx, newOwner), 1)
+
+	// redeeming the rest of the share tokens removes the record
+	got = handleMsgRedeemTokensForShares(ctx, NewMsgRedeemTokensForShares(delAddr, sdk.NewCoin(shareDenom, tokenizeTokens.Sub(redeemTokens))), keeper)
+	require.True(t, got.IsOK(), "%v", got)
+
+	delegation, found = keeper.GetDelegation(ctx, delAddr, valAddr)
+	require.True(t, found)
+	require.Equal(t, sdk.NewDecFromInt(delTokens), delegation.Shares)
+	_, found = keeper.GetDelegation(ctx, record.GetDelegatorAddress(), valAddr)
+	require.False(t, found)
+	_, found = keeper.GetTokenizeShareRecord(ctx, 1)
+	require.False(t, found)
+	require.True(t, sk.GetSupply(ctx).GetTotal().AmountOf(shareDenom).IsZero())
+	require.Equal(t, valTokens.Add(delTokens), keeper.TotalBondedTokens(ctx))
+	require.True(t, keeper.GetValidatorLiquidShares(ctx, valAddr).IsZero())
+	require.True(t, keeper.GetTotalLiquidStakedTokens(ctx).IsZero())
+}
+
+func TestTokenizeSharesVestingAccount(t *testing.T) {
+	ctx, ak, keeper, _ := keep.CreateTestInput(t, false, 1000)
+	valAddr, delAddr := sdk.ValAddress(keep.Addrs[0]), keep.Addrs[1]
+	bondDenom := keeper.BondDenom(ctx)
+
+	valTokens := sdk.TokensFromConsensusPower(100)
+	got := handleMsgCreateValidator(ctx, NewTestMsgCreateValidator(valAddr, keep.PKs[0], valTokens), keeper)
+	require.True(t, got.IsOK(), "%v", got)
+	keeper.ApplyAndReturnValidatorSetUpdates(ctx)
+
+	// turn the delegator into a vesting account and delegate partly vesting coins
+	acc := ak.GetAccount(ctx, delAddr)
+	baseAcc := auth.NewBaseAccount(delAddr, acc.GetCoins(), acc.GetPubKey(), acc.GetAccountNumber(), acc.GetSequence())
+	now := ctx.BlockHeader().Time
+	ak.SetAccount(ctx, auth.NewContinuousVestingAccount(baseAcc, now.Unix(), now.Add(24*time.Hour).Unix()))
+
+	delTokens := sdk.TokensFromConsensusPower(50)
+	got = handleMsgDelegate(ctx, NewTestMsgDelegate(delAddr, valAddr, delTokens), keeper)
+	require.True(t, got.IsOK(), "%v", got)
+	delegatedVesting := ak.GetAccount(ctx, delAddr).(auth.VestingAccount).GetDelegatedVesting()
+	require.False(t, delegatedVesting.IsZero())
+
+	msgTokenize := NewMsgTokenizeShares(delAddr, valAddr, sdk.NewCoin(bondDenom, sdk.TokensFromConsensusPower(20)))
+	got = handleMsgTokenizeShares(ctx, msgTokenize, keeper)
+	require.False(t, got.IsOK())
+	require.Equal(t, types.ErrTokenizeSharesVestingAccount(keeper.Codespace()).Code(), got.Code)
+
+	// the delegation and the vesting account are unchanged
+	delegation, found := keeper.GetDelegation(ctx, delAddr, valAddr)
+	require.True(t, found)
+	require.Equal(t, sdk.NewDecFromInt(delTokens), delegation.Shares)
+	require.Equal(t, delegatedVesting, ak.GetAccount(ctx, delAddr).(auth.VestingAccount).GetDelegatedVesting())
+	_, found = keeper.GetTokenizeShareRecord(ctx, 1)
+	require.False(t, found)
+}
+
+func TestTokenizeSharesLiquidStakingCaps(t *testing.T) {
+	ctx, _, keeper, _ := keep.CreateTestInput(t, false, 1000)
+	valAddr, delAddr := sdk.ValAddress(keep.Addrs[0]), keep.Addrs[1]
+	bondDenom := keeper.BondDenom(ctx)
+
+	valTokens := sdk.TokensFromConsensusPower(100)
+	got := handleMsgCreateValidator(ctx, NewTestMsgCreateValidator(valAddr, keep.PKs[0], valTokens), keeper)
+	require.True(t, got.IsOK(), "%v", got)
+	keeper.ApplyAndReturnValidatorSetUpdates(ctx)
+
+	got = handleMsgDelegate(ctx, NewTestMsgDelegate(delAddr, valAddr, valTokens), keeper)
+	require.True(t, got.IsOK(), "%v", got)
+
+	// at most 10% of the validator shares can be tokenized
+	params := keeper.GetParams(ctx)
+	params.ValidatorLiquidStakingCap = sdk.NewDecWithPrec(1, 1)
+	keeper.SetParams(ctx, params)
+
+	msgTokenize := NewMsgTokenizeShares(delAddr, valAddr, sdk.NewCoin(bondDenom, sdk.TokensFromConsensusPower(25)))
+	got = handleMsgTokenizeShares(ctx, msgTokenize, keeper)
+	require.False(t, got.IsOK())
+	require.Equal(t, types.ErrValidatorLiquidStakingCapExceeded(keeper.Codespace()).Code(), got.Code)
+
+	msgTokenize = NewMsgTokenizeShares(delAddr, valAddr, sdk.NewCoin(bondDenom, sdk.TokensFromConsensusPower(20)))
+	got = handleMsgTokenizeShares(ctx, msgTokenize, keeper)
+	require.True(t, got.IsOK(), "%v", got)
+	require.Equal(t, sdk.NewDecFromInt(sdk.TokensFromConsensusPower(20)), keeper.GetValidatorLiquidShares(ctx, valAddr))
+
+	// at most 10% of the bonded tokens can be tokenized
+	params.ValidatorLiquidStakingCap = sdk.OneDec()
+	params.GlobalLiquidStakingCap = sdk.NewDecWithPrec(1, 1)
+	keeper.SetParams(ctx, params)
+
+	msgTokenize = NewMsgTokenizeShares(delAddr, valAddr, sdk.NewCoin(bondDenom, sdk.TokensFromConsensusPower(1)))
+	got = handleMsgTokenizeShares(ctx, msgTokenize, keeper)
+	require.False(t, got.IsOK())
+	require.Equal(t, sdk.TokensFromConsensusPower(20), keeper.GetTotalLiquidStakedTokens(ctx))
+
+	// redeeming share tokens lowers the totals
+	shareToken := sdk.NewCoin(types.ShareTokenDenomPrefix+"1", sdk.TokensFromConsensusPower(10))
+	got = handleMsgRedeemTokensForShares(ctx, NewMsgRedeemTokensForShares(delAddr, shareToken), keeper)
+	require.True(t, got.IsOK(), "%v", got)
+	require.Equal(t, sdk.NewDecFromInt(sdk.TokensFromConsensusPower(10)), keeper.GetValidatorLiquidShares(ctx, valAddr))
+	require.Equal(t, sdk.TokensFromConsensusPower(10), keeper.GetTotalLiquidStakedTokens(ctx))
+
+	// slashing the validator by 10% slashes the liquid staked tokens alike
+	validator, found := keeper.GetValidator(ctx, valAddr)
+	require.True(t, found)
+	keeper.Slash(ctx, validator.GetConsAddr(), ctx.BlockHeight(), validator.GetConsensusPower(), sdk.NewDecWithPrec(1, 1))
+	require.Equal(t, sdk.NewDecFromInt(sdk.TokensFromConsensusPower(10)), keeper.GetValidatorLiquidShares(ctx, valAddr))
+	require.Equal(t, sdk.TokensFromConsensusPower(9), keeper.GetTotalLiquidStakedTokens(ctx))
+}
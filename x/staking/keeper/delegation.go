@@ -217,14 +217,15 @@ func (k Keeper) GetUBDQueueTimeSlice(ctx sdk.Context, timestamp time.Time) (dvPa
 	if bz == nil {
 		return []types.DVPair{}
 	}
-	k.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &dvPairs)
-	return dvPairs
+	pairs := types.DVPairs{}
+	k.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &pairs)
+	return pairs.Pairs
 }
 
 // Sets a specific unbonding queue timeslice.
 func (k Keeper) SetUBDQueueTimeSlice(ctx sdk.Context, timestamp time.Time, keys []types.DVPair) {
 	store := ctx.KVStore(k.storeKey)
-	bz := k.cdc.MustMarshalBinaryLengthPrefixed(keys)
+	bz := k.cdc.MustMarshalBinaryLengthPrefixed(&types.DVPairs{Pairs: keys})
 	store.Set(types.GetUnbondingDelegationTimeKey(timestamp), bz)
 }
 
@@ -258,10 +259,10 @@ func (k Keeper) DequeueAllMatureUBDQueue(ctx sdk.Context,
 	// gets an iterator for all timeslices from time 0 until the current Blockheader time
 	unbondingTimesliceIterator := k.UBDQueueIterator(ctx, ctx.BlockHeader().Time)
 	for ; unbondingTimesliceIterator.Valid(); unbondingTimesliceIterator.Next() {
-		timeslice := []types.DVPair{}
+		timeslice := types.DVPairs{}
 		value := unbondingTimesliceIterator.Value()
 		k.cdc.MustUnmarshalBinaryLengthPrefixed(value, &timeslice)
-		matureUnbonds = append(matureUnbonds, timeslice...)
+		matureUnbonds = append(matureUnbonds, timeslice.Pairs...)
 		store.Delete(unbondingTimesliceIterator.Key())
 	}
 	return matureUnbonds
@@ -403,14 +404,15 @@ func (k Keeper) GetRedelegationQueueTimeSlice(ctx sdk.Context, timestamp time.Ti
 	if bz == nil {
 		return []types.DVVTriplet{}
 	}
-	k.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &dvvTriplets)
-	return dvvTriplets
+	triplets := types.DVVTriplets{}
+	k.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &triplets)
+	return triplets.Triplets
 }
 
 // Sets a specific redelegation queue timeslice.
 func (k Keeper) SetRedelegationQueueTimeSlice(ctx sdk.Context, timestamp time.Time, keys []types.DVVTriplet) {
 	store := ctx.KVStore(k.storeKey)
-	bz := k.cdc.MustMarshalBinaryLengthPrefixed(keys)
+	bz := k.cdc.MustMarshalBinaryLengthPrefixed(&types.DVVTriplets{Triplets: keys})
 	store.Set(types.GetRedelegationTimeKey(timestamp), bz)
 }
 
@@ -445,10 +447,10 @@ func (k Keeper) DequeueAllMatureRedelegationQueue(ctx sdk.Context, currTime time
 	// gets an iterator for all timeslices from time 0 until the current Blockheader time
 	redelegationTimesliceIterator := k.RedelegationQueueIterator(ctx, ctx.BlockHeader().Time)
 	for ; redelegationTimesliceIterator.Valid(); redelegationTimesliceIterator.Next() {
-		timeslice := []types.DVVTriplet{}
+		timeslice := types.DVVTriplets{}
 		value := redelegationTimesliceIterator.Value()
 		k.cdc.MustUnmarshalBinaryLengthPrefixed(value, &timeslice)
-		matureRedelegations = append(matureRedelegations, timeslice...)
+		matureRedelegations = append(matureRedelegations, timeslice.Triplets...)
 		store.Delete(redelegationTimesliceIterator.Key())
 	}
 	return matureRedelegations
@@ -0,0 +1,294 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authexported "github.com/cosmos/cosmos-sdk/x/auth/exported"
+	"github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// GetValidatorLiquidShares returns the delegator shares of a validator held
+// by tokenize share records
+func (k Keeper) GetValidatorLiquidShares(ctx sdk.Context, valAddr sdk.ValAddress) sdk.Dec {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetValidatorLiquidSharesKey(valAddr))
+	if bz == nil {
+		return sdk.ZeroDec()
+	}
+	dp := sdk.DecProto{}
+	k.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &dp)
+	return dp.Dec
+}
+
+// SetValidatorLiquidShares sets the delegator shares of a validator held by
+// tokenize share records
+func (k Keeper) SetValidatorLiquidShares(ctx sdk.Context, valAddr sdk.ValAddress, shares sdk.Dec) {
+	store := ctx.KVStore(k.storeKey)
+	if !shares.IsPositive() {
+		store.Delete(types.GetValidatorLiquidSharesKey(valAddr))
+		return
+	}
+	bz := k.cdc.MustMarshalBinaryLengthPrefixed(&sdk.DecProto{Dec: shares})
+	store.Set(types.GetValidatorLiquidSharesKey(valAddr), bz)
+}
+
+// GetTotalLiquidStakedTokens returns the amount of tokens delegated to the
+// validators through tokenize share records, net of slashing
+func (k Keeper) GetTotalLiquidStakedTokens(ctx sdk.Context) sdk.Int {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.TotalLiquidStakedTokensKey)
+	if bz == nil {
+		return sdk.ZeroInt()
+	}
+	ip := sdk.IntProto{}
+	k.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &ip)
+	return ip.Int
+}
+
+// SetTotalLiquidStakedTokens sets the amount of tokens delegated to the
+// validators through tokenize share records
+func (k Keeper) SetTotalLiquidStakedTokens(ctx sdk.Context, tokens sdk.Int) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshalBinaryLengthPrefixed(&sdk.IntProto{Int: tokens})
+	store.Set(types.TotalLiquidStakedTokensKey, bz)
+}
+
+// addLiquidStake updates the liquid shares of a validator and the total liquid
+// staked tokens by the given amounts, which are negative when share tokens are
+// redeemed. The totals never go below zero.
+func (k Keeper) addLiquidStake(ctx sdk.Context, valAddr sdk.ValAddress, shares sdk.Dec, tokens sdk.Int) {
+	liquidShares := k.GetValidatorLiquidShares(ctx, valAddr).Add(shares)
+	k.SetValidatorLiquidShares(ctx, valAddr, sdk.MaxDec(liquidShares, sdk.ZeroDec()))
+
+	liquidTokens := k.GetTotalLiquidStakedTokens(ctx).Add(tokens)
+	k.SetTotalLiquidStakedTokens(ctx, sdk.MaxInt(liquidTokens, sdk.ZeroInt()))
+}
+
+// slashLiquidStake removes from the total liquid staked tokens the part of the
+// tokens slashed from a validator backing its liquid shares.
+func (k Keeper) slashLiquidStake(ctx sdk.Context, validator types.Validator, tokensToBurn sdk.Int) {
+	liquidShares := k.GetValidatorLiquidShares(ctx, validator.OperatorAddress)
+	if liquidShares.IsZero() || validator.DelegatorShares.IsZero() {
+		return
+	}
+
+	slashed := tokensToBurn.ToDec().Mul(liquidShares).Quo(validator.DelegatorShares).TruncateInt()
+	k.addLiquidStake(ctx, validator.OperatorAddress, sdk.ZeroDec(), slashed.Neg())
+}
+
+// checkLiquidStakingCaps returns an error if tokenizing the given shares of a
+// validator would exceed the validator or the global liquid staking cap. A cap
+// of 100% disables the corresponding check. The total liquid staked tokens
+// include the ones of the validators which aren't bonded, so tokenizing the
+// shares of any validator is checked against the global cap.
+func (k Keeper) checkLiquidStakingCaps(ctx sdk.Context, validator types.Validator, shares sdk.Dec) sdk.Error {
+	validatorCap := k.ValidatorLiquidStakingCap(ctx)
+	if validatorCap.LT(sdk.OneDec()) {
+		liquidShares := k.GetValidatorLiquidShares(ctx, validator.OperatorAddress).Add(shares)
+		if liquidShares.GT(validator.DelegatorShares.Mul(validatorCap)) {
+			return types.ErrValidatorLiquidStakingCapExceeded(k.Codespace())
+		}
+	}
+
+	globalCap := k.GlobalLiquidStakingCap(ctx)
+	if globalCap.LT(sdk.OneDec()) {
+		tokens := validator.TokensFromShares(shares).TruncateInt()
+		liquidTokens := k.GetTotalLiquidStakedTokens(ctx).Add(tokens)
+		if sdk.NewDecFromInt(liquidTokens).GT(sdk.NewDecFromInt(k.TotalBondedTokens(ctx)).Mul(globalCap)) {
+			return types.ErrGlobalLiquidStakingCapExceeded(k.Codespace())
+		}
+	}
+
+	return nil
+}
+
+// TokenizeShares moves the given shares of a delegation into a new tokenize
+// share record owned by the delegator, and mints the delegator one share token
+// of the record denomination per delegator share held by the record.
+//
+// The tokens are bonded again to the same validator straight away, so that
+// tokenizing changes neither the validator power nor the bonded pool. Vesting
+// accounts cannot tokenize shares, as the delegated amounts they track would no
+// longer match their delegations once the shares belong to the record.
+func (k Keeper) TokenizeShares(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress,
+	shares sdk.Dec) (types.TokenizeShareRecord, sdk.Coin, sdk.Error) {
+
+	validator, found := k.GetValidator(ctx, valAddr)
+	if !found {
+		return types.TokenizeShareRecord{}, sdk.Coin{}, types.ErrNoValidatorFound(k.Codespace())
+	}
+
+	// the self-delegation backs the validator's minimum self-delegation and
+	// must stay with the operator
+	if delAddr.Equals(validator.OperatorAddress) {
+		return types.TokenizeShareRecord{}, sdk.Coin{}, types.ErrTokenizeSelfDelegation(k.Codespace())
+	}
+
+	// redelegated shares may still be slashed for an infraction of the source
+	// validator, which needs the delegation to stay with the delegator
+	if k.HasReceivingRedelegation(ctx, delAddr, valAddr) {
+		return types.TokenizeShareRecord{}, sdk.Coin{}, types.ErrTokenizeSharesRedelegationInProgress(k.Codespace())
+	}
+
+	// the delegated vesting coins stay locked while delegated, tokenizing them
+	// would make them transferable
+	if _, ok := k.accountKeeper.GetAccount(ctx, delAddr).(authexported.VestingAccount); ok {
+		return types.TokenizeShareRecord{}, sdk.Coin{}, types.ErrTokenizeSharesVestingAccount(k.Codespace())
+	}
+
+	if err := k.checkLiquidStakingCaps(ctx, validator, shares); err != nil {
+		return types.TokenizeShareRecord{}, sdk.Coin{}, err
+	}
+
+	returnAmount, err := k.unbond(ctx, delAddr, valAddr, shares)
+	if err != nil {
+		return types.TokenizeShareRecord{}, sdk.Coin{}, err
+	}
+
+	if returnAmount.IsZero() {
+		return types.TokenizeShareRecord{}, sdk.Coin{}, types.ErrBadDelegationAmount(k.Codespace())
+	}
+
+	if validator.IsBonded() {
+		k.bondedTokensToNotBonded(ctx, returnAmount)
+	}
+
+	validator, found = k.GetValidator(ctx, valAddr)
+	if !found {
+		return types.TokenizeShareRecord{}, sdk.Coin{}, types.ErrNoValidatorFound(k.Codespace())
+	}
+
+	record := types.NewTokenizeShareRecord(k.GetLastTokenizeShareRecordID(ctx)+1, delAddr, valAddr)
+	k.SetLastTokenizeShareRecordID(ctx, record.ID)
+	k.SetTokenizeShareRecord(ctx, record)
+
+	newShares, err := k.Delegate(ctx, record.GetDelegatorAddress(), returnAmount, sdk.Unbonded, validator, false)
+	if err != nil {
+		return types.TokenizeShareRecord{}, sdk.Coin{}, err
+	}
+
+	k.addLiquidStake(ctx, valAddr, newShares, returnAmount)
+
+	shareToken := sdk.NewCoin(record.GetShareTokenDenom(), newShares.TruncateInt())
+	if shareToken.IsZero() {
+		return types.TokenizeShareRecord{}, sdk.Coin{}, types.ErrBadSharesAmount(k.Codespace())
+	}
+
+	err = k.supplyKeeper.MintCoins(ctx, types.ModuleName, sdk.NewCoins(shareToken))
+	if err != nil {
+		return types.TokenizeShareRecord{}, sdk.Coin{}, err
+	}
+	err = k.supplyKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, delAddr, sdk.NewCoins(shareToken))
+	if err != nil {
+		return types.TokenizeShareRecord{}, sdk.Coin{}, err
+	}
+
+	return record, shareToken, nil
+}
+
+// RedeemTokensForShares burns share tokens of a tokenize share record and
+// moves the matching shares of the record delegation to the delegator. The
+// record is removed once its whole delegation has been redeemed. It returns
+// the amount of tokens now delegated by the delegator.
+func (k Keeper) RedeemTokensForShares(ctx sdk.Context, delAddr sdk.AccAddress, shareToken sdk.Coin) (sdk.Int, sdk.Error) {
+	record, found := k.GetTokenizeShareRecordByDenom(ctx, shareToken.Denom)
+	if !found {
+		return sdk.ZeroInt(), types.ErrTokenizeShareRecordNotExists(k.Codespace())
+	}
+
+	validator, found := k.GetValidator(ctx, record.Validator)
+	if !found {
+		return sdk.ZeroInt(), types.ErrNoValidatorFound(k.Codespace())
+	}
+
+	recordAddr := record.GetDelegatorAddress()
+	delegation, found := k.GetDelegation(ctx, recordAddr, record.Validator)
+	if !found {
+		return sdk.ZeroInt(), types.ErrNoDelegatorForAddress(k.Codespace())
+	}
+
+	// the last share tokens also redeem the fraction of a share left over
+	// when the tokens were minted
+	shares := sdk.NewDecFromInt(shareToken.Amount)
+	if shareToken.Amount.Equal(k.supplyKeeper.GetSupply(ctx).GetTotal().AmountOf(shareToken.Denom)) {
+		shares = delegation.Shares
+	}
+	if shares.GT(delegation.Shares) {
+		return sdk.ZeroInt(), types.ErrNotEnoughDelegationShares(k.Codespace(), delegation.Shares.String())
+	}
+
+	shareTokens := sdk.NewCoins(shareToken)
+	err := k.supplyKeeper.SendCoinsFromAccountToModule(ctx, delAddr, types.ModuleName, shareTokens)
+	if err != nil {
+		return sdk.ZeroInt(), err
+	}
+	err = k.supplyKeeper.BurnCoins(ctx, types.ModuleName, shareTokens)
+	if err != nil {
+		return sdk.ZeroInt(), err
+	}
+
+	tokenSrc := validator.GetStatus()
+	returnAmount, err := k.unbond(ctx, recordAddr, record.Validator, shares)
+	if err != nil {
+		return sdk.ZeroInt(), err
+	}
+
+	if returnAmount.IsZero() {
+		return sdk.ZeroInt(), types.ErrTinyRedemptionAmount(k.Codespace())
+	}
+
+	k.addLiquidStake(ctx, record.Validator, shares.Neg(), returnAmount.Neg())
+
+	if _, found := k.GetDelegation(ctx, recordAddr, record.Validator); !found {
+		k.DeleteTokenizeShareRecord(ctx, record)
+	}
+
+	// the validator is removed when it is unbonded and the record held its
+	// last shares, in which case the tokens are returned to the delegator
+	validator, found = k.GetValidator(ctx, record.Validator)
+	if !found {
+		coins := sdk.NewCoins(sdk.NewCoin(k.BondDenom(ctx), returnAmount))
+		err = k.supplyKeeper.UndelegateCoinsFromModuleToAccount(ctx, types.NotBondedPoolName, delAddr, coins)
+		if err != nil {
+			return sdk.ZeroInt(), err
+		}
+		return returnAmount, nil
+	}
+
+	_, err = k.Delegate(ctx, delAddr, returnAmount, tokenSrc, validator, false)
+	if err != nil {
+		return sdk.ZeroInt(), err
+	}
+
+	return returnAmount, nil
+}
+
+// TransferTokenizeShareRecord transfers the ownership of a tokenize share
+// record, and with it the right to the rewards of the record delegation.
+func (k Keeper) TransferTokenizeShareRecord(ctx sdk.Context, id uint64, sender, newOwner sdk.AccAddress) sdk.Error {
+	record, found := k.GetTokenizeShareRecord(ctx, id)
+	if !found {
+		return types.ErrTokenizeShareRecordNotExists(k.Codespace())
+	}
+
+	if !record.Owner.Equals(sender) {
+		return types.ErrNotTokenizeShareRecordOwner(k.Codespace())
+	}
+
+	// the rewards accrued so far are withdrawn to the previous owner by the
+	// distribution hooks
+	recordAddr := record.GetDelegatorAddress()
+	_, found = k.GetDelegation(ctx, recordAddr, record.Validator)
+	if found {
+		k.BeforeDelegationSharesModified(ctx, recordAddr, record.Validator)
+	}
+
+	k.DeleteTokenizeShareRecord(ctx, record)
+	record.Owner = newOwner
+	k.SetTokenizeShareRecord(ctx, record)
+
+	if found {
+		k.AfterDelegationModified(ctx, recordAddr, record.Validator)
+	}
+
+	return nil
+}
@@ -42,6 +42,10 @@ func NewQuerier(k Keeper) sdk.Querier {
 			return queryPool(ctx, k)
 		case types.QueryParameters:
 			return queryParameters(ctx, k)
+		case types.QueryTokenizeShareRecordsByOwner:
+			return queryTokenizeShareRecordsByOwner(ctx, req, k)
+		case types.QueryTokenizeShareRecordByDenom:
+			return queryTokenizeShareRecordByDenom(ctx, req, k)
 		default:
 			return nil, sdk.ErrUnknownRequest("unknown staking query endpoint")
 		}
@@ -404,3 +408,45 @@ func redelegationsToRedelegationResponses(
 
 	return resp, nil
 }
+
+func queryTokenizeShareRecordsByOwner(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, sdk.Error) {
+	var params types.QueryTokenizeShareRecordsParams
+
+	err := types.ModuleCdc.UnmarshalJSON(req.Data, &params)
+	if err != nil {
+		return nil, sdk.ErrInternal(fmt.Sprintf("failed to parse params: %s", err))
+	}
+
+	records := k.GetTokenizeShareRecordsByOwner(ctx, params.Owner)
+	if records == nil {
+		records = types.TokenizeShareRecords{}
+	}
+
+	res, err := codec.MarshalJSONIndent(types.ModuleCdc, records)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+
+	return res, nil
+}
+
+func queryTokenizeShareRecordByDenom(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, sdk.Error) {
+	var params types.QueryTokenizeShareRecordParams
+
+	err := types.ModuleCdc.UnmarshalJSON(req.Data, &params)
+	if err != nil {
+		return nil, sdk.ErrInternal(fmt.Sprintf("failed to parse params: %s", err))
+	}
+
+	record, found := k.GetTokenizeShareRecordByDenom(ctx, params.Denom)
+	if !found {
+		return nil, types.ErrTokenizeShareRecordNotExists(k.Codespace())
+	}
+
+	res, err := codec.MarshalJSONIndent(types.ModuleCdc, record)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+
+	return res, nil
+}
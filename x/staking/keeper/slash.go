@@ -115,6 +115,9 @@ func (k Keeper) Slash(ctx sdk.Context, consAddr sdk.ConsAddress, infractionHeigh
 		k.BeforeValidatorSlashed(ctx, operatorAddress, effectiveFraction)
 	}
 
+	// the liquid staked tokens are slashed along with the validator tokens
+	k.slashLiquidStake(ctx, validator, tokensToBurn)
+
 	// Deduct from validator's bonded tokens and update the validator.
 	// Burn the slashed tokens from the pool account and decrease the total supply.
 	validator = k.RemoveValidatorTokens(ctx, validator, tokensToBurn)
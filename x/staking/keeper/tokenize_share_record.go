@@ -0,0 +1,125 @@
+package keeper
+
+import (
+	"encoding/binary"
+	"strconv"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// GetLastTokenizeShareRecordID returns the ID of the last created tokenize
+// share record
+func (k Keeper) GetLastTokenizeShareRecordID(ctx sdk.Context) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.LastTokenizeShareRecordIDKey)
+	if bz == nil {
+		return 0
+	}
+	return binary.BigEndian.Uint64(bz)
+}
+
+// SetLastTokenizeShareRecordID sets the ID of the last created tokenize share
+// record
+func (k Keeper) SetLastTokenizeShareRecordID(ctx sdk.Context, id uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.LastTokenizeShareRecordIDKey, sdk.Uint64ToBigEndian(id))
+}
+
+// GetTokenizeShareRecord returns a tokenize share record by ID
+func (k Keeper) GetTokenizeShareRecord(ctx sdk.Context, id uint64) (record types.TokenizeShareRecord, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetTokenizeShareRecordKey(id))
+	if bz == nil {
+		return record, false
+	}
+	return types.MustUnmarshalTokenizeShareRecord(k.cdc, bz), true
+}
+
+// GetTokenizeShareRecordByDenom returns the tokenize share record whose share
+// tokens have the given denomination
+func (k Keeper) GetTokenizeShareRecordByDenom(ctx sdk.Context, denom string) (record types.TokenizeShareRecord, found bool) {
+	if !strings.HasPrefix(denom, types.ShareTokenDenomPrefix) {
+		return record, false
+	}
+	id, err := strconv.ParseUint(strings.TrimPrefix(denom, types.ShareTokenDenomPrefix), 10, 64)
+	if err != nil {
+		return record, false
+	}
+	record, found = k.GetTokenizeShareRecord(ctx, id)
+	if !found || record.GetShareTokenDenom() != denom {
+		return types.TokenizeShareRecord{}, false
+	}
+	return record, true
+}
+
+// GetTokenizeShareRecordByDelegator returns the tokenize share record whose
+// delegation is held by the given address
+func (k Keeper) GetTokenizeShareRecordByDelegator(ctx sdk.Context, delAddr sdk.AccAddress) (record types.TokenizeShareRecord, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetTokenizeShareRecordByDelegatorIndexKey(delAddr))
+	if bz == nil {
+		return record, false
+	}
+	return k.GetTokenizeShareRecord(ctx, binary.BigEndian.Uint64(bz))
+}
+
+// GetTokenizeShareRecordsByOwner returns all the tokenize share records owned
+// by an account
+func (k Keeper) GetTokenizeShareRecordsByOwner(ctx sdk.Context, owner sdk.AccAddress) (records types.TokenizeShareRecords) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.GetTokenizeShareRecordsByOwnerIndexKey(owner))
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		key := iterator.Key()
+		id := binary.BigEndian.Uint64(key[len(key)-8:])
+		record, found := k.GetTokenizeShareRecord(ctx, id)
+		if !found {
+			panic("tokenize share record by owner index points to a missing record")
+		}
+		records = append(records, record)
+	}
+	return records
+}
+
+// IterateTokenizeShareRecords iterates through all the tokenize share records
+// by ID
+func (k Keeper) IterateTokenizeShareRecords(ctx sdk.Context, fn func(record types.TokenizeShareRecord) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.TokenizeShareRecordKey)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		record := types.MustUnmarshalTokenizeShareRecord(k.cdc, iterator.Value())
+		if fn(record) {
+			break
+		}
+	}
+}
+
+// GetAllTokenizeShareRecords returns all the tokenize share records
+func (k Keeper) GetAllTokenizeShareRecords(ctx sdk.Context) (records types.TokenizeShareRecords) {
+	k.IterateTokenizeShareRecords(ctx, func(record types.TokenizeShareRecord) bool {
+		records = append(records, record)
+		return false
+	})
+	return records
+}
+
+// SetTokenizeShareRecord sets a tokenize share record and its indexes
+func (k Keeper) SetTokenizeShareRecord(ctx sdk.Context, record types.TokenizeShareRecord) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetTokenizeShareRecordKey(record.ID), types.MustMarshalTokenizeShareRecord(k.cdc, record))
+	store.Set(types.GetTokenizeShareRecordByOwnerIndexKey(record.Owner, record.ID), []byte{})
+	store.Set(types.GetTokenizeShareRecordByDelegatorIndexKey(record.GetDelegatorAddress()), sdk.Uint64ToBigEndian(record.ID))
+}
+
+// DeleteTokenizeShareRecord removes a tokenize share record and its indexes
+func (k Keeper) DeleteTokenizeShareRecord(ctx sdk.Context, record types.TokenizeShareRecord) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetTokenizeShareRecordKey(record.ID))
+	store.Delete(types.GetTokenizeShareRecordByOwnerIndexKey(record.Owner, record.ID))
+	store.Delete(types.GetTokenizeShareRecordByDelegatorIndexKey(record.GetDelegatorAddress()))
+}
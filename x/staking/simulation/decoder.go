@@ -2,6 +2,7 @@ package simulation
 
 import (
 	"bytes"
+	"encoding/binary"
 	"fmt"
 
 	cmn "github.com/tendermint/tendermint/libs/common"
@@ -11,14 +12,19 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/staking/types"
 )
 
-// DecodeStore unmarshals the KVPair's Value to the corresponding staking type
-func DecodeStore(cdc *codec.Codec, kvA, kvB cmn.KVPair) string {
+// DecodeStore unmarshals the KVPair's Value to the corresponding staking type.
+// The staking store is protobuf encoded, so the amino codec is only used for
+// the JSON encoding.
+func DecodeStore(aminoCdc *codec.Codec, kvA, kvB cmn.KVPair) string {
+	cdc := codec.NewHybridCodec(aminoCdc)
+
 	switch {
-	case bytes.Equal(kvA.Key[:1], types.LastTotalPowerKey):
-		var powerA, powerB sdk.Int
+	case bytes.Equal(kvA.Key[:1], types.LastTotalPowerKey),
+		bytes.Equal(kvA.Key[:1], types.TotalLiquidStakedTokensKey):
+		var powerA, powerB sdk.IntProto
 		cdc.MustUnmarshalBinaryLengthPrefixed(kvA.Value, &powerA)
 		cdc.MustUnmarshalBinaryLengthPrefixed(kvB.Value, &powerB)
-		return fmt.Sprintf("%v\n%v", powerA, powerB)
+		return fmt.Sprintf("%v\n%v", powerA.Int, powerB.Int)
 
 	case bytes.Equal(kvA.Key[:1], types.ValidatorsKey):
 		var validatorA, validatorB types.Validator
@@ -51,6 +57,22 @@ func DecodeStore(cdc *codec.Codec, kvA, kvB cmn.KVPair) string {
 		cdc.MustUnmarshalBinaryLengthPrefixed(kvB.Value, &redB)
 		return fmt.Sprintf("%v\n%v", redA, redB)
 
+	case bytes.Equal(kvA.Key[:1], types.TokenizeShareRecordKey):
+		var recordA, recordB types.TokenizeShareRecord
+		cdc.MustUnmarshalBinaryLengthPrefixed(kvA.Value, &recordA)
+		cdc.MustUnmarshalBinaryLengthPrefixed(kvB.Value, &recordB)
+		return fmt.Sprintf("%v\n%v", recordA, recordB)
+
+	case bytes.Equal(kvA.Key[:1], types.TokenizeShareRecordByDelegatorIndexKey),
+		bytes.Equal(kvA.Key[:1], types.LastTokenizeShareRecordIDKey):
+		return fmt.Sprintf("%d\n%d", binary.BigEndian.Uint64(kvA.Value), binary.BigEndian.Uint64(kvB.Value))
+
+	case bytes.Equal(kvA.Key[:1], types.ValidatorLiquidSharesKey):
+		var sharesA, sharesB sdk.DecProto
+		cdc.MustUnmarshalBinaryLengthPrefixed(kvA.Value, &sharesA)
+		cdc.MustUnmarshalBinaryLengthPrefixed(kvB.Value, &sharesB)
+		return fmt.Sprintf("%v\n%v", sharesA.Dec, sharesB.Dec)
+
 	default:
 		panic(fmt.Sprintf("invalid staking key prefix %X", kvA.Key[:1]))
 	}
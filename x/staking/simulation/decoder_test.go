@@ -31,6 +31,7 @@ func makeTestCodec() (cdc *codec.Codec) {
 
 func TestDecodeStore(t *testing.T) {
 	cdc := makeTestCodec()
+	protoCdc := codec.NewHybridCodec(cdc)
 
 	bondTime := time.Now().UTC()
 
@@ -38,14 +39,19 @@ func TestDecodeStore(t *testing.T) {
 	del := types.NewDelegation(delAddr1, valAddr1, sdk.OneDec())
 	ubd := types.NewUnbondingDelegation(delAddr1, valAddr1, 15, bondTime, sdk.OneInt())
 	red := types.NewRedelegation(delAddr1, valAddr1, valAddr1, 12, bondTime, sdk.OneInt(), sdk.OneDec())
+	record := types.NewTokenizeShareRecord(1, delAddr1, valAddr1)
 
 	kvPairs := cmn.KVPairs{
-		cmn.KVPair{Key: types.LastTotalPowerKey, Value: cdc.MustMarshalBinaryLengthPrefixed(sdk.OneInt())},
-		cmn.KVPair{Key: types.GetValidatorKey(valAddr1), Value: cdc.MustMarshalBinaryLengthPrefixed(val)},
+		cmn.KVPair{Key: types.LastTotalPowerKey, Value: protoCdc.MustMarshalBinaryLengthPrefixed(&sdk.IntProto{Int: sdk.OneInt()})},
+		cmn.KVPair{Key: types.GetValidatorKey(valAddr1), Value: protoCdc.MustMarshalBinaryLengthPrefixed(&val)},
 		cmn.KVPair{Key: types.LastValidatorPowerKey, Value: valAddr1.Bytes()},
-		cmn.KVPair{Key: types.GetDelegationKey(delAddr1, valAddr1), Value: cdc.MustMarshalBinaryLengthPrefixed(del)},
-		cmn.KVPair{Key: types.GetUBDKey(delAddr1, valAddr1), Value: cdc.MustMarshalBinaryLengthPrefixed(ubd)},
-		cmn.KVPair{Key: types.GetREDKey(delAddr1, valAddr1, valAddr1), Value: cdc.MustMarshalBinaryLengthPrefixed(red)},
+		cmn.KVPair{Key: types.GetDelegationKey(delAddr1, valAddr1), Value: protoCdc.MustMarshalBinaryLengthPrefixed(&del)},
+		cmn.KVPair{Key: types.GetUBDKey(delAddr1, valAddr1), Value: protoCdc.MustMarshalBinaryLengthPrefixed(&ubd)},
+		cmn.KVPair{Key: types.GetREDKey(delAddr1, valAddr1, valAddr1), Value: protoCdc.MustMarshalBinaryLengthPrefixed(&red)},
+		cmn.KVPair{Key: types.GetTokenizeShareRecordKey(1), Value: protoCdc.MustMarshalBinaryLengthPrefixed(&record)},
+		cmn.KVPair{Key: types.LastTokenizeShareRecordIDKey, Value: sdk.Uint64ToBigEndian(1)},
+		cmn.KVPair{Key: types.TotalLiquidStakedTokensKey, Value: protoCdc.MustMarshalBinaryLengthPrefixed(&sdk.IntProto{Int: sdk.OneInt()})},
+		cmn.KVPair{Key: types.GetValidatorLiquidSharesKey(valAddr1), Value: protoCdc.MustMarshalBinaryLengthPrefixed(&sdk.DecProto{Dec: sdk.OneDec()})},
 		cmn.KVPair{Key: []byte{0x99}, Value: []byte{0x99}},
 	}
 
@@ -59,6 +65,10 @@ func TestDecodeStore(t *testing.T) {
 		{"Delegation", fmt.Sprintf("%v\n%v", del, del)},
 		{"UnbondingDelegation", fmt.Sprintf("%v\n%v", ubd, ubd)},
 		{"Redelegation", fmt.Sprintf("%v\n%v", red, red)},
+		{"TokenizeShareRecord", fmt.Sprintf("%v\n%v", record, record)},
+		{"TokenizeShareRecordByDelegator/LastTokenizeShareRecordID", "1\n1"},
+		{"TotalLiquidStakedTokens", fmt.Sprintf("%v\n%v", sdk.OneInt(), sdk.OneInt())},
+		{"ValidatorLiquidShares", fmt.Sprintf("%v\n%v", sdk.OneDec(), sdk.OneDec())},
 		{"other", ""},
 	}
 	for i, tt := range tests {
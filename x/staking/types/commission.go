@@ -65,6 +65,11 @@ func (c Commission) String() string {
 	)
 }
 
+// String implements the Stringer interface for CommissionRates.
+func (c CommissionRates) String() string {
+	return fmt.Sprintf("rate: %s, maxRate: %s, maxChangeRate: %s", c.Rate, c.MaxRate, c.MaxChangeRate)
+}
+
 // Validate performs basic sanity validation checks of initial commission
 // parameters. If validation fails, an SDK error is returned.
 func (c CommissionRates) Validate() sdk.Error {
@@ -29,6 +29,18 @@ type DVVTriplet struct {
 	ValidatorDstAddress sdk.ValAddress
 }
 
+// String implements the Stringer interface for a DVPair.
+func (dv DVPair) String() string {
+	return fmt.Sprintf("delegator: %s, validator: %s", dv.DelegatorAddress, dv.ValidatorAddress)
+}
+
+// String implements the Stringer interface for a DVVTriplet.
+func (dvv DVVTriplet) String() string {
+	return fmt.Sprintf("delegator: %s, validatorSrc: %s, validatorDst: %s",
+		dvv.DelegatorAddress, dvv.ValidatorSrcAddress, dvv.ValidatorDstAddress,
+	)
+}
+
 // Implements Delegation interface
 var _ exported.DelegationI = Delegation{}
 
@@ -53,12 +65,12 @@ func NewDelegation(delegatorAddr sdk.AccAddress, validatorAddr sdk.ValAddress,
 }
 
 // return the delegation
-func MustMarshalDelegation(cdc *codec.Codec, delegation Delegation) []byte {
-	return cdc.MustMarshalBinaryLengthPrefixed(delegation)
+func MustMarshalDelegation(cdc codec.Marshaler, delegation Delegation) []byte {
+	return cdc.MustMarshalBinaryLengthPrefixed(&delegation)
 }
 
 // return the delegation
-func MustUnmarshalDelegation(cdc *codec.Codec, value []byte) Delegation {
+func MustUnmarshalDelegation(cdc codec.Marshaler, value []byte) Delegation {
 	delegation, err := UnmarshalDelegation(cdc, value)
 	if err != nil {
 		panic(err)
@@ -67,7 +79,7 @@ func MustUnmarshalDelegation(cdc *codec.Codec, value []byte) Delegation {
 }
 
 // return the delegation
-func UnmarshalDelegation(cdc *codec.Codec, value []byte) (delegation Delegation, err error) {
+func UnmarshalDelegation(cdc codec.Marshaler, value []byte) (delegation Delegation, err error) {
 	err = cdc.UnmarshalBinaryLengthPrefixed(value, &delegation)
 	return delegation, err
 }
@@ -124,6 +136,13 @@ func (e UnbondingDelegationEntry) IsMature(currentTime time.Time) bool {
 	return !e.CompletionTime.After(currentTime)
 }
 
+// String implements the Stringer interface for an UnbondingDelegationEntry.
+func (e UnbondingDelegationEntry) String() string {
+	return fmt.Sprintf("creationHeight: %d, completionTime: %s, initialBalance: %s, balance: %s",
+		e.CreationHeight, e.CompletionTime, e.InitialBalance, e.Balance,
+	)
+}
+
 // NewUnbondingDelegation - create a new unbonding delegation object
 func NewUnbondingDelegation(delegatorAddr sdk.AccAddress,
 	validatorAddr sdk.ValAddress, creationHeight int64, minTime time.Time,
@@ -163,12 +182,12 @@ func (d *UnbondingDelegation) RemoveEntry(i int64) {
 }
 
 // return the unbonding delegation
-func MustMarshalUBD(cdc *codec.Codec, ubd UnbondingDelegation) []byte {
-	return cdc.MustMarshalBinaryLengthPrefixed(ubd)
+func MustMarshalUBD(cdc codec.Marshaler, ubd UnbondingDelegation) []byte {
+	return cdc.MustMarshalBinaryLengthPrefixed(&ubd)
 }
 
 // unmarshal a unbonding delegation from a store value
-func MustUnmarshalUBD(cdc *codec.Codec, value []byte) UnbondingDelegation {
+func MustUnmarshalUBD(cdc codec.Marshaler, value []byte) UnbondingDelegation {
 	ubd, err := UnmarshalUBD(cdc, value)
 	if err != nil {
 		panic(err)
@@ -177,7 +196,7 @@ func MustUnmarshalUBD(cdc *codec.Codec, value []byte) UnbondingDelegation {
 }
 
 // unmarshal a unbonding delegation from a store value
-func UnmarshalUBD(cdc *codec.Codec, value []byte) (ubd UnbondingDelegation, err error) {
+func UnmarshalUBD(cdc codec.Marshaler, value []byte) (ubd UnbondingDelegation, err error) {
 	err = cdc.UnmarshalBinaryLengthPrefixed(value, &ubd)
 	return ubd, err
 }
@@ -269,6 +288,13 @@ func (e RedelegationEntry) IsMature(currentTime time.Time) bool {
 	return !e.CompletionTime.After(currentTime)
 }
 
+// String implements the Stringer interface for a RedelegationEntry.
+func (e RedelegationEntry) String() string {
+	return fmt.Sprintf("creationHeight: %d, completionTime: %s, initialBalance: %s, sharesDst: %s",
+		e.CreationHeight, e.CompletionTime, e.InitialBalance, e.SharesDst,
+	)
+}
+
 // AddEntry - append entry to the unbonding delegation
 func (d *Redelegation) AddEntry(creationHeight int64,
 	minTime time.Time, balance sdk.Int,
@@ -284,12 +310,12 @@ func (d *Redelegation) RemoveEntry(i int64) {
 }
 
 // return the redelegation
-func MustMarshalRED(cdc *codec.Codec, red Redelegation) []byte {
-	return cdc.MustMarshalBinaryLengthPrefixed(red)
+func MustMarshalRED(cdc codec.Marshaler, red Redelegation) []byte {
+	return cdc.MustMarshalBinaryLengthPrefixed(&red)
 }
 
 // unmarshal a redelegation from a store value
-func MustUnmarshalRED(cdc *codec.Codec, value []byte) Redelegation {
+func MustUnmarshalRED(cdc codec.Marshaler, value []byte) Redelegation {
 	red, err := UnmarshalRED(cdc, value)
 	if err != nil {
 		panic(err)
@@ -298,7 +324,7 @@ func MustUnmarshalRED(cdc *codec.Codec, value []byte) Redelegation {
 }
 
 // unmarshal a redelegation from a store value
-func UnmarshalRED(cdc *codec.Codec, value []byte) (red Redelegation, err error) {
+func UnmarshalRED(cdc codec.Marshaler, value []byte) (red Redelegation, err error) {
 	err = cdc.UnmarshalBinaryLengthPrefixed(value, &red)
 	return red, err
 }
@@ -212,3 +212,36 @@ func ErrNeitherShareMsgsGiven(codespace sdk.CodespaceType) sdk.Error {
 func ErrMissingSignature(codespace sdk.CodespaceType) sdk.Error {
 	return sdk.NewError(codespace, CodeInvalidValidator, "missing signature")
 }
+
+func ErrTokenizeShareRecordNotExists(codespace sdk.CodespaceType) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidDelegation, "tokenize share record does not exist")
+}
+
+func ErrNotTokenizeShareRecordOwner(codespace sdk.CodespaceType) sdk.Error {
+	return sdk.NewError(codespace, CodeUnauthorized, "sender is not the owner of the tokenize share record")
+}
+
+func ErrTokenizeSelfDelegation(codespace sdk.CodespaceType) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidDelegation, "validator operators cannot tokenize their self-delegation")
+}
+
+func ErrTokenizeSharesRedelegationInProgress(codespace sdk.CodespaceType) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidDelegation,
+		"delegator has an incoming redelegation to this validator, wait for it to complete before tokenizing shares")
+}
+
+func ErrTokenizeSharesVestingAccount(codespace sdk.CodespaceType) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidDelegation, "vesting accounts cannot tokenize shares")
+}
+
+func ErrValidatorLiquidStakingCapExceeded(codespace sdk.CodespaceType) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidDelegation, "tokenizing these shares exceeds the validator liquid staking cap")
+}
+
+func ErrGlobalLiquidStakingCapExceeded(codespace sdk.CodespaceType) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidDelegation, "tokenizing these shares exceeds the global liquid staking cap")
+}
+
+func ErrTinyRedemptionAmount(codespace sdk.CodespaceType) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidDelegation, "too few share tokens to redeem (rounds to zero tokens)")
+}
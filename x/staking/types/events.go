@@ -10,6 +10,10 @@ var (
 	EventTypeUnbond               = "unbond"
 	EventTypeRedelegate           = "redelegate"
 
+	EventTypeTokenizeShares              = "tokenize_shares"
+	EventTypeRedeemShares                = "redeem_tokens_for_shares"
+	EventTypeTransferTokenizeShareRecord = "transfer_tokenize_share_record"
+
 	AttributeKeyValidator         = "validator"
 	AttributeKeyCommissionRate    = "commission_rate"
 	AttributeKeyMinSelfDelegation = "min_self_delegation"
@@ -17,5 +21,7 @@ var (
 	AttributeKeyDstValidator      = "destination_validator"
 	AttributeKeyDelegator         = "delegator"
 	AttributeKeyCompletionTime    = "completion_time"
+	AttributeKeyShareRecordID     = "share_record_id"
+	AttributeKeyShareOwner        = "share_owner"
 	AttributeValueCategory        = ModuleName
 )
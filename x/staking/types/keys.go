@@ -45,6 +45,13 @@ var (
 	UnbondingQueueKey    = []byte{0x41} // prefix for the timestamps in unbonding queue
 	RedelegationQueueKey = []byte{0x42} // prefix for the timestamps in redelegations queue
 	ValidatorQueueKey    = []byte{0x43} // prefix for the timestamps in validator queue
+
+	TokenizeShareRecordKey                 = []byte{0x51} // key for a tokenize share record
+	TokenizeShareRecordByOwnerIndexKey     = []byte{0x52} // prefix for each key for a tokenize share record, by owner
+	TokenizeShareRecordByDelegatorIndexKey = []byte{0x53} // prefix for each key for a tokenize share record, by delegation holder
+	LastTokenizeShareRecordIDKey           = []byte{0x54} // key for the last tokenize share record ID
+	TotalLiquidStakedTokensKey             = []byte{0x55} // key for the total liquid staked tokens
+	ValidatorLiquidSharesKey               = []byte{0x56} // prefix for each key for the liquid shares of a validator
 )
 
 // gets the key for the validator with address
@@ -278,3 +285,35 @@ func GetREDsByDelToValDstIndexKey(delAddr sdk.AccAddress, valDstAddr sdk.ValAddr
 		GetREDsToValDstIndexKey(valDstAddr),
 		delAddr.Bytes()...)
 }
+
+//________________________________________________________________________________
+
+// gets the key for a tokenize share record
+// VALUE: staking/TokenizeShareRecord
+func GetTokenizeShareRecordKey(id uint64) []byte {
+	return append(TokenizeShareRecordKey, sdk.Uint64ToBigEndian(id)...)
+}
+
+// gets the index-key for a tokenize share record, stored by owner
+// VALUE: none (key rearrangement used)
+func GetTokenizeShareRecordByOwnerIndexKey(owner sdk.AccAddress, id uint64) []byte {
+	return append(GetTokenizeShareRecordsByOwnerIndexKey(owner), sdk.Uint64ToBigEndian(id)...)
+}
+
+// gets the prefix keyspace for the tokenize share records of an owner
+func GetTokenizeShareRecordsByOwnerIndexKey(owner sdk.AccAddress) []byte {
+	return append(TokenizeShareRecordByOwnerIndexKey, owner.Bytes()...)
+}
+
+// gets the key for the liquid shares of a validator
+// VALUE: sdk.Dec
+func GetValidatorLiquidSharesKey(valAddr sdk.ValAddress) []byte {
+	return append(ValidatorLiquidSharesKey, valAddr.Bytes()...)
+}
+
+// gets the index-key for a tokenize share record, stored by the address
+// holding its delegation
+// VALUE: tokenize share record ID (big endian uint64)
+func GetTokenizeShareRecordByDelegatorIndexKey(delAddr sdk.AccAddress) []byte {
+	return append(TokenizeShareRecordByDelegatorIndexKey, delAddr.Bytes()...)
+}
@@ -16,6 +16,9 @@ var (
 	_ sdk.Msg = &MsgDelegate{}
 	_ sdk.Msg = &MsgUndelegate{}
 	_ sdk.Msg = &MsgBeginRedelegate{}
+	_ sdk.Msg = &MsgTokenizeShares{}
+	_ sdk.Msg = &MsgRedeemTokensForShares{}
+	_ sdk.Msg = &MsgTransferTokenizeShareRecord{}
 )
 
 //______________________________________________________________________
@@ -343,3 +346,121 @@ func (msg MsgUndelegate) ValidateBasic() sdk.Error {
 	}
 	return nil
 }
+
+//______________________________________________________________________
+
+// MsgTokenizeShares - struct for converting a delegation into share tokens
+type MsgTokenizeShares struct {
+	DelegatorAddress sdk.AccAddress `json:"delegator_address" yaml:"delegator_address"`
+	ValidatorAddress sdk.ValAddress `json:"validator_address" yaml:"validator_address"`
+	Amount           sdk.Coin       `json:"amount" yaml:"amount"`
+}
+
+func NewMsgTokenizeShares(delAddr sdk.AccAddress, valAddr sdk.ValAddress, amount sdk.Coin) MsgTokenizeShares {
+	return MsgTokenizeShares{
+		DelegatorAddress: delAddr,
+		ValidatorAddress: valAddr,
+		Amount:           amount,
+	}
+}
+
+//nolint
+func (msg MsgTokenizeShares) Route() string                { return RouterKey }
+func (msg MsgTokenizeShares) Type() string                 { return "tokenize_shares" }
+func (msg MsgTokenizeShares) GetSigners() []sdk.AccAddress { return []sdk.AccAddress{msg.DelegatorAddress} }
+
+// get the bytes for the message signer to sign on
+func (msg MsgTokenizeShares) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// quick validity check
+func (msg MsgTokenizeShares) ValidateBasic() sdk.Error {
+	if msg.DelegatorAddress.Empty() {
+		return ErrNilDelegatorAddr(DefaultCodespace)
+	}
+	if msg.ValidatorAddress.Empty() {
+		return ErrNilValidatorAddr(DefaultCodespace)
+	}
+	if msg.Amount.Amount.LTE(sdk.ZeroInt()) {
+		return ErrBadSharesAmount(DefaultCodespace)
+	}
+	return nil
+}
+
+// MsgRedeemTokensForShares - struct for converting share tokens back into a
+// delegation
+type MsgRedeemTokensForShares struct {
+	DelegatorAddress sdk.AccAddress `json:"delegator_address" yaml:"delegator_address"`
+	Amount           sdk.Coin       `json:"amount" yaml:"amount"`
+}
+
+func NewMsgRedeemTokensForShares(delAddr sdk.AccAddress, amount sdk.Coin) MsgRedeemTokensForShares {
+	return MsgRedeemTokensForShares{
+		DelegatorAddress: delAddr,
+		Amount:           amount,
+	}
+}
+
+//nolint
+func (msg MsgRedeemTokensForShares) Route() string { return RouterKey }
+func (msg MsgRedeemTokensForShares) Type() string  { return "redeem_tokens_for_shares" }
+func (msg MsgRedeemTokensForShares) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.DelegatorAddress}
+}
+
+// get the bytes for the message signer to sign on
+func (msg MsgRedeemTokensForShares) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// quick validity check
+func (msg MsgRedeemTokensForShares) ValidateBasic() sdk.Error {
+	if msg.DelegatorAddress.Empty() {
+		return ErrNilDelegatorAddr(DefaultCodespace)
+	}
+	if !msg.Amount.IsValid() || !msg.Amount.IsPositive() {
+		return ErrBadSharesAmount(DefaultCodespace)
+	}
+	return nil
+}
+
+// MsgTransferTokenizeShareRecord - struct for transferring the ownership of a
+// tokenize share record, and with it its rewards
+type MsgTransferTokenizeShareRecord struct {
+	TokenizeShareRecordID uint64         `json:"tokenize_share_record_id" yaml:"tokenize_share_record_id"`
+	Sender                sdk.AccAddress `json:"sender" yaml:"sender"`
+	NewOwner              sdk.AccAddress `json:"new_owner" yaml:"new_owner"`
+}
+
+func NewMsgTransferTokenizeShareRecord(recordID uint64, sender, newOwner sdk.AccAddress) MsgTransferTokenizeShareRecord {
+	return MsgTransferTokenizeShareRecord{
+		TokenizeShareRecordID: recordID,
+		Sender:                sender,
+		NewOwner:              newOwner,
+	}
+}
+
+//nolint
+func (msg MsgTransferTokenizeShareRecord) Route() string                { return RouterKey }
+func (msg MsgTransferTokenizeShareRecord) Type() string                 { return "transfer_tokenize_share_record" }
+func (msg MsgTransferTokenizeShareRecord) GetSigners() []sdk.AccAddress { return []sdk.AccAddress{msg.Sender} }
+
+// get the bytes for the message signer to sign on
+func (msg MsgTransferTokenizeShareRecord) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// quick validity check
+func (msg MsgTransferTokenizeShareRecord) ValidateBasic() sdk.Error {
+	if msg.Sender.Empty() {
+		return sdk.ErrInvalidAddress("missing sender address")
+	}
+	if msg.NewOwner.Empty() {
+		return sdk.ErrInvalidAddress("missing new owner address")
+	}
+	return nil
+}
@@ -24,12 +24,26 @@ const (
 	DefaultMaxEntries uint16 = 7
 )
 
+// Liquid staking caps default values, a cap of 100% disables the check
+var (
+	// DefaultGlobalLiquidStakingCap is the default maximum fraction of the
+	// total bonded tokens that can be tokenized
+	DefaultGlobalLiquidStakingCap = sdk.OneDec()
+
+	// DefaultValidatorLiquidStakingCap is the default maximum fraction of a
+	// validator's delegator shares that can be tokenized
+	DefaultValidatorLiquidStakingCap = sdk.OneDec()
+)
+
 // nolint - Keys for parameter access
 var (
 	KeyUnbondingTime = []byte("UnbondingTime")
 	KeyMaxValidators = []byte("MaxValidators")
 	KeyMaxEntries    = []byte("KeyMaxEntries")
 	KeyBondDenom     = []byte("BondDenom")
+
+	KeyGlobalLiquidStakingCap    = []byte("GlobalLiquidStakingCap")
+	KeyValidatorLiquidStakingCap = []byte("ValidatorLiquidStakingCap")
 )
 
 var _ params.ParamSet = (*Params)(nil)
@@ -41,17 +55,22 @@ type Params struct {
 	MaxEntries    uint16        `json:"max_entries" yaml:"max_entries"`       // max entries for either unbonding delegation or redelegation (per pair/trio)
 	// note: we need to be a bit careful about potential overflow here, since this is user-determined
 	BondDenom string `json:"bond_denom" yaml:"bond_denom"` // bondable coin denomination
+
+	GlobalLiquidStakingCap    sdk.Dec `json:"global_liquid_staking_cap" yaml:"global_liquid_staking_cap"`       // maximum fraction of the total bonded tokens held by tokenized delegations
+	ValidatorLiquidStakingCap sdk.Dec `json:"validator_liquid_staking_cap" yaml:"validator_liquid_staking_cap"` // maximum fraction of a validator's delegator shares held by tokenized delegations
 }
 
 // NewParams creates a new Params instance
 func NewParams(unbondingTime time.Duration, maxValidators, maxEntries uint16,
-	bondDenom string) Params {
+	bondDenom string, globalLiquidStakingCap, validatorLiquidStakingCap sdk.Dec) Params {
 
 	return Params{
-		UnbondingTime: unbondingTime,
-		MaxValidators: maxValidators,
-		MaxEntries:    maxEntries,
-		BondDenom:     bondDenom,
+		UnbondingTime:             unbondingTime,
+		MaxValidators:             maxValidators,
+		MaxEntries:                maxEntries,
+		BondDenom:                 bondDenom,
+		GlobalLiquidStakingCap:    globalLiquidStakingCap,
+		ValidatorLiquidStakingCap: validatorLiquidStakingCap,
 	}
 }
 
@@ -62,6 +81,8 @@ func (p *Params) ParamSetPairs() params.ParamSetPairs {
 		{Key: KeyMaxValidators, Value: &p.MaxValidators},
 		{Key: KeyMaxEntries, Value: &p.MaxEntries},
 		{Key: KeyBondDenom, Value: &p.BondDenom},
+		{Key: KeyGlobalLiquidStakingCap, Value: &p.GlobalLiquidStakingCap},
+		{Key: KeyValidatorLiquidStakingCap, Value: &p.ValidatorLiquidStakingCap},
 	}
 }
 
@@ -75,17 +96,21 @@ func (p Params) Equal(p2 Params) bool {
 
 // DefaultParams returns a default set of parameters.
 func DefaultParams() Params {
-	return NewParams(DefaultUnbondingTime, DefaultMaxValidators, DefaultMaxEntries, sdk.DefaultBondDenom)
+	return NewParams(DefaultUnbondingTime, DefaultMaxValidators, DefaultMaxEntries, sdk.DefaultBondDenom,
+		DefaultGlobalLiquidStakingCap, DefaultValidatorLiquidStakingCap)
 }
 
 // String returns a human readable string representation of the parameters.
 func (p Params) String() string {
 	return fmt.Sprintf(`Params:
-  Unbonding Time:    %s
-  Max Validators:    %d
-  Max Entries:       %d
-  Bonded Coin Denom: %s`, p.UnbondingTime,
-		p.MaxValidators, p.MaxEntries, p.BondDenom)
+  Unbonding Time:               %s
+  Max Validators:               %d
+  Max Entries:                  %d
+  Bonded Coin Denom:            %s
+  Global Liquid Staking Cap:    %s
+  Validator Liquid Staking Cap: %s`, p.UnbondingTime,
+		p.MaxValidators, p.MaxEntries, p.BondDenom,
+		p.GlobalLiquidStakingCap, p.ValidatorLiquidStakingCap)
 }
 
 // unmarshal the current staking params value from store key or panic
@@ -114,5 +139,21 @@ func (p Params) Validate() error {
 	if p.MaxValidators == 0 {
 		return fmt.Errorf("staking parameter MaxValidators must be a positive integer")
 	}
+	if err := validateLiquidStakingCap("GlobalLiquidStakingCap", p.GlobalLiquidStakingCap); err != nil {
+		return err
+	}
+	if err := validateLiquidStakingCap("ValidatorLiquidStakingCap", p.ValidatorLiquidStakingCap); err != nil {
+		return err
+	}
+	return nil
+}
+
+func validateLiquidStakingCap(name string, liquidStakingCap sdk.Dec) error {
+	if liquidStakingCap.IsNil() {
+		return fmt.Errorf("staking parameter %s must be set", name)
+	}
+	if liquidStakingCap.IsNegative() || liquidStakingCap.GT(sdk.OneDec()) {
+		return fmt.Errorf("staking parameter %s must be between 0 and 1, is %s", name, liquidStakingCap)
+	}
 	return nil
 }
@@ -20,6 +20,8 @@ const (
 	QueryDelegatorValidator            = "delegatorValidator"
 	QueryPool                          = "pool"
 	QueryParameters                    = "parameters"
+	QueryTokenizeShareRecordsByOwner   = "tokenizeShareRecordsByOwner"
+	QueryTokenizeShareRecordByDenom    = "tokenizeShareRecordByDenom"
 )
 
 // defines the params for the following queries:
@@ -96,3 +98,27 @@ type QueryValidatorsParams struct {
 func NewQueryValidatorsParams(page, limit int, status string) QueryValidatorsParams {
 	return QueryValidatorsParams{page, limit, status}
 }
+
+// QueryTokenizeShareRecordsParams defines the params for the following queries:
+// - 'custom/staking/tokenizeShareRecordsByOwner'
+type QueryTokenizeShareRecordsParams struct {
+	Owner sdk.AccAddress
+}
+
+func NewQueryTokenizeShareRecordsParams(owner sdk.AccAddress) QueryTokenizeShareRecordsParams {
+	return QueryTokenizeShareRecordsParams{
+		Owner: owner,
+	}
+}
+
+// QueryTokenizeShareRecordParams defines the params for the following queries:
+// - 'custom/staking/tokenizeShareRecordByDenom'
+type QueryTokenizeShareRecordParams struct {
+	Denom string
+}
+
+func NewQueryTokenizeShareRecordParams(denom string) QueryTokenizeShareRecordParams {
+	return QueryTokenizeShareRecordParams{
+		Denom: denom,
+	}
+}
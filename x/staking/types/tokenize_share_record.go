@@ -0,0 +1,93 @@
+package types
+
+import (
+	"fmt"
+
+	"github.com/tendermint/tendermint/crypto"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// TokenizeShareRecordAccountPrefix is the prefix of the name from which the
+	// address holding the delegation of a tokenize share record is derived.
+	TokenizeShareRecordAccountPrefix = "tokenizeshare_"
+
+	// ShareTokenDenomPrefix is the prefix of the denomination of the share
+	// tokens of a tokenize share record, followed by the record ID.
+	ShareTokenDenomPrefix = "share"
+)
+
+// TokenizeShareRecord links the share tokens minted for a tokenized
+// delegation to the validator the delegation is bonded to and to the account
+// entitled to its rewards. The delegation itself is held by an address
+// derived from the record ID, see GetDelegatorAddress.
+type TokenizeShareRecord struct {
+	ID        uint64         `json:"id" yaml:"id"`
+	Owner     sdk.AccAddress `json:"owner" yaml:"owner"`
+	Validator sdk.ValAddress `json:"validator" yaml:"validator"`
+}
+
+// NewTokenizeShareRecord creates a new tokenize share record
+func NewTokenizeShareRecord(id uint64, owner sdk.AccAddress, validator sdk.ValAddress) TokenizeShareRecord {
+	return TokenizeShareRecord{
+		ID:        id,
+		Owner:     owner,
+		Validator: validator,
+	}
+}
+
+// GetDelegatorAddress returns the address holding the tokenized delegation.
+// No private key exists for it, so the delegation can only be moved through
+// the record's share tokens.
+func (r TokenizeShareRecord) GetDelegatorAddress() sdk.AccAddress {
+	name := fmt.Sprintf("%s%d", TokenizeShareRecordAccountPrefix, r.ID)
+	return sdk.AccAddress(crypto.AddressHash([]byte(name)))
+}
+
+// GetShareTokenDenom returns the denomination of the share tokens minted for
+// the record, in the form share<recordID>, which fits the coin denomination
+// format for up to 11 digit IDs.
+func (r TokenizeShareRecord) GetShareTokenDenom() string {
+	return fmt.Sprintf("%s%d", ShareTokenDenomPrefix, r.ID)
+}
+
+// String returns a human readable string representation of a TokenizeShareRecord.
+func (r TokenizeShareRecord) String() string {
+	return fmt.Sprintf(`Tokenize Share Record %d:
+  Owner:       %s
+  Validator:   %s
+  Delegator:   %s
+  Share Denom: %s`, r.ID, r.Owner, r.Validator, r.GetDelegatorAddress(), r.GetShareTokenDenom())
+}
+
+// TokenizeShareRecords is a collection of tokenize share records
+type TokenizeShareRecords []TokenizeShareRecord
+
+func (rs TokenizeShareRecords) String() (out string) {
+	for _, r := range rs {
+		out += r.String() + "\n"
+	}
+	return out
+}
+
+// return the tokenize share record
+func MustMarshalTokenizeShareRecord(cdc codec.Marshaler, record TokenizeShareRecord) []byte {
+	return cdc.MustMarshalBinaryLengthPrefixed(&record)
+}
+
+// unmarshal a tokenize share record from a store value
+func MustUnmarshalTokenizeShareRecord(cdc codec.Marshaler, value []byte) TokenizeShareRecord {
+	record, err := UnmarshalTokenizeShareRecord(cdc, value)
+	if err != nil {
+		panic(err)
+	}
+	return record
+}
+
+// unmarshal a tokenize share record from a store value
+func UnmarshalTokenizeShareRecord(cdc codec.Marshaler, value []byte) (record TokenizeShareRecord, err error) {
+	err = cdc.UnmarshalBinaryLengthPrefixed(value, &record)
+	return record, err
+}
@@ -6,8 +6,10 @@ import (
 	"strings"
 	"time"
 
+	gogotypes "github.com/gogo/protobuf/types"
 	abci "github.com/tendermint/tendermint/abci/types"
 	"github.com/tendermint/tendermint/crypto"
+	cryptoamino "github.com/tendermint/tendermint/crypto/encoding/amino"
 	tmtypes "github.com/tendermint/tendermint/types"
 	yaml "gopkg.in/yaml.v2"
 
@@ -120,12 +122,12 @@ func NewValidator(operator sdk.ValAddress, pubKey crypto.PubKey, description Des
 }
 
 // return the redelegation
-func MustMarshalValidator(cdc *codec.Codec, validator Validator) []byte {
-	return cdc.MustMarshalBinaryLengthPrefixed(validator)
+func MustMarshalValidator(cdc codec.Marshaler, validator Validator) []byte {
+	return cdc.MustMarshalBinaryLengthPrefixed(&validator)
 }
 
 // unmarshal a redelegation from a store value
-func MustUnmarshalValidator(cdc *codec.Codec, value []byte) Validator {
+func MustUnmarshalValidator(cdc codec.Marshaler, value []byte) Validator {
 	validator, err := UnmarshalValidator(cdc, value)
 	if err != nil {
 		panic(err)
@@ -134,11 +136,131 @@ func MustUnmarshalValidator(cdc *codec.Codec, value []byte) Validator {
 }
 
 // unmarshal a redelegation from a store value
-func UnmarshalValidator(cdc *codec.Codec, value []byte) (validator Validator, err error) {
+func UnmarshalValidator(cdc codec.Marshaler, value []byte) (validator Validator, err error) {
 	err = cdc.UnmarshalBinaryLengthPrefixed(value, &validator)
 	return validator, err
 }
 
+// Marshal implements codec.ProtoMarshaler. The encoding is written by hand
+// since the consensus public key is an interface, which is stored with its
+// amino encoding.
+func (v *Validator) Marshal() ([]byte, error) {
+	tokens, err := v.Tokens.Marshal()
+	if err != nil {
+		return nil, err
+	}
+	shares, err := v.DelegatorShares.Marshal()
+	if err != nil {
+		return nil, err
+	}
+	minSelfDelegation, err := v.MinSelfDelegation.Marshal()
+	if err != nil {
+		return nil, err
+	}
+	unbondingTime, err := gogotypes.TimestampProto(v.UnbondingCompletionTime)
+	if err != nil {
+		return nil, err
+	}
+
+	bz := codec.AppendProtoBytes(nil, 1, v.OperatorAddress)
+	if v.ConsPubKey != nil {
+		bz = codec.AppendProtoBytes(bz, 2, v.ConsPubKey.Bytes())
+	}
+	if v.Jailed {
+		bz = codec.AppendProtoVarint(bz, 3, 1)
+	}
+	bz = codec.AppendProtoVarint(bz, 4, uint64(v.Status))
+	bz = codec.AppendProtoBytes(bz, 5, tokens)
+	bz = codec.AppendProtoBytes(bz, 6, shares)
+	if bz, err = codec.AppendProtoMessage(bz, 7, &v.Description); err != nil {
+		return nil, err
+	}
+	bz = codec.AppendProtoVarint(bz, 8, uint64(v.UnbondingHeight))
+	if bz, err = codec.AppendProtoMessage(bz, 9, unbondingTime); err != nil {
+		return nil, err
+	}
+	if bz, err = codec.AppendProtoMessage(bz, 10, &v.Commission); err != nil {
+		return nil, err
+	}
+	bz = codec.AppendProtoBytes(bz, 11, minSelfDelegation)
+
+	return bz, nil
+}
+
+// MarshalTo implements codec.ProtoMarshaler.
+func (v *Validator) MarshalTo(data []byte) (int, error) {
+	bz, err := v.Marshal()
+	if err != nil {
+		return 0, err
+	}
+
+	return copy(data, bz), nil
+}
+
+// Size implements codec.ProtoMarshaler.
+func (v *Validator) Size() int {
+	bz, _ := v.Marshal()
+	return len(bz)
+}
+
+// Unmarshal implements codec.ProtoMarshaler.
+func (v *Validator) Unmarshal(bz []byte) error {
+	*v = Validator{}
+
+	for len(bz) > 0 {
+		field, value, data, n, err := codec.DecodeProtoField(bz)
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+
+		switch field {
+		case 1:
+			v.OperatorAddress = append(sdk.ValAddress{}, data...)
+		case 2:
+			if v.ConsPubKey, err = cryptoamino.PubKeyFromBytes(data); err != nil {
+				return err
+			}
+		case 3:
+			v.Jailed = value != 0
+		case 4:
+			v.Status = sdk.BondStatus(value)
+		case 5:
+			if err := v.Tokens.Unmarshal(data); err != nil {
+				return err
+			}
+		case 6:
+			if err := v.DelegatorShares.Unmarshal(data); err != nil {
+				return err
+			}
+		case 7:
+			if err := v.Description.Unmarshal(data); err != nil {
+				return err
+			}
+		case 8:
+			v.UnbondingHeight = int64(value)
+		case 9:
+			var ts gogotypes.Timestamp
+			if err := ts.Unmarshal(data); err != nil {
+				return err
+			}
+			if v.UnbondingCompletionTime, err = gogotypes.TimestampFromProto(&ts); err != nil {
+				return err
+			}
+		case 10:
+			if err := v.Commission.Unmarshal(data); err != nil {
+				return err
+			}
+		case 11:
+			if err := v.MinSelfDelegation.Unmarshal(data); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
 // String returns a human readable string representation of a validator.
 func (v Validator) String() string {
 	bechConsPubKey, err := sdk.Bech32ifyConsPub(v.ConsPubKey)
@@ -279,6 +401,13 @@ func NewDescription(moniker, identity, website, securityContact, details string)
 	}
 }
 
+// String implements the Stringer interface for a Description.
+func (d Description) String() string {
+	return fmt.Sprintf("moniker: %s, identity: %s, website: %s, securityContact: %s, details: %s",
+		d.Moniker, d.Identity, d.Website, d.SecurityContact, d.Details,
+	)
+}
+
 // UpdateDescription updates the fields of a given description. An error is
 // returned if the resulting description contains an invalid length.
 func (d Description) UpdateDescription(d2 Description) (Description, sdk.Error) {
@@ -0,0 +1,167 @@
+package upgrade_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/cosmos/cosmos-sdk/x/upgrade"
+)
+
+type testInput struct {
+	keeper  upgrade.Keeper
+	handler govtypes.Handler
+	ctx     sdk.Context
+}
+
+func setupTest(height int64) testInput {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, abci.Header{Height: height, Time: time.Now()})
+
+	return testInput{
+		keeper:  app.UpgradeKeeper,
+		handler: upgrade.NewSoftwareUpgradeProposalHandler(app.UpgradeKeeper),
+		ctx:     ctx,
+	}
+}
+
+func (ti testInput) beginBlockAt(height int64, t time.Time) sdk.Context {
+	ctx := ti.ctx.WithBlockHeight(height).WithBlockTime(t)
+	upgrade.BeginBlocker(ti.keeper, ctx, abci.RequestBeginBlock{Header: ctx.BlockHeader()})
+	return ctx
+}
+
+func TestRequireName(t *testing.T) {
+	ti := setupTest(10)
+
+	err := ti.handler(ti.ctx, upgrade.SoftwareUpgradeProposal{Title: "prop", Plan: upgrade.Plan{Height: 20}})
+	require.NotNil(t, err)
+	require.Equal(t, upgrade.CodeInvalidPlan, err.Code())
+}
+
+func TestRequireFutureTimeAndHeight(t *testing.T) {
+	ti := setupTest(10)
+
+	err := ti.handler(ti.ctx, upgrade.SoftwareUpgradeProposal{Title: "prop", Plan: upgrade.Plan{Name: "test", Time: ti.ctx.BlockTime()}})
+	require.NotNil(t, err)
+	require.Equal(t, upgrade.CodeInvalidPlan, err.Code())
+
+	err = ti.handler(ti.ctx, upgrade.SoftwareUpgradeProposal{Title: "prop", Plan: upgrade.Plan{Name: "test", Height: ti.ctx.BlockHeight()}})
+	require.NotNil(t, err)
+	require.Equal(t, upgrade.CodeInvalidPlan, err.Code())
+}
+
+func TestHaltIfTooNew(t *testing.T) {
+	ti := setupTest(10)
+
+	called := 0
+	ti.keeper.SetUpgradeHandler("future", func(ctx sdk.Context, plan upgrade.Plan) {
+		called++
+	})
+
+	// a handler for an unscheduled upgrade is ignored
+	require.NotPanics(t, func() {
+		ti.beginBlockAt(11, ti.ctx.BlockTime())
+	})
+	require.Equal(t, 0, called)
+
+	err := ti.handler(ti.ctx, upgrade.SoftwareUpgradeProposal{Title: "prop", Plan: upgrade.Plan{Name: "future", Height: 13}})
+	require.Nil(t, err)
+
+	// the binary knows the upgrade before it is due
+	require.Panics(t, func() {
+		ti.beginBlockAt(12, ti.ctx.BlockTime())
+	})
+	require.Equal(t, 0, called)
+
+	ctx := ti.beginBlockAt(13, ti.ctx.BlockTime())
+	require.Equal(t, 1, called)
+
+	_, havePlan := ti.keeper.GetUpgradePlan(ctx)
+	require.False(t, havePlan)
+	require.Equal(t, int64(13), ti.keeper.GetDoneHeight(ctx, "future"))
+}
+
+func TestCanOverwriteScheduleUpgrade(t *testing.T) {
+	ti := setupTest(10)
+
+	err := ti.handler(ti.ctx, upgrade.SoftwareUpgradeProposal{Title: "prop", Plan: upgrade.Plan{Name: "bad_test", Height: 20}})
+	require.Nil(t, err)
+	err = ti.handler(ti.ctx, upgrade.SoftwareUpgradeProposal{Title: "prop", Plan: upgrade.Plan{Name: "test", Height: 15}})
+	require.Nil(t, err)
+
+	plan, havePlan := ti.keeper.GetUpgradePlan(ti.ctx)
+	require.True(t, havePlan)
+	require.Equal(t, "test", plan.Name)
+	require.Equal(t, int64(15), plan.Height)
+}
+
+func TestHaltAtHeightWithoutHandler(t *testing.T) {
+	ti := setupTest(10)
+
+	err := ti.handler(ti.ctx, upgrade.SoftwareUpgradeProposal{Title: "prop", Plan: upgrade.Plan{Name: "test", Height: 15}})
+	require.Nil(t, err)
+
+	require.NotPanics(t, func() {
+		ti.beginBlockAt(14, ti.ctx.BlockTime())
+	})
+	require.Panics(t, func() {
+		ti.beginBlockAt(15, ti.ctx.BlockTime())
+	})
+
+	ti.keeper.SetUpgradeHandler("test", func(ctx sdk.Context, plan upgrade.Plan) {})
+	require.NotPanics(t, func() {
+		ctx := ti.beginBlockAt(15, ti.ctx.BlockTime())
+		require.Equal(t, int64(15), ti.keeper.GetDoneHeight(ctx, "test"))
+	})
+}
+
+func TestHaltAtTimeWithoutHandler(t *testing.T) {
+	ti := setupTest(10)
+
+	upgradeTime := ti.ctx.BlockTime().Add(time.Hour)
+	err := ti.handler(ti.ctx, upgrade.SoftwareUpgradeProposal{Title: "prop", Plan: upgrade.Plan{Name: "test", Time: upgradeTime}})
+	require.Nil(t, err)
+
+	require.NotPanics(t, func() {
+		ti.beginBlockAt(11, upgradeTime.Add(-time.Second))
+	})
+	require.Panics(t, func() {
+		ti.beginBlockAt(12, upgradeTime)
+	})
+}
+
+func TestCantApplySameUpgradeTwice(t *testing.T) {
+	ti := setupTest(10)
+
+	ti.keeper.SetUpgradeHandler("test", func(ctx sdk.Context, plan upgrade.Plan) {})
+	err := ti.handler(ti.ctx, upgrade.SoftwareUpgradeProposal{Title: "prop", Plan: upgrade.Plan{Name: "test", Height: 11}})
+	require.Nil(t, err)
+
+	ctx := ti.beginBlockAt(11, ti.ctx.BlockTime())
+
+	err = ti.handler(ctx, upgrade.SoftwareUpgradeProposal{Title: "prop", Plan: upgrade.Plan{Name: "test", Height: 20}})
+	require.NotNil(t, err)
+	require.Equal(t, upgrade.CodeDuplicatePlan, err.Code())
+}
+
+func TestCancelUpgrade(t *testing.T) {
+	ti := setupTest(10)
+
+	err := ti.handler(ti.ctx, upgrade.SoftwareUpgradeProposal{Title: "prop", Plan: upgrade.Plan{Name: "test", Height: 15}})
+	require.Nil(t, err)
+
+	err = ti.handler(ti.ctx, upgrade.CancelSoftwareUpgradeProposal{Title: "cancel"})
+	require.Nil(t, err)
+
+	_, havePlan := ti.keeper.GetUpgradePlan(ti.ctx)
+	require.False(t, havePlan)
+	require.NotPanics(t, func() {
+		ti.beginBlockAt(15, ti.ctx.BlockTime())
+	})
+}
@@ -0,0 +1,61 @@
+// nolint
+// autogenerated code using github.com/rigelrozanski/multitool
+// aliases generated for the following subdirectories:
+// ALIASGEN: github.com/cosmos/cosmos-sdk/x/upgrade/internal/keeper
+// ALIASGEN: github.com/cosmos/cosmos-sdk/x/upgrade/internal/types
+package upgrade
+
+import (
+	"github.com/cosmos/cosmos-sdk/x/upgrade/internal/keeper"
+	"github.com/cosmos/cosmos-sdk/x/upgrade/internal/types"
+)
+
+const (
+	ModuleName                        = types.ModuleName
+	RouterKey                         = types.RouterKey
+	StoreKey                          = types.StoreKey
+	QuerierRoute                      = types.QuerierRoute
+	PlanByte                          = types.PlanByte
+	DoneByte                          = types.DoneByte
+	ProposalTypeSoftwareUpgrade       = types.ProposalTypeSoftwareUpgrade
+	ProposalTypeCancelSoftwareUpgrade = types.ProposalTypeCancelSoftwareUpgrade
+	QueryCurrent                      = types.QueryCurrent
+	QueryApplied                      = types.QueryApplied
+	DefaultCodespace                  = types.DefaultCodespace
+	CodeInvalidPlan                   = types.CodeInvalidPlan
+	CodeNoUpgradePlan                 = types.CodeNoUpgradePlan
+	CodeDuplicatePlan                 = types.CodeDuplicatePlan
+	CodeUpgradeNotFound               = types.CodeUpgradeNotFound
+	EventTypeUpgrade                  = types.EventTypeUpgrade
+	AttributeKeyName                  = types.AttributeKeyName
+	AttributeKeyHeight                = types.AttributeKeyHeight
+)
+
+var (
+	// functions aliases
+	NewKeeper                        = keeper.NewKeeper
+	NewQuerier                       = keeper.NewQuerier
+	RegisterCodec                    = types.RegisterCodec
+	ErrInvalidPlan                   = types.ErrInvalidPlan
+	ErrNoUpgradePlan                 = types.ErrNoUpgradePlan
+	ErrDuplicatePlan                 = types.ErrDuplicatePlan
+	ErrUpgradeNotFound               = types.ErrUpgradeNotFound
+	PlanKey                          = types.PlanKey
+	DoneKey                          = types.DoneKey
+	NewPlan                          = types.NewPlan
+	NewSoftwareUpgradeProposal       = types.NewSoftwareUpgradeProposal
+	NewCancelSoftwareUpgradeProposal = types.NewCancelSoftwareUpgradeProposal
+	NewQueryAppliedParams            = types.NewQueryAppliedParams
+
+	// variable aliases
+	ModuleCdc = types.ModuleCdc
+)
+
+type (
+	Keeper                        = keeper.Keeper
+	UpgradeHandler                = types.UpgradeHandler
+	Plan                          = types.Plan
+	SoftwareUpgradeProposal       = types.SoftwareUpgradeProposal
+	CancelSoftwareUpgradeProposal = types.CancelSoftwareUpgradeProposal
+	QueryAppliedParams            = types.QueryAppliedParams
+)
@@ -0,0 +1,102 @@
+package cli
+
+import (
+	"encoding/binary"
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/x/upgrade/internal/types"
+)
+
+// GetQueryCmd returns the cli query commands for the upgrade module.
+func GetQueryCmd(cdc *codec.Codec) *cobra.Command {
+	upgradeQueryCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Querying commands for the upgrade module",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	upgradeQueryCmd.AddCommand(
+		client.GetCommands(
+			GetCmdQueryPlan(cdc),
+			GetCmdQueryApplied(cdc),
+		)...,
+	)
+
+	return upgradeQueryCmd
+}
+
+// GetCmdQueryPlan implements a command to return the currently scheduled
+// upgrade plan, if any.
+func GetCmdQueryPlan(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "plan",
+		Short: "Query the upgrade plan (if one exists)",
+		Long:  "Gets the currently scheduled upgrade plan, if one exists",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			route := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryCurrent)
+			res, _, err := cliCtx.QueryWithData(route, nil)
+			if err != nil {
+				return err
+			}
+
+			if len(res) == 0 {
+				return fmt.Errorf("no upgrade scheduled")
+			}
+
+			var plan types.Plan
+			if err := cdc.UnmarshalJSON(res, &plan); err != nil {
+				return err
+			}
+
+			return cliCtx.PrintOutput(plan)
+		},
+	}
+}
+
+// GetCmdQueryApplied implements a command to return the height at which a
+// named upgrade was applied.
+func GetCmdQueryApplied(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "applied [upgrade-name]",
+		Short: "Query the block height at which a completed upgrade was applied",
+		Long: "If upgrade-name was previously executed on the chain, this returns the height at which it was applied. " +
+			"This helps a client determine which binary was valid over a given range of blocks.",
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			bz, err := cdc.MarshalJSON(types.NewQueryAppliedParams(args[0]))
+			if err != nil {
+				return err
+			}
+
+			route := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryApplied)
+			res, _, err := cliCtx.QueryWithData(route, bz)
+			if err != nil {
+				return err
+			}
+
+			if len(res) == 0 {
+				return fmt.Errorf("no upgrade found with name %s", args[0])
+			}
+
+			if len(res) != 8 {
+				return fmt.Errorf("unknown format for applied-upgrade")
+			}
+
+			applied := int64(binary.BigEndian.Uint64(res))
+			fmt.Println(applied)
+			return nil
+		},
+	}
+}
@@ -0,0 +1,152 @@
+package cli
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/cosmos/cosmos-sdk/x/auth/client/utils"
+	govcli "github.com/cosmos/cosmos-sdk/x/gov/client/cli"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/cosmos/cosmos-sdk/x/upgrade/internal/types"
+)
+
+// nolint
+const (
+	// TimeFormat specifies ISO UTC format for submitting the time for a new upgrade proposal
+	TimeFormat = "2006-01-02T15:04:05Z"
+
+	FlagUpgradeHeight = "upgrade-height"
+	FlagUpgradeTime   = "upgrade-time"
+	FlagUpgradeInfo   = "upgrade-info"
+)
+
+// GetCmdSubmitUpgradeProposal implements a command handler for submitting a
+// software upgrade proposal transaction.
+func GetCmdSubmitUpgradeProposal(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "software-upgrade [name] (--upgrade-height [height] | --upgrade-time [time]) (--upgrade-info [info]) [flags]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Submit a software upgrade proposal",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Submit a software upgrade proposal along with an initial deposit.
+The upgrade is scheduled either at a given block height or at a given UTC time
+(in the %s format), and the name must match the upgrade handler registered in
+the new binary.
+
+Example:
+$ %s tx gov submit-proposal software-upgrade v0.38 --upgrade-height=200000 --upgrade-info="https://example.com/v0.38" --title="Upgrade to v0.38" --description="Some description" --deposit="10000stake" --from=<key_or_address>
+`,
+				TimeFormat, version.ClientName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			txBldr := auth.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			content, err := parseSubmitUpgradeFlags(args[0])
+			if err != nil {
+				return err
+			}
+
+			deposit, err := sdk.ParseCoins(viper.GetString(govcli.FlagDeposit))
+			if err != nil {
+				return err
+			}
+
+			msg := govtypes.NewMsgSubmitProposal(content, deposit, cliCtx.GetFromAddress())
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+
+	cmd.Flags().String(govcli.FlagTitle, "", "title of proposal")
+	cmd.Flags().String(govcli.FlagDescription, "", "description of proposal")
+	cmd.Flags().String(govcli.FlagDeposit, "", "deposit of proposal")
+	cmd.Flags().Int64(FlagUpgradeHeight, 0, "The height at which the upgrade must happen (not to be used together with --upgrade-time)")
+	cmd.Flags().String(FlagUpgradeTime, "", fmt.Sprintf("The time at which the upgrade must happen (ex. %s) (not to be used together with --upgrade-height)", TimeFormat))
+	cmd.Flags().String(FlagUpgradeInfo, "", "Optional info for the planned upgrade such as commit hash, etc.")
+
+	return cmd
+}
+
+// GetCmdSubmitCancelUpgradeProposal implements a command handler for
+// submitting a software upgrade cancellation proposal transaction.
+func GetCmdSubmitCancelUpgradeProposal(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cancel-software-upgrade [flags]",
+		Args:  cobra.NoArgs,
+		Short: "Submit a proposal to cancel a scheduled software upgrade",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Cancel a software upgrade along with an initial deposit.
+
+Example:
+$ %s tx gov submit-proposal cancel-software-upgrade --title="Cancel v0.38" --description="Some description" --deposit="10000stake" --from=<key_or_address>
+`,
+				version.ClientName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			txBldr := auth.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			deposit, err := sdk.ParseCoins(viper.GetString(govcli.FlagDeposit))
+			if err != nil {
+				return err
+			}
+
+			content := types.NewCancelSoftwareUpgradeProposal(
+				viper.GetString(govcli.FlagTitle), viper.GetString(govcli.FlagDescription),
+			)
+
+			msg := govtypes.NewMsgSubmitProposal(content, deposit, cliCtx.GetFromAddress())
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+
+	cmd.Flags().String(govcli.FlagTitle, "", "title of proposal")
+	cmd.Flags().String(govcli.FlagDescription, "", "description of proposal")
+	cmd.Flags().String(govcli.FlagDeposit, "", "deposit of proposal")
+
+	return cmd
+}
+
+func parseSubmitUpgradeFlags(name string) (govtypes.Content, error) {
+	height := viper.GetInt64(FlagUpgradeHeight)
+	timeStr := viper.GetString(FlagUpgradeTime)
+
+	if height != 0 && len(timeStr) != 0 {
+		return nil, fmt.Errorf("only one of --%s or --%s should be specified", FlagUpgradeTime, FlagUpgradeHeight)
+	}
+
+	var upgradeTime time.Time
+	if len(timeStr) != 0 {
+		t, err := time.Parse(TimeFormat, timeStr)
+		if err != nil {
+			return nil, err
+		}
+		upgradeTime = t
+	}
+
+	plan := types.NewPlan(name, height, upgradeTime, viper.GetString(FlagUpgradeInfo))
+	content := types.NewSoftwareUpgradeProposal(
+		viper.GetString(govcli.FlagTitle), viper.GetString(govcli.FlagDescription), plan,
+	)
+
+	return content, nil
+}
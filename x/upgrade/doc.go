@@ -0,0 +1,54 @@
+/*
+Package upgrade provides a module for coordinating software upgrades of a live
+chain. It halts the state machine at a pre-defined block height or time, so
+that every validator switches binaries at exactly the same point, and lets the
+new binary run its in-place migrations on the first block after the switch.
+
+The module does not prescribe how an upgrade is decided upon; it only exposes
+the Plan and the SoftwareUpgradeProposal and CancelSoftwareUpgradeProposal gov
+content types that schedule and clear it.
+
+General Workflow
+
+A new release defines a named upgrade handler (eg. "v0.38") and registers it
+with the keeper during app initialization:
+
+	app.UpgradeKeeper.SetUpgradeHandler("v0.38", func(ctx sdk.Context, plan upgrade.Plan) {
+		// perform any state migrations needed for this upgrade
+	})
+
+Governance then votes on a SoftwareUpgradeProposal carrying a Plan with the
+same name and a future height or time. The old binary has no handler for the
+plan, so once the plan is due its BeginBlocker logs
+
+	UPGRADE "<Name>" NEEDED at height: <NNNN>: <Info>
+
+and panics, which stops block processing without exiting the process. When the
+node is restarted with the new binary, the handler is found, executed and the
+plan is marked as done so the name cannot be reused. A binary that registers
+the handler before the plan is due panics as well, to prevent switching too
+early.
+
+Store Migrations
+
+If the new binary renames or deletes a store, the multistore has to be migrated
+while it is loaded, before the upgrade handler runs. UpgradeStoreLoader wraps
+baseapp.StoreLoaderWithUpgrade and only applies the migrations when restarting
+right before the upgrade height:
+
+	app.SetStoreLoader(upgrade.UpgradeStoreLoader(upgradeHeight, &storetypes.StoreUpgrades{
+		Renamed: []storetypes.StoreRename{{OldKey: "foo", NewKey: "bar"}},
+	}))
+
+Migrations that are only known to the node operator can still be applied with
+baseapp.UpgradeableStoreLoader, which reads them from a JSON file on disk.
+
+Cancelling Upgrades
+
+A scheduled plan can be removed with a CancelSoftwareUpgradeProposal, or
+overwritten by passing another SoftwareUpgradeProposal. If a problem is only
+found once the plan is due, operators can restart the old binary with the
+halt-height option set to the upgrade height and agree on a new course of
+action off-chain.
+*/
+package upgrade
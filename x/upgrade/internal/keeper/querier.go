@@ -0,0 +1,61 @@
+package keeper
+
+import (
+	"encoding/binary"
+	"fmt"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/upgrade/internal/types"
+)
+
+// NewQuerier creates a querier for upgrade cli and REST endpoints
+func NewQuerier(k Keeper) sdk.Querier {
+	return func(ctx sdk.Context, path []string, req abci.RequestQuery) ([]byte, sdk.Error) {
+		switch path[0] {
+		case types.QueryCurrent:
+			return queryCurrent(ctx, k)
+
+		case types.QueryApplied:
+			return queryApplied(ctx, req, k)
+
+		default:
+			return nil, sdk.ErrUnknownRequest(fmt.Sprintf("unknown upgrade query endpoint: %s", path[0]))
+		}
+	}
+}
+
+func queryCurrent(ctx sdk.Context, k Keeper) ([]byte, sdk.Error) {
+	plan, has := k.GetUpgradePlan(ctx)
+	if !has {
+		return nil, nil
+	}
+
+	res, err := codec.MarshalJSONIndent(k.cdc, &plan)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("failed to marshal JSON", err.Error()))
+	}
+
+	return res, nil
+}
+
+func queryApplied(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, sdk.Error) {
+	var params types.QueryAppliedParams
+
+	err := k.cdc.UnmarshalJSON(req.Data, &params)
+	if err != nil {
+		return nil, sdk.ErrUnknownRequest(sdk.AppendMsgToErr("incorrectly formatted request data", err.Error()))
+	}
+
+	applied := k.GetDoneHeight(ctx, params.Name)
+	if applied == 0 {
+		return nil, nil
+	}
+
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, uint64(applied))
+
+	return bz, nil
+}
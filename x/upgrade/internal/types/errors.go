@@ -0,0 +1,41 @@
+package types
+
+// DONTCOVER
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Codes for upgrade errors
+const (
+	DefaultCodespace sdk.CodespaceType = ModuleName
+
+	CodeInvalidPlan     sdk.CodeType = 1
+	CodeNoUpgradePlan   sdk.CodeType = 2
+	CodeDuplicatePlan   sdk.CodeType = 3
+	CodeUpgradeNotFound sdk.CodeType = 4
+)
+
+// ErrInvalidPlan error for a plan that failed validation
+func ErrInvalidPlan(codespace sdk.CodespaceType, msg string) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidPlan, fmt.Sprintf("invalid upgrade plan: %s", msg))
+}
+
+// ErrNoUpgradePlan error returned when there is no scheduled upgrade plan
+func ErrNoUpgradePlan(codespace sdk.CodespaceType) sdk.Error {
+	return sdk.NewError(codespace, CodeNoUpgradePlan, "no upgrade plan is currently scheduled")
+}
+
+// ErrDuplicatePlan error returned when a plan with the given name has already
+// been applied
+func ErrDuplicatePlan(codespace sdk.CodespaceType, name string) sdk.Error {
+	return sdk.NewError(codespace, CodeDuplicatePlan, fmt.Sprintf("upgrade with name %s has already been completed", name))
+}
+
+// ErrUpgradeNotFound error returned when no upgrade with the given name has
+// been applied
+func ErrUpgradeNotFound(codespace sdk.CodespaceType, name string) sdk.Error {
+	return sdk.NewError(codespace, CodeUpgradeNotFound, fmt.Sprintf("no upgrade with name %s has been applied", name))
+}
@@ -0,0 +1,9 @@
+package types
+
+// upgrade module event types
+const (
+	EventTypeUpgrade = "upgrade"
+
+	AttributeKeyName   = "name"
+	AttributeKeyHeight = "height"
+)
@@ -0,0 +1,30 @@
+package upgrade
+
+import (
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// UpgradeStoreLoader returns a baseapp.StoreLoader that applies the given store
+// upgrades (renames and deletions) only when the node is restarted right
+// before the height at which the upgrade plan is executed, ie. when the last
+// committed height is upgradeHeight - 1. On any other restart it behaves like
+// baseapp.DefaultStoreLoader, so the migration is only ever applied once.
+//
+// For upgrades that are not known at compile time, baseapp.UpgradeableStoreLoader
+// can still be used to read the StoreUpgrades from a file on disk.
+func UpgradeStoreLoader(upgradeHeight int64, storeUpgrades *storetypes.StoreUpgrades) baseapp.StoreLoader {
+	return func(ms sdk.CommitMultiStore) error {
+		if err := baseapp.DefaultStoreLoader(ms); err != nil {
+			return err
+		}
+
+		if upgradeHeight != ms.LastCommitID().Version+1 {
+			return nil
+		}
+
+		// reload the latest version, this time applying the store migrations
+		return baseapp.StoreLoaderWithUpgrade(storeUpgrades)(ms)
+	}
+}
@@ -0,0 +1,64 @@
+package upgrade
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/store/rootmulti"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func commitStore(t *testing.T, db dbm.DB, storeKey string, k, v []byte, commits int) {
+	rs := rootmulti.NewStore(db)
+	key := sdk.NewKVStoreKey(storeKey)
+	rs.MountStoreWithDB(key, storetypes.StoreTypeIAVL, nil)
+	require.NoError(t, rs.LoadLatestVersion())
+
+	kv := rs.GetStore(key).(storetypes.KVStore)
+	kv.Set(k, v)
+	for i := 0; i < commits; i++ {
+		rs.Commit()
+	}
+}
+
+func TestUpgradeStoreLoader(t *testing.T) {
+	k, v := []byte("key"), []byte("value")
+	upgrades := &storetypes.StoreUpgrades{
+		Renamed: []storetypes.StoreRename{{OldKey: "bnk", NewKey: "bank"}},
+	}
+
+	cases := map[string]struct {
+		upgradeHeight int64
+		expectRenamed bool
+	}{
+		"restart before the upgrade height": {upgradeHeight: 4, expectRenamed: true},
+		"upgrade height already passed":     {upgradeHeight: 3, expectRenamed: false},
+		"upgrade height not yet reached":    {upgradeHeight: 10, expectRenamed: false},
+	}
+
+	for name, tc := range cases {
+		tc := tc // copy to local variable for scopelint
+		t.Run(name, func(t *testing.T) {
+			db := dbm.NewMemDB()
+			commitStore(t, db, "bnk", k, v, 3)
+
+			rs := rootmulti.NewStore(db)
+			key := sdk.NewKVStoreKey("bank")
+			rs.MountStoreWithDB(key, storetypes.StoreTypeIAVL, nil)
+
+			err := UpgradeStoreLoader(tc.upgradeHeight, upgrades)(rs)
+			require.NoError(t, err)
+			require.Equal(t, int64(3), rs.LastCommitID().Version)
+
+			kv := rs.GetStore(key).(storetypes.KVStore)
+			if tc.expectRenamed {
+				require.Equal(t, v, kv.Get(k))
+			} else {
+				require.Nil(t, kv.Get(k))
+			}
+		})
+	}
+}